@@ -25,6 +25,7 @@ const (
 	// The channel ordering
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagEncoding               = "encoding"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -76,11 +77,12 @@ via the {ordering} flag. Generates a new port identifier using the provided owne
 
 func newSendTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
-		Short: "Send an interchain account tx on the provided connection.",
+		Use:   "send-tx [connection-id|client-id] [path/to/packet_msg.json]",
+		Short: "Send an interchain account tx on the provided connection or IBC v2 client.",
 		Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed on the host chain and attempts to send the packet. 
 Packet data is provided as json, file or string. A relative timeout timestamp can be provided using the flag {packet-timeout-timestamp}. 
-An absolute timeout is calculated on chain using the current block time. If no timeout value is set then a default relative timeout value of 10 minutes is used.`),
+An absolute timeout is calculated on chain using the current block time. If no timeout value is set then a default relative timeout value of 10 minutes is used.
+When an IBC v2 client identifier is provided, the encoding of the messages can be set using the flag {encoding}.`),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -112,13 +114,20 @@ An absolute timeout is calculated on chain using the current block time. If no t
 				return err
 			}
 
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendTx(owner, connectionID, timeoutTimestamp, icaMsgData)
+			msg.Encoding = encoding
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("Encoding of the messages when sending over an IBC v2 client, can be one of: %s, %s", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
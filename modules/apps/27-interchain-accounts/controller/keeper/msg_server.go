@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
@@ -72,7 +73,14 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
 	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
 	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
-	seq, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+
+	// if the provided identifier is not a connection identifier, it is treated as an IBC v2 client identifier
+	var seq uint64
+	if connectiontypes.IsValidConnectionID(msg.ConnectionId) {
+		seq, err = s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	} else {
+		seq, err = s.sendTxV2(ctx, msg.ConnectionId, msg.Owner, portID, msg.Encoding, msg.PacketData, absoluteTimeout)
+	}
 	if err != nil {
 		return nil, err
 	}
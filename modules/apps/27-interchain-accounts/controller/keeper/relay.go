@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +13,8 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
@@ -53,6 +59,56 @@ func (k *Keeper) sendTx(ctx sdk.Context, connectionID, portID string,
 	return sequence, nil
 }
 
+// sendTxV2 sends the provided packet data to the interchain accounts host on the counterparty of the provided IBC v2 client.
+// No channel handshake is required, the interchain account is created by the host chain when the first packet is received.
+// The absolute timeoutTimestamp is provided in nanoseconds and converted to the seconds precision used by IBC v2.
+func (k *Keeper) sendTxV2(ctx sdk.Context, clientID, owner, portID, encoding string,
+	icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64,
+) (uint64, error) {
+	if !k.GetParams(ctx).ControllerEnabled {
+		return 0, types.ErrControllerSubModuleDisabled
+	}
+
+	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return 0, icatypes.ErrInvalidTimeoutTimestamp
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if encoding == "" {
+		encoding = icatypes.EncodingProtobuf
+	}
+
+	payload := channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, encoding, icaPacketData.GetBytes())
+	msg := channeltypesv2.NewMsgSendPacket(clientID, timeoutTimestamp/uint64(time.Second), owner, payload)
+
+	handler := k.msgRouter.Handler(msg)
+	if handler == nil {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "unrecognized packet type: %T", msg)
+	}
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	// Each individual sdk.Result has exactly one Msg response. We aggregate here.
+	msgResponse := res.MsgResponses[0]
+	if msgResponse == nil {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrLogic, "got nil Msg response for msg %s", sdk.MsgTypeURL(msg))
+	}
+	var sendResponse channeltypesv2.MsgSendPacketResponse
+	if err := proto.Unmarshal(msgResponse.Value, &sendResponse); err != nil {
+		return 0, err
+	}
+
+	return sendResponse.Sequence, nil
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
 // due to the semantics of ORDERED channels
 func (*Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
@@ -69,7 +70,8 @@ func NewMsgSendTx(owner, connectionID string, relativeTimeoutTimestamp uint64, p
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgSendTx) ValidateBasic() error {
-	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+	// the connection ID may also be an IBC v2 client ID
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil && !clienttypes.IsValidClientID(msg.ConnectionId) {
 		return errorsmod.Wrap(err, "invalid connection ID")
 	}
 
@@ -113,6 +113,13 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			func() {},
 			nil,
 		},
+		{
+			"success: v2 client id",
+			func() {
+				msg.ConnectionId = "08-wasm-0"
+			},
+			nil,
+		},
 		{
 			"connection id is invalid",
 			func() {
@@ -119,6 +119,10 @@ type MsgSendTx struct {
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// Encoding of the messages contained in the packet data. This is only used when the connection_id is
+	// an IBC v2 client identifier, as IBC v1 channels negotiate the encoding during the channel handshake.
+	// Defaults to proto3 if empty.
+	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -286,49 +290,50 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x1c, 0xed, 0x4a, 0x29, 0x30, 0x20, 0xe8, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01, 0x49, 0x98,
-	0xb1, 0xf5, 0x5f, 0x52, 0xe3, 0x41, 0xc0, 0x43, 0x63, 0x1a, 0x71, 0xc5, 0x84, 0x78, 0x69, 0xa6,
-	0xb3, 0x93, 0x61, 0x42, 0x77, 0x66, 0x9d, 0x99, 0xae, 0x78, 0x33, 0x9e, 0x8c, 0x07, 0xe3, 0xc1,
-	0x0f, 0xc0, 0x47, 0xe0, 0xee, 0x07, 0x90, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x81, 0x9b, 0x9f, 0xc1,
-	0xec, 0x9f, 0x6e, 0x51, 0x90, 0x60, 0xe1, 0xb6, 0xbf, 0xdf, 0xcc, 0x7b, 0xbf, 0xf7, 0xde, 0xcc,
-	0x0e, 0x78, 0xc8, 0x5b, 0x04, 0xe1, 0x20, 0x68, 0x73, 0x82, 0x0d, 0x97, 0x42, 0x23, 0x2e, 0x0c,
-	0x55, 0x64, 0x1d, 0x73, 0xd1, 0xc4, 0x84, 0xc8, 0x8e, 0x30, 0x1a, 0x11, 0x29, 0x8c, 0x92, 0xed,
-	0x36, 0x55, 0x28, 0xac, 0x20, 0xb3, 0x09, 0x03, 0x25, 0x8d, 0xb4, 0xab, 0xbc, 0x45, 0xe0, 0x61,
-	0x30, 0x3c, 0x06, 0x0c, 0x7b, 0x60, 0x18, 0x56, 0x9c, 0x49, 0x26, 0x99, 0x8c, 0xe1, 0x28, 0xfa,
-	0x4a, 0x98, 0x9c, 0xbb, 0xa7, 0x92, 0x11, 0x56, 0x50, 0x80, 0xc9, 0x06, 0x35, 0x29, 0x6a, 0xa9,
-	0x0f, 0xf1, 0x87, 0xd4, 0x24, 0x24, 0x53, 0x44, 0x6a, 0x5f, 0x6a, 0xe4, 0x6b, 0x16, 0xad, 0xfb,
-	0x9a, 0xa5, 0x0b, 0xd7, 0x23, 0x76, 0x22, 0x15, 0x45, 0x64, 0x1d, 0x0b, 0x41, 0xdb, 0x31, 0x3c,
-	0xf9, 0x4c, 0xb6, 0x94, 0xbf, 0x5a, 0xe0, 0x6a, 0x43, 0x33, 0x97, 0x32, 0xae, 0x0d, 0x55, 0xf5,
-	0x6c, 0xfa, 0xe3, 0x64, 0xb8, 0x3d, 0x09, 0x06, 0xe5, 0x1b, 0x41, 0x55, 0xd1, 0x9a, 0xb5, 0xe6,
-	0x46, 0xdc, 0xa4, 0xb0, 0x6f, 0x80, 0x8b, 0x44, 0x0a, 0x41, 0x49, 0x24, 0xba, 0xc9, 0xbd, 0xe2,
-	0x85, 0x78, 0x75, 0xac, 0xd7, 0xac, 0x7b, 0x76, 0x11, 0x0c, 0x85, 0x54, 0x69, 0x2e, 0x45, 0x71,
-	0x20, 0x5e, 0xee, 0x96, 0xf6, 0x7d, 0x30, 0x2c, 0x95, 0x47, 0x15, 0x17, 0xac, 0x98, 0x9f, 0xb5,
-	0xe6, 0xc6, 0xab, 0x0e, 0x8c, 0x4e, 0x22, 0xd2, 0x0a, 0xbb, 0x02, 0xc3, 0x0a, 0x7c, 0x16, 0x6d,
-	0x72, 0xb3, 0xbd, 0xb5, 0xf1, 0x0f, 0x5b, 0x33, 0xb9, 0xf7, 0x07, 0xdb, 0xf3, 0x89, 0x8c, 0xb2,
-	0x07, 0x6e, 0x9e, 0x24, 0xde, 0xa5, 0x3a, 0x90, 0x42, 0x53, 0xfb, 0x1a, 0x00, 0x29, 0x6b, 0xa4,
-	0x35, 0x71, 0x32, 0x92, 0x76, 0xea, 0x9e, 0x3d, 0x05, 0x86, 0x02, 0xa9, 0x4c, 0xcf, 0x47, 0x21,
-	0x2a, 0xeb, 0x5e, 0x2d, 0x1f, 0xcd, 0x2b, 0xff, 0xb2, 0xc0, 0x48, 0x43, 0xb3, 0x17, 0x54, 0x78,
-	0xab, 0x9b, 0x67, 0x09, 0x64, 0x03, 0x8c, 0x26, 0xa7, 0xdf, 0xf4, 0xb0, 0xc1, 0x71, 0x28, 0xa3,
-	0xd5, 0x65, 0x78, 0xaa, 0x3b, 0x18, 0x56, 0xe0, 0x11, 0x7f, 0x2b, 0x31, 0xd9, 0x32, 0x36, 0x78,
-	0x31, 0xbf, 0xf3, 0x63, 0x26, 0xe7, 0x82, 0x20, 0xeb, 0xd8, 0xb7, 0xc0, 0x25, 0x45, 0xdb, 0xd8,
-	0xf0, 0x90, 0x36, 0x0d, 0xf7, 0xa9, 0xec, 0x98, 0x38, 0xeb, 0xbc, 0x3b, 0xd1, 0xed, 0xaf, 0x26,
-	0xed, 0x23, 0xb1, 0xde, 0x03, 0x97, 0x33, 0xbf, 0x59, 0x86, 0x0e, 0x18, 0xd6, 0xf4, 0x75, 0x87,
-	0x0a, 0x42, 0x63, 0xeb, 0x79, 0x37, 0xab, 0xd3, 0x9c, 0xbe, 0x58, 0x60, 0xa2, 0xa1, 0xd9, 0xcb,
-	0xc0, 0xc3, 0x86, 0xae, 0x60, 0x85, 0x7d, 0x6d, 0x5f, 0x01, 0x05, 0xcd, 0x59, 0x2f, 0xae, 0xb4,
-	0xb2, 0xd7, 0x40, 0x21, 0x88, 0x77, 0xc4, 0x41, 0x8d, 0x56, 0x6b, 0xf0, 0xff, 0xff, 0x44, 0x98,
-	0xcc, 0x48, 0xbd, 0xa7, 0x7c, 0xb5, 0x89, 0xae, 0x99, 0x74, 0x54, 0x79, 0x1a, 0x4c, 0xfd, 0xa5,
-	0xaa, 0xeb, 0xa9, 0xfa, 0x31, 0x0f, 0x06, 0x1a, 0x9a, 0xd9, 0xdf, 0x2c, 0x30, 0xfd, 0xef, 0x5f,
-	0x60, 0xa5, 0x1f, 0x6d, 0x27, 0xdd, 0x4b, 0x67, 0xed, 0xbc, 0x19, 0xb3, 0x53, 0xfa, 0x64, 0x81,
-	0x42, 0x7a, 0x51, 0x1f, 0xf5, 0x39, 0x24, 0x81, 0x3b, 0x4f, 0xce, 0x04, 0xcf, 0x04, 0x6d, 0x59,
-	0x60, 0xec, 0x8f, 0x1b, 0xb1, 0xd4, 0x27, 0xef, 0x61, 0x12, 0xe7, 0xe9, 0x39, 0x90, 0x74, 0x25,
-	0x3a, 0x83, 0xef, 0x0e, 0xb6, 0xe7, 0xad, 0xc5, 0x8d, 0x9d, 0xbd, 0x92, 0xb5, 0xbb, 0x57, 0xb2,
-	0x7e, 0xee, 0x95, 0xac, 0xcf, 0xfb, 0xa5, 0xdc, 0xee, 0x7e, 0x29, 0xf7, 0x7d, 0xbf, 0x94, 0x7b,
-	0xf5, 0x9c, 0x71, 0xb3, 0xde, 0x69, 0x41, 0x22, 0x7d, 0x94, 0xbe, 0xb5, 0xbc, 0x45, 0x16, 0x98,
-	0x44, 0x61, 0xe5, 0x36, 0xf2, 0xa5, 0xd7, 0x69, 0x53, 0x1d, 0x3d, 0xe3, 0x1a, 0x55, 0x1f, 0x2c,
-	0xf4, 0x84, 0x2c, 0x1c, 0xf7, 0x82, 0x9b, 0xb7, 0x01, 0xd5, 0xad, 0x42, 0xfc, 0xfc, 0xde, 0xf9,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x07, 0x43, 0x4f, 0xbe, 0x06, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x42, 0x29, 0x30, 0xf0, 0x83, 0x9f, 0x1b, 0x22, 0x65, 0xa3, 0x05, 0xab, 0x07, 0x24,
+	0x61, 0xc6, 0xd6, 0x7f, 0x49, 0x8d, 0x07, 0x01, 0x0f, 0x8d, 0x69, 0xc4, 0x15, 0x13, 0xe2, 0xa5,
+	0x99, 0xce, 0x4e, 0x86, 0x09, 0xdd, 0x99, 0x75, 0x66, 0xba, 0xe2, 0xcd, 0x78, 0x32, 0x1c, 0x8c,
+	0x07, 0x3f, 0x00, 0x1f, 0x81, 0xbb, 0x1f, 0x40, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x07, 0xbe, 0x86,
+	0xd9, 0x3f, 0xdd, 0xa2, 0x20, 0xc1, 0xc2, 0x6d, 0xdf, 0x77, 0xe6, 0x79, 0xde, 0xe7, 0x7d, 0xe6,
+	0xdd, 0x17, 0x3c, 0xe2, 0x2d, 0x82, 0x70, 0x10, 0xb4, 0x39, 0xc1, 0x86, 0x4b, 0xa1, 0x11, 0x17,
+	0x86, 0x2a, 0xb2, 0x81, 0xb9, 0x68, 0x62, 0x42, 0x64, 0x47, 0x18, 0x8d, 0x88, 0x14, 0x46, 0xc9,
+	0x76, 0x9b, 0x2a, 0x14, 0x56, 0x90, 0xd9, 0x82, 0x81, 0x92, 0x46, 0xda, 0x55, 0xde, 0x22, 0xf0,
+	0x38, 0x18, 0x9e, 0x02, 0x86, 0x3d, 0x30, 0x0c, 0x2b, 0xce, 0x14, 0x93, 0x4c, 0xc6, 0x70, 0x14,
+	0x7d, 0x25, 0x4c, 0xce, 0xbd, 0x73, 0xc9, 0x08, 0x2b, 0x28, 0xc0, 0x64, 0x93, 0x9a, 0x14, 0xb5,
+	0xdc, 0x87, 0xf8, 0x5e, 0x94, 0x92, 0x4c, 0x13, 0xa9, 0x7d, 0xa9, 0x91, 0xaf, 0x59, 0x74, 0xee,
+	0x6b, 0x96, 0x1e, 0xdc, 0x88, 0xd8, 0x89, 0x54, 0x14, 0x91, 0x0d, 0x2c, 0x04, 0x6d, 0xc7, 0xf0,
+	0xe4, 0x33, 0xb9, 0x52, 0xfe, 0x6a, 0x81, 0x6b, 0x0d, 0xcd, 0x5c, 0xca, 0xb8, 0x36, 0x54, 0xd5,
+	0xb3, 0xea, 0x4f, 0x92, 0xe2, 0xf6, 0x14, 0x18, 0x92, 0x6f, 0x05, 0x55, 0x45, 0x6b, 0xce, 0x9a,
+	0x1f, 0x75, 0x93, 0xc0, 0xbe, 0x09, 0xfe, 0x23, 0x52, 0x08, 0x4a, 0x22, 0xd1, 0x4d, 0xee, 0x15,
+	0x07, 0xe2, 0xd3, 0xf1, 0x5e, 0xb2, 0xee, 0xd9, 0x45, 0x30, 0x1c, 0x52, 0xa5, 0xb9, 0x14, 0xc5,
+	0xc1, 0xf8, 0xb8, 0x1b, 0xda, 0x0f, 0xc0, 0x88, 0x54, 0x1e, 0x55, 0x5c, 0xb0, 0x62, 0x7e, 0xce,
+	0x9a, 0x9f, 0xa8, 0x3a, 0x30, 0x7a, 0x89, 0x48, 0x2b, 0xec, 0x0a, 0x0c, 0x2b, 0xf0, 0x79, 0x74,
+	0xc9, 0xcd, 0xee, 0xd6, 0x26, 0x3e, 0xee, 0xcc, 0xe6, 0x3e, 0x1c, 0xed, 0x2e, 0x24, 0x32, 0xca,
+	0x1e, 0xb8, 0x75, 0x96, 0x78, 0x97, 0xea, 0x40, 0x0a, 0x4d, 0xed, 0xeb, 0x00, 0xa4, 0xac, 0x91,
+	0xd6, 0xa4, 0x93, 0xd1, 0x34, 0x53, 0xf7, 0xec, 0x69, 0x30, 0x1c, 0x48, 0x65, 0x7a, 0x7d, 0x14,
+	0xa2, 0xb0, 0xee, 0xd5, 0xf2, 0x51, 0xbd, 0xf2, 0xf6, 0x00, 0x18, 0x6d, 0x68, 0xf6, 0x92, 0x0a,
+	0x6f, 0x6d, 0xeb, 0x22, 0x86, 0x6c, 0x82, 0xb1, 0xe4, 0xf5, 0x9b, 0x1e, 0x36, 0x38, 0x36, 0x65,
+	0xac, 0xba, 0x02, 0xcf, 0x35, 0x83, 0x61, 0x05, 0x9e, 0xe8, 0x6f, 0x35, 0x26, 0x5b, 0xc1, 0x06,
+	0x2f, 0xe5, 0xf7, 0x7e, 0xcc, 0xe6, 0x5c, 0x10, 0x64, 0x19, 0xfb, 0x36, 0xf8, 0x5f, 0xd1, 0x36,
+	0x36, 0x3c, 0xa4, 0x4d, 0xc3, 0x7d, 0x2a, 0x3b, 0x26, 0xf6, 0x3a, 0xef, 0x4e, 0x76, 0xf3, 0x6b,
+	0x49, 0xda, 0x76, 0xc0, 0x08, 0x15, 0x44, 0x7a, 0xd1, 0x73, 0x0c, 0xc5, 0xba, 0xb3, 0xf8, 0x84,
+	0xe5, 0xf7, 0xc1, 0x95, 0xcc, 0x8b, 0xcc, 0x5f, 0x07, 0x8c, 0x68, 0xfa, 0xa6, 0x43, 0x05, 0xa1,
+	0xb1, 0x2d, 0x79, 0x37, 0x8b, 0x53, 0x0f, 0xbf, 0x58, 0x60, 0xb2, 0xa1, 0xd9, 0xab, 0xc0, 0xc3,
+	0x86, 0xae, 0x62, 0x85, 0x7d, 0x6d, 0x5f, 0x05, 0x05, 0xcd, 0x59, 0xcf, 0xca, 0x34, 0xb2, 0xd7,
+	0x41, 0x21, 0x88, 0x6f, 0xc4, 0x26, 0x8e, 0x55, 0x6b, 0xf0, 0xdf, 0xff, 0x52, 0x98, 0xd4, 0x48,
+	0x7d, 0x49, 0xf9, 0x6a, 0x93, 0xdd, 0x66, 0xd2, 0x52, 0xe5, 0x19, 0x30, 0xfd, 0x87, 0xaa, 0x6e,
+	0x4f, 0xd5, 0xed, 0x3c, 0x18, 0x6c, 0x68, 0x66, 0x7f, 0xb3, 0xc0, 0xcc, 0xdf, 0x7f, 0x8f, 0xd5,
+	0x7e, 0xb4, 0x9d, 0x35, 0xb3, 0xce, 0xfa, 0x65, 0x33, 0x66, 0xaf, 0xf4, 0xc9, 0x02, 0x85, 0x74,
+	0x88, 0x1f, 0xf7, 0x59, 0x24, 0x81, 0x3b, 0x4f, 0x2f, 0x04, 0xcf, 0x04, 0xed, 0x58, 0x60, 0xfc,
+	0xb7, 0x89, 0x58, 0xee, 0x93, 0xf7, 0x38, 0x89, 0xf3, 0xec, 0x12, 0x48, 0xba, 0x12, 0x9d, 0xa1,
+	0xf7, 0x47, 0xbb, 0x0b, 0xd6, 0xd2, 0xe6, 0xde, 0x41, 0xc9, 0xda, 0x3f, 0x28, 0x59, 0x3f, 0x0f,
+	0x4a, 0xd6, 0xe7, 0xc3, 0x52, 0x6e, 0xff, 0xb0, 0x94, 0xfb, 0x7e, 0x58, 0xca, 0xbd, 0x7e, 0xc1,
+	0xb8, 0xd9, 0xe8, 0xb4, 0x20, 0x91, 0x3e, 0x4a, 0xf7, 0x30, 0x6f, 0x91, 0x45, 0x26, 0x51, 0x58,
+	0xb9, 0x83, 0x7c, 0xe9, 0x75, 0xda, 0x54, 0x47, 0x2b, 0x5e, 0xa3, 0xea, 0xc3, 0xc5, 0x9e, 0x90,
+	0xc5, 0xd3, 0xb6, 0xbb, 0x79, 0x17, 0x50, 0xdd, 0x2a, 0xc4, 0xab, 0xf9, 0xee, 0xaf, 0x01, 0x00,
+	0xed, 0xba, 0xb3, 0xd6, 0xda, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -596,6 +601,13 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -790,6 +802,10 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1259,6 +1275,38 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,91 @@
+package v2
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 application interface for interchain accounts controller chains.
+// It should be registered on the IBC v2 router as a prefix route using icatypes.ControllerPortRoutePrefix.
+type IBCModule struct {
+	keeper *keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k *keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. It enforces that the payload is sent from the
+// controller port of the signer, so that only the owner may send transactions to its interchain account.
+func (im *IBCModule) OnSendPacket(ctx sdk.Context, _ string, _ string, _ uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if !im.keeper.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	if err := icatypes.ValidatePayloadV2(payload); err != nil {
+		return err
+	}
+
+	portID, err := icatypes.NewControllerPortID(signer.String())
+	if err != nil {
+		return err
+	}
+
+	if payload.SourcePort != portID {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "signer %s is not the owner of controller port %s", signer, payload.SourcePort)
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(payload.Value); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	return data.ValidateBasic()
+}
+
+// OnRecvPacket implements the IBCModule interface. A controller chain does not receive packets.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, _ string, _ string, sequence uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	im.keeper.Logger(ctx).Error("cannot receive packet on controller chain", "sequence", sequence)
+
+	return channeltypesv2.RecvPacketResult{
+		Status: channeltypesv2.PacketStatus_Failure,
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface. As IBC v2 does not close channels on timeout,
+// no state changes are required and the owner may simply send a new transaction.
+func (*IBCModule) OnTimeoutPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (*IBCModule) OnAcknowledgementPacket(_ sdk.Context, _ string, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return nil
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided payload value into an InterchainAccountPacketData.
+// It implements the PacketDataUnmarshaler interface.
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(payload.Value); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
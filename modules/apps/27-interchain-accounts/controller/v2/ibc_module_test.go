@@ -0,0 +1,212 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type InterchainAccountsV2TestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestInterchainAccountsV2TestSuite(t *testing.T) {
+	testifysuite.Run(t, new(InterchainAccountsV2TestSuite))
+}
+
+func (s *InterchainAccountsV2TestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.SetupV2()
+}
+
+func (s *InterchainAccountsV2TestSuite) TestSendTx() {
+	var (
+		sendAmount  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+		icaAddress  sdk.AccAddress
+		msgSendTx   *controllertypes.MsgSendTx
+		expSendErr  error
+		expAckError bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"success: interchain account address was funded before the account was created",
+			func() {
+				// the funded base account is converted into an interchain account on the first packet
+				err := s.chainB.GetSimApp().BankKeeper.SendCoins(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), icaAddress, sendAmount)
+				s.Require().NoError(err)
+			},
+		},
+		{
+			"success: proto3json encoding",
+			func() {
+				msg := &banktypes.MsgSend{
+					FromAddress: icaAddress.String(),
+					ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sendAmount,
+				}
+
+				data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProto3JSON)
+				s.Require().NoError(err)
+
+				msgSendTx.PacketData.Data = data
+				msgSendTx.Encoding = icatypes.EncodingProto3JSON
+			},
+		},
+		{
+			"failure: controller submodule is disabled",
+			func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), controllertypes.NewParams(false))
+				expSendErr = controllertypes.ErrControllerSubModuleDisabled
+			},
+		},
+		{
+			"failure: unsupported encoding",
+			func() {
+				msgSendTx.Encoding = "invalid-encoding"
+				expSendErr = icatypes.ErrInvalidCodec
+			},
+		},
+		{
+			"failure: message type is not allowed on the host",
+			func() {
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}))
+				expAckError = true
+			},
+		},
+		{
+			"failure: host submodule is disabled",
+			func() {
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), hosttypes.NewParams(false, []string{hosttypes.AllowAllHostMsgs}))
+				expAckError = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			expSendErr = nil
+			expAckError = false
+
+			owner := s.chainA.SenderAccount.GetAddress().String()
+			icaAddress = icatypes.GenerateAddressV2(s.path.EndpointB.ClientID, owner)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: icaAddress.String(),
+				ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sendAmount,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			s.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msgSendTx = controllertypes.NewMsgSendTx(owner, s.path.EndpointA.ClientID, uint64(time.Hour.Nanoseconds()), packetData)
+
+			tc.malleate()
+
+			// fund the interchain account so that the bank send can be executed
+			err = s.chainB.GetSimApp().BankKeeper.SendCoins(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), icaAddress, sendAmount)
+			s.Require().NoError(err)
+
+			icaBalance := s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), icaAddress)
+
+			res, err := s.chainA.SendMsgs(msgSendTx)
+			if expSendErr != nil {
+				s.Require().ErrorContains(err, expSendErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			packet, err := ibctesting.ParseV2PacketFromEvents(res.Events)
+			s.Require().NoError(err)
+			s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+			expPortID, err := icatypes.NewControllerPortID(owner)
+			s.Require().NoError(err)
+			s.Require().Equal(expPortID, packet.Payloads[0].SourcePort)
+			s.Require().Equal(icatypes.HostPortID, packet.Payloads[0].DestinationPort)
+
+			ack, err := s.path.EndpointB.MsgRecvPacketWithAck(packet)
+			s.Require().NoError(err)
+
+			err = s.path.EndpointA.MsgAcknowledgePacket(packet, ack)
+			s.Require().NoError(err)
+
+			accountAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointB.ClientID, expPortID)
+			if expAckError {
+				s.Require().Equal(channeltypesv2.ErrorAcknowledgement[:], ack.AppAcknowledgements[0])
+				s.Require().Equal(icaBalance, s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), icaAddress))
+				return
+			}
+
+			s.Require().NotEqual(channeltypesv2.ErrorAcknowledgement[:], ack.AppAcknowledgements[0])
+			s.Require().True(found)
+			s.Require().Equal(icaAddress.String(), accountAddress)
+
+			account := s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), icaAddress)
+			interchainAccount, ok := account.(*icatypes.InterchainAccount)
+			s.Require().True(ok)
+			s.Require().Equal(expPortID, interchainAccount.AccountOwner)
+
+			// the interchain account has sent the funds back to the sender
+			s.Require().Equal(icaBalance.Sub(sendAmount...), s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), icaAddress))
+		})
+	}
+}
+
+func (s *InterchainAccountsV2TestSuite) TestSendPacketUnauthorized() {
+	owner := s.chainB.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	// chain A sender attempts to send a packet from the controller port of another owner
+	payload := channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, icatypes.EncodingProtobuf, packetData.GetBytes())
+	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	_, err = s.path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	s.Require().ErrorContains(err, ibcerrors.ErrUnauthorized.Error())
+}
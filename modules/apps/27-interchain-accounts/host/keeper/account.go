@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,3 +35,34 @@ func (k *Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controll
 
 	return accAddress, nil
 }
+
+// getOrCreateInterchainAccountV2 returns the interchain account owned by the controller portID on the provided host clientID,
+// creating it if it does not exist yet. The address is derived from the host clientID and the controller owner, see GenerateAddressV2.
+// As the address is deterministic, it may already have been funded before the interchain account is created. In that case the
+// existing base account is converted into an interchain account, any other existing account type results in an error.
+func (k *Keeper) getOrCreateInterchainAccountV2(ctx sdk.Context, clientID, controllerPortID string) (sdk.AccAddress, error) {
+	if addr, found := k.GetInterchainAccountAddress(ctx, clientID, controllerPortID); found {
+		return sdk.AccAddressFromBech32(addr)
+	}
+
+	owner := strings.TrimPrefix(controllerPortID, icatypes.ControllerPortPrefix)
+	accAddress := icatypes.GenerateAddressV2(clientID, owner)
+
+	acc := k.accountKeeper.GetAccount(ctx, accAddress)
+	if acc == nil {
+		interchainAccount := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(accAddress), controllerPortID)
+		k.accountKeeper.NewAccount(ctx, interchainAccount)
+		k.accountKeeper.SetAccount(ctx, interchainAccount)
+	} else {
+		baseAccount, ok := acc.(*authtypes.BaseAccount)
+		if !ok || baseAccount.GetPubKey() != nil || baseAccount.GetSequence() != 0 {
+			return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for interchain account address %s", accAddress)
+		}
+
+		k.accountKeeper.SetAccount(ctx, icatypes.NewInterchainAccount(baseAccount, controllerPortID))
+	}
+
+	k.SetInterchainAccountAddress(ctx, clientID, controllerPortID, accAddress.String())
+
+	return accAddress, nil
+}
@@ -43,3 +43,23 @@ func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// EmitAcknowledgementEventV2 emits an event signalling a successful or failed acknowledgement for a packet received
+// over IBC v2 on the provided host client and including the error details if any.
+func EmitAcknowledgementEventV2(ctx sdk.Context, clientID string, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyHostClientID, clientID),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			attributes...,
+		),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
@@ -36,7 +37,45 @@ func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]by
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+		if !found {
+			return nil, channeltypes.ErrChannelNotFound
+		}
+
+		txResponse, err := k.executeTx(ctx, channel.ConnectionHops[0], packet.SourcePort, msgs)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+		}
+		return txResponse, nil
+	default:
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// OnRecvPacketV2 handles a given interchain accounts payload received over IBC v2 on a destination host chain.
+// The interchain account owned by the controller port on the provided client is created if it does not exist yet.
+// The payload encoding defines the encoding of the messages contained in the packet data.
+// If the transaction is successfully executed, the transaction response bytes will be returned.
+func (k *Keeper) OnRecvPacketV2(ctx sdk.Context, destinationClient string, payload channeltypesv2.Payload) ([]byte, error) {
+	var data icatypes.InterchainAccountPacketData
+	err := data.UnmarshalJSON(payload.Value)
+	if err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	switch data.Type {
+	case icatypes.EXECUTE_TX:
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, payload.Encoding)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
+		}
+
+		if _, err := k.getOrCreateInterchainAccountV2(ctx, destinationClient, payload.SourcePort); err != nil {
+			return nil, err
+		}
+
+		txResponse, err := k.executeTx(ctx, destinationClient, payload.SourcePort, msgs)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
@@ -50,13 +89,9 @@ func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]by
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k *Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
-	if !found {
-		return nil, channeltypes.ErrChannelNotFound
-	}
-
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+// The connectionID is the host connection identifier for IBC v1 channels and the host client identifier for IBC v2.
+func (k *Keeper) executeTx(ctx sdk.Context, connectionID, controllerPortID string, msgs []sdk.Msg) ([]byte, error) {
+	if err := k.authenticateTx(ctx, msgs, connectionID, controllerPortID); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,100 @@
+package v2
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 application interface for interchain accounts host chains.
+// Interchain accounts are created on the first packet received from a controller owner over a given client,
+// no channel handshake is required.
+type IBCModule struct {
+	keeper *keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k *keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface
+func (*IBCModule) OnSendPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidHostPort, "host chain cannot send packets")
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, _ string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if !im.keeper.GetParams(ctx).HostEnabled {
+		im.keeper.Logger(ctx).Info("host submodule is disabled")
+		keeper.EmitAcknowledgementEventV2(ctx, destinationClient, types.ErrHostSubModuleDisabled)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	if err := icatypes.ValidatePayloadV2(payload); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		keeper.EmitAcknowledgementEventV2(ctx, destinationClient, err)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	txResponse, err := im.keeper.OnRecvPacketV2(ctx, destinationClient, payload)
+
+	// Emit an event indicating a successful or failed acknowledgement.
+	keeper.EmitAcknowledgementEventV2(ctx, destinationClient, err)
+
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", sequence)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(txResponse).Acknowledgement(),
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (*IBCModule) OnTimeoutPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host chain, a host chain does not send packets")
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (*IBCModule) OnAcknowledgementPacket(_ sdk.Context, _ string, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host chain, a host chain does not send packets")
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided payload value into an InterchainAccountPacketData.
+// It implements the PacketDataUnmarshaler interface.
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(payload.Value); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,233 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icahostv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/v2"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type InterchainAccountsV2TestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestInterchainAccountsV2TestSuite(t *testing.T) {
+	testifysuite.Run(t, new(InterchainAccountsV2TestSuite))
+}
+
+func (s *InterchainAccountsV2TestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func (s *InterchainAccountsV2TestSuite) TestOnRecvPacket() {
+	var payload channeltypesv2.Payload
+
+	sendAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus channeltypesv2.PacketStatus
+	}{
+		{
+			"success",
+			func() {},
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"failure: invalid controller port",
+			func() {
+				payload.SourcePort = icatypes.ControllerPortPrefix
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: invalid host port",
+			func() {
+				payload.DestinationPort = ibctesting.MockPort
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: invalid version",
+			func() {
+				payload.Version = ibctesting.InvalidID
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: unsupported encoding",
+			func() {
+				payload.Encoding = ibctesting.InvalidID
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: cannot unmarshal packet data",
+			func() {
+				payload.Value = []byte("invalid packet data")
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			owner := s.chainA.SenderAccount.GetAddress().String()
+			portID, err := icatypes.NewControllerPortID(owner)
+			s.Require().NoError(err)
+
+			// the interchain account of the owner on the host client sends the funds back to the sender of chain B
+			icaAddress := icatypes.GenerateAddressV2(ibctesting.SecondClientID, owner)
+			err = s.chainB.GetSimApp().BankKeeper.SendCoins(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), icaAddress, sendAmount)
+			s.Require().NoError(err)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: icaAddress.String(),
+				ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sendAmount,
+			}
+			data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			s.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+			payload = channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, icatypes.EncodingProtobuf, packetData.GetBytes())
+
+			tc.malleate()
+
+			module := icahostv2.NewIBCModule(s.chainB.GetSimApp().ICAHostKeeper)
+			res := module.OnRecvPacket(s.chainB.GetContext(), ibctesting.FirstClientID, ibctesting.SecondClientID, 1, payload, s.chainB.SenderAccount.GetAddress())
+			s.Require().Equal(tc.expStatus, res.Status)
+
+			accountAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.SecondClientID, portID)
+			icaBalance := s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), icaAddress)
+
+			if tc.expStatus != channeltypesv2.PacketStatus_Success {
+				s.Require().False(found)
+				s.Require().Equal(sendAmount, icaBalance)
+				return
+			}
+
+			// the interchain account is created at the address derived from the host client and the owner
+			s.Require().True(found)
+			s.Require().Equal(icaAddress.String(), accountAddress)
+
+			account := s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), icaAddress)
+			interchainAccount, ok := account.(*icatypes.InterchainAccount)
+			s.Require().True(ok)
+			s.Require().Equal(portID, interchainAccount.AccountOwner)
+
+			// the message has been executed by the interchain account
+			s.Require().True(icaBalance.IsZero())
+			s.requireMsgSendResponse(res.Acknowledgement)
+		})
+	}
+}
+
+// TestInterchainAccountRoundTrip sends a transaction from the controller chain to the host chain over IBC v2,
+// and relays the acknowledgement containing the transaction response back to the controller chain.
+func (s *InterchainAccountsV2TestSuite) TestInterchainAccountRoundTrip() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	sendAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	owner := s.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	// the controller can compute the address of the interchain account before it is created
+	icaAddress := icatypes.GenerateAddressV2(path.EndpointB.ClientID, owner)
+	err = s.chainB.GetSimApp().BankKeeper.SendCoins(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), icaAddress, sendAmount)
+	s.Require().NoError(err)
+
+	receiver := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	msg := &banktypes.MsgSend{
+		FromAddress: icaAddress.String(),
+		ToAddress:   receiver.String(),
+		Amount:      sendAmount,
+	}
+	data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	msgSendTx := controllertypes.NewMsgSendTx(owner, path.EndpointA.ClientID, uint64(time.Hour.Nanoseconds()), packetData)
+
+	res, err := s.chainA.SendMsgs(msgSendTx)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParseV2PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+	s.Require().NoError(path.EndpointB.UpdateClient())
+
+	receiverBalance := s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), receiver)
+
+	ack, err := path.EndpointB.MsgRecvPacketWithAck(packet)
+	s.Require().NoError(err)
+	s.Require().Len(ack.AppAcknowledgements, 1)
+	s.requireMsgSendResponse(ack.AppAcknowledgements[0])
+
+	accountAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), path.EndpointB.ClientID, portID)
+	s.Require().True(found)
+	s.Require().Equal(icaAddress.String(), accountAddress)
+	s.Require().Equal(receiverBalance.Add(sendAmount...), s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), receiver))
+
+	// the acknowledgement completes the packet lifecycle on the controller chain
+	s.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, ack))
+
+	commitment := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(s.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	s.Require().Empty(commitment)
+}
+
+// requireMsgSendResponse asserts that the acknowledgement is a successful acknowledgement containing the response of a single bank send.
+func (s *InterchainAccountsV2TestSuite) requireMsgSendResponse(acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack))
+	s.Require().True(ack.Success())
+
+	var txMsgData sdk.TxMsgData
+	s.Require().NoError(proto.Unmarshal(ack.GetResult(), &txMsgData))
+	s.Require().Len(txMsgData.MsgResponses, 1)
+
+	var msgSendResponse banktypes.MsgSendResponse
+	s.Require().NoError(proto.Unmarshal(txMsgData.MsgResponses[0].Value, &msgSendResponse))
+}
+
+func (s *InterchainAccountsV2TestSuite) TestHostCannotSendPackets() {
+	module := icahostv2.NewIBCModule(s.chainB.GetSimApp().ICAHostKeeper)
+	ctx := s.chainB.GetContext()
+	signer := s.chainB.SenderAccount.GetAddress()
+
+	var payload channeltypesv2.Payload
+	s.Require().ErrorIs(module.OnSendPacket(ctx, ibctesting.FirstClientID, ibctesting.SecondClientID, 1, payload, signer), icatypes.ErrInvalidHostPort)
+	s.Require().ErrorIs(module.OnAcknowledgementPacket(ctx, ibctesting.FirstClientID, ibctesting.SecondClientID, 1, nil, payload, signer), icatypes.ErrInvalidChannelFlow)
+	s.Require().ErrorIs(module.OnTimeoutPacket(ctx, ibctesting.FirstClientID, ibctesting.SecondClientID, 1, payload, signer), icatypes.ErrInvalidChannelFlow)
+}
@@ -53,6 +53,16 @@ func GenerateAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddres
 	return sdkaddress.Derive(hostModuleAcc, buf)
 }
 
+// GenerateAddressV2 returns an sdk.AccAddress derived using a host module account address, the host client ID and the
+// controller owner. Unlike GenerateAddress, the derivation does not depend on block data, allowing controller chains to
+// compute the address of an interchain account created over IBC v2 ahead of time.
+func GenerateAddressV2(clientID, owner string) sdk.AccAddress {
+	hostModuleAcc := sdkaddress.Module(ModuleName, []byte(hostAccountsKey))
+
+	// client identifiers cannot contain a slash, so the separator guarantees distinct (clientID, owner) pairs derive distinct addresses
+	return sdkaddress.Derive(hostModuleAcc, []byte(clientID+"/"+owner))
+}
+
 // ValidateAccountAddress performs basic validation of interchain account addresses, enforcing constraints
 // on address length and character set
 func ValidateAccountAddress(addr string) error {
@@ -14,6 +14,10 @@ const (
 	// ControllerPortPrefix is the default port prefix that the interchain accounts controller submodule binds to
 	ControllerPortPrefix = "icacontroller-"
 
+	// ControllerPortRoutePrefix is the alphanumeric port prefix used to register the interchain accounts controller
+	// submodule as a prefix route on the IBC v2 router, matching all ports prefixed with ControllerPortPrefix
+	ControllerPortRoutePrefix = "icacontroller"
+
 	// Version defines the current version for interchain accounts
 	Version = "ics27-1"
 
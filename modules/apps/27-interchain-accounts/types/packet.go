@@ -9,6 +9,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
@@ -20,6 +21,29 @@ var (
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 32768
 
+// ValidatePayloadV2 performs basic validation of an interchain accounts IBC v2 payload. As IBC v2 does not negotiate
+// the application connection through a channel handshake, the payload must be sent from a controller port to the host
+// port, using the interchain accounts version and a supported encoding for the messages contained in the packet data.
+func ValidatePayloadV2(payload channeltypesv2.Payload) error {
+	if !strings.HasPrefix(payload.SourcePort, ControllerPortPrefix) || len(payload.SourcePort) == len(ControllerPortPrefix) {
+		return errorsmod.Wrapf(ErrInvalidControllerPort, "expected %s{owner}, got %s", ControllerPortPrefix, payload.SourcePort)
+	}
+
+	if payload.DestinationPort != HostPortID {
+		return errorsmod.Wrapf(ErrInvalidHostPort, "expected %s, got %s", HostPortID, payload.DestinationPort)
+	}
+
+	if payload.Version != Version {
+		return errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, payload.Version)
+	}
+
+	if !isSupportedEncoding(payload.Encoding) {
+		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", payload.Encoding)
+	}
+
+	return nil
+}
+
 // ValidateBasic performs basic validation of the interchain account packet data.
 // The memo may be empty.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
@@ -670,7 +670,8 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				packet.Data = packetData.GetBytes()
 			},
 			noExecution,
-			channeltypes.NewErrorAcknowledgement(types.ErrInvalidCallbackData),
+			// the callback memo keys are validated by the transfer application before the callback data is parsed
+			channeltypes.NewErrorAcknowledgement(transfertypes.ErrInvalidMemo),
 		},
 		{
 			"failure: callback execution reach out of gas, but sufficient gas provided by relayer",
@@ -1055,10 +1056,10 @@ func (s *CallbacksTestSuite) TestUnmarshalPacketDataV1() {
 	}
 
 	expPacketDataICS20V2 := transfertypes.InternalTransferRepresentation{
-		Token: transfertypes.Token{
+		Tokens: transfertypes.Tokens{transfertypes.Token{
 			Denom:  transfertypes.NewDenom(ibctesting.TestCoin.Denom),
 			Amount: ibctesting.TestCoin.Amount.String(),
-		},
+		}},
 		Sender:   ibctesting.TestAccAddress,
 		Receiver: ibctesting.TestAccAddress,
 		Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s"}, "dest_callback": {"address":"%s"}}`, ibctesting.TestAccAddress, ibctesting.TestAccAddress),
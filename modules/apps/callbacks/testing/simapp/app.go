@@ -84,6 +84,7 @@ import (
 	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	ibccallbacksv2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -358,10 +359,14 @@ func NewSimApp(
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]),
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// Validate the callback memo keys of ICS-20 transfers before they are sent or received
+	app.TransferKeeper.RegisterMemoKeyValidator(ibccallbackstypes.SourceCallbackKey, ibccallbackstypes.ValidateCallbackMemo)
+	app.TransferKeeper.RegisterMemoKeyValidator(ibccallbackstypes.DestinationCallbackKey, ibccallbackstypes.ValidateCallbackMemo)
 
 	// Mock Module Stack
 
@@ -426,8 +431,10 @@ func NewSimApp(
 	// mockModule.OnAcknowledgementPacket -> callbacks.OnAcknowledgementPacket -> channel.OnAcknowledgementPacket
 
 	// add transfer v2 module wrapped by callbacks v2 middleware
-	cbTransferModulev2 := ibccallbacksv2.NewIBCMiddleware(transferv2.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeperV2, app.MockContractKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
-	ibcRouterV2.AddRoute(ibctransfertypes.PortID, cbTransferModulev2)
+	cbTransferStackV2 := ibcapi.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeperV2)
+	cbTransferStackV2.Base(transferv2.NewIBCModule(app.TransferKeeper)).
+		Next(ibccallbacksv2.NewIBCMiddleware(app.MockContractKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas))
+	ibcRouterV2.AddRoute(ibctransfertypes.PortID, cbTransferStackV2.Build())
 
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -155,6 +155,31 @@ func GetCallbackData(
 	}, true, nil
 }
 
+// ValidateCallbackMemo validates the value of a callback memo key, i.e. SourceCallbackKey or
+// DestinationCallbackKey. It is meant to be registered as the memo key validator of both keys in
+// the transfer keeper.
+func ValidateCallbackMemo(value any) error {
+	callbackData, ok := value.(map[string]any)
+	if !ok {
+		return errorsmod.Wrap(ErrInvalidCallbackData, "callback data must be a JSON object")
+	}
+
+	callbackAddress, err := getCallbackAddress(callbackData)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(callbackAddress) == "" {
+		return errorsmod.Wrap(ErrInvalidCallbackData, "callback address cannot be empty")
+	}
+
+	if _, err := getUserDefinedGasLimit(callbackData); err != nil {
+		return err
+	}
+
+	_, err = getCalldata(callbackData)
+	return err
+}
+
 func computeExecAndCommitGasLimit(callbackData map[string]any, remainingGas, maxGas uint64) (uint64, uint64, error) {
 	// get the gas limit from the callback data
 	commitGasLimit, err := getUserDefinedGasLimit(callbackData)
@@ -878,3 +878,63 @@ func (s *CallbacksTypesTestSuite) TestUserDefinedGasLimit() {
 		})
 	}
 }
+
+func (s *CallbacksTypesTestSuite) TestValidateCallbackMemo() {
+	testCases := []struct {
+		name     string
+		value    any
+		expError error
+	}{
+		{
+			"success: address only",
+			map[string]any{"address": ibctesting.TestAccAddress},
+			nil,
+		},
+		{
+			"success: address, gas limit and calldata",
+			map[string]any{"address": ibctesting.TestAccAddress, "gas_limit": "100000", "calldata": "aabbcc"},
+			nil,
+		},
+		{
+			"failure: callback data is not a json object",
+			"callback",
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: missing address",
+			map[string]any{"gas_limit": "100000"},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: empty address",
+			map[string]any{"address": " "},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: gas limit is a number",
+			map[string]any{"address": ibctesting.TestAccAddress, "gas_limit": float64(100000)},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: gas limit is not a uint64",
+			map[string]any{"address": ibctesting.TestAccAddress, "gas_limit": "-1"},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: calldata is not a hex string",
+			map[string]any{"address": ibctesting.TestAccAddress, "calldata": "calldata"},
+			types.ErrInvalidCallbackData,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := types.ValidateCallbackMemo(tc.value)
+			if tc.expError == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -105,4 +105,9 @@ type ChannelKeeperV2 interface {
 		clientID string,
 		sequence uint64,
 	) (channeltypesv2.Packet, bool)
+	GetAsyncAcknowledgement(
+		ctx sdk.Context,
+		clientID string,
+		sequence uint64,
+	) (channeltypesv2.AsyncAcknowledgement, bool)
 }
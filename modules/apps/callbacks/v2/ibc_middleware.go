@@ -19,8 +19,9 @@ import (
 )
 
 var (
-	_ api.IBCModule            = (*IBCMiddleware)(nil)
-	_ exported.Acknowledgement = (*RecvAcknowledgement)(nil)
+	_ api.Middleware                = (*IBCMiddleware)(nil)
+	_ api.PacketUnmarshalerModuleV2 = (*IBCMiddleware)(nil)
+	_ exported.Acknowledgement      = (*RecvAcknowledgement)(nil)
 )
 
 // Create internal implementation of exported.Acknowledgement
@@ -55,25 +56,16 @@ type IBCMiddleware struct {
 	maxCallbackGas uint64
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware instance given the keeper and underlying application.
-// The underlying application must implement the required callback interfaces.
+// NewIBCMiddleware creates a new IBCMiddleware instance given the contract keeper and the channel keeper v2.
+// The underlying application and the WriteAcknowledgementWrapper are set when the middleware is composed into
+// a stack with the api.IBCStackBuilder. The underlying application must implement the required callback interfaces.
 func NewIBCMiddleware(
-	app api.IBCModule, writeAckWrapper api.WriteAcknowledgementWrapper,
 	contractKeeper types.ContractKeeper, chanKeeperV2 types.ChannelKeeperV2, maxCallbackGas uint64,
 ) *IBCMiddleware {
-	packetDataUnmarshalerApp, ok := app.(api.PacketUnmarshalerModuleV2)
-	if !ok {
-		panic(fmt.Errorf("underlying application does not implement %T", (*api.PacketUnmarshalerModuleV2)(nil)))
-	}
-
 	if contractKeeper == nil {
 		panic(errors.New("contract keeper cannot be nil"))
 	}
 
-	if writeAckWrapper == nil {
-		panic(errors.New("write acknowledgement wrapper cannot be nil"))
-	}
-
 	if chanKeeperV2 == nil {
 		panic(errors.New("channel keeper v2 cannot be nil"))
 	}
@@ -83,16 +75,37 @@ func NewIBCMiddleware(
 	}
 
 	return &IBCMiddleware{
-		app:             packetDataUnmarshalerApp,
-		writeAckWrapper: writeAckWrapper,
-		contractKeeper:  contractKeeper,
-		chanKeeperV2:    chanKeeperV2,
-		maxCallbackGas:  maxCallbackGas,
+		contractKeeper: contractKeeper,
+		chanKeeperV2:   chanKeeperV2,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// SetUnderlyingApplication sets the underlying IBC v2 module. This function is used by the stack builder
+// after the middleware's creation to set the module which is below this middleware.
+// The underlying application must implement the PacketUnmarshalerModuleV2 interface.
+func (im *IBCMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if im.app != nil {
+		panic(errors.New("underlying application already set"))
 	}
+
+	packetDataUnmarshalerApp, ok := app.(api.PacketUnmarshalerModuleV2)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*api.PacketUnmarshalerModuleV2)(nil)))
+	}
+
+	im.app = packetDataUnmarshalerApp
 }
 
-// WithWriteAckWrapper sets the WriteAcknowledgementWrapper for the middleware.
-func (im *IBCMiddleware) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+// SetWriteAckWrapper sets the WriteAcknowledgementWrapper for the middleware.
+func (im *IBCMiddleware) SetWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	if writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
 	im.writeAckWrapper = writeAckWrapper
 }
 
@@ -382,19 +395,57 @@ func (im *IBCMiddleware) WriteAcknowledgement(
 		return errorsmod.Wrapf(channeltypesv2.ErrInvalidAcknowledgement, "async packet not found for clientID (%s) and sequence (%d)", clientID, sequence)
 	}
 
+	// the acknowledgement of a packet with multiple payloads is the app acknowledgement of its only pending payload
+	if len(packet.Payloads) != 1 {
+		asyncAck, found := im.chanKeeperV2.GetAsyncAcknowledgement(ctx, clientID, sequence)
+		if !found || len(asyncAck.PendingPayloadIndices) != 1 || len(ack.AppAcknowledgements) != 1 {
+			return errorsmod.Wrapf(channeltypesv2.ErrInvalidAcknowledgement, "async packet has multiple pending payloads")
+		}
+
+		return im.WritePayloadAcknowledgement(ctx, clientID, sequence, asyncAck.PendingPayloadIndices[0], ack.AppAcknowledgements[0])
+	}
+
 	err := im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
 	if err != nil {
 		return err
 	}
 
-	// NOTE: use first payload as the payload that is being handled by callbacks middleware
-	// must reconsider if multipacket data gets supported with async packets
-	// TRACKING ISSUE: https://github.com/cosmos/ibc-go/issues/7950
-	if len(packet.Payloads) != 1 {
-		return errorsmod.Wrapf(channeltypesv2.ErrInvalidAcknowledgement, "async packet has multiple payloads")
+	return im.processAsyncRecvPacketCallback(ctx, clientID, sequence, packet, packet.Payloads[0], ack.AppAcknowledgements[0])
+}
+
+// WritePayloadAcknowledgement implements the ReceivePacket destination callbacks for the ibc-callbacks middleware
+// during asynchronous acknowledgement of a payload of a packet with multiple payloads.
+// It defers to the underlying application and then calls the contract callback for the acknowledged payload.
+func (im *IBCMiddleware) WritePayloadAcknowledgement(
+	ctx sdk.Context,
+	clientID string,
+	sequence uint64,
+	payloadIndex uint32,
+	appAck []byte,
+) error {
+	packet, found := im.chanKeeperV2.GetAsyncPacket(ctx, clientID, sequence)
+	if !found {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidAcknowledgement, "async packet not found for clientID (%s) and sequence (%d)", clientID, sequence)
 	}
-	payload := packet.Payloads[0]
 
+	// the payload index is validated when the acknowledgement is written
+	err := im.writeAckWrapper.WritePayloadAcknowledgement(ctx, clientID, sequence, payloadIndex, appAck)
+	if err != nil {
+		return err
+	}
+
+	return im.processAsyncRecvPacketCallback(ctx, clientID, sequence, packet, packet.Payloads[payloadIndex], appAck)
+}
+
+// processAsyncRecvPacketCallback calls the destination contract callback of an asynchronously acknowledged payload.
+func (im *IBCMiddleware) processAsyncRecvPacketCallback(
+	ctx sdk.Context,
+	clientID string,
+	sequence uint64,
+	packet channeltypesv2.Packet,
+	payload channeltypesv2.Payload,
+	appAck []byte,
+) error {
 	packetData, err := im.app.UnmarshalPacketData(payload)
 	if err != nil {
 		return err
@@ -416,7 +467,7 @@ func (im *IBCMiddleware) WriteAcknowledgement(
 
 	recvResult := channeltypesv2.RecvPacketResult{
 		Status:          channeltypesv2.PacketStatus_Success,
-		Acknowledgement: ack.AppAcknowledgements[0],
+		Acknowledgement: appAck,
 	}
 	callbackExecutor := func(cachedCtx sdk.Context) error {
 		// reconstruct a channel v1 packet from the v2 packet
@@ -452,3 +503,9 @@ func (im *IBCMiddleware) WriteAcknowledgement(
 
 	return nil
 }
+
+// UnmarshalPacketData defers to the underlying app to unmarshal the packet data.
+// This function implements the optional PacketDataUnmarshaler interface.
+func (im *IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.UnmarshalPacketData(payload)
+}
@@ -33,42 +33,28 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 		{
 			"success",
 			func() {
-				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
+				_ = v2.NewIBCMiddleware(simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
 			},
 			nil,
 		},
-		{
-			"panics with nil ics4wrapper",
-			func() {
-				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, nil, simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
-			},
-			errors.New("write acknowledgement wrapper cannot be nil"),
-		},
-		{
-			"panics with nil underlying app",
-			func() {
-				_ = v2.NewIBCMiddleware(nil, &channelkeeperv2.Keeper{}, simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
-			},
-			fmt.Errorf("underlying application does not implement %T", (*api.PacketUnmarshalerModuleV2)(nil)),
-		},
 		{
 			"panics with nil contract keeper",
 			func() {
-				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, nil, &channelkeeperv2.Keeper{}, maxCallbackGas)
+				_ = v2.NewIBCMiddleware(nil, &channelkeeperv2.Keeper{}, maxCallbackGas)
 			},
 			errors.New("contract keeper cannot be nil"),
 		},
 		{
 			"panics with nil channel v2 keeper",
 			func() {
-				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, simapp.ContractKeeper{}, nil, maxCallbackGas)
+				_ = v2.NewIBCMiddleware(simapp.ContractKeeper{}, nil, maxCallbackGas)
 			},
 			errors.New("channel keeper v2 cannot be nil"),
 		},
 		{
 			"panics with zero maxCallbackGas",
 			func() {
-				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, uint64(0))
+				_ = v2.NewIBCMiddleware(simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, uint64(0))
 			},
 			errors.New("maxCallbackGas cannot be zero"),
 		},
@@ -85,16 +71,70 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 	}
 }
 
-func (s *CallbacksTestSuite) TestWithWriteAckWrapper() {
+func (s *CallbacksTestSuite) TestSetUnderlyingApplication() {
+	var cbsMiddleware *v2.IBCMiddleware
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		app      api.IBCModule
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			ibcmockv2.IBCModule{},
+			nil,
+		},
+		{
+			"panics with nil underlying app",
+			func() {},
+			nil,
+			errors.New("underlying application cannot be nil"),
+		},
+		{
+			"panics with underlying app already set",
+			func() {
+				cbsMiddleware.SetUnderlyingApplication(ibcmockv2.IBCModule{})
+			},
+			ibcmockv2.IBCModule{},
+			errors.New("underlying application already set"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cbsMiddleware = v2.NewIBCMiddleware(simapp.ContractKeeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
+
+			tc.malleate()
+
+			setFn := func() {
+				cbsMiddleware.SetUnderlyingApplication(tc.app)
+			}
+
+			if tc.expError == nil {
+				s.Require().NotPanics(setFn)
+			} else {
+				s.Require().PanicsWithError(tc.expError.Error(), setFn)
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestSetWriteAckWrapper() {
 	s.setupChains()
 
 	cbsMiddleware := v2.IBCMiddleware{}
 	s.Require().Nil(cbsMiddleware.GetWriteAckWrapper())
 
-	cbsMiddleware.WithWriteAckWrapper(s.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+	cbsMiddleware.SetWriteAckWrapper(s.chainA.App.GetIBCKeeper().ChannelKeeperV2)
 	writeAckWrapper := cbsMiddleware.GetWriteAckWrapper()
 
 	s.Require().IsType((*channelkeeperv2.Keeper)(nil), writeAckWrapper)
+
+	s.Require().PanicsWithError("write acknowledgement wrapper cannot be nil", func() {
+		cbsMiddleware.SetWriteAckWrapper(nil)
+	})
 }
 
 func (s *CallbacksTestSuite) TestSendPacket() {
@@ -716,11 +756,12 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 
 func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 	var (
-		packetData   transfertypes.FungibleTokenPacketData
-		destClient   string
-		ctx          sdk.Context
-		ack          channeltypesv2.Acknowledgement
-		multiPayload bool
+		packetData     transfertypes.FungibleTokenPacketData
+		destClient     string
+		ctx            sdk.Context
+		ack            channeltypesv2.Acknowledgement
+		multiPayload   bool
+		pendingPayload bool
 	)
 
 	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
@@ -772,6 +813,16 @@ func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 			"none",
 			channeltypesv2.ErrInvalidAcknowledgement,
 		},
+		{
+			"success: multipayload with single pending payload",
+			func() {
+				multiPayload = true
+				pendingPayload = true
+				ack = successAck
+			},
+			types.CallbackTypeReceivePacket,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -790,6 +841,8 @@ func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 			ctx = s.chainB.GetContext()
 			gasLimit := ctx.GasMeter().Limit()
 			destClient = s.path.EndpointB.ClientID
+			multiPayload = false
+			pendingPayload = false
 
 			tc.malleate()
 
@@ -814,6 +867,12 @@ func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 			// mock async receive manually so WriteAcknowledgement can pass
 			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
 			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
+			if pendingPayload {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, channeltypesv2.AsyncAcknowledgement{
+					AppAcknowledgements:   [][]byte{successAck.AppAcknowledgements[0], nil},
+					PendingPayloadIndices: []uint32{1},
+				})
+			}
 
 			// callbacks module is routed as top level middleware
 			cbs := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
@@ -841,3 +900,103 @@ func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 		})
 	}
 }
+
+func (s *CallbacksTestSuite) TestWritePayloadAcknowledgement() {
+	var payloadIndex uint32
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		callbackType types.CallbackType
+		expError     error
+	}{
+		{
+			"success",
+			func() {},
+			types.CallbackTypeReceivePacket,
+			nil,
+		},
+		{
+			"failure: payload is not pending",
+			func() {
+				payloadIndex = 0
+			},
+			"none",
+			channeltypesv2.ErrAcknowledgementExists,
+		},
+		{
+			"failure: payload index out of range",
+			func() {
+				payloadIndex = 2
+			},
+			"none",
+			channeltypesv2.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				ibctesting.TestCoin.Denom,
+				ibctesting.TestCoin.Amount.String(),
+				ibctesting.TestAccAddress,
+				s.chainB.SenderAccount.GetAddress().String(),
+				fmt.Sprintf(`{"dest_callback": {"address":"%s", "gas_limit":"600000"}}`, ibctesting.TestAccAddress),
+			)
+			payloadIndex = 1
+
+			tc.malleate()
+
+			ctx := s.chainB.GetContext()
+			gasLimit := ctx.GasMeter().Limit()
+			appAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+			payload := channeltypesv2.NewPayload(
+				transfertypes.PortID, transfertypes.PortID,
+				transfertypes.V1, transfertypes.EncodingJSON,
+				packetData.GetBytes(),
+			)
+			packet := channeltypesv2.NewPacket(
+				1, s.path.EndpointA.ClientID, s.path.EndpointB.ClientID,
+				uint64(ctx.BlockTime().Unix()), payload, payload,
+			)
+
+			// mock async receive of the second payload manually so WritePayloadAcknowledgement can pass
+			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
+			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
+			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, channeltypesv2.AsyncAcknowledgement{
+				AppAcknowledgements:   [][]byte{appAck, nil},
+				PendingPayloadIndices: []uint32{1},
+			})
+
+			// callbacks module is routed as top level middleware
+			cbs := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
+			mw, ok := cbs.(api.WriteAcknowledgementWrapper)
+			s.Require().True(ok)
+
+			err := mw.WritePayloadAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, payloadIndex, appAck)
+
+			expPass := tc.expError == nil
+			s.AssertHasExecutedExpectedCallback(tc.callbackType, expPass)
+
+			if expPass {
+				s.Require().NoError(err)
+
+				expEvent, exists := GetExpectedEvent(
+					ctx, packetData, gasLimit, payload.Version,
+					payload.DestinationPort, packet.DestinationClient, packet.Sequence, types.CallbackTypeReceivePacket, nil,
+				)
+				s.Require().True(exists)
+				s.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), expEvent)
+
+				// the acknowledgement of the packet is written as all payloads have been acknowledged
+				expAck := channeltypesv2.NewAcknowledgement(appAck, appAck)
+				s.Require().Equal(channeltypesv2.CommitAcknowledgement(expAck), s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence))
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "pfm",
+		Short:                      "IBC packet forward middleware querying subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryInFlightPackets(),
+		GetCmdQueryInFlightPacket(),
+		GetCmdParams(),
+	)
+	return cmd
+}
+
+// NewTxCmd returns the cli transaction commands for this module.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "pfm",
+		Short:                      "IBC packet forward middleware transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewRecoverInFlightPacketCmd(),
+	)
+	return cmd
+}
@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+)
+
+const (
+	FlagOriginalSender     = "sender"
+	FlagRefundChannel      = "refund-channel"
+	FlagDestinationChannel = "destination-channel"
+)
+
+// GetCmdQueryInFlightPackets defines the command to query all in-flight packets.
+func GetCmdQueryInFlightPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-flight-packets",
+		Short: "Query all packets which have been forwarded and are awaiting an acknowledgement or timeout",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all packets which have been forwarded and are awaiting an acknowledgement or timeout.
+The results can be filtered by the sender of the original packet, the channel the original packet was received on
+(which is refunded if the forward fails) and the channel the packet was forwarded on.
+
+Example:
+  $ %s query pfm in-flight-packets
+  $ %s query pfm in-flight-packets --sender=[address] --refund-channel=[channel-id] --destination-channel=[channel-id]
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sender, err := cmd.Flags().GetString(FlagOriginalSender)
+			if err != nil {
+				return err
+			}
+
+			refundChannel, err := cmd.Flags().GetString(FlagRefundChannel)
+			if err != nil {
+				return err
+			}
+
+			destinationChannel, err := cmd.Flags().GetString(FlagDestinationChannel)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInFlightPacketsRequest{
+				OriginalSenderAddress: sender,
+				RefundChannelId:       refundChannel,
+				DestinationChannelId:  destinationChannel,
+				Pagination:            pageReq,
+			}
+
+			res, err := queryClient.InFlightPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOriginalSender, "", "Filter by the sender of the original packet")
+	cmd.Flags().String(FlagRefundChannel, "", "Filter by the channel (or client for IBC v2) the original packet was received on")
+	cmd.Flags().String(FlagDestinationChannel, "", "Filter by the channel (or client for IBC v2) the packet was forwarded on")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight packets")
+
+	return cmd
+}
+
+// GetCmdQueryInFlightPacket defines the command to query an in-flight packet by the identifiers of the forwarded packet.
+func GetCmdQueryInFlightPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packet [channel-id] [port-id] [sequence]",
+		Short:   "Query an in-flight packet by the channel, port and sequence of the forwarded packet",
+		Example: fmt.Sprintf("%s query pfm in-flight-packet channel-0 transfer 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInFlightPacketRequest{
+				ChannelId: args[0],
+				PortId:    args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.InFlightPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the packet forward middleware parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current packet forward middleware parameters",
+		Long:    "Query the current packet forward middleware parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query pfm params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+)
+
+// NewRecoverInFlightPacketCmd defines the command to recover the funds of an in-flight packet.
+func NewRecoverInFlightPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-in-flight-packet [channel-id] [port-id] [sequence] [recovery-address]",
+		Short: "Recover the funds of an in-flight packet to an address on this chain",
+		Long: strings.TrimSpace(`Remove the in-flight packet held after its forward failed while the refund channel was closed or its
+client was expired or frozen, and release the funds held for it to the recovery address. The in-flight packet is identified by the channel (or client for IBC v2), port and sequence of the
+forwarded packet. The signer must be the authority or, if sender recovery is enabled, the sender of the original packet.`),
+		Example: fmt.Sprintf("%s tx pfm recover-in-flight-packet channel-0 transfer 1 [recovery-address]", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRecoverInFlightPacket(clientCtx.GetFromAddress().String(), args[0], args[1], sequence, args[3])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
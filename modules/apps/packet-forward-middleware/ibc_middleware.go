@@ -3,7 +3,6 @@ package packetforward
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -11,9 +10,9 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/internal"
 	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -33,17 +32,13 @@ var (
 type IBCMiddleware struct {
 	app    porttypes.PacketUnmarshalerModule
 	keeper *keeper.Keeper
-
-	retriesOnTimeout uint8
-	forwardTimeout   time.Duration
 }
 
 // NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
-func NewIBCMiddleware(k *keeper.Keeper, retriesOnTimeout uint8, forwardTimeout time.Duration) *IBCMiddleware {
+// The retries on timeout and timeout of forwarded packets default to the values set in the params.
+func NewIBCMiddleware(k *keeper.Keeper) *IBCMiddleware {
 	return &IBCMiddleware{
-		keeper:           k,
-		retriesOnTimeout: retriesOnTimeout,
-		forwardTimeout:   forwardTimeout,
+		keeper: k,
 	}
 }
 
@@ -82,56 +77,12 @@ func (im *IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID string, cha
 	return im.app.UnmarshalPacketData(ctx, portID, channelID, bz)
 }
 
-func getDenomForThisChain(port, channel, counterpartyPort, counterpartyChannel string, denom transfertypes.Denom) string {
-	if denom.HasPrefix(counterpartyPort, counterpartyChannel) {
-		// unwind denom
-		denom.Trace = denom.Trace[1:]
-		if len(denom.Trace) == 0 {
-			// denom is now unwound back to native denom
-			return denom.Path()
-		}
-		// denom is still IBC denom
-		return denom.IBCDenom()
-	}
-	// append port and channel from this chain to denom
-	trace := []transfertypes.Hop{transfertypes.NewHop(port, channel)}
-	denom.Trace = append(trace, denom.Trace...)
-
-	return denom.IBCDenom()
-}
-
-// getBoolFromAny returns the bool value is any is a valid bool, otherwise false.
-func getBoolFromAny(value any) bool {
-	if value == nil {
-		return false
-	}
-	boolVal, ok := value.(bool)
-	if !ok {
-		return false
-	}
-	return boolVal
-}
-
 // GetReceiver returns the receiver address for a given channel and original sender.
 // it overrides the receiver address to be a hash of the channel/origSender so that
 // the receiver address is deterministic and can be used to identify the sender on the
 // initial chain.
 func GetReceiver(channel string, originalSender string) (string, error) {
-	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
-	senderHash32 := address.Hash(types.ModuleName, []byte(senderStr))
-	sender := sdk.AccAddress(senderHash32[:20])
-	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
-}
-
-// newErrorAcknowledgement returns an error that identifies PFM and provides the error.
-// It's okay if these errors are non-deterministic, because they will not be committed to state, only emitted as events.
-func newErrorAcknowledgement(err error) channeltypes.Acknowledgement {
-	return channeltypes.Acknowledgement{
-		Response: &channeltypes.Acknowledgement_Error{
-			Error: fmt.Sprintf("packet-forward-middleware error: %s", err.Error()),
-		},
-	}
+	return internal.GetReceiver(channel, originalSender)
 }
 
 // OnRecvPacket checks the memo field on this packet and if the metadata inside's root key indicates this packet
@@ -142,6 +93,11 @@ func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, pa
 
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		if err := validateNoForwardMetadataV2(channelVersion, packet); err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket cannot forward ICS-20 v2 packet", "error", err)
+			return internal.NewErrorAcknowledgement(err)
+		}
+
 		logger.Debug(fmt.Sprintf("packetForwardMiddleware OnRecvPacket payload is not a FungibleTokenPacketData: %s", err.Error()))
 		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
@@ -171,64 +127,77 @@ func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, pa
 	}
 	if err != nil && isPFM {
 		logger.Error("packetForwardMiddleware OnRecvPacket error parsing forward metadata", "error", err)
-		return newErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
+		return internal.NewErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
 	}
 
 	metadata := packetMetadata.Forward
 
 	goCtx := ctx.Context()
-	nonrefundable := getBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
+	nonrefundable := internal.GetBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
 
 	if err := metadata.Validate(); err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
-		return newErrorAcknowledgement(err)
+		return internal.NewErrorAcknowledgement(err)
+	}
+
+	params := im.keeper.GetParams(ctx)
+	if err := params.ValidateForwardMetadata(metadata); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is not allowed", "error", err)
+		return internal.NewErrorAcknowledgement(err)
 	}
 
 	// override the receiver so that senders cannot move funds through arbitrary addresses.
 	overrideReceiver, err := GetReceiver(packet.DestinationChannel, data.Sender)
 	if err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket failed to construct override receiver", "error", err)
-		return newErrorAcknowledgement(fmt.Errorf("failed to construct override receiver: %w", err))
+		return internal.NewErrorAcknowledgement(fmt.Errorf("failed to construct override receiver: %w", err))
 	}
 
 	if err := im.receiveFunds(ctx, channelVersion, packet, data, overrideReceiver, relayer); err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket error receiving packet", "error", err)
-		return newErrorAcknowledgement(fmt.Errorf("error receiving packet: %w", err))
+		return internal.NewErrorAcknowledgement(fmt.Errorf("error receiving packet: %w", err))
 	}
 
 	// if this packet's token denom is already the base denom for some native token on this chain,
 	// we do not need to do any further composition of the denom before forwarding the packet
-	denomOnThisChain := getDenomForThisChain(packet.DestinationPort, packet.DestinationChannel, packet.SourcePort, packet.SourceChannel, transferDetail.Token.Denom)
+	denomOnThisChain := internal.GetDenomForThisChain(packet.DestinationPort, packet.DestinationChannel, packet.SourcePort, packet.SourceChannel, transferDetail.Tokens[0].Denom)
 
-	amountInt, ok := sdkmath.NewIntFromString(transferDetail.Token.Amount)
+	amountInt, ok := sdkmath.NewIntFromString(transferDetail.Tokens[0].Amount)
 	if !ok {
-		logger.Error("packetForwardMiddleware OnRecvPacket error parsing amount for forward", "amount", transferDetail.Token.Amount)
-		return newErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", transferDetail.Token.Amount))
+		logger.Error("packetForwardMiddleware OnRecvPacket error parsing amount for forward", "amount", transferDetail.Tokens[0].Amount)
+		return internal.NewErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", transferDetail.Tokens[0].Amount))
 	}
 
 	token := sdk.NewCoin(denomOnThisChain, amountInt)
 
-	timeout := metadata.Timeout
-
-	if timeout.Nanoseconds() <= 0 {
-		timeout = im.forwardTimeout
+	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, metadata, token, params.ForwardRetries(metadata), params.ForwardTimeout(metadata), []metrics.Label{}, nonrefundable)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
+		return internal.NewErrorAcknowledgement(err)
 	}
 
-	var retries uint8
-	if metadata.Retries != nil {
-		retries = *metadata.Retries
-	} else {
-		retries = im.retriesOnTimeout
+	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
+	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
+	return nil
+}
+
+// validateNoForwardMetadataV2 returns an error if the packet is an ICS-20 v2 packet containing forward metadata.
+// Forwarding ICS-20 v2 packets, which may carry multiple tokens, is not supported. They are rejected instead of
+// being received by the intermediate receiver, so that the tokens are refunded to the sender.
+func validateNoForwardMetadataV2(channelVersion string, packet channeltypes.Packet) error {
+	if channelVersion != transfertypes.V2 {
+		return nil
 	}
 
-	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, metadata, token, retries, timeout, []metrics.Label{}, nonrefundable)
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
 	if err != nil {
-		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
-		return newErrorAcknowledgement(err)
+		return nil
+	}
+
+	if _, isPFM, _ := types.GetPacketMetadataFromPacketdata(data); isPFM {
+		return errorsmod.Wrapf(types.ErrForwardNotAllowed, "cannot forward %s packet with %d tokens", transfertypes.V2, len(data.Tokens))
 	}
 
-	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
-	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
 	return nil
 }
 
@@ -341,7 +310,7 @@ func (im *IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string,
 		if err != nil {
 			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
 			// WriteAcknowledgement with proxied ack to return success/fail to previous chain.
-			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, transferDetail, inFlightPacket, newErrorAcknowledgement(err))
+			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, transferDetail, inFlightPacket, internal.NewErrorAcknowledgement(err))
 		}
 		// timeout should be retried. In order to do that, we need to handle this timeout to refund on this chain first.
 		if err := im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
@@ -1,12 +1,12 @@
 package packetforward_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	packetforward "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware"
-	packetforwardkeeper "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -65,7 +65,7 @@ func (s *PFMTestSuite) TestSetUnderlyingApplication() {
 
 	pfmKeeper := s.chainA.GetSimApp().PFMKeeper
 
-	pfm := packetforward.NewIBCMiddleware(pfmKeeper, 0, packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp)
+	pfm := packetforward.NewIBCMiddleware(pfmKeeper)
 
 	s.Require().Panics(func() {
 		pfm.SetUnderlyingApplication(nil)
@@ -241,6 +241,156 @@ func (s *PFMTestSuite) TestOnRecvPacket_ForwardNoFee() {
 	s.Require().NoError(err)
 }
 
+func (s *PFMTestSuite) TestOnRecvPacket_ForwardNotAllowed() {
+	s.setupChains()
+
+	params := packetforwardtypes.DefaultParams()
+	params.DeniedChannels = []string{s.pathBC.EndpointA.ChannelID}
+	s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+	senderAddr := s.chainA.SenderAccount.GetAddress()
+	receiverAddr := s.chainC.SenderAccount.GetAddress()
+	metadata := &packetforwardtypes.PacketMetadata{
+		Forward: packetforwardtypes.ForwardMetadata{
+			Receiver: receiverAddr.String(),
+			Port:     s.pathBC.EndpointA.ChannelConfig.PortID,
+			Channel:  s.pathBC.EndpointA.ChannelID,
+		},
+	}
+	metadataJSON, err := metadata.ToMemo()
+	s.Require().NoError(err)
+	packet := s.transferPacket(senderAddr.String(), receiverAddr.String(), s.pathAB, 0, metadataJSON)
+	version := s.pathAB.EndpointA.GetChannel().Version
+
+	pfmB := s.pktForwardMiddleware(s.chainB)
+	ack := pfmB.OnRecvPacket(s.chainB.GetContext(), version, packet, senderAddr)
+	s.Require().False(ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err = s.chainB.Codec.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
+	s.Require().NoError(err)
+	s.Require().Contains(expectedAck.GetError(), packetforwardtypes.ErrForwardNotAllowed.Error())
+}
+
+func (s *PFMTestSuite) TestOnRecvPacket_MultiTokenPacket() {
+	s.setupChains()
+
+	senderAddr := s.chainA.SenderAccount.GetAddress()
+	receiverAddr := s.chainC.SenderAccount.GetAddress()
+	metadata := &packetforwardtypes.PacketMetadata{
+		Forward: packetforwardtypes.ForwardMetadata{
+			Receiver: receiverAddr.String(),
+			Port:     s.pathBC.EndpointA.ChannelConfig.PortID,
+			Channel:  s.pathBC.EndpointA.ChannelID,
+		},
+	}
+	metadataJSON, err := metadata.ToMemo()
+	s.Require().NoError(err)
+
+	tokens := []transfertypes.Token{
+		{Denom: transfertypes.NewDenom("uatom"), Amount: "100"},
+		{Denom: transfertypes.NewDenom("uosmo"), Amount: "200"},
+	}
+
+	pfmB := s.pktForwardMiddleware(s.chainB)
+	receiver := s.chainB.SenderAccount.GetAddress()
+
+	// the packet with forward metadata is rejected instead of being received by the intermediate receiver
+	packet := s.transferPacket(senderAddr.String(), receiver.String(), s.pathAB, 0, "")
+	packet.Data = transfertypes.NewFungibleTokenPacketDataV2(tokens, senderAddr.String(), receiver.String(), metadataJSON).GetBytes()
+
+	ack := pfmB.OnRecvPacket(s.chainB.GetContext(), transfertypes.V2, packet, senderAddr)
+	s.Require().False(ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err = s.chainB.Codec.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
+	s.Require().NoError(err)
+	s.Require().Contains(expectedAck.GetError(), packetforwardtypes.ErrForwardNotAllowed.Error())
+
+	// the packet without forward metadata is received by the underlying application
+	packet.Data = transfertypes.NewFungibleTokenPacketDataV2(tokens, senderAddr.String(), receiver.String(), "").GetBytes()
+
+	ack = pfmB.OnRecvPacket(s.chainB.GetContext(), transfertypes.V2, packet, senderAddr)
+	s.Require().True(ack.Success())
+}
+
+func (s *PFMTestSuite) TestOnAcknowledgementPacket_HeldPacket() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expHeld  bool
+	}{
+		{
+			"success: refund channel is closed",
+			func() {
+				s.pathAB.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			true,
+		},
+		{
+			"success: refund client is frozen",
+			func() {
+				s.pathAB.EndpointB.FreezeClient()
+			},
+			true,
+		},
+		{
+			"refund path is open",
+			func() {},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setupChains()
+
+			senderAddr := s.chainA.SenderAccount.GetAddress()
+			intermediateAddr := s.chainB.SenderAccount.GetAddress()
+			receiverAddr := s.chainC.SenderAccount.GetAddress()
+
+			// the packet forwarded from chain B to chain C for a packet received from chain A
+			packet := s.transferPacket(intermediateAddr.String(), receiverAddr.String(), s.pathBC, 1, "")
+			inFlightPacket := &packetforwardtypes.InFlightPacket{
+				OriginalSenderAddress: senderAddr.String(),
+				RefundChannelId:       s.pathAB.EndpointB.ChannelID,
+				RefundPortId:          s.pathAB.EndpointB.ChannelConfig.PortID,
+				PacketSrcChannelId:    s.pathAB.EndpointA.ChannelID,
+				PacketSrcPortId:       s.pathAB.EndpointA.ChannelConfig.PortID,
+				PacketData:            s.transferPacket(senderAddr.String(), intermediateAddr.String(), s.pathAB, 1, "").Data,
+				RefundSequence:        1,
+			}
+
+			pfmKeeper := s.chainB.GetSimApp().PFMKeeper
+			err := pfmKeeper.SetInflightPacket(s.chainB.GetContext(), packet.SourceChannel, packet.SourcePort, packet.Sequence, inFlightPacket)
+			s.Require().NoError(err)
+
+			tc.malleate()
+
+			pfmB := s.pktForwardMiddleware(s.chainB)
+			ack := channeltypes.NewErrorAcknowledgement(errors.New("forward failed"))
+			version := s.pathBC.EndpointA.GetChannel().Version
+
+			ctxB := s.chainB.GetContext()
+			err = pfmB.OnAcknowledgementPacket(ctxB, version, packet, ack.Acknowledgement(), intermediateAddr)
+
+			heldPacket, getErr := pfmKeeper.GetInflightPacket(ctxB, packet)
+			s.Require().NoError(getErr)
+
+			if tc.expHeld {
+				// the acknowledgement of the forward completes and the funds are held until they are recovered
+				s.Require().NoError(err)
+				s.Require().NotNil(heldPacket)
+				s.Require().True(heldPacket.Held)
+			} else {
+				// the funds of the forward are refunded, which fails as they were never escrowed in this test
+				s.Require().Error(err)
+				s.Require().Nil(heldPacket)
+			}
+		})
+	}
+}
+
 func (s *PFMTestSuite) pktForwardMiddleware(chain *ibctesting.TestChain) *packetforward.IBCMiddleware {
 	pfmKeeper := chain.GetSimApp().PFMKeeper
 
@@ -250,7 +400,7 @@ func (s *PFMTestSuite) pktForwardMiddleware(chain *ibctesting.TestChain) *packet
 	transferStack, ok := ibcModule.(porttypes.PacketUnmarshalerModule)
 	s.Require().True(ok)
 
-	ibcMiddleware := packetforward.NewIBCMiddleware(pfmKeeper, 0, packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp)
+	ibcMiddleware := packetforward.NewIBCMiddleware(pfmKeeper)
 	ibcMiddleware.SetUnderlyingApplication(transferStack)
 	return ibcMiddleware
 }
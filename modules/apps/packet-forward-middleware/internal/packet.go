@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// GetDenomForThisChain returns the denom of the received token on this chain, unwinding the
+// trace if the token is returning to this chain and prefixing it with the hop otherwise.
+func GetDenomForThisChain(port, channel, counterpartyPort, counterpartyChannel string, denom transfertypes.Denom) string {
+	if denom.HasPrefix(counterpartyPort, counterpartyChannel) {
+		// unwind denom
+		denom.Trace = denom.Trace[1:]
+		if len(denom.Trace) == 0 {
+			// denom is now unwound back to native denom
+			return denom.Path()
+		}
+		// denom is still IBC denom
+		return denom.IBCDenom()
+	}
+	// append port and channel from this chain to denom
+	trace := []transfertypes.Hop{transfertypes.NewHop(port, channel)}
+	denom.Trace = append(trace, denom.Trace...)
+
+	return denom.IBCDenom()
+}
+
+// GetBoolFromAny returns the bool value is any is a valid bool, otherwise false.
+func GetBoolFromAny(value any) bool {
+	if value == nil {
+		return false
+	}
+	boolVal, ok := value.(bool)
+	if !ok {
+		return false
+	}
+	return boolVal
+}
+
+// GetReceiver returns the receiver address for a given channel and original sender.
+// it overrides the receiver address to be a hash of the channel/origSender so that
+// the receiver address is deterministic and can be used to identify the sender on the
+// initial chain.
+func GetReceiver(channel string, originalSender string) (string, error) {
+	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
+	senderHash32 := address.Hash(types.ModuleName, []byte(senderStr))
+	sender := sdk.AccAddress(senderHash32[:20])
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
+}
+
+// NewErrorAcknowledgement returns an error that identifies PFM and provides the error.
+// It's okay if these errors are non-deterministic, because they will not be committed to state, only emitted as events.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: fmt.Sprintf("packet-forward-middleware error: %s", err.Error()),
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+)
+
+// emitRecoverInFlightPacketEvent emits an event when the funds of an in-flight packet are recovered.
+func emitRecoverInFlightPacketEvent(ctx sdk.Context, msg *types.MsgRecoverInFlightPacket, inFlightPacket *types.InFlightPacket, amount sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecoverInFlightPacket,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(msg.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyOriginalSenderAddress, inFlightPacket.OriginalSenderAddress),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRecoveryAddress, msg.RecoveryAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
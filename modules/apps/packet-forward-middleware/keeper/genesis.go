@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
@@ -10,6 +12,8 @@ import (
 
 // InitGenesis
 func (k *Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
 	// Initialize store refund path for forwarded packets in genesis state that have not yet been acked.
 	store := k.storeService.OpenKVStore(ctx)
 	for key, value := range state.InFlightPackets {
@@ -31,9 +35,17 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 	for ; itr.Valid(); itr.Next() {
+		// the params are stored alongside the in-flight packets and are exported separately
+		if bytes.Equal(itr.Key(), []byte(types.ParamsKey)) {
+			continue
+		}
+
 		var inFlightPacket types.InFlightPacket
 		k.cdc.MustUnmarshal(itr.Value(), &inFlightPacket)
 		inFlightPackets[string(itr.Key())] = inFlightPacket
 	}
-	return &types.GenesisState{InFlightPackets: inFlightPackets}
+	return &types.GenesisState{
+		InFlightPackets: inFlightPackets,
+		Params:          k.GetParams(ctx),
+	}
 }
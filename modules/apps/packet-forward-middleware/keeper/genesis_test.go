@@ -22,11 +22,18 @@ func (s *KeeperTestSuite) TestGenesis() {
 
 	key := types.RefundPacketKey(sampleInflight.PacketSrcChannelId, sampleInflight.PacketSrcPortId, sampleInflight.RefundSequence)
 	keeper := s.chainA.GetSimApp().PFMKeeper
+
+	params := types.DefaultParams()
+	params.DefaultRetries = 3
+	params.AllowedChannels = []string{"channel-0"}
+	keeper.SetParams(s.chainA.GetContext(), params)
 	err := keeper.SetInflightPacket(s.chainA.GetContext(), sampleInflight.PacketSrcChannelId, sampleInflight.PacketSrcPortId, sampleInflight.RefundSequence, &sampleInflight)
 	s.Require().NoError(err)
 
 	genState := keeper.ExportGenesis(s.chainA.GetContext())
 	s.Require().Len(genState.InFlightPackets, 1)
+	s.Require().Equal(params, genState.Params)
+	s.Require().NoError(genState.Validate())
 
 	genesisInflight := genState.InFlightPackets[string(key)]
 
@@ -37,7 +44,9 @@ func (s *KeeperTestSuite) TestGenesis() {
 	s.Require().NoError(err)
 	s.Require().Nil(inflightFromStore)
 
+	keeper.SetParams(s.chainA.GetContext(), types.DefaultParams())
 	keeper.InitGenesis(s.chainA.GetContext(), *genState)
+	s.Require().Equal(params, keeper.GetParams(s.chainA.GetContext()))
 
 	inflightFromStore, err = keeper.GetInflightPacket(s.chainA.GetContext(), sampleInflight.ChannelPacket())
 	s.Require().NoError(err)
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines the packet forward middleware gRPC query server.
+type Querier struct {
+	k *Keeper
+}
+
+// NewQuerier returns a new Querier for the provided keeper.
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{k: keeper}
+}
+
+// InFlightPackets implements the Query/InFlightPackets gRPC method
+func (q Querier) InFlightPackets(c context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var inFlightPackets []types.IdentifiedInFlightPacket
+	store := runtime.KVStoreAdapter(q.k.storeService.OpenKVStore(ctx))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// the params are stored alongside the in-flight packets
+		if bytes.Equal(key, []byte(types.ParamsKey)) {
+			return false, nil
+		}
+
+		channelID, portID, sequence, err := types.ParseRefundPacketKey(key)
+		if err != nil {
+			return false, err
+		}
+
+		// ignore the packet and continue to the next item if it does not match the requested filters
+		if req.DestinationChannelId != "" && channelID != req.DestinationChannelId {
+			return false, nil
+		}
+
+		var inFlightPacket types.InFlightPacket
+		if err := q.k.cdc.Unmarshal(value, &inFlightPacket); err != nil {
+			return false, err
+		}
+
+		if req.OriginalSenderAddress != "" && inFlightPacket.OriginalSenderAddress != req.OriginalSenderAddress {
+			return false, nil
+		}
+
+		if req.RefundChannelId != "" && inFlightPacket.RefundChannelId != req.RefundChannelId {
+			return false, nil
+		}
+
+		if accumulate {
+			inFlightPackets = append(inFlightPackets, types.IdentifiedInFlightPacket{
+				ChannelId:      channelID,
+				PortId:         portID,
+				Sequence:       sequence,
+				InFlightPacket: inFlightPacket,
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInFlightPacketsResponse{
+		InFlightPackets: inFlightPackets,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// InFlightPacket implements the Query/InFlightPacket gRPC method
+func (q Querier) InFlightPacket(c context.Context, req *types.QueryInFlightPacketRequest) (*types.QueryInFlightPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	inFlightPacket, err := q.k.GetInflightPacket(ctx, channeltypes.Packet{
+		SourceChannel: req.ChannelId,
+		SourcePort:    req.PortId,
+		Sequence:      req.Sequence,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if inFlightPacket == nil {
+		return nil, status.Errorf(codes.NotFound, "in-flight packet not found for channel %s, port %s and sequence %d", req.ChannelId, req.PortId, req.Sequence)
+	}
+
+	return &types.QueryInFlightPacketResponse{InFlightPacket: *inFlightPacket}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
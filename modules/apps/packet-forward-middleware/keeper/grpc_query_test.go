@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
+	pfmtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (s *KeeperTestSuite) TestQueryInFlightPackets() {
+	var (
+		req        *pfmtypes.QueryInFlightPacketsRequest
+		expPackets []pfmtypes.IdentifiedInFlightPacket
+	)
+
+	sender := s.chainA.SenderAccount.GetAddress().String()
+	otherSender := s.chainB.SenderAccount.GetAddress().String()
+
+	// in-flight packets are listed in store key order
+	inFlightPackets := []pfmtypes.IdentifiedInFlightPacket{
+		{
+			ChannelId: ibctesting.SecondClientID,
+			PortId:    transfertypes.PortID,
+			Sequence:  1,
+			InFlightPacket: pfmtypes.InFlightPacket{
+				OriginalSenderAddress: sender,
+				RefundChannelId:       ibctesting.FirstClientID,
+				RefundPortId:          transfertypes.PortID,
+				RefundSequence:        3,
+				IsV2:                  true,
+			},
+		},
+		{
+			ChannelId: ibctesting.FirstChannelID,
+			PortId:    transfertypes.PortID,
+			Sequence:  1,
+			InFlightPacket: pfmtypes.InFlightPacket{
+				OriginalSenderAddress: sender,
+				RefundChannelId:       ibctesting.FirstChannelID,
+				RefundPortId:          transfertypes.PortID,
+				RefundSequence:        1,
+			},
+		},
+		{
+			ChannelId: ibctesting.FirstChannelID,
+			PortId:    transfertypes.PortID,
+			Sequence:  2,
+			InFlightPacket: pfmtypes.InFlightPacket{
+				OriginalSenderAddress: otherSender,
+				RefundChannelId:       ibctesting.SecondChannelID,
+				RefundPortId:          transfertypes.PortID,
+				RefundSequence:        1,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no filters",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{}
+				expPackets = inFlightPackets
+			},
+			nil,
+		},
+		{
+			"success: filter by original sender",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{OriginalSenderAddress: otherSender}
+				expPackets = inFlightPackets[2:]
+			},
+			nil,
+		},
+		{
+			"success: filter by refund channel",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{RefundChannelId: ibctesting.FirstClientID}
+				expPackets = inFlightPackets[:1]
+			},
+			nil,
+		},
+		{
+			"success: filter by destination channel",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{DestinationChannelId: ibctesting.FirstChannelID}
+				expPackets = inFlightPackets[1:]
+			},
+			nil,
+		},
+		{
+			"success: combined filters",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{OriginalSenderAddress: sender, DestinationChannelId: ibctesting.FirstChannelID}
+				expPackets = inFlightPackets[1:2]
+			},
+			nil,
+		},
+		{
+			"success: paginated",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{
+					OriginalSenderAddress: sender,
+					Pagination:            &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+				expPackets = inFlightPackets[:1]
+			},
+			nil,
+		},
+		{
+			"success: no matches",
+			func() {
+				req = &pfmtypes.QueryInFlightPacketsRequest{RefundChannelId: ibctesting.InvalidID}
+				expPackets = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ctx := s.chainA.GetContext()
+			pfmKeeper := s.chainA.GetSimApp().PFMKeeper
+			for _, packet := range inFlightPackets {
+				err := pfmKeeper.SetInflightPacket(ctx, packet.ChannelId, packet.PortId, packet.Sequence, &packet.InFlightPacket)
+				s.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			res, err := keeper.NewQuerier(pfmKeeper).InFlightPackets(ctx, req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(expPackets, res.InFlightPackets)
+			if req.Pagination != nil && req.Pagination.CountTotal {
+				s.Require().Equal(uint64(2), res.Pagination.Total)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryInFlightPacket() {
+	var req *pfmtypes.QueryInFlightPacketRequest
+
+	inFlightPacket := pfmtypes.InFlightPacket{
+		OriginalSenderAddress: s.chainA.SenderAccount.GetAddress().String(),
+		RefundChannelId:       ibctesting.FirstChannelID,
+		RefundPortId:          transfertypes.PortID,
+		RefundSequence:        1,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"failure: in-flight packet not found",
+			func() {
+				req.Sequence = 2
+			},
+			status.Errorf(codes.NotFound, "in-flight packet not found for channel %s, port %s and sequence %d", ibctesting.SecondChannelID, transfertypes.PortID, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ctx := s.chainA.GetContext()
+			pfmKeeper := s.chainA.GetSimApp().PFMKeeper
+			err := pfmKeeper.SetInflightPacket(ctx, ibctesting.SecondChannelID, transfertypes.PortID, 1, &inFlightPacket)
+			s.Require().NoError(err)
+
+			req = &pfmtypes.QueryInFlightPacketRequest{
+				ChannelId: ibctesting.SecondChannelID,
+				PortId:    transfertypes.PortID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := keeper.NewQuerier(pfmKeeper).InFlightPacket(ctx, req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(inFlightPacket, res.InFlightPacket)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryParams() {
+	ctx := s.chainA.GetContext()
+	pfmKeeper := s.chainA.GetSimApp().PFMKeeper
+
+	expParams := pfmtypes.DefaultParams()
+	expParams.DefaultRetries = 1
+	pfmKeeper.SetParams(ctx, expParams)
+
+	res, err := keeper.NewQuerier(pfmKeeper).Params(ctx, &pfmtypes.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&expParams, res.Params)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"time"
@@ -22,7 +23,10 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	coremetrics "github.com/cosmos/ibc-go/v10/modules/core/metrics"
 )
@@ -42,16 +46,21 @@ type Keeper struct {
 
 	transferKeeper types.TransferKeeper
 	channelKeeper  types.ChannelKeeper
+	clientKeeper   types.ClientKeeper
 	bankKeeper     types.BankKeeper
 	ics4Wrapper    porttypes.ICS4Wrapper
 
+	// writeAckWrapperV2 is used to write acknowledgements for packets received over IBC v2.
+	// It is set by the IBC v2 middleware when it is wired into the v2 router.
+	writeAckWrapperV2 api.WriteAcknowledgementWrapper
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 }
 
 // NewKeeper creates a new forward Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper, authority string) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper, bankKeeper types.BankKeeper, authority string) *Keeper {
 	return &Keeper{
 		cdc:            cdc,
 		storeService:   storeService,
@@ -60,6 +69,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, tra
 		// This can be overridden later with WithICS4Wrapper (e.g. by the middleware stack wiring)
 		ics4Wrapper:   channelKeeper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		bankKeeper:    bankKeeper,
 		authority:     authority,
 	}
@@ -70,11 +80,42 @@ func (k *Keeper) WithICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = ics4Wrapper
 }
 
+// WithWriteAckWrapperV2 sets the WriteAcknowledgementWrapper used to write acknowledgements
+// for forwarded packets that were originally received over IBC v2.
+func (k *Keeper) WithWriteAckWrapperV2(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	k.writeAckWrapperV2 = writeAckWrapper
+}
+
 // GetAuthority returns the module's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetParams returns the packet forward middleware parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("packet forward middleware params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the packet forward middleware parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
 // SetICS4Wrapper sets the ICS4 wrapper.
 func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = ics4Wrapper
@@ -95,6 +136,18 @@ func (*Keeper) Logger(ctx sdk.Context) log.Logger {
 // i.e. an operation has occurred to make the original packet funds inaccessible to the user, e.g. a swap.
 // We cannot refund the funds back to the original chain, so we move them to an account on this chain that the user can access.
 func (k *Keeper) moveFundsToUserRecoverableAccount(ctx sdk.Context, packet channeltypes.Packet, token transfertypes.Token, inFlightPacket *types.InFlightPacket) error {
+	userAccount, err := userRecoverableAccount(inFlightPacket)
+	if err != nil {
+		return fmt.Errorf("failed to get user recoverable account: %w", err)
+	}
+
+	return k.moveFundsToAccount(ctx, packet.SourcePort, packet.SourceChannel, token, inFlightPacket, userAccount)
+}
+
+// moveFundsToAccount releases the funds of a packet forwarded on the given port and channel, together with the fee
+// withheld from it, to an account on this chain. The funds are moved out of the escrow account if the forwarded tokens
+// were escrowed and are minted back if they were burned when the packet was forwarded.
+func (k *Keeper) moveFundsToAccount(ctx sdk.Context, sourcePort, sourceChannel string, token transfertypes.Token, inFlightPacket *types.InFlightPacket, account sdk.AccAddress) error {
 	amount, ok := sdkmath.NewIntFromString(token.GetAmount())
 	if !ok {
 		return fmt.Errorf("failed to parse amount from packet data for forward recovery: %s", token.GetAmount())
@@ -102,27 +155,27 @@ func (k *Keeper) moveFundsToUserRecoverableAccount(ctx sdk.Context, packet chann
 	denom := token.GetDenom()
 	coin := sdk.NewCoin(denom.IBCDenom(), amount)
 
-	userAccount, err := userRecoverableAccount(inFlightPacket)
-	if err != nil {
-		return fmt.Errorf("failed to get user recoverable account: %w", err)
+	// the fee withheld from the forwarded packet is not charged as the forward did not succeed
+	if err := k.sendForwardFee(ctx, inFlightPacket, account); err != nil {
+		return fmt.Errorf("failed to send forward fee to recovery account: %w", err)
 	}
 
-	if !denom.HasPrefix(packet.SourcePort, packet.SourceChannel) {
-		// mint vouchers back to sender
+	if denom.HasPrefix(sourcePort, sourceChannel) {
+		// the vouchers were burned when the packet was forwarded, mint them back
 		if err := k.bankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
 			return err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, userAccount, sdk.NewCoins(coin)); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, account, sdk.NewCoins(coin)); err != nil {
 			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 		}
 		return nil
 	}
 
-	escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+	escrowAddress := transfertypes.GetEscrowAddress(sourcePort, sourceChannel)
 
-	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, userAccount, sdk.NewCoins(coin)); err != nil {
-		return fmt.Errorf("failed to send coins from escrow account to user recoverable account: %w", err)
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, account, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("failed to send coins from escrow account to recovery account: %w", err)
 	}
 
 	// update the total escrow amount for the denom.
@@ -154,14 +207,27 @@ func userRecoverableAccount(inFlightPacket *types.InFlightPacket) (sdk.AccAddres
 }
 
 func (k *Keeper) WriteAcknowledgementForForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, transferDetail transfertypes.InternalTransferRepresentation, inFlightPacket *types.InFlightPacket, ack channeltypes.Acknowledgement) error {
-	// Lookup module by channel capability
-	_, found := k.channelKeeper.GetChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
-	if !found {
-		return errors.New("could not retrieve module from port-id")
+	// the funds of a failed forward are held on this chain if the acknowledgement cannot be relayed back, so that they
+	// can be recovered with MsgRecoverInFlightPacket instead of blocking the acknowledgement or timeout of the forward
+	if !ack.Success() && !k.isRefundPathOpen(ctx, inFlightPacket) {
+		return k.holdInFlightPacket(ctx, packet, inFlightPacket)
+	}
+
+	// IBC v2 packets are not received over a channel, the async packet is looked up by client ID instead
+	if !inFlightPacket.IsV2 {
+		// Lookup module by channel capability
+		_, found := k.channelKeeper.GetChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if !found {
+			return errors.New("could not retrieve module from port-id")
+		}
 	}
 
 	if ack.Success() {
-		return k.ics4Wrapper.WriteAcknowledgement(ctx, inFlightPacket.ChannelPacket(), ack)
+		if err := k.payForwardFee(ctx, inFlightPacket); err != nil {
+			return err
+		}
+
+		return k.writeAcknowledgementForInFlightPacket(ctx, inFlightPacket, ack)
 	}
 
 	// For forwarded packets, the funds were moved into an escrow account if the denom originated on this chain.
@@ -173,24 +239,28 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(ctx sdk.Context, packet
 	if inFlightPacket.Nonrefundable {
 		// We are not allowed to refund back to the source chain.
 		// attempt to move funds to user recoverable account on this chain.
-		if err := k.moveFundsToUserRecoverableAccount(ctx, packet, transferDetail.Token, inFlightPacket); err != nil {
+		if err := k.moveFundsToUserRecoverableAccount(ctx, packet, transferDetail.Tokens[0], inFlightPacket); err != nil {
 			return err
 		}
 
 		ackResult := fmt.Sprintf("packet forward failed after point of no return: %s", ack.GetError())
 		newAck := channeltypes.NewResultAcknowledgement([]byte(ackResult))
 
-		return k.ics4Wrapper.WriteAcknowledgement(ctx, inFlightPacket.ChannelPacket(), newAck)
+		return k.writeAcknowledgementForInFlightPacket(ctx, inFlightPacket, newAck)
 	}
 
-	amount, ok := sdkmath.NewIntFromString(transferDetail.Token.GetAmount())
+	amount, ok := sdkmath.NewIntFromString(transferDetail.Tokens[0].GetAmount())
 	if !ok {
-		return fmt.Errorf("failed to parse amount from packet data for forward refund: %s", transferDetail.Token.GetAmount())
+		return fmt.Errorf("failed to parse amount from packet data for forward refund: %s", transferDetail.Tokens[0].GetAmount())
 	}
 
-	denom := transferDetail.Token.GetDenom()
+	denom := transferDetail.Tokens[0].GetDenom()
 	coin := sdk.NewCoin(denom.IBCDenom(), amount)
 
+	if err := k.refundForwardFee(ctx, inFlightPacket, denom); err != nil {
+		return err
+	}
+
 	escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
 	refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
 
@@ -237,7 +307,122 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(ctx sdk.Context, packet
 		k.transferKeeper.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 	}
 
-	return k.ics4Wrapper.WriteAcknowledgement(ctx, inFlightPacket.ChannelPacket(), ack)
+	return k.writeAcknowledgementForInFlightPacket(ctx, inFlightPacket, ack)
+}
+
+// writeAcknowledgementForInFlightPacket writes the acknowledgement for the original packet of a forward.
+// Packets received over IBC v1 are acknowledged through the ICS4Wrapper, while packets received over IBC v2
+// are acknowledged through the v2 WriteAcknowledgementWrapper, as the app acknowledgement of the forwarded
+// payload. IBC v2 does not support custom error acknowledgements, so the sentinel error acknowledgement is
+// written for unsuccessful forwards.
+func (k *Keeper) writeAcknowledgementForInFlightPacket(ctx sdk.Context, inFlightPacket *types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	if !inFlightPacket.IsV2 {
+		return k.ics4Wrapper.WriteAcknowledgement(ctx, inFlightPacket.ChannelPacket(), ack)
+	}
+
+	if k.writeAckWrapperV2 == nil {
+		return errors.New("write acknowledgement wrapper for IBC v2 is not set")
+	}
+
+	appAck := ack.Acknowledgement()
+	if !ack.Success() {
+		appAck = channeltypesv2.ErrorAcknowledgement[:]
+	}
+
+	return k.writeAckWrapperV2.WritePayloadAcknowledgement(ctx, inFlightPacket.RefundChannelId, inFlightPacket.RefundSequence, inFlightPacket.RefundPayloadIndex, appAck)
+}
+
+// payForwardFee pays the fee withheld from the forwarded packet to the fee receiver.
+func (k *Keeper) payForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket) error {
+	if !hasForwardFee(inFlightPacket) {
+		return nil
+	}
+
+	feeReceiver, err := sdk.AccAddressFromBech32(inFlightPacket.FeeReceiver)
+	if err != nil {
+		return fmt.Errorf("failed to decode fee receiver address: %w", err)
+	}
+
+	if err := k.sendForwardFee(ctx, inFlightPacket, feeReceiver); err != nil {
+		return fmt.Errorf("failed to send forward fee to fee receiver: %w", err)
+	}
+
+	return nil
+}
+
+// sendForwardFee sends the fee withheld from the forwarded packet from the fee payer to the recipient.
+func (k *Keeper) sendForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, recipient sdk.AccAddress) error {
+	if !hasForwardFee(inFlightPacket) {
+		return nil
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(inFlightPacket.FeePayer)
+	if err != nil {
+		return fmt.Errorf("failed to decode fee payer address: %w", err)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, feePayer, recipient, sdk.NewCoins(*inFlightPacket.Fee))
+}
+
+// refundForwardFee reverts the receipt of the fee withheld from the forwarded packet so that it can be refunded
+// on the chain the original packet was sent from, together with the forwarded amount. The fee is burned if the
+// vouchers were minted when the original packet was received and is moved back to the refund escrow account otherwise.
+func (k *Keeper) refundForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, denom transfertypes.Denom) error {
+	if !hasForwardFee(inFlightPacket) {
+		return nil
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(inFlightPacket.FeePayer)
+	if err != nil {
+		return fmt.Errorf("failed to decode fee payer address: %w", err)
+	}
+
+	fee := sdk.NewCoins(*inFlightPacket.Fee)
+
+	if denom.HasPrefix(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId) {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, transfertypes.ModuleName, fee); err != nil {
+			return fmt.Errorf("failed to send forward fee to module account for burn: %w", err)
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, fee); err != nil {
+			// NOTE: should not happen as the module account has just received the fee.
+			panic(fmt.Sprintf("cannot burn coins after a successful send from fee payer to module account: %v", err))
+		}
+
+		return nil
+	}
+
+	refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err := k.bankKeeper.SendCoins(ctx, feePayer, refundEscrowAddress, fee); err != nil {
+		return fmt.Errorf("failed to send forward fee to refund escrow account: %w", err)
+	}
+
+	currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, inFlightPacket.Fee.Denom)
+	k.transferKeeper.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(*inFlightPacket.Fee))
+
+	return nil
+}
+
+// hasForwardFee returns true if a fee was withheld from the forwarded packet.
+func hasForwardFee(inFlightPacket *types.InFlightPacket) bool {
+	return inFlightPacket.Fee != nil && inFlightPacket.Fee.IsPositive()
+}
+
+// withholdForwardFee deducts the forwarding fee from the token to be forwarded and records it on the in-flight packet.
+// The fee stays with the fee payer, i.e. the receiver of the original packet, until the forwarded packet is acknowledged.
+func (k *Keeper) withholdForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, feePayer string, token sdk.Coin) sdk.Coin {
+	params := k.GetParams(ctx)
+
+	fee := params.ForwardFee(token)
+	if !fee.IsPositive() {
+		return token
+	}
+
+	inFlightPacket.Fee = &fee
+	inFlightPacket.FeeReceiver = params.FeeReceiver
+	inFlightPacket.FeePayer = feePayer
+
+	return token.Sub(fee)
 }
 
 // unescrowToken will update the total escrow by deducting the unescrowed token
@@ -249,6 +434,50 @@ func (k *Keeper) unescrowToken(ctx sdk.Context, token sdk.Coin) {
 }
 
 func (k *Keeper) ForwardTransferPacket(ctx sdk.Context, inFlightPacket *types.InFlightPacket, srcPacket channeltypes.Packet, srcPacketSender, receiver string, metadata types.ForwardMetadata, token sdk.Coin, maxRetries uint8, timeoutDelta time.Duration, labels []metrics.Label, nonrefundable bool) error {
+	if inFlightPacket == nil {
+		inFlightPacket = newInFlightPacket(srcPacket, srcPacketSender, maxRetries, timeoutDelta, nonrefundable)
+		token = k.withholdForwardFee(ctx, inFlightPacket, receiver, token)
+	} else {
+		inFlightPacket.RetriesRemaining--
+	}
+
+	return k.forwardTransferPacket(ctx, inFlightPacket, receiver, metadata, token, timeoutDelta, labels)
+}
+
+// ForwardTransferPacketV2 forwards a packet received over IBC v2. The source packet must be the IBC v1
+// representation of the received payload, with the source and destination client IDs used as channel IDs.
+// The acknowledgement for the received payload, at the given index of the received packet, is written
+// asynchronously through the v2 WriteAcknowledgementWrapper once the forwarded packet is acknowledged or timed out.
+func (k *Keeper) ForwardTransferPacketV2(ctx sdk.Context, srcPacket channeltypes.Packet, srcPayloadIndex uint32, srcPacketSender, receiver string, metadata types.ForwardMetadata, token sdk.Coin, maxRetries uint8, timeoutDelta time.Duration, labels []metrics.Label, nonrefundable bool) error {
+	inFlightPacket := newInFlightPacket(srcPacket, srcPacketSender, maxRetries, timeoutDelta, nonrefundable)
+	inFlightPacket.IsV2 = true
+	inFlightPacket.RefundPayloadIndex = srcPayloadIndex
+	token = k.withholdForwardFee(ctx, inFlightPacket, receiver, token)
+
+	return k.forwardTransferPacket(ctx, inFlightPacket, receiver, metadata, token, timeoutDelta, labels)
+}
+
+// newInFlightPacket returns the information about the original packet required for refunding if necessary.
+func newInFlightPacket(srcPacket channeltypes.Packet, srcPacketSender string, maxRetries uint8, timeoutDelta time.Duration, nonrefundable bool) *types.InFlightPacket {
+	return &types.InFlightPacket{
+		PacketData:            srcPacket.Data,
+		OriginalSenderAddress: srcPacketSender,
+		RefundChannelId:       srcPacket.DestinationChannel,
+		RefundPortId:          srcPacket.DestinationPort,
+		RefundSequence:        srcPacket.Sequence,
+		PacketSrcPortId:       srcPacket.SourcePort,
+		PacketSrcChannelId:    srcPacket.SourceChannel,
+
+		PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
+		PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
+
+		RetriesRemaining: int32(maxRetries),
+		Timeout:          uint64(timeoutDelta.Nanoseconds()),
+		Nonrefundable:    nonrefundable,
+	}
+}
+
+func (k *Keeper) forwardTransferPacket(ctx sdk.Context, inFlightPacket *types.InFlightPacket, receiver string, metadata types.ForwardMetadata, token sdk.Coin, timeoutDelta time.Duration, labels []metrics.Label) error {
 	memo := ""
 
 	// set memo for next transfer with next from this transfer.
@@ -270,7 +499,14 @@ func (k *Keeper) ForwardTransferPacket(ctx sdk.Context, inFlightPacket *types.In
 		"denom", token.Denom,
 	)
 
-	msgTransfer := transfertypes.NewMsgTransfer(metadata.Port, metadata.Channel, token, receiver, metadata.Receiver, DefaultTransferPacketTimeoutHeight, uint64(ctx.BlockTime().UnixNano())+uint64(timeoutDelta.Nanoseconds()), memo)
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(timeoutDelta.Nanoseconds())
+	// if the channel does not exist the transfer keeper sends the packet over IBC v2, in which case
+	// the channel in the metadata is the source client ID and the timeout timestamp is in seconds.
+	if _, found := k.channelKeeper.GetChannel(ctx, metadata.Port, metadata.Channel); !found {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(timeoutDelta).Unix())
+	}
+
+	msgTransfer := transfertypes.NewMsgTransfer(metadata.Port, metadata.Channel, token, receiver, metadata.Receiver, DefaultTransferPacketTimeoutHeight, timeoutTimestamp, memo)
 	// send tokens to destination
 	res, err := k.transferKeeper.Transfer(ctx, msgTransfer)
 	if err != nil {
@@ -290,27 +526,7 @@ func (k *Keeper) ForwardTransferPacket(ctx sdk.Context, inFlightPacket *types.In
 	// Store the following information in keeper:
 	// key - information about forwarded packet: src_channel (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence
 	// value - information about original packet for refunding if necessary: retries, srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
-	if inFlightPacket == nil {
-		inFlightPacket = &types.InFlightPacket{
-			PacketData:            srcPacket.Data,
-			OriginalSenderAddress: srcPacketSender,
-			RefundChannelId:       srcPacket.DestinationChannel,
-			RefundPortId:          srcPacket.DestinationPort,
-			RefundSequence:        srcPacket.Sequence,
-			PacketSrcPortId:       srcPacket.SourcePort,
-			PacketSrcChannelId:    srcPacket.SourceChannel,
-
-			PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
-			PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
-
-			RetriesRemaining: int32(maxRetries),
-			Timeout:          uint64(timeoutDelta.Nanoseconds()),
-			Nonrefundable:    nonrefundable,
-		}
-	} else {
-		inFlightPacket.RetriesRemaining--
-	}
-
+	// For packets forwarded over IBC v2 the src_channel is the source client ID.
 	if err := k.SetInflightPacket(ctx, metadata.Channel, metadata.Port, res.Sequence, inFlightPacket); err != nil {
 		return err
 	}
@@ -370,19 +586,19 @@ func (k *Keeper) RetryTimeout(ctx sdk.Context, channel, port string, transferDet
 		metadata.Next = &next
 	}
 
-	amount, ok := sdkmath.NewIntFromString(transferDetail.Token.GetAmount())
+	amount, ok := sdkmath.NewIntFromString(transferDetail.Tokens[0].GetAmount())
 	if !ok {
 		k.Logger(ctx).Error("packetForwardMiddleware error parsing amount from string for packetforward retry on timeout",
 			"original-sender-address", inFlightPacket.OriginalSenderAddress,
 			"refund-channel-id", inFlightPacket.RefundChannelId,
 			"refund-port-id", inFlightPacket.RefundPortId,
 			"retries-remaining", inFlightPacket.RetriesRemaining,
-			"amount", transferDetail.Token.GetAmount(),
+			"amount", transferDetail.Tokens[0].GetAmount(),
 		)
-		return fmt.Errorf("error parsing amount from string for packetforward retry: %s", transferDetail.Token.GetAmount())
+		return fmt.Errorf("error parsing amount from string for packetforward retry: %s", transferDetail.Tokens[0].GetAmount())
 	}
 
-	ibcDenom := transferDetail.Token.Denom.IBCDenom()
+	ibcDenom := transferDetail.Tokens[0].Denom.IBCDenom()
 
 	token := sdk.NewCoin(ibcDenom, amount)
 
@@ -431,6 +647,105 @@ func (k *Keeper) RemoveInFlightPacket(ctx sdk.Context, packet channeltypes.Packe
 	}
 }
 
+// authorizeRecovery returns an error if the signer is not allowed to recover the in-flight packet. The authority can always
+// recover held in-flight packets. If enabled in the params, the original sender of a packet received over IBC v1 can recover
+// the held in-flight packet, as its funds could not be refunded to it.
+func (k *Keeper) authorizeRecovery(ctx sdk.Context, signer string, inFlightPacket *types.InFlightPacket) error {
+	if signer == k.GetAuthority() {
+		return nil
+	}
+
+	if !k.GetParams(ctx).SenderRecoveryEnabled {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), signer)
+	}
+
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	// the original sender is an address on the chain the packet was sent from, so only the address bytes are compared
+	_, senderAddr, err := bech32.DecodeAndConvert(inFlightPacket.OriginalSenderAddress)
+	if err != nil || !bytes.Equal(signerAddr, senderAddr) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or original sender %s, got %s", k.GetAuthority(), inFlightPacket.OriginalSenderAddress, signer)
+	}
+
+	if inFlightPacket.IsV2 {
+		return errorsmod.Wrap(ibcerrors.ErrUnauthorized, "in-flight packets received over IBC v2 can only be recovered by the authority")
+	}
+
+	return nil
+}
+
+// isRefundPathOpen returns true if the acknowledgement of the original packet of an in-flight packet can be written and
+// relayed back to the chain it was sent from, i.e. if the refund channel is open and its client is active. For packets
+// received over IBC v2, the refund client must be active.
+func (k *Keeper) isRefundPathOpen(ctx sdk.Context, inFlightPacket *types.InFlightPacket) bool {
+	clientID := inFlightPacket.RefundChannelId
+	if !inFlightPacket.IsV2 {
+		channel, found := k.channelKeeper.GetChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if !found || channel.State != channeltypes.OPEN {
+			return false
+		}
+
+		var err error
+		clientID, _, err = k.channelKeeper.GetChannelClientState(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if err != nil {
+			return false
+		}
+	}
+
+	return k.clientKeeper.GetClientStatus(ctx, clientID) == ibcexported.Active
+}
+
+// holdInFlightPacket marks the in-flight packet of a failed forward as held, as its funds cannot be refunded to the
+// chain the original packet was sent from. The funds are kept on this chain until the in-flight packet is recovered.
+func (k *Keeper) holdInFlightPacket(ctx sdk.Context, packet channeltypes.Packet, inFlightPacket *types.InFlightPacket) error {
+	inFlightPacket.Held = true
+	if err := k.SetInflightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence, inFlightPacket); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("packetForwardMiddleware held in-flight packet of failed forward as the refund path is closed",
+		"channel", packet.SourceChannel,
+		"port", packet.SourcePort,
+		"sequence", packet.Sequence,
+		"refund-channel", inFlightPacket.RefundChannelId,
+		"refund-port", inFlightPacket.RefundPortId,
+	)
+
+	return nil
+}
+
+// forwardedToken returns the token that was forwarded for the in-flight packet, i.e. the token of the original packet
+// as denominated on this chain, without the fee withheld from it.
+func forwardedToken(inFlightPacket *types.InFlightPacket) (transfertypes.Token, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &data); err != nil {
+		return transfertypes.Token{}, fmt.Errorf("failed to unmarshal original packet data: %w", err)
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return transfertypes.Token{}, fmt.Errorf("failed to parse amount from original packet data: %s", data.Amount)
+	}
+
+	if hasForwardFee(inFlightPacket) {
+		amount = amount.Sub(inFlightPacket.Fee.Amount)
+	}
+
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	if denom.HasPrefix(inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId) {
+		// the tokens were returning to this chain, remove the prefix added by the sender chain
+		denom.Trace = denom.Trace[1:]
+	} else {
+		// the vouchers were minted on this chain, add the prefix of the refund channel
+		denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)}, denom.Trace...)
+	}
+
+	return transfertypes.Token{Denom: denom, Amount: amount.String()}, nil
+}
+
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
 func (k *Keeper) SendPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	return k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
@@ -141,7 +141,7 @@ func (s *KeeperTestSuite) TestWriteAcknowledgementForForwardedPacket() {
 				Denom:  transfertypes.ExtractDenomFromPath(ibctesting.TestCoin.GetDenom()),
 				Amount: ibctesting.DefaultCoinAmount.String(),
 			}
-			data := transfertypes.NewInternalTransferRepresentation(token, initialSender.String(), finalReceiver.String(), "")
+			data := transfertypes.NewInternalTransferRepresentation(transfertypes.Tokens{token}, initialSender.String(), finalReceiver.String(), "")
 			expectedAckBz = channeltypes.CommitAcknowledgement(tc.ack.Acknowledgement())
 			if tc.malleate != nil {
 				tc.malleate()
@@ -150,6 +150,7 @@ func (s *KeeperTestSuite) TestWriteAcknowledgementForForwardedPacket() {
 			// Escrow on chainC
 			escrow := transfertypes.GetEscrowAddress(srcPacket.SourcePort, srcPacket.SourceChannel)
 			fundAcc(ctxC, s.chainC.GetSimApp().BankKeeper, escrow)
+			s.chainC.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctxC, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000000))
 
 			err = pfmKeeperC.WriteAcknowledgementForForwardedPacket(ctxC, srcPacket, data, inflightPacket, tc.ack)
 			s.Require().NoError(err)
@@ -165,7 +166,7 @@ func (s *KeeperTestSuite) TestForwardTransferPacket() {
 	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
 	path.Setup()
 
-	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
+	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().IBCKeeper.ClientKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
 
 	ctx := s.chainA.GetContext()
 	srcPacket := channeltypes.Packet{
@@ -224,7 +225,7 @@ func (s *KeeperTestSuite) TestForwardTransferPacketWithNext() {
 	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
 	path.Setup()
 
-	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
+	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().IBCKeeper.ClientKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
 	ctx := s.chainA.GetContext()
 	srcPacket := channeltypes.Packet{
 		Data:               []byte{1},
@@ -283,15 +284,15 @@ func (s *KeeperTestSuite) TestRetryTimeoutErrorGettingNext() {
 	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
 	path.Setup()
 
-	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
+	pfmKeeper := keeper.NewKeeper(s.chainA.GetSimApp().AppCodec(), runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)), &transferMock{}, s.chainA.GetSimApp().IBCKeeper.ChannelKeeper, s.chainA.GetSimApp().IBCKeeper.ClientKeeper, s.chainA.GetSimApp().BankKeeper, "authority")
 	ctx := s.chainA.GetContext()
 
 	// Create a transfer detail with invalid memo that will cause GetPacketMetadataFromPacketdata to fail
 	transferDetail := transfertypes.InternalTransferRepresentation{
-		Token: transfertypes.Token{
+		Tokens: transfertypes.Tokens{transfertypes.Token{
 			Denom:  transfertypes.Denom{Base: "denom"},
 			Amount: "1000",
-		},
+		}},
 		Sender:   "sender",
 		Receiver: "receiver",
 		Memo:     `{"invalid_json": malformed}`, // This will cause JSON parsing to fail
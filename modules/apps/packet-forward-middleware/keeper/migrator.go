@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/migrations/v3"
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -22,3 +23,10 @@ func NewMigrator(k *Keeper) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.Migrate(ctx, m.keeper.bankKeeper, m.keeper.channelKeeper, m.keeper.transferKeeper)
 }
+
+// Migrate3to4 migrates the module state from the consensus version 3 to
+// version 4 by setting the default params.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
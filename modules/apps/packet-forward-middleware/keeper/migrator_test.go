@@ -145,3 +145,18 @@ func (s *KeeperTestSuite) TestMigrator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	ctx := s.chainA.GetContext()
+	pfmKeeper := s.chainA.GetSimApp().PFMKeeper
+
+	// chains upgrading from consensus version 3 do not have params set in store
+	ctx.KVStore(s.chainA.GetSimApp().GetKey(pfmtypes.StoreKey)).Delete([]byte(pfmtypes.ParamsKey))
+	s.Require().Panics(func() {
+		pfmKeeper.GetParams(ctx)
+	})
+
+	err := pfmkeeper.NewMigrator(pfmKeeper).Migrate3to4(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(pfmtypes.DefaultParams(), pfmKeeper.GetParams(ctx))
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the packet forward middleware parameters.
+func (k *Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RecoverInFlightPacket defines an rpc handler method for MsgRecoverInFlightPacket. Removes the held in-flight packet and
+// releases the funds held for the forward to the recovery address. Only in-flight packets whose forward failed while the
+// refund path was closed are held, so the funds cannot be released while the forward can still succeed or be refunded.
+func (k *Keeper) RecoverInFlightPacket(goCtx context.Context, msg *types.MsgRecoverInFlightPacket) (*types.MsgRecoverInFlightPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packet := channeltypes.Packet{SourceChannel: msg.ChannelId, SourcePort: msg.PortId, Sequence: msg.Sequence}
+	inFlightPacket, err := k.GetInflightPacket(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if inFlightPacket == nil {
+		return nil, errorsmod.Wrapf(types.ErrInFlightPacketNotFound, "channel %s, port %s and sequence %d", msg.ChannelId, msg.PortId, msg.Sequence)
+	}
+
+	if !inFlightPacket.Held {
+		return nil, errorsmod.Wrapf(types.ErrForwardInProgress, "in-flight packet for channel %s, port %s and sequence %d is not held", msg.ChannelId, msg.PortId, msg.Sequence)
+	}
+
+	if err := k.authorizeRecovery(ctx, msg.Signer, inFlightPacket); err != nil {
+		return nil, err
+	}
+
+	recoveryAddress, err := sdk.AccAddressFromBech32(msg.RecoveryAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recovery address could not be parsed as address: %v", err)
+	}
+
+	token, err := forwardedToken(inFlightPacket)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveInFlightPacket(ctx, packet)
+
+	if err := k.moveFundsToAccount(ctx, msg.PortId, msg.ChannelId, token, inFlightPacket, recoveryAddress); err != nil {
+		return nil, err
+	}
+
+	coin, err := token.ToCoin()
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoins(coin)
+	if hasForwardFee(inFlightPacket) {
+		amount = amount.Add(*inFlightPacket.Fee)
+	}
+
+	k.Logger(ctx).Info("packetForwardMiddleware recovered in-flight packet",
+		"channel", msg.ChannelId,
+		"port", msg.PortId,
+		"sequence", msg.Sequence,
+		"signer", msg.Signer,
+		"recovery-address", msg.RecoveryAddress,
+		"amount", amount.String(),
+	)
+
+	emitRecoverInFlightPacketEvent(ctx, msg, inFlightPacket, amount)
+
+	return &types.MsgRecoverInFlightPacketResponse{Amount: amount}, nil
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// TestUpdateParams tests UpdateParams rpc handler
+func (s *KeeperTestSuite) TestUpdateParams() {
+	signer := s.chainA.GetSimApp().PFMKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.MaxRetries = 5
+	params.DeniedChannels = []string{ibctesting.FirstChannelID}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: valid signer and params",
+			types.NewMsgUpdateParams(signer, params),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams("signer", params),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is not the authority",
+			types.NewMsgUpdateParams(s.chainA.SenderAccount.GetAddress().String(), params),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ctx := s.chainA.GetContext()
+			_, err := s.chainA.GetSimApp().PFMKeeper.UpdateParams(ctx, tc.msg)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.msg.Params, s.chainA.GetSimApp().PFMKeeper.GetParams(ctx))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(types.DefaultParams(), s.chainA.GetSimApp().PFMKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+// TestRecoverInFlightPacket tests RecoverInFlightPacket rpc handler
+func (s *KeeperTestSuite) TestRecoverInFlightPacket() {
+	var (
+		pathAB, pathBC *ibctesting.Path
+		inFlightPacket *types.InFlightPacket
+		msg            *types.MsgRecoverInFlightPacket
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authority",
+			func() {},
+			nil,
+		},
+		{
+			"success: original sender",
+			func() {
+				params := s.chainB.GetSimApp().PFMKeeper.GetParams(s.chainB.GetContext())
+				params.SenderRecoveryEnabled = true
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+				msg.Signer = s.chainA.SenderAccount.GetAddress().String()
+			},
+			nil,
+		},
+		{
+			"failure: in-flight packet not found",
+			func() {
+				msg.Sequence = 2
+			},
+			types.ErrInFlightPacketNotFound,
+		},
+		{
+			"failure: sender recovery is disabled",
+			func() {
+				msg.Signer = s.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is not the original sender",
+			func() {
+				params := s.chainB.GetSimApp().PFMKeeper.GetParams(s.chainB.GetContext())
+				params.SenderRecoveryEnabled = true
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+				msg.Signer = s.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: forward is in progress",
+			func() {
+				inFlightPacket.Held = false
+				err := s.chainB.GetSimApp().PFMKeeper.SetInflightPacket(s.chainB.GetContext(), msg.ChannelId, msg.PortId, msg.Sequence, inFlightPacket)
+				s.Require().NoError(err)
+			},
+			types.ErrForwardInProgress,
+		},
+		{
+			"failure: original sender of a packet received over IBC v2",
+			func() {
+				params := s.chainB.GetSimApp().PFMKeeper.GetParams(s.chainB.GetContext())
+				params.SenderRecoveryEnabled = true
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+				inFlightPacket.IsV2 = true
+				err := s.chainB.GetSimApp().PFMKeeper.SetInflightPacket(s.chainB.GetContext(), msg.ChannelId, msg.PortId, msg.Sequence, inFlightPacket)
+				s.Require().NoError(err)
+
+				msg.Signer = s.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			pathAB = ibctesting.NewTransferPath(s.chainA, s.chainB)
+			pathAB.Setup()
+			pathBC = ibctesting.NewTransferPath(s.chainB, s.chainC)
+			pathBC.Setup()
+
+			ctx := s.chainB.GetContext()
+			pfmKeeper := s.chainB.GetSimApp().PFMKeeper
+			bankKeeper := s.chainB.GetSimApp().BankKeeper
+
+			// the vouchers received from chain A were forwarded to chain C and are held in escrow,
+			// except for the fee which is withheld by the receiver of the original packet
+			voucher := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID))
+			fee := sdk.NewInt64Coin(voucher.IBCDenom(), 10)
+			forwarded := sdk.NewInt64Coin(voucher.IBCDenom(), 90)
+			feePayer := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			escrowAddress := transfertypes.GetEscrowAddress(pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+
+			s.Require().NoError(bankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(fee.Add(forwarded))))
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, feePayer, sdk.NewCoins(fee)))
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, escrowAddress, sdk.NewCoins(forwarded)))
+			s.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, forwarded)
+
+			packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", s.chainA.SenderAccount.GetAddress().String(), feePayer.String(), "")
+			inFlightPacket = &types.InFlightPacket{
+				PacketData:            packetData.GetBytes(),
+				OriginalSenderAddress: s.chainA.SenderAccount.GetAddress().String(),
+				RefundChannelId:       pathAB.EndpointB.ChannelID,
+				RefundPortId:          pathAB.EndpointB.ChannelConfig.PortID,
+				RefundSequence:        1,
+				PacketSrcPortId:       pathAB.EndpointA.ChannelConfig.PortID,
+				PacketSrcChannelId:    pathAB.EndpointA.ChannelID,
+				Fee:                   &fee,
+				FeeReceiver:           s.chainB.SenderAccount.GetAddress().String(),
+				FeePayer:              feePayer.String(),
+				Held:                  true,
+			}
+			err := pfmKeeper.SetInflightPacket(ctx, pathBC.EndpointA.ChannelID, pathBC.EndpointA.ChannelConfig.PortID, 1, inFlightPacket)
+			s.Require().NoError(err)
+
+			recoveryAddress := s.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+			msg = types.NewMsgRecoverInFlightPacket(pfmKeeper.GetAuthority(), pathBC.EndpointA.ChannelID, pathBC.EndpointA.ChannelConfig.PortID, 1, recoveryAddress.String())
+
+			tc.malleate()
+
+			ctx = s.chainB.GetContext()
+			res, err := pfmKeeper.RecoverInFlightPacket(ctx, msg)
+
+			forwardedPacket := channeltypes.Packet{SourceChannel: msg.ChannelId, SourcePort: msg.PortId, Sequence: msg.Sequence}
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().True(bankKeeper.GetBalance(ctx, recoveryAddress, voucher.IBCDenom()).IsZero())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(voucher.IBCDenom(), 100)), res.Amount)
+			s.Require().Equal(res.Amount[0], bankKeeper.GetBalance(ctx, recoveryAddress, voucher.IBCDenom()))
+			s.Require().True(bankKeeper.GetBalance(ctx, escrowAddress, voucher.IBCDenom()).IsZero())
+			s.Require().True(bankKeeper.GetBalance(ctx, feePayer, voucher.IBCDenom()).IsZero())
+			s.Require().True(s.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, voucher.IBCDenom()).IsZero())
+
+			recovered, err := pfmKeeper.GetInflightPacket(ctx, forwardedPacket)
+			s.Require().NoError(err)
+			s.Require().Nil(recovered)
+		})
+	}
+}
+
+// TestRecoverInFlightPacketBurnedVouchers tests that RecoverInFlightPacket mints back the vouchers burned when they
+// were forwarded back to their source chain.
+func (s *KeeperTestSuite) TestRecoverInFlightPacketBurnedVouchers() {
+	pathAB := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	pathAB.Setup()
+	pathBC := ibctesting.NewTransferPath(s.chainB, s.chainC)
+	pathBC.Setup()
+
+	ctx := s.chainB.GetContext()
+	pfmKeeper := s.chainB.GetSimApp().PFMKeeper
+	bankKeeper := s.chainB.GetSimApp().BankKeeper
+
+	// the vouchers of chain C were received back from chain A and burned when they were forwarded to chain C,
+	// except for the fee which is withheld by the receiver of the original packet
+	voucher := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID))
+	fee := sdk.NewInt64Coin(voucher.IBCDenom(), 10)
+	feePayer := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	s.Require().NoError(bankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(fee)))
+	s.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, feePayer, sdk.NewCoins(fee)))
+	supply := bankKeeper.GetSupply(ctx, voucher.IBCDenom())
+
+	originalDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(pathAB.EndpointA.ChannelConfig.PortID, pathAB.EndpointA.ChannelID), voucher.Trace[0])
+	packetData := transfertypes.NewFungibleTokenPacketData(originalDenom.Path(), "100", s.chainA.SenderAccount.GetAddress().String(), feePayer.String(), "")
+	inFlightPacket := &types.InFlightPacket{
+		PacketData:            packetData.GetBytes(),
+		OriginalSenderAddress: s.chainA.SenderAccount.GetAddress().String(),
+		RefundChannelId:       pathAB.EndpointB.ChannelID,
+		RefundPortId:          pathAB.EndpointB.ChannelConfig.PortID,
+		RefundSequence:        1,
+		PacketSrcPortId:       pathAB.EndpointA.ChannelConfig.PortID,
+		PacketSrcChannelId:    pathAB.EndpointA.ChannelID,
+		Fee:                   &fee,
+		FeeReceiver:           s.chainB.SenderAccount.GetAddress().String(),
+		FeePayer:              feePayer.String(),
+		Held:                  true,
+	}
+	err := pfmKeeper.SetInflightPacket(ctx, pathBC.EndpointA.ChannelID, pathBC.EndpointA.ChannelConfig.PortID, 1, inFlightPacket)
+	s.Require().NoError(err)
+
+	recoveryAddress := s.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+	msg := types.NewMsgRecoverInFlightPacket(pfmKeeper.GetAuthority(), pathBC.EndpointA.ChannelID, pathBC.EndpointA.ChannelConfig.PortID, 1, recoveryAddress.String())
+
+	res, err := pfmKeeper.RecoverInFlightPacket(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(voucher.IBCDenom(), 100)), res.Amount)
+	s.Require().Equal(res.Amount[0], bankKeeper.GetBalance(ctx, recoveryAddress, voucher.IBCDenom()))
+	s.Require().True(bankKeeper.GetBalance(ctx, feePayer, voucher.IBCDenom()).IsZero())
+
+	// only the forwarded amount is minted, the fee is returned by the fee payer
+	s.Require().Equal(supply.AddAmount(sdkmath.NewInt(90)), bankKeeper.GetSupply(ctx, voucher.IBCDenom()))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 var amino = codec.NewLegacyAmino()
@@ -24,5 +25,9 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 }
 
+// RegisterInterfaces registers the packet forward middleware interfaces to protobuf Any.
 func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{}, &MsgRecoverInFlightPacket{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
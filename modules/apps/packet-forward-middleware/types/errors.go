@@ -7,4 +7,7 @@ import (
 var (
 	ErrMetadataKeyNotFound    = errorsmod.Register(ModuleName, 1, "metadata key not found in packet data")
 	ErrInvalidForwardMetadata = errorsmod.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardNotAllowed      = errorsmod.Register(ModuleName, 3, "forward not allowed")
+	ErrInFlightPacketNotFound = errorsmod.Register(ModuleName, 4, "in-flight packet not found")
+	ErrForwardInProgress      = errorsmod.Register(ModuleName, 5, "forward in progress")
 )
@@ -0,0 +1,15 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeRecoverInFlightPacket = "recover_in_flight_packet"
+
+	AttributeKeyChannelID             = "channel_id"
+	AttributeKeyPortID                = "port_id"
+	AttributeKeySequence              = "sequence"
+	AttributeKeySigner                = "signer"
+	AttributeKeyOriginalSenderAddress = "original_sender_address"
+	AttributeKeyRefundChannelID       = "refund_channel_id"
+	AttributeKeyRecoveryAddress       = "recovery_address"
+	AttributeKeyAmount                = "amount"
+)
@@ -10,6 +10,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 // TransferKeeper defines the expected transfer keeper
@@ -28,11 +29,17 @@ type TransferKeeper interface {
 type ChannelKeeper interface {
 	porttypes.ICS4Wrapper
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (clientID string, clientState ibcexported.ClientState, err error)
 
 	// Only used for v3 migration
 	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
 }
 
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientID string) ibcexported.Status
+}
+
 // BankKeeper defines the expected bank keeper
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
@@ -105,6 +105,32 @@ func GetPacketMetadataFromPacketdata(transferDetail ibcexported.PacketDataProvid
 	}, true, nil
 }
 
+// ValidateForwardMemo validates the value of the forward memo key, including the metadata of all
+// next hops. It is meant to be registered as the memo key validator of ForwardMetadataKey in the
+// transfer keeper.
+func ValidateForwardMemo(value any) error {
+	forwardData, ok := value.(map[string]any)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "key %s must be a JSON object", ForwardMetadataKey)
+	}
+
+	forwardMetadata, err := getForwardMetadata(forwardData)
+	if err != nil {
+		return err
+	}
+
+	metadata := &forwardMetadata
+	for {
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+		if metadata.Next == nil {
+			return nil
+		}
+		metadata = &metadata.Next.Forward
+	}
+}
+
 func getForwardMetadata(forwardData map[string]any) (ForwardMetadata, error) {
 	receiver, ok := forwardData[ForwardReceiverKey].(string)
 	if !ok {
@@ -489,3 +489,86 @@ func TestGetPacketMetadataErrorCases(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateForwardMemo(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     any
+		expectErr bool
+	}{
+		{
+			name: "valid forward metadata",
+			value: map[string]any{
+				"receiver": "test-receiver",
+				"port":     "test-port",
+				"channel":  "test-channel",
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid forward metadata with next",
+			value: map[string]any{
+				"receiver": "test-receiver",
+				"port":     "test-port",
+				"channel":  "test-channel",
+				"next": map[string]any{
+					"forward": map[string]any{
+						"receiver": "next-receiver",
+						"port":     "next-port",
+						"channel":  "next-channel",
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "forward metadata is not a json object",
+			value:     "forward",
+			expectErr: true,
+		},
+		{
+			name: "missing channel",
+			value: map[string]any{
+				"receiver": "test-receiver",
+				"port":     "test-port",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid port",
+			value: map[string]any{
+				"receiver": "test-receiver",
+				"port":     "!nv@lidport",
+				"channel":  "test-channel",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid channel of next hop",
+			value: map[string]any{
+				"receiver": "test-receiver",
+				"port":     "test-port",
+				"channel":  "test-channel",
+				"next": map[string]any{
+					"forward": map[string]any{
+						"receiver": "next-receiver",
+						"port":     "next-port",
+						"channel":  "invalid|channel",
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateForwardMemo(tt.value)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
-// DefaultGenesisState returns a GenesisState with an empty map of in-flight packets.
+// DefaultGenesisState returns a GenesisState with an empty map of in-flight packets and the default params.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		InFlightPackets: make(map[string]InFlightPacket),
+		Params:          DefaultParams(),
 	}
 }
 
@@ -15,5 +19,13 @@ func (gs GenesisState) Validate() error {
 		return errors.New("in-flight packets cannot be nil")
 	}
 
+	if _, found := gs.InFlightPackets[ParamsKey]; found {
+		return fmt.Errorf("in-flight packet key cannot be %s", ParamsKey)
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +31,8 @@ type GenesisState struct {
 	// information about original packet for refunding if necessary: retries,
 	// srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
 	InFlightPackets map[string]InFlightPacket `protobuf:"bytes,2,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// params defines the packet forward middleware parameters.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,6 +75,13 @@ func (m *GenesisState) GetInFlightPackets() map[string]InFlightPacket {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // InFlightPacket contains information about original packet for
 // writing the acknowledgement and refunding if necessary.
 type InFlightPacket struct {
@@ -87,6 +97,23 @@ type InFlightPacket struct {
 	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
 	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+	// is_v2 is true if the original packet was received over IBC v2, in which case
+	// refund_channel_id and packet_src_channel_id hold client identifiers.
+	IsV2 bool `protobuf:"varint,13,opt,name=is_v2,json=isV2,proto3" json:"is_v2,omitempty"`
+	// fee is the forwarding fee withheld from the forwarded amount. It is held by the fee payer
+	// until the forwarded packet is acknowledged.
+	Fee *types.Coin `protobuf:"bytes,14,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_receiver is the address the fee is paid to once the forwarded packet is successfully acknowledged.
+	FeeReceiver string `protobuf:"bytes,15,opt,name=fee_receiver,json=feeReceiver,proto3" json:"fee_receiver,omitempty"`
+	// fee_payer is the intermediate address holding the fee until the forwarded packet is acknowledged.
+	FeePayer string `protobuf:"bytes,16,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund_payload_index is the index of the forwarded payload in the original packet received over IBC v2,
+	// under which the acknowledgement of the payload is written.
+	RefundPayloadIndex uint32 `protobuf:"varint,17,opt,name=refund_payload_index,json=refundPayloadIndex,proto3" json:"refund_payload_index,omitempty"`
+	// held is true if the forwarded packet failed while the refund channel was closed or its client was not active.
+	// The funds of the forward are then held on this chain until the in-flight packet is recovered with
+	// MsgRecoverInFlightPacket, and no acknowledgement is written for the original packet.
+	Held bool `protobuf:"varint,18,opt,name=held,proto3" json:"held,omitempty"`
 }
 
 func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
@@ -206,6 +233,48 @@ func (m *InFlightPacket) GetNonrefundable() bool {
 	return false
 }
 
+func (m *InFlightPacket) GetIsV2() bool {
+	if m != nil {
+		return m.IsV2
+	}
+	return false
+}
+
+func (m *InFlightPacket) GetFee() *types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetFeeReceiver() string {
+	if m != nil {
+		return m.FeeReceiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundPayloadIndex() uint32 {
+	if m != nil {
+		return m.RefundPayloadIndex
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetHeld() bool {
+	if m != nil {
+		return m.Held
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.packet_forward_middleware.v1.GenesisState")
 	proto.RegisterMapType((map[string]InFlightPacket)(nil), "ibc.applications.packet_forward_middleware.v1.GenesisState.InFlightPacketsEntry")
@@ -217,45 +286,55 @@ func init() {
 }
 
 var fileDescriptor_421a822166afb238 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4b, 0x6e, 0xdb, 0x3c,
-	0x14, 0x85, 0x4d, 0xe7, 0x4d, 0xfb, 0xcf, 0x83, 0x7f, 0xd2, 0x12, 0x19, 0x38, 0x82, 0x11, 0xa0,
-	0x42, 0x03, 0x4b, 0x75, 0x0a, 0x14, 0x41, 0x8a, 0x0e, 0x9a, 0x3e, 0x3d, 0x0b, 0xe4, 0x8c, 0x3a,
-	0x11, 0x68, 0x89, 0x91, 0x89, 0x48, 0xa4, 0x4a, 0xd2, 0x0e, 0x3c, 0xec, 0x0e, 0xba, 0x82, 0x76,
-	0x11, 0xdd, 0x44, 0x86, 0x19, 0x76, 0x14, 0x14, 0xf1, 0x0e, 0xba, 0x82, 0x42, 0x22, 0x95, 0xda,
-	0x68, 0x3b, 0xc8, 0xc8, 0xf4, 0x3d, 0xf7, 0x7c, 0xf7, 0xe8, 0x42, 0x22, 0x7c, 0xce, 0x06, 0x91,
-	0x4f, 0xf2, 0x3c, 0x65, 0x11, 0xd1, 0x4c, 0x70, 0xe5, 0xe7, 0x24, 0xba, 0xa0, 0x3a, 0x3c, 0x17,
-	0xf2, 0x92, 0xc8, 0x38, 0xcc, 0x58, 0x1c, 0xa7, 0xf4, 0x92, 0x48, 0xea, 0x8f, 0xbb, 0x7e, 0x42,
-	0x39, 0x55, 0x4c, 0x79, 0xb9, 0x14, 0x5a, 0xa0, 0x0e, 0x1b, 0x44, 0xde, 0xac, 0xd9, 0xfb, 0xa7,
-	0xd9, 0x1b, 0x77, 0x77, 0xb7, 0x13, 0x91, 0x88, 0xd2, 0xe9, 0x17, 0x27, 0x03, 0x69, 0x7f, 0xab,
-	0xc3, 0xe6, 0x3b, 0x83, 0xed, 0x6b, 0xa2, 0x29, 0xfa, 0x02, 0xe0, 0x16, 0xe3, 0xe1, 0x79, 0xca,
-	0x92, 0xa1, 0x0e, 0x0d, 0x51, 0xe1, 0xba, 0xb3, 0xe0, 0x36, 0x0e, 0x4f, 0xbd, 0x7b, 0x8d, 0xf4,
-	0x66, 0xc1, 0x5e, 0x8f, 0xbf, 0x2d, 0x99, 0xa7, 0x06, 0xf9, 0x86, 0x6b, 0x39, 0x39, 0x71, 0xae,
-	0x6e, 0xf6, 0x6a, 0x3f, 0x6f, 0xf6, 0xf0, 0x84, 0x64, 0xe9, 0x71, 0xfb, 0x8f, 0xc1, 0xed, 0x60,
-	0x83, 0xcd, 0xfb, 0x76, 0x3f, 0x01, 0xb8, 0xfd, 0x37, 0x16, 0xda, 0x84, 0x0b, 0x17, 0x74, 0x82,
-	0x81, 0x03, 0xdc, 0xb5, 0xa0, 0x38, 0xa2, 0x3e, 0x5c, 0x1a, 0x93, 0x74, 0x44, 0x71, 0xdd, 0x01,
-	0x6e, 0xe3, 0xf0, 0xc5, 0x3d, 0xe3, 0xcf, 0x4f, 0x09, 0x0c, 0xeb, 0xb8, 0x7e, 0x04, 0xda, 0x5f,
-	0x17, 0xe1, 0xfa, 0xbc, 0x8a, 0x9e, 0xc1, 0x87, 0x42, 0xb2, 0x84, 0x71, 0x92, 0x86, 0x8a, 0xf2,
-	0x98, 0xca, 0x90, 0xc4, 0xb1, 0xa4, 0x4a, 0xd9, 0x44, 0x3b, 0x95, 0xdc, 0x2f, 0xd5, 0x97, 0x46,
-	0x44, 0x8f, 0xe1, 0x96, 0xa4, 0xe7, 0x23, 0x1e, 0x87, 0xd1, 0x90, 0x70, 0x4e, 0xd3, 0x90, 0xc5,
-	0x65, 0xde, 0xb5, 0x60, 0xc3, 0x08, 0xaf, 0x4c, 0xbd, 0x17, 0xa3, 0x7d, 0xb8, 0x6e, 0x7b, 0x73,
-	0x21, 0x75, 0xd1, 0xb8, 0x50, 0x36, 0x36, 0x4d, 0xf5, 0x54, 0x48, 0xdd, 0x8b, 0x51, 0x17, 0xee,
-	0xd8, 0xc7, 0x52, 0x32, 0x9a, 0xa5, 0x2e, 0x96, 0xcd, 0xc8, 0x88, 0x7d, 0x19, 0xfd, 0x06, 0x1f,
-	0x40, 0x34, 0x63, 0xa9, 0xe0, 0x4b, 0x26, 0xc5, 0x5d, 0xbf, 0xe5, 0x1f, 0x41, 0x6c, 0x9b, 0x35,
-	0xcb, 0xa8, 0x18, 0x99, 0x5f, 0xa5, 0x49, 0x96, 0xe3, 0x65, 0x07, 0xb8, 0x8b, 0xc1, 0x03, 0xa3,
-	0x9f, 0x19, 0xf9, 0xac, 0x52, 0xd1, 0xe1, 0x5d, 0xb2, 0xca, 0x39, 0xa4, 0xc5, 0x0a, 0xf1, 0x4a,
-	0x39, 0xe9, 0xff, 0x39, 0xdb, 0xfb, 0x52, 0x42, 0x7b, 0xb0, 0x61, 0x3d, 0x31, 0xd1, 0x04, 0xaf,
-	0x3a, 0xc0, 0x6d, 0x06, 0xd0, 0x94, 0x5e, 0x13, 0x4d, 0xd0, 0x23, 0x68, 0xf7, 0x14, 0x2a, 0xfa,
-	0x71, 0x44, 0x79, 0x44, 0xf1, 0x5a, 0x99, 0xc2, 0xee, 0xaa, 0x6f, 0xab, 0xe8, 0xa0, 0xd8, 0xb4,
-	0x96, 0x8c, 0xaa, 0x50, 0xd2, 0x8c, 0x30, 0xce, 0x78, 0x82, 0xa1, 0x03, 0xdc, 0xa5, 0x60, 0xd3,
-	0x0a, 0x41, 0x55, 0x47, 0x18, 0xae, 0xd8, 0x8c, 0xb8, 0x51, 0xd2, 0xaa, 0xbf, 0x68, 0x1f, 0xfe,
-	0xc7, 0x05, 0x37, 0x6c, 0x32, 0x48, 0x29, 0x6e, 0x3a, 0xc0, 0x5d, 0x0d, 0xe6, 0x8b, 0x27, 0xd1,
-	0xd5, 0x6d, 0x0b, 0x5c, 0xdf, 0xb6, 0xc0, 0x8f, 0xdb, 0x16, 0xf8, 0x3c, 0x6d, 0xd5, 0xae, 0xa7,
-	0xad, 0xda, 0xf7, 0x69, 0xab, 0xf6, 0xa1, 0x97, 0x30, 0x3d, 0x1c, 0x0d, 0xbc, 0x48, 0x64, 0x7e,
-	0x24, 0x54, 0x26, 0x94, 0xcf, 0x06, 0x51, 0x27, 0x11, 0xfe, 0xb8, 0xfb, 0xc4, 0xcf, 0x44, 0x3c,
-	0x4a, 0xa9, 0x2a, 0x2e, 0x85, 0xea, 0x32, 0xe8, 0xd8, 0xb7, 0xb3, 0x33, 0x73, 0x19, 0xe8, 0x49,
-	0x4e, 0xd5, 0x60, 0xb9, 0xfc, 0x86, 0x9f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x12, 0x34, 0xfe,
-	0x19, 0x47, 0x04, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0x62, 0x3b, 0x4d, 0x68, 0xe7, 0x17, 0x9b, 0x6e, 0x5c, 0x06, 0x38, 0x5a, 0x50, 0x60,
+	0xc6, 0x82, 0x48, 0xb5, 0x87, 0x0d, 0x45, 0x86, 0x1d, 0x96, 0xee, 0x97, 0x6f, 0x86, 0x5c, 0xec,
+	0xb0, 0x8b, 0x40, 0x49, 0xcf, 0x32, 0x51, 0x89, 0xd4, 0x48, 0xda, 0x9d, 0x8f, 0xbb, 0xed, 0xb8,
+	0xbf, 0x60, 0x7f, 0x4f, 0x8f, 0x3d, 0xee, 0x54, 0x0c, 0xc9, 0x61, 0xf7, 0xfd, 0x05, 0x83, 0x48,
+	0xaa, 0xb3, 0xb1, 0xf5, 0xe0, 0x93, 0xa9, 0xf7, 0xbd, 0xef, 0x7b, 0x1f, 0x1f, 0xe8, 0x0f, 0x7d,
+	0xc1, 0x92, 0x34, 0xa4, 0x55, 0x55, 0xb0, 0x94, 0x6a, 0x26, 0xb8, 0x0a, 0x2b, 0x9a, 0xbe, 0x00,
+	0x1d, 0xcf, 0x84, 0x7c, 0x49, 0x65, 0x16, 0x97, 0x2c, 0xcb, 0x0a, 0x78, 0x49, 0x25, 0x84, 0xcb,
+	0x61, 0x98, 0x03, 0x07, 0xc5, 0x54, 0x50, 0x49, 0xa1, 0x05, 0xbe, 0x66, 0x49, 0x1a, 0xac, 0x93,
+	0x83, 0x77, 0x92, 0x83, 0xe5, 0xf0, 0xfc, 0x2c, 0x17, 0xb9, 0x30, 0xcc, 0xb0, 0x3e, 0x59, 0x91,
+	0xf3, 0x7e, 0x2a, 0x54, 0x29, 0x54, 0x98, 0x50, 0x55, 0x8f, 0x48, 0x40, 0xd3, 0x61, 0x98, 0x0a,
+	0xc6, 0x1d, 0x7e, 0xb3, 0x9d, 0xc3, 0x8a, 0x4a, 0x5a, 0x3a, 0x83, 0x97, 0xbf, 0xb6, 0x50, 0xef,
+	0x3b, 0x6b, 0x79, 0xaa, 0xa9, 0x06, 0xfc, 0xbb, 0x87, 0x4e, 0x19, 0x8f, 0x67, 0x05, 0xcb, 0xe7,
+	0x3a, 0xb6, 0x42, 0x8a, 0xec, 0xfa, 0xad, 0x41, 0x77, 0x34, 0x09, 0xb6, 0xba, 0x4e, 0xb0, 0x2e,
+	0x1c, 0x8c, 0xf9, 0xb7, 0x46, 0x73, 0x62, 0x25, 0xbf, 0xe1, 0x5a, 0xae, 0x6e, 0xfd, 0x57, 0x6f,
+	0x2e, 0x76, 0xfe, 0x7e, 0x73, 0x41, 0x56, 0xb4, 0x2c, 0x6e, 0x2e, 0xff, 0x33, 0xf8, 0x32, 0x3a,
+	0x66, 0x9b, 0x3c, 0x3c, 0x45, 0x7b, 0xf6, 0x06, 0xa4, 0xe5, 0x7b, 0x83, 0xee, 0xe8, 0xb3, 0x2d,
+	0x4d, 0x4d, 0x0c, 0xf9, 0xb6, 0x5d, 0x4f, 0x8e, 0x9c, 0xd4, 0xf9, 0x2f, 0x1e, 0x3a, 0xfb, 0x3f,
+	0x83, 0xf8, 0x04, 0xb5, 0x5e, 0xc0, 0x8a, 0x78, 0xbe, 0x37, 0x38, 0x88, 0xea, 0x23, 0x9e, 0xa2,
+	0xce, 0x92, 0x16, 0x0b, 0x20, 0xbb, 0x66, 0xfc, 0x97, 0x5b, 0x8e, 0xdf, 0x9c, 0x12, 0x59, 0xad,
+	0x9b, 0xdd, 0xa7, 0xde, 0xe5, 0x5f, 0x1d, 0x74, 0xb4, 0x89, 0xe2, 0xcf, 0xd1, 0xfb, 0x42, 0xb2,
+	0x9c, 0x71, 0x5a, 0xc4, 0x0a, 0x78, 0x06, 0x32, 0xa6, 0x59, 0x26, 0x41, 0x29, 0xe7, 0xe8, 0x51,
+	0x03, 0x4f, 0x0d, 0xfa, 0x95, 0x05, 0xf1, 0x27, 0xe8, 0x54, 0xc2, 0x6c, 0xc1, 0xb3, 0x38, 0x9d,
+	0x53, 0xce, 0xa1, 0x88, 0x59, 0x66, 0xfc, 0x1e, 0x44, 0xc7, 0x16, 0x78, 0x66, 0xeb, 0xe3, 0x0c,
+	0x3f, 0x46, 0x47, 0xae, 0xb7, 0x12, 0x52, 0xd7, 0x8d, 0x2d, 0xd3, 0xd8, 0xb3, 0xd5, 0x89, 0x90,
+	0x7a, 0x9c, 0xe1, 0x21, 0x7a, 0xe4, 0xae, 0xa5, 0x64, 0xba, 0xae, 0xda, 0x36, 0xcd, 0xd8, 0x82,
+	0x53, 0x99, 0xfe, 0x2b, 0x7c, 0x85, 0xf0, 0x1a, 0xa5, 0x11, 0xef, 0x58, 0x17, 0x6f, 0xfb, 0x9d,
+	0xfe, 0x53, 0x44, 0x5c, 0xb3, 0x66, 0x25, 0x88, 0x85, 0xfd, 0x55, 0x9a, 0x96, 0x15, 0xd9, 0xf3,
+	0xbd, 0x41, 0x3b, 0x7a, 0xcf, 0xe2, 0xcf, 0x2d, 0xfc, 0xbc, 0x41, 0xf1, 0xe8, 0xad, 0xb3, 0x86,
+	0x39, 0x87, 0x7a, 0x85, 0xe4, 0x81, 0x99, 0xf4, 0x70, 0x83, 0xf6, 0xbd, 0x81, 0xf0, 0x05, 0xea,
+	0x3a, 0x4e, 0x46, 0x35, 0x25, 0xfb, 0xbe, 0x37, 0xe8, 0x45, 0xc8, 0x96, 0xbe, 0xa6, 0x9a, 0xe2,
+	0x8f, 0x91, 0xdb, 0x53, 0xac, 0xe0, 0xa7, 0x05, 0xf0, 0x14, 0xc8, 0x81, 0x71, 0xe1, 0x76, 0x35,
+	0x75, 0x55, 0x7c, 0x55, 0x6f, 0x5a, 0x4b, 0x06, 0x2a, 0x96, 0x50, 0x52, 0xc6, 0x19, 0xcf, 0x09,
+	0xf2, 0xbd, 0x41, 0x27, 0x3a, 0x71, 0x40, 0xd4, 0xd4, 0x31, 0x41, 0x0f, 0x9c, 0x47, 0xd2, 0x35,
+	0x6a, 0xcd, 0x27, 0x7e, 0x8c, 0x0e, 0xb9, 0xe0, 0x56, 0x9b, 0x26, 0x05, 0x90, 0x9e, 0xef, 0x0d,
+	0xf6, 0xa3, 0xcd, 0x22, 0x7e, 0x88, 0x3a, 0x4c, 0xc5, 0xcb, 0x11, 0x39, 0x34, 0x68, 0x9b, 0xa9,
+	0x1f, 0x46, 0xf8, 0x0a, 0xb5, 0x66, 0x00, 0xe4, 0xc8, 0xbc, 0xc6, 0x0f, 0x02, 0x9b, 0x15, 0x41,
+	0x9d, 0x15, 0x81, 0xcb, 0x8a, 0xe0, 0x99, 0x60, 0x3c, 0xaa, 0xbb, 0xf0, 0x47, 0xa8, 0x37, 0x03,
+	0x88, 0x25, 0xa4, 0xc0, 0x96, 0x20, 0xc9, 0xb1, 0xd9, 0x51, 0x77, 0x06, 0x10, 0xb9, 0x12, 0xfe,
+	0x10, 0x1d, 0xd4, 0x2d, 0x15, 0x5d, 0x81, 0x24, 0x27, 0x06, 0xdf, 0x9f, 0x01, 0x4c, 0xea, 0x6f,
+	0xfc, 0x04, 0x9d, 0x35, 0x8f, 0x85, 0xae, 0x0a, 0x41, 0xb3, 0x98, 0xf1, 0x0c, 0x7e, 0x26, 0xa7,
+	0xbe, 0x37, 0x38, 0x8c, 0xb0, 0x7b, 0x32, 0x16, 0x1a, 0xd7, 0x08, 0xc6, 0xa8, 0x3d, 0x87, 0x22,
+	0x23, 0xd8, 0x5a, 0xae, 0xcf, 0xb7, 0xe9, 0xab, 0xbb, 0xbe, 0xf7, 0xfa, 0xae, 0xef, 0xfd, 0x79,
+	0xd7, 0xf7, 0x7e, 0xbb, 0xef, 0xef, 0xbc, 0xbe, 0xef, 0xef, 0xfc, 0x71, 0xdf, 0xdf, 0xf9, 0x71,
+	0x9c, 0x33, 0x3d, 0x5f, 0x24, 0x41, 0x2a, 0xca, 0xd0, 0xa5, 0x1e, 0x4b, 0xd2, 0xeb, 0x5c, 0x84,
+	0xcb, 0xe1, 0x93, 0xb0, 0x14, 0xd9, 0xa2, 0x00, 0x55, 0x67, 0x5d, 0x93, 0x71, 0xd7, 0xee, 0x5f,
+	0x76, 0xbd, 0x96, 0x71, 0x7a, 0x55, 0x81, 0x4a, 0xf6, 0x4c, 0xc0, 0x7d, 0xfa, 0xcf, 0x00, 0x06,
+	0x9d, 0x03, 0xee, 0xc0, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -278,6 +357,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.InFlightPackets) > 0 {
 		for k := range m.InFlightPackets {
 			v := m.InFlightPackets[k]
@@ -325,6 +414,63 @@ func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Held {
+		i--
+		if m.Held {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RefundPayloadIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundPayloadIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.FeeReceiver) > 0 {
+		i -= len(m.FeeReceiver)
+		copy(dAtA[i:], m.FeeReceiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeReceiver)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.IsV2 {
+		i--
+		if m.IsV2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Nonrefundable {
 		i--
 		if m.Nonrefundable {
@@ -433,6 +579,8 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -485,6 +633,27 @@ func (m *InFlightPacket) Size() (n int) {
 	if m.Nonrefundable {
 		n += 2
 	}
+	if m.IsV2 {
+		n += 2
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.FeeReceiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundPayloadIndex != 0 {
+		n += 2 + sovGenesis(uint64(m.RefundPayloadIndex))
+	}
+	if m.Held {
+		n += 3
+	}
 	return n
 }
 
@@ -652,6 +821,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.InFlightPackets[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1024,6 +1226,165 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Nonrefundable = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsV2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsV2 = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &types.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPayloadIndex", wireType)
+			}
+			m.RefundPayloadIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundPayloadIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Held = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,14 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
 
 const (
 	// ModuleName defines the module name
@@ -14,6 +22,10 @@ const (
 	// QuerierRoute is the querier route for IBC transfer
 	QuerierRoute = ModuleName
 
+	// ParamsKey defines the key to store the params in store.
+	// NOTE: the key must not contain a "/" so that it cannot collide with the keys of in-flight packets.
+	ParamsKey = "params"
+
 	ForwardMetadataKey = "forward"
 	ForwardReceiverKey = "receiver"
 	ForwardPortKey     = "port"
@@ -28,3 +40,18 @@ type NonrefundableKey struct{}
 func RefundPacketKey(channelID, portID string, sequence uint64) []byte {
 	return fmt.Appendf(nil, "%s/%s/%d", channelID, portID, sequence)
 }
+
+// ParseRefundPacketKey parses the channel ID, port ID and sequence of the forwarded packet from a key created with RefundPacketKey.
+func ParseRefundPacketKey(key []byte) (string, string, uint64, error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) != 3 {
+		return "", "", 0, errorsmod.Wrapf(host.ErrInvalidPath, "expected key format {channelID}/{portID}/{sequence}, got %s", key)
+	}
+
+	sequence, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, errorsmod.Wrapf(host.ErrInvalidPath, "failed to parse sequence from key %s: %v", key, err)
+	}
+
+	return parts[0], parts[1], sequence, nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+
+	_ sdk.Msg              = (*MsgRecoverInFlightPacket)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecoverInFlightPacket)(nil)
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgRecoverInFlightPacket creates a new MsgRecoverInFlightPacket instance
+func NewMsgRecoverInFlightPacket(signer, channelID, portID string, sequence uint64, recoveryAddress string) *MsgRecoverInFlightPacket {
+	return &MsgRecoverInFlightPacket{
+		Signer:          signer,
+		ChannelId:       channelID,
+		PortId:          portID,
+		Sequence:        sequence,
+		RecoveryAddress: recoveryAddress,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgRecoverInFlightPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "sequence cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RecoveryAddress); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recovery address could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
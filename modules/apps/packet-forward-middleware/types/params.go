@@ -0,0 +1,167 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+const (
+	// DefaultRetries is the default number of retries on timeout of a forwarded packet.
+	DefaultRetries = 0
+	// DefaultMaxRetries is the default maximum number of retries on timeout of a forwarded packet.
+	DefaultMaxRetries = math.MaxUint8
+	// DefaultTimeout is the default timeout of a forwarded packet.
+	DefaultTimeout = 10 * time.Minute
+	// DefaultMaxTimeout is the default maximum timeout of a forwarded packet.
+	DefaultMaxTimeout = 24 * time.Hour
+)
+
+// NewParams creates a new parameter configuration for the packet forward middleware.
+func NewParams(
+	defaultRetries, maxRetries uint32, defaultTimeout, maxTimeout time.Duration,
+	feePercentage sdkmath.LegacyDec, feeReceiver string, allowedChannels, deniedChannels []string,
+	senderRecoveryEnabled bool,
+) Params {
+	return Params{
+		DefaultRetries:        defaultRetries,
+		MaxRetries:            maxRetries,
+		DefaultTimeout:        defaultTimeout,
+		MaxTimeout:            maxTimeout,
+		FeePercentage:         feePercentage,
+		FeeReceiver:           feeReceiver,
+		AllowedChannels:       allowedChannels,
+		DeniedChannels:        deniedChannels,
+		SenderRecoveryEnabled: senderRecoveryEnabled,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the packet forward middleware.
+// No fee is charged, packets may be forwarded on any channel and only the authority can recover in-flight packets.
+func DefaultParams() Params {
+	return NewParams(DefaultRetries, DefaultMaxRetries, DefaultTimeout, DefaultMaxTimeout, sdkmath.LegacyZeroDec(), "", nil, nil, false)
+}
+
+// Validate performs basic validation of the packet forward middleware parameters.
+func (p Params) Validate() error {
+	if p.MaxRetries > math.MaxUint8 {
+		return fmt.Errorf("max retries must not exceed %d, got %d", math.MaxUint8, p.MaxRetries)
+	}
+
+	if p.DefaultRetries > p.MaxRetries {
+		return fmt.Errorf("default retries (%d) must not exceed max retries (%d)", p.DefaultRetries, p.MaxRetries)
+	}
+
+	if p.DefaultTimeout <= 0 {
+		return fmt.Errorf("default timeout must be positive, got %s", p.DefaultTimeout)
+	}
+
+	if p.DefaultTimeout > p.MaxTimeout {
+		return fmt.Errorf("default timeout (%s) must not exceed max timeout (%s)", p.DefaultTimeout, p.MaxTimeout)
+	}
+
+	if p.FeePercentage.IsNil() || p.FeePercentage.IsNegative() || p.FeePercentage.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("fee percentage must be in the range [0, 1), got %s", p.FeePercentage)
+	}
+
+	if p.FeeReceiver != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FeeReceiver); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "fee receiver could not be parsed as address: %v", err)
+		}
+	} else if p.FeePercentage.IsPositive() {
+		return errors.New("fee receiver must be set if fee percentage is positive")
+	}
+
+	if err := validateChannels(p.AllowedChannels); err != nil {
+		return fmt.Errorf("invalid allowed channels: %w", err)
+	}
+
+	if err := validateChannels(p.DeniedChannels); err != nil {
+		return fmt.Errorf("invalid denied channels: %w", err)
+	}
+
+	for _, channel := range p.DeniedChannels {
+		if slices.Contains(p.AllowedChannels, channel) {
+			return fmt.Errorf("channel %s cannot be both allowed and denied", channel)
+		}
+	}
+
+	return nil
+}
+
+// ValidateForwardMetadata returns an error if the forward metadata requests more retries or a longer timeout
+// than allowed, or forwards the packet on a channel which is not allowed.
+func (p Params) ValidateForwardMetadata(metadata ForwardMetadata) error {
+	if metadata.Retries != nil && uint32(*metadata.Retries) > p.MaxRetries {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "retries (%d) exceed max retries (%d)", *metadata.Retries, p.MaxRetries)
+	}
+
+	if metadata.Timeout > p.MaxTimeout {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "timeout (%s) exceeds max timeout (%s)", metadata.Timeout, p.MaxTimeout)
+	}
+
+	if slices.Contains(p.DeniedChannels, metadata.Channel) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "forwarding on channel %s is denied", metadata.Channel)
+	}
+
+	if len(p.AllowedChannels) > 0 && !slices.Contains(p.AllowedChannels, metadata.Channel) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "forwarding on channel %s is not allowed", metadata.Channel)
+	}
+
+	return nil
+}
+
+// ForwardRetries returns the number of retries on timeout requested by the forward metadata,
+// or the default number of retries if the metadata does not set retries.
+func (p Params) ForwardRetries(metadata ForwardMetadata) uint8 {
+	if metadata.Retries != nil {
+		return *metadata.Retries
+	}
+
+	return uint8(p.DefaultRetries)
+}
+
+// ForwardTimeout returns the timeout requested by the forward metadata,
+// or the default timeout if the metadata does not set a timeout.
+func (p Params) ForwardTimeout(metadata ForwardMetadata) time.Duration {
+	if metadata.Timeout.Nanoseconds() > 0 {
+		return metadata.Timeout
+	}
+
+	return p.DefaultTimeout
+}
+
+// ForwardFee returns the fee charged for forwarding the given token.
+// The fee is truncated and is zero if no fee percentage is set.
+func (p Params) ForwardFee(token sdk.Coin) sdk.Coin {
+	if p.FeePercentage.IsNil() || !p.FeePercentage.IsPositive() {
+		return sdk.NewCoin(token.Denom, sdkmath.ZeroInt())
+	}
+
+	return sdk.NewCoin(token.Denom, p.FeePercentage.MulInt(token.Amount).TruncateInt())
+}
+
+// validateChannels validates the identifiers of a list of channels (or clients for IBC v2).
+func validateChannels(channels []string) error {
+	for i, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return err
+		}
+
+		if slices.Contains(channels[:i], channel) {
+			return fmt.Errorf("duplicate channel %s", channel)
+		}
+	}
+
+	return nil
+}
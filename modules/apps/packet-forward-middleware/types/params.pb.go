@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packet_forward_middleware/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of packet forward middleware parameters.
+type Params struct {
+	// default_retries is the number of retries on timeout used when the forward metadata does not set retries.
+	DefaultRetries uint32 `protobuf:"varint,1,opt,name=default_retries,json=defaultRetries,proto3" json:"default_retries,omitempty"`
+	// max_retries is the maximum number of retries on timeout the forward metadata may request.
+	MaxRetries uint32 `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// default_timeout is the timeout of the forwarded packet used when the forward metadata does not set a timeout.
+	DefaultTimeout time.Duration `protobuf:"bytes,3,opt,name=default_timeout,json=defaultTimeout,proto3,stdduration" json:"default_timeout"`
+	// max_timeout is the maximum timeout of the forwarded packet the forward metadata may request.
+	MaxTimeout time.Duration `protobuf:"bytes,4,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout"`
+	// fee_percentage is the fraction of the forwarded amount charged as a fee on every hop forwarded by this chain.
+	// The fee is paid to the fee receiver once the forwarded packet is successfully acknowledged and is refunded
+	// together with the forwarded amount otherwise.
+	FeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_percentage,json=feePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percentage"`
+	// fee_receiver is the address receiving the forwarding fees. It must be set if fee_percentage is positive.
+	FeeReceiver string `protobuf:"bytes,6,opt,name=fee_receiver,json=feeReceiver,proto3" json:"fee_receiver,omitempty"`
+	// allowed_channels is the list of channel identifiers (or client identifiers for IBC v2) packets may be
+	// forwarded on. If empty, packets may be forwarded on any channel which is not denied.
+	AllowedChannels []string `protobuf:"bytes,7,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// denied_channels is the list of channel identifiers (or client identifiers for IBC v2) packets may not be
+	// forwarded on.
+	DeniedChannels []string `protobuf:"bytes,8,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels,omitempty"`
+	// sender_recovery_enabled allows the original sender of a packet received over IBC v1 to recover the funds of
+	// its held in-flight packet with MsgRecoverInFlightPacket. The authority can always recover held in-flight
+	// packets.
+	SenderRecoveryEnabled bool `protobuf:"varint,9,opt,name=sender_recovery_enabled,json=senderRecoveryEnabled,proto3" json:"sender_recovery_enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53fbb1e3a659fcc1, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDefaultRetries() uint32 {
+	if m != nil {
+		return m.DefaultRetries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultTimeout() time.Duration {
+	if m != nil {
+		return m.DefaultTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetFeeReceiver() string {
+	if m != nil {
+		return m.FeeReceiver
+	}
+	return ""
+}
+
+func (m *Params) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedChannels() []string {
+	if m != nil {
+		return m.DeniedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetSenderRecoveryEnabled() bool {
+	if m != nil {
+		return m.SenderRecoveryEnabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.packet_forward_middleware.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packet_forward_middleware/v1/params.proto", fileDescriptor_53fbb1e3a659fcc1)
+}
+
+var fileDescriptor_53fbb1e3a659fcc1 = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x6d, 0x28, 0x94, 0x36, 0xe5, 0x7a, 0x28, 0x02, 0x91, 0x3b, 0xa4, 0xb4, 0xb0, 0x50, 0x86,
+	0xda, 0x14, 0x24, 0x06, 0xc6, 0x52, 0x06, 0xa4, 0x1b, 0x4e, 0x11, 0x03, 0x62, 0x89, 0x1c, 0xfb,
+	0x4b, 0x6a, 0x5d, 0x12, 0x47, 0xb6, 0xd3, 0x5e, 0xff, 0x05, 0x23, 0x3f, 0x84, 0x1f, 0x71, 0xe3,
+	0x89, 0x09, 0x31, 0x1c, 0xa8, 0xfd, 0x01, 0xfc, 0x05, 0x94, 0xd8, 0x2d, 0x5d, 0x90, 0xd8, 0xec,
+	0xf7, 0xde, 0xf7, 0xbe, 0x27, 0xfb, 0xb9, 0x6f, 0x78, 0x4c, 0x31, 0x29, 0xcb, 0x8c, 0x53, 0xa2,
+	0xb9, 0x28, 0x14, 0x2e, 0x09, 0xbd, 0x00, 0x1d, 0x25, 0x42, 0xae, 0x88, 0x64, 0x51, 0xce, 0x19,
+	0xcb, 0x60, 0x45, 0x24, 0xe0, 0xe5, 0x14, 0x97, 0x44, 0x92, 0x5c, 0xa1, 0x52, 0x0a, 0x2d, 0xbc,
+	0x09, 0x8f, 0x29, 0x3a, 0x9c, 0x45, 0xff, 0x9c, 0x45, 0xcb, 0xe9, 0xe9, 0x83, 0x54, 0xa4, 0xa2,
+	0x99, 0xc4, 0xf5, 0xc9, 0x98, 0x9c, 0x9e, 0x50, 0xa1, 0x72, 0xa1, 0x22, 0x43, 0x98, 0x8b, 0xa5,
+	0x82, 0x54, 0x88, 0x34, 0x03, 0xdc, 0xdc, 0xe2, 0x2a, 0xc1, 0xac, 0x92, 0xcd, 0x22, 0xc3, 0x3f,
+	0xfd, 0xdd, 0x76, 0x3b, 0xe7, 0x4d, 0x20, 0xef, 0x99, 0x7b, 0xcc, 0x20, 0x21, 0x55, 0xa6, 0x23,
+	0x09, 0x5a, 0x72, 0x50, 0xbe, 0x33, 0x72, 0xc6, 0x47, 0xe1, 0xc0, 0xc2, 0xa1, 0x41, 0xbd, 0xa1,
+	0xdb, 0xcf, 0xc9, 0xe5, 0x5e, 0x74, 0xab, 0x11, 0xb9, 0x39, 0xb9, 0xdc, 0x09, 0xce, 0xfe, 0x3a,
+	0x69, 0x9e, 0x83, 0xa8, 0xb4, 0xdf, 0x1e, 0x39, 0xe3, 0xfe, 0xcb, 0x13, 0x64, 0xe2, 0xa0, 0x5d,
+	0x1c, 0x34, 0xb7, 0x71, 0x66, 0xdd, 0xab, 0x9b, 0x61, 0xeb, 0xcb, 0xcf, 0xa1, 0xb3, 0x5f, 0xf7,
+	0xc1, 0x8c, 0x7a, 0x73, 0xb3, 0x6e, 0xe7, 0x74, 0xfb, 0xff, 0x9d, 0xea, 0x4c, 0x3b, 0x97, 0x8f,
+	0xee, 0x20, 0x01, 0x88, 0x4a, 0x90, 0x14, 0x0a, 0x4d, 0x52, 0xf0, 0xef, 0x8c, 0x9c, 0x71, 0x6f,
+	0x36, 0xad, 0xd5, 0x3f, 0x6e, 0x86, 0x8f, 0xcd, 0xb3, 0x29, 0x76, 0x81, 0xb8, 0xc0, 0x39, 0xd1,
+	0x0b, 0x74, 0x06, 0x29, 0xa1, 0xeb, 0x39, 0xd0, 0x6f, 0x5f, 0x27, 0xae, 0x7d, 0xd5, 0x39, 0xd0,
+	0xf0, 0x28, 0x01, 0x38, 0xdf, 0xfb, 0x78, 0x4f, 0xdc, 0x7b, 0xb5, 0xb3, 0x04, 0x0a, 0x7c, 0x09,
+	0xd2, 0xef, 0xd4, 0xbe, 0x61, 0x3f, 0x01, 0x08, 0x2d, 0xe4, 0x3d, 0x77, 0xef, 0x93, 0x2c, 0x13,
+	0x2b, 0x60, 0x11, 0x5d, 0x90, 0xa2, 0x80, 0x4c, 0xf9, 0x77, 0x47, 0xed, 0x71, 0x2f, 0x3c, 0xb6,
+	0xf8, 0x5b, 0x0b, 0x9b, 0x5f, 0x28, 0xf8, 0xa1, 0xb2, 0xdb, 0x28, 0x07, 0x06, 0xde, 0x0b, 0x5f,
+	0xbb, 0x8f, 0x14, 0x14, 0x0c, 0x64, 0xbd, 0x59, 0x2c, 0x41, 0xae, 0x23, 0x28, 0x48, 0x9c, 0x01,
+	0xf3, 0x7b, 0x23, 0x67, 0xdc, 0x0d, 0x1f, 0x1a, 0x3a, 0xb4, 0xec, 0x3b, 0x43, 0xce, 0xe8, 0xd5,
+	0x26, 0x70, 0xae, 0x37, 0x81, 0xf3, 0x6b, 0x13, 0x38, 0x9f, 0xb7, 0x41, 0xeb, 0x7a, 0x1b, 0xb4,
+	0xbe, 0x6f, 0x83, 0xd6, 0xa7, 0xf7, 0x29, 0xd7, 0x8b, 0x2a, 0x46, 0x54, 0xe4, 0xb6, 0x44, 0x98,
+	0xc7, 0x74, 0x92, 0x0a, 0xbc, 0x9c, 0xbe, 0xc0, 0xb9, 0x60, 0x55, 0x06, 0xaa, 0x2e, 0xfa, 0xae,
+	0xe0, 0x13, 0x5b, 0xd2, 0xc9, 0x41, 0xc1, 0xf5, 0xba, 0x04, 0x15, 0x77, 0x9a, 0x6f, 0x79, 0xf5,
+	0x67, 0x00, 0xe0, 0x4f, 0x34, 0x3d, 0x1b, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SenderRecoveryEnabled {
+		i--
+		if m.SenderRecoveryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedChannels[iNdEx])
+			copy(dAtA[i:], m.DeniedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeReceiver) > 0 {
+		i -= len(m.FeeReceiver)
+		copy(dAtA[i:], m.FeeReceiver)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultTimeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DefaultRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultRetries != 0 {
+		n += 1 + sovParams(uint64(m.DefaultRetries))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeReceiver)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for _, s := range m.DeniedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SenderRecoveryEnabled {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRetries", wireType)
+			}
+			m.DefaultRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DefaultTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderRecoveryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SenderRecoveryEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,228 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	feeReceiver := ibctesting.TestAccAddress
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		expErr   error
+	}{
+		{
+			"success: default params",
+			func(*types.Params) {},
+			nil,
+		},
+		{
+			"success: fee with fee receiver",
+			func(p *types.Params) {
+				p.FeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+				p.FeeReceiver = feeReceiver
+			},
+			nil,
+		},
+		{
+			"success: allowed and denied channels",
+			func(p *types.Params) {
+				p.AllowedChannels = []string{ibctesting.FirstChannelID, ibctesting.FirstClientID}
+				p.DeniedChannels = []string{ibctesting.SecondChannelID}
+			},
+			nil,
+		},
+		{
+			"failure: max retries exceeds uint8",
+			func(p *types.Params) {
+				p.MaxRetries = 256
+			},
+			errors.New("max retries must not exceed 255, got 256"),
+		},
+		{
+			"failure: default retries exceed max retries",
+			func(p *types.Params) {
+				p.DefaultRetries = 2
+				p.MaxRetries = 1
+			},
+			errors.New("default retries (2) must not exceed max retries (1)"),
+		},
+		{
+			"failure: default timeout is zero",
+			func(p *types.Params) {
+				p.DefaultTimeout = 0
+			},
+			errors.New("default timeout must be positive, got 0s"),
+		},
+		{
+			"failure: default timeout exceeds max timeout",
+			func(p *types.Params) {
+				p.MaxTimeout = time.Minute
+			},
+			errors.New("default timeout (10m0s) must not exceed max timeout (1m0s)"),
+		},
+		{
+			"failure: negative fee percentage",
+			func(p *types.Params) {
+				p.FeePercentage = sdkmath.LegacyNewDec(-1)
+				p.FeeReceiver = feeReceiver
+			},
+			errors.New("fee percentage must be in the range [0, 1), got -1.000000000000000000"),
+		},
+		{
+			"failure: fee percentage of one",
+			func(p *types.Params) {
+				p.FeePercentage = sdkmath.LegacyOneDec()
+				p.FeeReceiver = feeReceiver
+			},
+			errors.New("fee percentage must be in the range [0, 1), got 1.000000000000000000"),
+		},
+		{
+			"failure: fee without fee receiver",
+			func(p *types.Params) {
+				p.FeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+			},
+			errors.New("fee receiver must be set if fee percentage is positive"),
+		},
+		{
+			"failure: invalid fee receiver",
+			func(p *types.Params) {
+				p.FeeReceiver = ibctesting.InvalidID
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid allowed channel",
+			func(p *types.Params) {
+				p.AllowedChannels = []string{""}
+			},
+			errors.New("invalid allowed channels: identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"failure: duplicate denied channel",
+			func(p *types.Params) {
+				p.DeniedChannels = []string{ibctesting.FirstChannelID, ibctesting.FirstChannelID}
+			},
+			errors.New("invalid denied channels: duplicate channel channel-0"),
+		},
+		{
+			"failure: channel both allowed and denied",
+			func(p *types.Params) {
+				p.AllowedChannels = []string{ibctesting.FirstChannelID}
+				p.DeniedChannels = []string{ibctesting.FirstChannelID}
+			},
+			errors.New("channel channel-0 cannot be both allowed and denied"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func TestParamsValidateForwardMetadata(t *testing.T) {
+	retries := uint8(3)
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params, *types.ForwardMetadata)
+		expErr   error
+	}{
+		{
+			"success: default params",
+			func(*types.Params, *types.ForwardMetadata) {},
+			nil,
+		},
+		{
+			"success: channel is allowed",
+			func(p *types.Params, _ *types.ForwardMetadata) {
+				p.AllowedChannels = []string{ibctesting.FirstChannelID}
+			},
+			nil,
+		},
+		{
+			"failure: retries exceed max retries",
+			func(p *types.Params, _ *types.ForwardMetadata) {
+				p.MaxRetries = 2
+			},
+			types.ErrForwardNotAllowed,
+		},
+		{
+			"failure: timeout exceeds max timeout",
+			func(_ *types.Params, m *types.ForwardMetadata) {
+				m.Timeout = types.DefaultMaxTimeout + time.Second
+			},
+			types.ErrForwardNotAllowed,
+		},
+		{
+			"failure: channel is denied",
+			func(p *types.Params, _ *types.ForwardMetadata) {
+				p.DeniedChannels = []string{ibctesting.FirstChannelID}
+			},
+			types.ErrForwardNotAllowed,
+		},
+		{
+			"failure: channel is not allowed",
+			func(p *types.Params, _ *types.ForwardMetadata) {
+				p.AllowedChannels = []string{ibctesting.SecondChannelID}
+			},
+			types.ErrForwardNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			metadata := types.ForwardMetadata{
+				Receiver: "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+				Port:     "transfer",
+				Channel:  ibctesting.FirstChannelID,
+				Timeout:  time.Minute,
+				Retries:  &retries,
+			}
+
+			tc.malleate(&params, &metadata)
+
+			err := params.ValidateForwardMetadata(metadata)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParamsForwardFee(t *testing.T) {
+	params := types.DefaultParams()
+	token := sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)
+
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), params.ForwardFee(token))
+
+	// the fee is truncated
+	params.FeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 9), params.ForwardFee(token))
+}
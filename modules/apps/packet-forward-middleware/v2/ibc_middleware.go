@@ -0,0 +1,368 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/internal"
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ api.Middleware                = (*IBCMiddleware)(nil)
+	_ api.PacketUnmarshalerModuleV2 = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the IBC v2 callbacks for the forward middleware given the
+// forward keeper and the underlying application.
+type IBCMiddleware struct {
+	app             api.PacketUnmarshalerModuleV2
+	writeAckWrapper api.WriteAcknowledgementWrapper
+	keeper          *keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper. The underlying application and the
+// WriteAcknowledgementWrapper used to write the asynchronous acknowledgements of forwarded packets are
+// set when the middleware is composed into a stack with the api.IBCStackBuilder. The retries on timeout
+// and timeout of forwarded packets default to the values set in the params.
+func NewIBCMiddleware(k *keeper.Keeper) *IBCMiddleware {
+	return &IBCMiddleware{
+		keeper: k,
+	}
+}
+
+// SetUnderlyingApplication sets the underlying IBC v2 module.
+// The underlying application must implement the PacketUnmarshalerModuleV2 interface.
+func (im *IBCMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	if im.app != nil {
+		panic(errors.New("underlying application already set"))
+	}
+
+	packetDataUnmarshalerApp, ok := app.(api.PacketUnmarshalerModuleV2)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*api.PacketUnmarshalerModuleV2)(nil)))
+	}
+
+	im.app = packetDataUnmarshalerApp
+}
+
+// SetWriteAckWrapper sets the WriteAcknowledgementWrapper used to write the asynchronous acknowledgements
+// of forwarded packets and of the underlying application.
+func (im *IBCMiddleware) SetWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	if writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
+	im.writeAckWrapper = writeAckWrapper
+	im.keeper.WithWriteAckWrapperV2(writeAckWrapper)
+}
+
+// OnSendPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnSendPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket checks the memo field on this packet and if the metadata inside's root key indicates this packet
+// should be forwarded, it receives the funds into an intermediate account and forwards them to the next hop.
+// The acknowledgement is written asynchronously once the forwarded packet is acknowledged or timed out.
+func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	logger := im.keeper.Logger(ctx)
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		logger.Debug(fmt.Sprintf("packetForwardMiddleware OnRecvPacket payload is not a FungibleTokenPacketData: %s", err.Error()))
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	logger.Debug("packetForwardMiddleware OnRecvPacket",
+		"sequence", sequence,
+		"src-client", sourceClient,
+		"src-port", payload.SourcePort,
+		"dst-client", destinationClient,
+		"dst-port", payload.DestinationPort,
+		"tokens", data.Tokens,
+		"memo", data.Memo,
+	)
+
+	packetMetadata, isPFM, err := types.GetPacketMetadataFromPacketdata(data)
+	if err != nil && !isPFM {
+		// not a packet that should be forwarded
+		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+	if err != nil && isPFM {
+		logger.Error("packetForwardMiddleware OnRecvPacket error parsing forward metadata", "error", err)
+		return newErrorRecvPacketResult(fmt.Errorf("error parsing forward metadata: %w", err))
+	}
+
+	// only packets transferring a single token can be forwarded
+	if len(data.Tokens) != 1 {
+		logger.Error("packetForwardMiddleware OnRecvPacket cannot forward multiple tokens", "tokens", len(data.Tokens))
+		return newErrorRecvPacketResult(fmt.Errorf("cannot forward packet transferring %d tokens", len(data.Tokens)))
+	}
+
+	// the acknowledgement of the forwarded payload is written by its index in the received packet
+	receivedPayload, found := channeltypesv2.GetReceivedPayload(ctx)
+	if !found {
+		logger.Error("packetForwardMiddleware OnRecvPacket received payload not found in context")
+		return newErrorRecvPacketResult(errors.New("received payload not found in context"))
+	}
+
+	// a forward which fails is acknowledged with the sentinel error acknowledgement, which cannot be written
+	// for a payload of an atomic packet with multiple payloads. Forwarding is rejected for such packets, so that
+	// the packet fails and the state changes of all its payloads are reverted.
+	if !receivedPayload.CanFailAsynchronously() {
+		logger.Error("packetForwardMiddleware OnRecvPacket cannot forward payload of atomic packet with multiple payloads")
+		return newErrorRecvPacketResult(errorsmod.Wrapf(types.ErrForwardNotAllowed, "cannot forward payload of atomic packet with %d payloads", len(receivedPayload.Packet.Payloads)))
+	}
+
+	metadata := packetMetadata.Forward
+
+	goCtx := ctx.Context()
+	nonrefundable := internal.GetBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
+
+	if err := metadata.Validate(); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
+		return newErrorRecvPacketResult(err)
+	}
+
+	params := im.keeper.GetParams(ctx)
+	if err := params.ValidateForwardMetadata(metadata); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is not allowed", "error", err)
+		return newErrorRecvPacketResult(err)
+	}
+
+	// override the receiver so that senders cannot move funds through arbitrary addresses.
+	overrideReceiver, err := internal.GetReceiver(destinationClient, data.Sender)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket failed to construct override receiver", "error", err)
+		return newErrorRecvPacketResult(fmt.Errorf("failed to construct override receiver: %w", err))
+	}
+
+	if err := im.receiveFunds(ctx, sourceClient, destinationClient, sequence, payload, data, overrideReceiver, relayer); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error receiving packet", "error", err)
+		return newErrorRecvPacketResult(fmt.Errorf("error receiving packet: %w", err))
+	}
+
+	// if this packet's token denom is already the base denom for some native token on this chain,
+	// we do not need to do any further composition of the denom before forwarding the packet
+	denomOnThisChain := internal.GetDenomForThisChain(payload.DestinationPort, destinationClient, payload.SourcePort, sourceClient, data.Tokens[0].Denom)
+
+	amountInt, ok := sdkmath.NewIntFromString(data.Tokens[0].Amount)
+	if !ok {
+		logger.Error("packetForwardMiddleware OnRecvPacket error parsing amount for forward", "amount", data.Tokens[0].Amount)
+		return newErrorRecvPacketResult(fmt.Errorf("error parsing amount for forward: %s", data.Tokens[0].Amount))
+	}
+
+	token := sdk.NewCoin(denomOnThisChain, amountInt)
+
+	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence, data)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket failed to convert v2 packet to v1 packet", "error", err)
+		return newErrorRecvPacketResult(err)
+	}
+
+	err = im.keeper.ForwardTransferPacketV2(ctx, packet, receivedPayload.Index, data.Sender, overrideReceiver, metadata, token, params.ForwardRetries(metadata), params.ForwardTimeout(metadata), []metrics.Label{}, nonrefundable)
+	if err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
+		return newErrorRecvPacketResult(err)
+	}
+
+	// returning an async result will prevent the acknowledgement from being written for the forwarded packet.
+	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
+	return channeltypesv2.RecvPacketResult{
+		Status: channeltypesv2.PacketStatus_Async,
+	}
+}
+
+// receiveFunds receives funds from the packet into the override receiver
+// address and returns an error if the funds cannot be received.
+func (im *IBCMiddleware) receiveFunds(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, data transfertypes.InternalTransferRepresentation, overrideReceiver string, relayer sdk.AccAddress) error {
+	overrideData := transfertypes.NewFungibleTokenPacketData(data.Tokens[0].Denom.Path(), data.Tokens[0].Amount, data.Sender, overrideReceiver, "") // Memo explicitly emptied.
+
+	overrideDataBz, err := transfertypes.MarshalPacketData(overrideData, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	overridePayload := payload
+	overridePayload.Value = overrideDataBz // Override data.
+	result := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, overridePayload, relayer)
+	if result.Status != channeltypesv2.PacketStatus_Success {
+		return fmt.Errorf("recv packet result status: %s, ack: %s", result.Status, string(result.Acknowledgement))
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from timeout packet",
+			"sequence", sequence,
+			"src-client", sourceClient,
+			"src-port", payload.SourcePort,
+			"dst-client", destinationClient,
+			"dst-port", payload.DestinationPort,
+			"error", err,
+		)
+		return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// packets transferring multiple tokens are never forwarded
+	if len(data.Tokens) != 1 {
+		return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	im.keeper.Logger(ctx).Debug("packetForwardMiddleware OnTimeoutPacket",
+		"sequence", sequence,
+		"src-client", sourceClient,
+		"src-port", payload.SourcePort,
+		"dst-client", destinationClient,
+		"dst-port", payload.DestinationPort,
+		"amount", data.Tokens[0].Amount,
+		"denom", data.Tokens[0].Denom.Path(),
+	)
+
+	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence, data)
+	if err != nil {
+		return err
+	}
+
+	inFlightPacket, err := im.keeper.TimeoutShouldRetry(ctx, packet)
+	if inFlightPacket != nil {
+		im.keeper.RemoveInFlightPacket(ctx, packet)
+		if err != nil {
+			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
+			// WriteAcknowledgement with proxied ack to return success/fail to previous chain.
+			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, internal.NewErrorAcknowledgement(err))
+		}
+		// timeout should be retried. In order to do that, we need to handle this timeout to refund on this chain first.
+		if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+			return err
+		}
+		return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, data, inFlightPacket)
+	}
+
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im *IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from ack packet",
+			"sequence", sequence,
+			"src-client", sourceClient,
+			"src-port", payload.SourcePort,
+			"dst-client", destinationClient,
+			"dst-port", payload.DestinationPort,
+			"error", err,
+		)
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	// packets transferring multiple tokens are never forwarded
+	if len(data.Tokens) != 1 {
+		return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	}
+
+	im.keeper.Logger(ctx).Debug("packetForwardMiddleware OnAcknowledgementPacket",
+		"sequence", sequence,
+		"src-client", sourceClient,
+		"src-port", payload.SourcePort,
+		"dst-client", destinationClient,
+		"dst-port", payload.DestinationPort,
+		"amount", data.Tokens[0].Amount,
+		"denom", data.Tokens[0].Denom.Path(),
+	)
+
+	var ack channeltypes.Acknowledgement
+	// construct an error acknowledgement if the acknowledgement bytes are the sentinel error acknowledgement
+	// so that the shared forwarding logic can be used
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		ack = internal.NewErrorAcknowledgement(transfertypes.ErrReceiveFailed)
+	} else if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence, data)
+	if err != nil {
+		return err
+	}
+
+	inFlightPacket, err := im.keeper.GetInflightPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if inFlightPacket != nil {
+		im.keeper.RemoveInFlightPacket(ctx, packet)
+		// this is a forwarded packet, so override handling to avoid refund from being processed.
+		return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
+	}
+
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// WriteAcknowledgement implements the WriteAcknowledgementWrapper interface.
+func (im *IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	return im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+// WritePayloadAcknowledgement implements the WriteAcknowledgementWrapper interface.
+func (im *IBCMiddleware) WritePayloadAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, payloadIndex uint32, appAck []byte) error {
+	return im.writeAckWrapper.WritePayloadAcknowledgement(ctx, clientID, sequence, payloadIndex, appAck)
+}
+
+// UnmarshalPacketData implements PacketDataUnmarshaler.
+func (im *IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.UnmarshalPacketData(payload)
+}
+
+// newErrorRecvPacketResult returns a failed RecvPacketResult with an acknowledgement that identifies PFM and provides the error.
+func newErrorRecvPacketResult(err error) channeltypesv2.RecvPacketResult {
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Failure,
+		Acknowledgement: internal.NewErrorAcknowledgement(err).Acknowledgement(),
+	}
+}
+
+// v2ToV1Packet returns the IBC v1 representation of the given payload, using the client IDs as channel IDs.
+// The packet data is always JSON encoded ICS-20 v1 packet data so that in-flight packets are stored
+// independently of the encoding of the received payload.
+func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64, data transfertypes.InternalTransferRepresentation) (channeltypes.Packet, error) {
+	packetData := transfertypes.NewFungibleTokenPacketData(data.Tokens[0].Denom.Path(), data.Tokens[0].Amount, data.Sender, data.Receiver, data.Memo)
+
+	packetDataBz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingJSON)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               packetDataBz,
+		TimeoutHeight:      clienttypes.Height{},
+		TimeoutTimestamp:   0,
+	}, nil
+}
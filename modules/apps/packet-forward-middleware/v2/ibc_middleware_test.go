@@ -0,0 +1,384 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	packetforward "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware"
+	"github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	packetforwardv2 "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/v2"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+)
+
+type PFMV2TestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	pathAB *ibctesting.Path
+	pathBC *ibctesting.Path
+}
+
+func TestPFMV2TestSuite(t *testing.T) {
+	suite.Run(t, new(PFMV2TestSuite))
+}
+
+func (s *PFMV2TestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 3)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+	s.chainC = s.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	s.pathAB = ibctesting.NewPath(s.chainA, s.chainB)
+	s.pathAB.SetupV2()
+
+	s.pathBC = ibctesting.NewPath(s.chainB, s.chainC)
+	s.pathBC.SetupV2()
+}
+
+func (s *PFMV2TestSuite) TestNewIBCMiddleware() {
+	pfmKeeper := s.chainA.GetSimApp().PFMKeeper
+	writeAckWrapper := s.chainA.App.GetIBCKeeper().ChannelKeeperV2
+
+	s.Require().Panics(func() {
+		packetforwardv2.NewIBCMiddleware(pfmKeeper).SetUnderlyingApplication(struct{ api.IBCModule }{mockv2.NewIBCModule()})
+	}, "underlying application must implement PacketUnmarshalerModuleV2")
+
+	s.Require().Panics(func() {
+		middleware := packetforwardv2.NewIBCMiddleware(pfmKeeper)
+		middleware.SetUnderlyingApplication(mockv2.NewIBCModule())
+		middleware.SetUnderlyingApplication(mockv2.NewIBCModule())
+	}, "underlying application already set")
+
+	s.Require().Panics(func() {
+		packetforwardv2.NewIBCMiddleware(pfmKeeper).SetWriteAckWrapper(nil)
+	}, "write acknowledgement wrapper cannot be nil")
+
+	s.Require().NotPanics(func() {
+		api.NewIBCStackBuilder(writeAckWrapper).
+			Base(mockv2.NewIBCModule()).
+			Next(packetforwardv2.NewIBCMiddleware(pfmKeeper)).
+			Build()
+	})
+}
+
+func (s *PFMV2TestSuite) TestForwardPacket() {
+	var (
+		forwardClient string
+		sendAmount    = sdkmath.NewInt(1000)
+		timeout       = "10m"
+		expFee        sdkmath.Int
+	)
+
+	feeReceiver := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expAck   channeltypesv2.Acknowledgement
+		expFwd   bool
+	}{
+		{
+			"success: forward to chain C",
+			func() {},
+			channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()),
+			true,
+		},
+		{
+			"success: forward fee is paid to the fee receiver",
+			func() {
+				params := types.DefaultParams()
+				params.FeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+				params.FeeReceiver = feeReceiver.String()
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+				expFee = sdkmath.NewInt(10)
+			},
+			channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()),
+			true,
+		},
+		{
+			"failure: forward client does not exist",
+			func() {
+				forwardClient = ibctesting.InvalidID
+			},
+			channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:]),
+			false,
+		},
+		{
+			"failure: forward client is denied",
+			func() {
+				params := types.DefaultParams()
+				params.DeniedChannels = []string{forwardClient}
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+			},
+			channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:]),
+			false,
+		},
+		{
+			"failure: timeout exceeds max timeout",
+			func() {
+				params := types.DefaultParams()
+				params.DefaultTimeout = time.Minute
+				params.MaxTimeout = 5 * time.Minute
+				s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+			},
+			channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:]),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			forwardClient = s.pathBC.EndpointA.ClientID
+			expFee = sdkmath.ZeroInt()
+
+			tc.malleate()
+
+			sender := s.chainA.SenderAccount.GetAddress()
+			receiver := s.chainC.SenderAccount.GetAddress()
+			memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"%s"}}`, receiver, transfertypes.PortID, forwardClient, timeout)
+
+			packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, sendAmount.String(), sender.String(), "pfm", memo)
+			bz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingJSON)
+			s.Require().NoError(err)
+
+			payload := channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, transfertypes.V1, transfertypes.EncodingJSON, bz)
+			timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+			packet, err := s.pathAB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+			s.Require().NoError(err)
+
+			// receive the packet on chain B and forward it to chain C
+			packetKey := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+			proof, proofHeight := s.pathAB.EndpointA.QueryProof(packetKey)
+			res, err := s.chainB.SendMsgs(channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String()))
+			s.Require().NoError(err)
+			s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+			s.Require().NoError(s.pathBC.EndpointB.UpdateClient())
+
+			if tc.expFwd {
+				forwardedPacket, err := ibctesting.ParseV2PacketFromEvents(res.Events)
+				s.Require().NoError(err)
+				s.Require().Equal(s.pathBC.EndpointA.ClientID, forwardedPacket.SourceClient)
+
+				// the acknowledgement is not written until the forwarded packet is acknowledged
+				_, found := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				s.Require().True(found)
+
+				inFlightPacket, err := s.chainB.GetSimApp().PFMKeeper.GetInflightPacket(s.chainB.GetContext(), channeltypes.Packet{
+					SourcePort:    transfertypes.PortID,
+					SourceChannel: forwardedPacket.SourceClient,
+					Sequence:      forwardedPacket.Sequence,
+				})
+				s.Require().NoError(err)
+				s.Require().NotNil(inFlightPacket)
+				s.Require().True(inFlightPacket.IsV2)
+				s.Require().Equal(packet.DestinationClient, inFlightPacket.RefundChannelId)
+
+				err = s.pathBC.EndpointA.RelayPacket(forwardedPacket)
+				s.Require().NoError(err)
+				s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+
+				_, found = s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				s.Require().False(found)
+
+				denomOnC := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, s.pathBC.EndpointB.ClientID), transfertypes.NewHop(transfertypes.PortID, s.pathAB.EndpointB.ClientID))
+				balance := s.chainC.GetSimApp().BankKeeper.GetBalance(s.chainC.GetContext(), receiver, denomOnC.IBCDenom())
+				s.Require().Equal(sendAmount.Sub(expFee), balance.Amount)
+
+				denomOnB := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, s.pathAB.EndpointB.ClientID))
+				feeBalance := s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), feeReceiver, denomOnB.IBCDenom())
+				s.Require().Equal(expFee, feeBalance.Amount)
+			}
+
+			err = s.pathAB.EndpointA.MsgAcknowledgePacket(packet, tc.expAck)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *PFMV2TestSuite) TestForwardPacketTimeout() {
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainC.SenderAccount.GetAddress()
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"%s","retries":0}}`, receiver, transfertypes.PortID, s.pathBC.EndpointA.ClientID, "5s")
+
+	// the forwarding fee is refunded together with the forwarded amount
+	feeReceiver := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	params := types.DefaultParams()
+	params.FeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+	params.FeeReceiver = feeReceiver.String()
+	s.chainB.GetSimApp().PFMKeeper.SetParams(s.chainB.GetContext(), params)
+
+	originalBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", sender.String(), "pfm", memo)
+	bz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingJSON)
+	s.Require().NoError(err)
+
+	payload := channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, transfertypes.V1, transfertypes.EncodingJSON, bz)
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	packet, err := s.pathAB.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	s.Require().NoError(err)
+
+	packetKey := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+	proof, proofHeight := s.pathAB.EndpointA.QueryProof(packetKey)
+	res, err := s.chainB.SendMsgs(channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+	s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+	s.Require().NoError(s.pathBC.EndpointB.UpdateClient())
+
+	forwardedPacket, err := ibctesting.ParseV2PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	// let the forwarded packet time out on chain C
+	s.coordinator.IncrementTimeBy(time.Minute)
+	s.Require().NoError(s.pathBC.EndpointA.UpdateClient())
+
+	err = s.pathBC.EndpointA.MsgTimeoutPacket(forwardedPacket)
+	s.Require().NoError(err)
+
+	// the forward failed, so the original packet is acknowledged with the sentinel error acknowledgement
+	s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+	err = s.pathAB.EndpointA.MsgAcknowledgePacket(packet, channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:]))
+	s.Require().NoError(err)
+
+	// the funds are refunded on chain A
+	balance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	s.Require().Equal(originalBalance, balance)
+
+	// no funds are left in the intermediate account on chain B
+	intermediateAddr, err := packetforward.GetReceiver(packet.DestinationClient, sender.String())
+	s.Require().NoError(err)
+	s.Require().True(s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), sdk.MustAccAddressFromBech32(intermediateAddr)).IsZero())
+
+	// no fee is paid to the fee receiver and no vouchers are left on chain B
+	denomOnB := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, packet.DestinationClient))
+	s.Require().True(s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), feeReceiver, denomOnB.IBCDenom()).IsZero())
+	s.Require().True(s.chainB.GetSimApp().BankKeeper.GetSupply(s.chainB.GetContext(), denomOnB.IBCDenom()).IsZero())
+}
+
+func (s *PFMV2TestSuite) TestForwardMultiPayloadPacket() {
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainC.SenderAccount.GetAddress()
+	originalBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the first payload is forwarded successfully, while the forward of the second payload times out
+	payloads := make([]channeltypesv2.Payload, 2)
+	for i, timeout := range []string{"10m", "5s"} {
+		memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"%s","retries":0}}`, receiver, transfertypes.PortID, s.pathBC.EndpointA.ClientID, timeout)
+		packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", sender.String(), "pfm", memo)
+		bz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingJSON)
+		s.Require().NoError(err)
+
+		payloads[i] = channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, transfertypes.V1, transfertypes.EncodingJSON, bz)
+	}
+
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+	packet, err := s.pathAB.EndpointA.MsgSendNonAtomicPacket(timeoutTimestamp, payloads...)
+	s.Require().NoError(err)
+
+	packetKey := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+	proof, proofHeight := s.pathAB.EndpointA.QueryProof(packetKey)
+	res, err := s.chainB.SendMsgs(channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+	s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+	s.Require().NoError(s.pathBC.EndpointB.UpdateClient())
+
+	forwardedPackets, err := ibctesting.ParseIBCV2Packets(channeltypesv2.EventTypeSendPacket, res.Events)
+	s.Require().NoError(err)
+	s.Require().Len(forwardedPackets, 2)
+
+	// each in-flight packet holds the index of its payload in the original packet
+	for i, forwardedPacket := range forwardedPackets {
+		inFlightPacket, err := s.chainB.GetSimApp().PFMKeeper.GetInflightPacket(s.chainB.GetContext(), channeltypes.Packet{
+			SourcePort:    transfertypes.PortID,
+			SourceChannel: forwardedPacket.SourceClient,
+			Sequence:      forwardedPacket.Sequence,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(inFlightPacket)
+		s.Require().Equal(uint32(i), inFlightPacket.RefundPayloadIndex)
+	}
+
+	err = s.pathBC.EndpointA.RelayPacket(forwardedPackets[0])
+	s.Require().NoError(err)
+
+	// the acknowledgement is not written until both forwards complete
+	_, found := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	s.Require().True(found)
+
+	s.coordinator.IncrementTimeBy(time.Minute)
+	s.Require().NoError(s.pathBC.EndpointA.UpdateClient())
+
+	err = s.pathBC.EndpointA.MsgTimeoutPacket(forwardedPackets[1])
+	s.Require().NoError(err)
+
+	_, found = s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetAsyncPacket(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	s.Require().False(found)
+
+	// the failed forward is acknowledged with the sentinel error acknowledgement
+	s.Require().NoError(s.pathAB.EndpointA.UpdateClient())
+	err = s.pathAB.EndpointA.MsgAcknowledgePacket(packet, channeltypesv2.NewNonAtomicAcknowledgement(
+		channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+		channeltypesv2.ErrorAcknowledgement[:],
+	))
+	s.Require().NoError(err)
+
+	// only the funds of the failed forward are refunded on chain A
+	balance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	s.Require().Equal(originalBalance.Amount.SubRaw(1000), balance.Amount)
+
+	denomOnC := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, s.pathBC.EndpointB.ClientID), transfertypes.NewHop(transfertypes.PortID, s.pathAB.EndpointB.ClientID))
+	balance = s.chainC.GetSimApp().BankKeeper.GetBalance(s.chainC.GetContext(), receiver, denomOnC.IBCDenom())
+	s.Require().Equal(sdkmath.NewInt(1000), balance.Amount)
+}
+
+func (s *PFMV2TestSuite) TestForwardAtomicMultiPayloadPacket() {
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainC.SenderAccount.GetAddress()
+	originalBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, receiver, transfertypes.PortID, s.pathBC.EndpointA.ClientID)
+	packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", sender.String(), "pfm", memo)
+	bz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingJSON)
+	s.Require().NoError(err)
+
+	forwardPayload := channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, transfertypes.V1, transfertypes.EncodingJSON, bz)
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	packet, err := s.pathAB.EndpointA.MsgSendPacket(timeoutTimestamp, forwardPayload, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+	s.Require().NoError(err)
+
+	// forwarding is rejected, so the whole packet fails
+	ack, err := s.pathAB.EndpointB.MsgRecvPacketWithAck(packet)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:]), ack)
+
+	err = s.pathAB.EndpointA.MsgAcknowledgePacket(packet, ack)
+	s.Require().NoError(err)
+
+	// the funds are refunded on chain A
+	balance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	s.Require().Equal(originalBalance, balance)
+}
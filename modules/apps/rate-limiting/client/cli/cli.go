@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRateLimit(),
 		GetCmdQueryAllRateLimits(),
 		GetCmdQueryRateLimitsByChainID(),
+		GetCmdQueryRateLimitFlowBuckets(),
 		GetCmdQueryAllBlacklistedDenoms(),
 		GetCmdQueryAllWhitelistedAddresses(),
 	)
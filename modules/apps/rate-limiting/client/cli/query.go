@@ -141,6 +141,49 @@ func GetCmdQueryRateLimitsByChainID() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryRateLimitFlowBuckets returns the flow buckets of a sliding window rate limit
+func GetCmdQueryRateLimitFlowBuckets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flow-buckets [channel-or-client-id] [denom]",
+		Short: "Query the flow buckets of a sliding window rate limit from a given channel-id/client-id and denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the flow buckets that make up the current window of a sliding window rate limit.
+
+Example:
+  $ %s query %s flow-buckets [channel-or-client-id] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelOrClientID := args[0]
+			denom := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitFlowBucketsRequest{
+				Denom:             denom,
+				ChannelOrClientId: channelOrClientID,
+			}
+			res, err := queryClient.RateLimitFlowBuckets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryAllBlacklistedDenoms returns the command to query all blacklisted denoms
 func GetCmdQueryAllBlacklistedDenoms() *cobra.Command {
 	cmd := &cobra.Command{
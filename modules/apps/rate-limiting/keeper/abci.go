@@ -4,8 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Before each hour epoch, check if any of the rate limits have expired,
-// and reset them if they have
+// Before each hour epoch, check if any of the fixed window rate limits have expired,
+// and reset them if they have. The flow of sliding window rate limits is refreshed
+// The per sender outflows are reset at the end of the window for both types of rate limits
 func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 	epochStarting, epochNumber, err := k.CheckHourEpochStarting(ctx)
 	if err != nil {
@@ -16,9 +17,20 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 		return
 	}
 	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		// Sliding windows are never reset, instead the expired buckets are removed
+		if rateLimit.Quota.IsSlidingWindow() {
+			flowBuckets := k.refreshSlidingWindowFlow(ctx, &rateLimit)
+			k.SetRateLimit(ctx, rateLimit)
+			k.SetRateLimitFlowBuckets(ctx, flowBuckets)
+		}
 		if rateLimit.Quota.DurationHours == 0 || epochNumber%rateLimit.Quota.DurationHours != 0 {
 			continue
 		}
+		// The outflow of each sender is always reset at the end of the window
+		if rateLimit.Quota.IsSlidingWindow() {
+			k.RemoveAllSenderFlows(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+			continue
+		}
 		if err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId); err != nil {
 			k.Logger(ctx).Error("Unable to reset quota", "Denom", rateLimit.Path.Denom, "ChannelOrClientId", rateLimit.Path.ChannelOrClientId, "error", err)
 		}
@@ -98,3 +98,35 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestBeginBlocker_SlidingWindow() {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.coordinator.SetTime(blockTime)
+
+	// Store a sliding window rate limit with one bucket that is still in the window
+	// and one bucket that has already slid out of it
+	rateLimit, flowBuckets := createSlidingWindowRateLimit()
+	currentBucketNumber := rateLimit.Quota.BucketNumber(blockTime)
+	flowBuckets.Buckets[0].BucketNumber = currentBucketNumber - rateLimit.Quota.WindowBuckets()
+	flowBuckets.Buckets[1].BucketNumber = currentBucketNumber - 1
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(s.chainA.GetContext(), rateLimit)
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimitFlowBuckets(s.chainA.GetContext(), flowBuckets)
+
+	// Trigger an epoch that would reset a fixed window of the same duration
+	err := s.chainA.GetSimApp().RateLimitKeeper.SetHourEpoch(s.chainA.GetContext(), types.HourEpoch{
+		EpochNumber:    rateLimit.Quota.DurationHours - 1,
+		Duration:       time.Minute,
+		EpochStartTime: blockTime.Add(-2 * time.Minute),
+	})
+	s.Require().NoError(err)
+	s.chainA.GetSimApp().RateLimitKeeper.BeginBlocker(s.chainA.GetContext())
+
+	// The flow should only include the bucket that is still in the window
+	rateLimit, found := s.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(s.chainA.GetContext(), rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+	s.Require().True(found)
+	s.Require().Equal(flowBuckets.Buckets[1].Inflow, rateLimit.Flow.Inflow)
+	s.Require().Equal(flowBuckets.Buckets[1].Outflow, rateLimit.Flow.Outflow)
+
+	storedBuckets := s.chainA.GetSimApp().RateLimitKeeper.GetRateLimitFlowBuckets(s.chainA.GetContext(), rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+	s.Require().Equal(flowBuckets.Buckets[1:], storedBuckets.Buckets)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// PacketInfoExtractor extracts the information a packet is rate limited on from the packet data
+// of the application wrapped by the rate-limiting middleware. This allows the middleware to be used
+// on top of applications other than ICS-20 transfer.
+type PacketInfoExtractor interface {
+	// ExtractPacketInfo returns the channel, denom, amount, sender and receiver of a packet
+	// Packets moving multiple denoms return one packet info per denom, which are rate limited together
+	// For a SEND packet, the channelID must be the SOURCE channel
+	// For a RECEIVE packet, the channelID must be the DESTINATION channel
+	ExtractPacketInfo(packet channeltypes.Packet, direction types.PacketDirection) ([]RateLimitedPacketInfo, error)
+}
+
+var (
+	_ PacketInfoExtractor = (*TransferPacketInfoExtractor)(nil)
+	_ PacketInfoExtractor = (*PacketCountInfoExtractor)(nil)
+)
+
+// TransferPacketInfoExtractor extracts the rate limited information of ICS-20 transfer packets
+// It is the default extractor of the keeper
+type TransferPacketInfoExtractor struct{}
+
+// ExtractPacketInfo implements PacketInfoExtractor
+func (TransferPacketInfoExtractor) ExtractPacketInfo(packet channeltypes.Packet, direction types.PacketDirection) ([]RateLimitedPacketInfo, error) {
+	return ParsePacketInfo(packet, direction)
+}
+
+// PacketCountInfoExtractor rate limits the number of packets of applications without a fungible value
+// Every packet has an amount of one, and all packets on a channel share the PacketCountDenom,
+// so that they can be limited by a single packet count quota
+type PacketCountInfoExtractor struct{}
+
+// ExtractPacketInfo implements PacketInfoExtractor
+func (PacketCountInfoExtractor) ExtractPacketInfo(packet channeltypes.Packet, direction types.PacketDirection) ([]RateLimitedPacketInfo, error) {
+	channelID := packet.GetSourceChannel()
+	if direction == types.PACKET_RECV {
+		channelID = packet.GetDestChannel()
+	}
+
+	return []RateLimitedPacketInfo{{
+		ChannelID: channelID,
+		Denom:     types.PacketCountDenom,
+		Amount:    sdkmath.OneInt(),
+	}}, nil
+}
+
+// ExtractPacketInfo extracts the rate limited information of a packet with the PacketInfoExtractor of the keeper
+// An error is returned if the packet is not recognised by the extractor
+func (k *Keeper) ExtractPacketInfo(packet channeltypes.Packet, direction types.PacketDirection) ([]RateLimitedPacketInfo, error) {
+	return k.packetInfoExtractor.ExtractPacketInfo(packet, direction)
+}
+
+// Returns the port of the given channel
+// Transfer channels are checked first, before falling back to the channels of all other ports
+func (k *Keeper) getChannelPortID(ctx sdk.Context, channelID string) (string, bool) {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID); found {
+		return transfertypes.PortID, true
+	}
+
+	var portID string
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.ChannelId == channelID {
+			portID = channel.PortId
+			return true
+		}
+		return false
+	})
+
+	return portID, portID != ""
+}
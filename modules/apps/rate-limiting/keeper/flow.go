@@ -41,24 +41,72 @@ func (k *Keeper) CheckRateLimitAndUpdateFlow(ctx sdk.Context, direction types.Pa
 		return false, nil
 	}
 
+	// Packet count quotas are incremented by one for each packet, regardless of the amount
+	if rateLimit.Quota.IsPacketCount() {
+		amount = sdkmath.OneInt()
+	}
+
+	// For sliding windows, the flow is the total of the buckets in the current window
+	var flowBuckets types.RateLimitFlowBuckets
+	if rateLimit.Quota.IsSlidingWindow() {
+		flowBuckets = k.refreshSlidingWindowFlow(ctx, &rateLimit)
+	}
+
 	// Update the flow object with the change in amount
 	if err := rateLimit.UpdateFlow(direction, amount); err != nil {
 		// If the rate limit was exceeded, emit an event
 		EmitTransferDeniedEvent(ctx, types.EventRateLimitExceeded, denom, channelOrClientID, direction, amount, err)
+		if direction == types.PACKET_RECV {
+			k.reportCircuitBreakerAnomaly(ctx, rateLimit)
+		}
 		return false, err
 	}
 
 	// If there's no quota error, update the rate limit object in the store with the new flow
 	k.SetRateLimit(ctx, rateLimit)
 
+	if rateLimit.Quota.IsSlidingWindow() {
+		flowBuckets.AddFlow(rateLimit.Quota.BucketNumber(ctx.BlockTime()), direction, amount)
+		k.SetRateLimitFlowBuckets(ctx, flowBuckets)
+	}
+
 	return true, nil
 }
 
 // If a SendPacket fails or times out, undo the outflow increment that happened during the send
-func (k *Keeper) UndoSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, denom string, amount sdkmath.Int) error {
+// The outflow of the sender is decremented as well
+func (k *Keeper) UndoSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, denom string, sender string, amount sdkmath.Int) error {
+	k.undoSendPacketFlow(ctx, channelOrClientID, sequence, denom, sender, amount)
+	k.RemovePendingSendPacket(ctx, channelOrClientID, sequence)
+	return nil
+}
+
+// Undoes the outflow of each token of a failed SendPacket
+// The pending packet is shared by all tokens of the packet, so it is only removed once all outflows are undone
+func (k *Keeper) undoSendPackets(ctx sdk.Context, sequence uint64, packetInfos []RateLimitedPacketInfo) error {
+	for _, packetInfo := range packetInfos {
+		k.undoSendPacketFlow(ctx, packetInfo.ChannelID, sequence, packetInfo.Denom, packetInfo.Sender, packetInfo.Amount)
+	}
+	for _, packetInfo := range packetInfos {
+		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, sequence)
+	}
+	return nil
+}
+
+// Undoes the outflow increment of a single denom of a failed SendPacket, without removing the pending packet
+func (k *Keeper) undoSendPacketFlow(ctx sdk.Context, channelOrClientID string, sequence uint64, denom string, sender string, amount sdkmath.Int) {
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientID)
 	if !found {
-		return nil
+		return
+	}
+
+	if rateLimit.Quota.IsPacketCount() {
+		amount = sdkmath.OneInt()
+	}
+
+	if rateLimit.Quota.IsSlidingWindow() {
+		k.undoSlidingWindowSendPacket(ctx, rateLimit, channelOrClientID, sequence, sender, amount)
+		return
 	}
 
 	// If the packet was sent during this quota, decrement the outflow
@@ -66,9 +114,48 @@ func (k *Keeper) UndoSendPacket(ctx sdk.Context, channelOrClientID string, seque
 	if k.CheckPacketSentDuringCurrentQuota(ctx, channelOrClientID, sequence) {
 		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
 		k.SetRateLimit(ctx, rateLimit)
+		k.undoSenderOutflow(ctx, rateLimit, sender, amount)
+	}
+}
 
-		k.RemovePendingSendPacket(ctx, channelOrClientID, sequence)
+// If a SendPacket on a sliding window rate limit fails or times out, undo the outflow increment
+// in the bucket the packet was sent in, provided that bucket is still part of the window
+func (k *Keeper) undoSlidingWindowSendPacket(ctx sdk.Context, rateLimit types.RateLimit, channelOrClientID string, sequence uint64, sender string, amount sdkmath.Int) {
+	bucketNumber, found := k.GetPendingSendPacketBucket(ctx, channelOrClientID, sequence)
+	if !found {
+		return
 	}
 
-	return nil
+	k.undoSenderOutflow(ctx, rateLimit, sender, amount)
+
+	flowBuckets := k.refreshSlidingWindowFlow(ctx, &rateLimit)
+	bucket, found := flowBuckets.GetBucket(bucketNumber)
+	if !found {
+		return
+	}
+
+	bucket.Outflow = bucket.Outflow.Sub(amount)
+	rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetRateLimitFlowBuckets(ctx, flowBuckets)
+}
+
+// Reports an exceeded receive quota to the IBC circuit breaker, which is tripped once the quota
+// is exceeded circuit_breaker_threshold times within the same window
+// Exceeded send quotas are not reported, as the send is rejected together with the state changes of the transaction
+func (k *Keeper) reportCircuitBreakerAnomaly(ctx sdk.Context, rateLimit types.RateLimit) {
+	if k.circuitBreakerKeeper == nil || rateLimit.Quota.CircuitBreakerThreshold == 0 || rateLimit.Quota.DurationHours == 0 {
+		return
+	}
+
+	hourEpoch, err := k.GetHourEpoch(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("unable to report anomaly to the circuit breaker", "error", err)
+		return
+	}
+
+	// The window is reset at the epochs divisible by the duration of the quota (see BeginBlocker)
+	window := hourEpoch.EpochNumber / rateLimit.Quota.DurationHours
+	k.circuitBreakerKeeper.ReportCircuitBreakerAnomaly(ctx, rateLimit.Path.ChannelOrClientId, window, rateLimit.Quota.CircuitBreakerThreshold)
 }
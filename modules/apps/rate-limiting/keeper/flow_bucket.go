@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// Stores/Updates the flow buckets of a sliding window rate limit
+func (k *Keeper) SetRateLimitFlowBuckets(ctx sdk.Context, flowBuckets types.RateLimitFlowBuckets) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.FlowBucketsKeyPrefix)
+
+	key := types.RateLimitItemKey(flowBuckets.Path.Denom, flowBuckets.Path.ChannelOrClientId)
+	store.Set(key, k.cdc.MustMarshal(&flowBuckets))
+}
+
+// Removes the flow buckets of a rate limit from the store using denom and channel-id
+func (k *Keeper) RemoveRateLimitFlowBuckets(ctx sdk.Context, denom string, channelID string) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.FlowBucketsKeyPrefix)
+	store.Delete(types.RateLimitItemKey(denom, channelID))
+}
+
+// Grabs and returns the flow buckets of a rate limit from the store using denom and channel-id
+// If no buckets have been stored yet, an empty set of buckets is returned
+func (k *Keeper) GetRateLimitFlowBuckets(ctx sdk.Context, denom string, channelID string) types.RateLimitFlowBuckets {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.FlowBucketsKeyPrefix)
+
+	flowBuckets := types.RateLimitFlowBuckets{
+		Path: types.Path{Denom: denom, ChannelOrClientId: channelID},
+	}
+
+	bz := store.Get(types.RateLimitItemKey(denom, channelID))
+	if len(bz) == 0 {
+		return flowBuckets
+	}
+
+	k.cdc.MustUnmarshal(bz, &flowBuckets)
+	return flowBuckets
+}
+
+// Returns the flow buckets of all sliding window rate limits
+func (k *Keeper) GetAllRateLimitFlowBuckets(ctx sdk.Context) []types.RateLimitFlowBuckets {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.FlowBucketsKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allFlowBuckets := []types.RateLimitFlowBuckets{}
+	for ; iterator.Valid(); iterator.Next() {
+		flowBuckets := types.RateLimitFlowBuckets{}
+		k.cdc.MustUnmarshal(iterator.Value(), &flowBuckets)
+		allFlowBuckets = append(allFlowBuckets, flowBuckets)
+	}
+
+	return allFlowBuckets
+}
+
+// Removes the buckets that have slid out of the window of a sliding window rate limit and
+// updates the flow of the rate limit to the total of the remaining buckets
+// The channel value is refreshed whenever a new bucket starts
+// Returns the remaining buckets, which must be stored together with the rate limit
+func (k *Keeper) refreshSlidingWindowFlow(ctx sdk.Context, rateLimit *types.RateLimit) types.RateLimitFlowBuckets {
+	currentBucketNumber := rateLimit.Quota.BucketNumber(ctx.BlockTime())
+
+	flowBuckets := k.GetRateLimitFlowBuckets(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+	flowBuckets.PruneBuckets(*rateLimit.Quota, currentBucketNumber)
+
+	channelValue := rateLimit.Flow.ChannelValue
+	if _, found := flowBuckets.GetBucket(currentBucketNumber); !found {
+		channelValue = k.GetChannelValue(ctx, rateLimit.Path.Denom)
+	}
+
+	inflow, outflow := flowBuckets.TotalFlow()
+	rateLimit.Flow = &types.Flow{
+		Inflow:       inflow,
+		Outflow:      outflow,
+		ChannelValue: channelValue,
+	}
+
+	return flowBuckets
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -428,14 +430,14 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 
 	// Undo a send of 10 from the first rate limit, with sequence 1
 	// If should NOT modify the outflow since sequence 1 was not sent in the current quota
-	err := s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(s.chainA.GetContext(), channelID, 1, denom, packetSendAmount)
+	err := s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(s.chainA.GetContext(), channelID, 1, denom, sender, packetSendAmount)
 	s.Require().NoError(err, "no error expected when undoing send packet sequence 1")
 
 	checkOutflow(channelID, denom, initialOutflow)
 
 	// Now undo a send from the same rate limit with sequence 2
 	// If should decrement the outflow since 2 is in the current quota
-	err = s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(s.chainA.GetContext(), channelID, 2, denom, packetSendAmount)
+	err = s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(s.chainA.GetContext(), channelID, 2, denom, sender, packetSendAmount)
 	s.Require().NoError(err, "no error expected when undoing send packet sequence 2")
 
 	checkOutflow(channelID, denom, initialOutflow.Sub(packetSendAmount))
@@ -447,3 +449,135 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 	found := s.chainA.GetSimApp().RateLimitKeeper.CheckPacketSentDuringCurrentQuota(s.chainA.GetContext(), channelID, 2)
 	s.Require().False(found, "packet sequence number should have been removed")
 }
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_SlidingWindow() {
+	// The window is one hour, divided into four 15 minute buckets
+	windowStart := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctxAt := func(minutes int) sdk.Context {
+		return s.chainA.GetContext().WithBlockTime(windowStart.Add(time.Duration(minutes) * time.Minute))
+	}
+
+	// Mint the channel value, which is refreshed at the start of each bucket
+	err := s.chainA.GetSimApp().BankKeeper.MintCoins(s.chainA.GetContext(), minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	s.Require().NoError(err)
+
+	quota := types.Quota{
+		MaxPercentSend:        sdkmath.NewInt(10),
+		MaxPercentRecv:        sdkmath.NewInt(10),
+		DurationHours:         1,
+		Mode:                  types.SLIDING_WINDOW,
+		BucketDurationMinutes: 15,
+	}
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(s.chainA.GetContext(), types.RateLimit{
+		Path:  &types.Path{Denom: denom, ChannelOrClientId: channelID},
+		Quota: &quota,
+		Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+	})
+	firstBucket := quota.BucketNumber(windowStart)
+
+	send := func(minutes int, amount int64) error {
+		_, err := s.chainA.GetSimApp().RateLimitKeeper.CheckRateLimitAndUpdateFlow(ctxAt(minutes), types.PACKET_SEND, keeper.RateLimitedPacketInfo{
+			ChannelID: channelID,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(amount),
+			Sender:    sender,
+			Receiver:  receiver,
+		})
+		return err
+	}
+	checkOutflow := func(expectedOutflow int64) {
+		rateLimit, found := s.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(s.chainA.GetContext(), denom, channelID)
+		s.Require().True(found)
+		s.Require().Equal(expectedOutflow, rateLimit.Flow.Outflow.Int64())
+	}
+
+	// Fill the quota across the first and last bucket of the window
+	s.Require().NoError(send(0, 6))
+	s.Require().NoError(send(50, 4))
+	s.Require().ErrorContains(send(50, 1), "Outflow exceeds quota")
+	checkOutflow(10)
+
+	// Once the first bucket slides out of the window, only its flow is released
+	// (a fixed window would have reset the full quota at this point)
+	s.Require().NoError(send(61, 6))
+	s.Require().ErrorContains(send(61, 1), "Outflow exceeds quota")
+	checkOutflow(10)
+
+	// The packet sent at minute 50 is still part of the window 100 minutes in
+	s.Require().ErrorContains(send(100, 1), "Outflow exceeds quota")
+
+	// At minute 106 it has slid out as well
+	s.Require().NoError(send(106, 4))
+	checkOutflow(10)
+
+	flowBuckets := s.chainA.GetSimApp().RateLimitKeeper.GetRateLimitFlowBuckets(s.chainA.GetContext(), denom, channelID)
+	s.Require().Len(flowBuckets.Buckets, 2)
+	s.Require().Equal(firstBucket+4, flowBuckets.Buckets[0].BucketNumber)
+	s.Require().Equal(int64(6), flowBuckets.Buckets[0].Outflow.Int64())
+	s.Require().Equal(firstBucket+7, flowBuckets.Buckets[1].BucketNumber)
+	s.Require().Equal(int64(4), flowBuckets.Buckets[1].Outflow.Int64())
+
+	// Undoing a packet from a bucket that is no longer part of the window should be ignored
+	s.chainA.GetSimApp().RateLimitKeeper.SetPendingSendPacketBucket(s.chainA.GetContext(), channelID, 1, firstBucket+3)
+	err = s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(ctxAt(106), channelID, 1, denom, sender, sdkmath.NewInt(4))
+	s.Require().NoError(err)
+	checkOutflow(10)
+
+	// Undoing a packet from the current window should decrement the outflow of its bucket
+	s.chainA.GetSimApp().RateLimitKeeper.SetPendingSendPacketBucket(s.chainA.GetContext(), channelID, 2, firstBucket+7)
+	err = s.chainA.GetSimApp().RateLimitKeeper.UndoSendPacket(ctxAt(106), channelID, 2, denom, sender, sdkmath.NewInt(4))
+	s.Require().NoError(err)
+	checkOutflow(6)
+
+	flowBuckets = s.chainA.GetSimApp().RateLimitKeeper.GetRateLimitFlowBuckets(s.chainA.GetContext(), denom, channelID)
+	s.Require().True(flowBuckets.Buckets[1].Outflow.IsZero())
+
+	// Both pending packets should have been removed
+	s.Require().False(s.chainA.GetSimApp().RateLimitKeeper.CheckPacketSentDuringCurrentQuota(s.chainA.GetContext(), channelID, 1))
+	s.Require().False(s.chainA.GetSimApp().RateLimitKeeper.CheckPacketSentDuringCurrentQuota(s.chainA.GetContext(), channelID, 2))
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_CircuitBreaker() {
+	ctx := s.chainA.GetContext()
+	rateLimitKeeper := s.chainA.GetSimApp().RateLimitKeeper
+	channelKeeperV2 := s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2
+
+	err := rateLimitKeeper.SetHourEpoch(ctx, types.HourEpoch{EpochNumber: 5, Duration: time.Hour})
+	s.Require().NoError(err)
+	rateLimitKeeper.SetRateLimit(ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelOrClientId: channelID},
+		Quota: &types.Quota{
+			MaxPercentSend:          sdkmath.NewInt(10),
+			MaxPercentRecv:          sdkmath.NewInt(10),
+			DurationHours:           2,
+			CircuitBreakerThreshold: 2,
+		},
+		Flow: &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+	})
+
+	checkRateLimit := func(direction types.PacketDirection) error {
+		_, err := rateLimitKeeper.CheckRateLimitAndUpdateFlow(ctx, direction, keeper.RateLimitedPacketInfo{
+			ChannelID: channelID,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(11),
+			Sender:    sender,
+			Receiver:  receiver,
+		})
+		return err
+	}
+
+	// Exceeded send quotas are not reported to the circuit breaker
+	s.Require().ErrorContains(checkRateLimit(types.PACKET_SEND), "Outflow exceeds quota")
+	s.Require().Zero(channelKeeperV2.GetCircuitBreakerAnomalies(ctx, channelID).Count)
+
+	// Exceeded receive quotas are counted within the current window
+	s.Require().ErrorContains(checkRateLimit(types.PACKET_RECV), "Inflow exceeds quota")
+	s.Require().Equal(uint64(2), channelKeeperV2.GetCircuitBreakerAnomalies(ctx, channelID).Epoch)
+	s.Require().Equal(uint64(1), channelKeeperV2.GetCircuitBreakerAnomalies(ctx, channelID).Count)
+	s.Require().False(channelKeeperV2.GetCircuitBreaker(ctx, channelID).IsTripped())
+
+	// The circuit breaker is tripped once the threshold is reached
+	s.Require().ErrorContains(checkRateLimit(types.PACKET_RECV), "Inflow exceeds quota")
+	s.Require().True(channelKeeperV2.IsSendPaused(ctx, channelID))
+	s.Require().True(channelKeeperV2.IsRecvPaused(ctx, channelID))
+}
@@ -30,6 +30,19 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetPendingSendPacket(ctx, channelOrClientID, sequence)
 	}
 
+	// Set the flow buckets of sliding window rate limits, and the buckets their pending packets were sent in
+	for _, flowBuckets := range state.FlowBuckets {
+		k.SetRateLimitFlowBuckets(ctx, flowBuckets)
+	}
+	for _, pendingPacketBucket := range state.PendingSendPacketBuckets {
+		k.SetPendingSendPacketBucket(ctx, pendingPacketBucket.ChannelOrClientId, pendingPacketBucket.Sequence, pendingPacketBucket.BucketNumber)
+	}
+
+	// Set the outflows of the senders on rate limits with a per sender threshold
+	for _, senderFlow := range state.SenderFlows {
+		k.SetSenderFlow(ctx, senderFlow)
+	}
+
 	// If the hour epoch has been initialized already (epoch number != 0), validate and then use it
 	if state.HourEpoch.EpochNumber > 0 {
 		if err := k.SetHourEpoch(ctx, state.HourEpoch); err != nil {
@@ -61,5 +74,8 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		WhitelistedAddressPairs:          k.GetAllWhitelistedAddressPairs(ctx),
 		PendingSendPacketSequenceNumbers: k.GetAllPendingSendPackets(ctx),
 		HourEpoch:                        hourEpoch,
+		FlowBuckets:                      k.GetAllRateLimitFlowBuckets(ctx),
+		PendingSendPacketBuckets:         k.GetAllPendingSendPacketBuckets(ctx),
+		SenderFlows:                      k.GetAllSenderFlows(ctx),
 	}
 }
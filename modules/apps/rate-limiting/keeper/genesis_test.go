@@ -24,11 +24,30 @@ func createRateLimits() []types.RateLimit {
 	return rateLimits
 }
 
+func createSlidingWindowRateLimit() (types.RateLimit, types.RateLimitFlowBuckets) {
+	path := types.Path{Denom: "denom-4", ChannelOrClientId: "channel-4"}
+	rateLimit := types.RateLimit{
+		Path:  &path,
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(4), MaxPercentRecv: sdkmath.NewInt(4), DurationHours: 4, Mode: types.SLIDING_WINDOW, BucketDurationMinutes: 15},
+		Flow:  &types.Flow{Inflow: sdkmath.NewInt(3), Outflow: sdkmath.NewInt(7), ChannelValue: sdkmath.NewInt(100)},
+	}
+	flowBuckets := types.RateLimitFlowBuckets{
+		Path: path,
+		Buckets: []types.FlowBucket{
+			{BucketNumber: 10, Inflow: sdkmath.NewInt(1), Outflow: sdkmath.NewInt(5)},
+			{BucketNumber: 12, Inflow: sdkmath.NewInt(2), Outflow: sdkmath.NewInt(2)},
+		},
+	}
+	return rateLimit, flowBuckets
+}
+
 func (s *KeeperTestSuite) TestGenesis() {
 	currentHour := 13
 	blockTime := time.Date(2024, 1, 1, currentHour, 55, 8, 0, time.UTC) // 13:55:08
 	blockHeight := int64(10)
 
+	slidingRateLimit, slidingFlowBuckets := createSlidingWindowRateLimit()
+
 	testCases := []struct {
 		name         string
 		genesisState types.GenesisState
@@ -43,19 +62,27 @@ func (s *KeeperTestSuite) TestGenesis() {
 		{
 			name: "valid custom state",
 			genesisState: types.GenesisState{
-				RateLimits: createRateLimits(),
+				RateLimits: append(createRateLimits(), slidingRateLimit),
 				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
 					{Sender: "senderA", Receiver: "receiverA"},
 					{Sender: "senderB", Receiver: "receiverB"},
 				},
 				BlacklistedDenoms:                []string{"denomA", "denomB"},
-				PendingSendPacketSequenceNumbers: []string{"channel-0/1", "channel-2/3"},
+				PendingSendPacketSequenceNumbers: []string{"channel-0/1", "channel-2/3", "channel-4/5"},
 				HourEpoch: types.HourEpoch{
 					EpochNumber:      1,
 					EpochStartTime:   blockTime,
 					Duration:         time.Minute,
 					EpochStartHeight: 1,
 				},
+				FlowBuckets: []types.RateLimitFlowBuckets{slidingFlowBuckets},
+				PendingSendPacketBuckets: []types.PendingSendPacketBucket{
+					{ChannelOrClientId: "channel-4", Sequence: 5, BucketNumber: 12},
+				},
+				SenderFlows: []types.SenderFlow{
+					{Path: types.Path{Denom: "denom-1", ChannelOrClientId: "channel-1"}, Sender: "senderA", Outflow: sdkmath.NewInt(1)},
+					{Path: types.Path{Denom: "denom-1", ChannelOrClientId: "channel-1"}, Sender: "senderB", Outflow: sdkmath.NewInt(2)},
+				},
 			},
 			firstEpoch: false,
 		},
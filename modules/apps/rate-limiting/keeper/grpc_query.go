@@ -64,7 +64,12 @@ func (k Querier) RateLimitsByChainID(c context.Context, req *types.QueryRateLimi
 	rateLimits := make([]types.RateLimit, 0)
 	for _, rateLimit := range k.k.GetAllRateLimits(ctx) {
 		// Determine the client state from the channel Id
-		_, clientState, err := k.k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, rateLimit.Path.ChannelOrClientId)
+		// If the channel doesn't exist on any port, the Id is treated as a client Id
+		portID, found := k.k.getChannelPortID(ctx, rateLimit.Path.ChannelOrClientId)
+		if !found {
+			portID = transfertypes.PortID
+		}
+		_, clientState, err := k.k.channelKeeper.GetChannelClientState(ctx, portID, rateLimit.Path.ChannelOrClientId)
 		if err != nil {
 			var ok bool
 			clientState, ok = k.k.clientKeeper.GetClientState(ctx, rateLimit.Path.ChannelOrClientId)
@@ -133,3 +138,27 @@ func (k Querier) AllWhitelistedAddresses(c context.Context, req *types.QueryAllW
 	whitelistedAddresses := k.k.GetAllWhitelistedAddressPairs(ctx)
 	return &types.QueryAllWhitelistedAddressesResponse{AddressPairs: whitelistedAddresses}, nil
 }
+
+// Query the flow buckets of a sliding window rate limit by denom and channelId
+func (k Querier) RateLimitFlowBuckets(c context.Context, req *types.QueryRateLimitFlowBucketsRequest) (*types.QueryRateLimitFlowBucketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.k.GetRateLimit(ctx, req.Denom, req.ChannelOrClientId)
+	if !found || !rateLimit.Quota.IsSlidingWindow() {
+		return &types.QueryRateLimitFlowBucketsResponse{Buckets: []types.FlowBucket{}}, nil
+	}
+
+	// Only return the buckets that are still part of the window
+	currentBucketNumber := rateLimit.Quota.BucketNumber(ctx.BlockTime())
+	flowBuckets := k.k.GetRateLimitFlowBuckets(ctx, req.Denom, req.ChannelOrClientId)
+	flowBuckets.PruneBuckets(*rateLimit.Quota, currentBucketNumber)
+
+	return &types.QueryRateLimitFlowBucketsResponse{
+		Buckets:             flowBuckets.Buckets,
+		CurrentBucketNumber: currentBucketNumber,
+	}, nil
+}
@@ -123,3 +123,31 @@ func (s *KeeperTestSuite) TestQueryAllWhitelistedAddresses() {
 	}
 	s.Require().Equal(expectedWhitelist, queryResponse.AddressPairs)
 }
+
+func (s *KeeperTestSuite) TestQueryRateLimitFlowBuckets() {
+	rateLimit, flowBuckets := createSlidingWindowRateLimit()
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(s.chainA.GetContext(), rateLimit)
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimitFlowBuckets(s.chainA.GetContext(), flowBuckets)
+
+	// Query at the time of the last bucket, so that both buckets are in the window
+	blockTime := time.Unix(int64(flowBuckets.Buckets[1].BucketNumber)*int64(rateLimit.Quota.BucketDuration().Seconds()), 0) //nolint:gosec
+	ctx := s.chainA.GetContext().WithBlockTime(blockTime)
+
+	querier := keeper.NewQuerier(s.chainA.GetSimApp().RateLimitKeeper)
+	queryResponse, err := querier.RateLimitFlowBuckets(ctx, &types.QueryRateLimitFlowBucketsRequest{
+		Denom:             rateLimit.Path.Denom,
+		ChannelOrClientId: rateLimit.Path.ChannelOrClientId,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(flowBuckets.Buckets, queryResponse.Buckets)
+	s.Require().Equal(flowBuckets.Buckets[1].BucketNumber, queryResponse.CurrentBucketNumber)
+
+	// Once the first bucket slides out of the window, it should no longer be returned
+	ctx = ctx.WithBlockTime(blockTime.Add(rateLimit.Quota.BucketDuration() * 14))
+	queryResponse, err = querier.RateLimitFlowBuckets(ctx, &types.QueryRateLimitFlowBucketsRequest{
+		Denom:             rateLimit.Path.Denom,
+		ChannelOrClientId: rateLimit.Path.ChannelOrClientId,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(flowBuckets.Buckets[1:], queryResponse.Buckets)
+}
@@ -26,6 +26,12 @@ type Keeper struct {
 
 	bankKeeper types.BankKeeper
 	authority  string
+
+	packetInfoExtractor PacketInfoExtractor
+
+	// circuitBreakerKeeper is optional, and trips the IBC circuit breaker once a receive
+	// quota has been exceeded too many times within a window
+	circuitBreakerKeeper types.CircuitBreakerKeeper
 }
 
 // NewKeeper creates a new rate-limiting Keeper instance
@@ -44,6 +50,9 @@ func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, cha
 		clientKeeper:  clientKeeper,
 		bankKeeper:    bankKeeper,
 		authority:     authority,
+		// Defaults to rate limiting ICS-20 transfer packets
+		// This can be overridden with SetPacketInfoExtractor for other applications
+		packetInfoExtractor: TransferPacketInfoExtractor{},
 	}
 }
 
@@ -54,11 +63,23 @@ func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = ics4Wrapper
 }
 
+// SetCircuitBreakerKeeper sets the keeper used to trip the IBC circuit breaker of a channel or client
+// once the receive quota of a rate limit with a circuit breaker threshold is exceeded too many times.
+func (k *Keeper) SetCircuitBreakerKeeper(circuitBreakerKeeper types.CircuitBreakerKeeper) {
+	k.circuitBreakerKeeper = circuitBreakerKeeper
+}
+
 // ICS4Wrapper returns the ICS4Wrapper to send packets downstream.
 func (k *Keeper) ICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// SetPacketInfoExtractor sets the PacketInfoExtractor used to parse the packets of the wrapped application.
+// It must be set to rate limit applications other than ICS-20 transfer, e.g. PacketCountInfoExtractor.
+func (k *Keeper) SetPacketInfoExtractor(extractor PacketInfoExtractor) {
+	k.packetInfoExtractor = extractor
+}
+
 // GetAuthority returns the module's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// MigrateQuotaMode explicitly sets all existing rate limits to the fixed window quota mode,
+// so that they continue to be reset at the end of each window after sliding windows are introduced
+// Existing rate limits have no absolute or per sender thresholds, so those are set to zero,
+// and they all limit token amounts
+func (m Migrator) MigrateQuotaMode(ctx sdk.Context) error {
+	for _, rateLimit := range m.keeper.GetAllRateLimits(ctx) {
+		if rateLimit.Quota == nil {
+			continue
+		}
+		rateLimit.Quota.Mode = types.FIXED_WINDOW
+		rateLimit.Quota.BucketDurationMinutes = 0
+		rateLimit.Quota.MaxAmountSend = sdkmath.ZeroInt()
+		rateLimit.Quota.MaxAmountRecv = sdkmath.ZeroInt()
+		rateLimit.Quota.MaxPercentSendPerSender = sdkmath.ZeroInt()
+		rateLimit.Quota.Unit = types.TOKEN_AMOUNT
+		m.keeper.SetRateLimit(ctx, rateLimit)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated rate limits to fixed window quota mode without absolute or per sender thresholds")
+	return nil
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateQuotaMode() {
+	rateLimits := createRateLimits()
+	for _, rateLimit := range rateLimits {
+		s.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(s.chainA.GetContext(), rateLimit)
+	}
+
+	migrator := keeper.NewMigrator(s.chainA.GetSimApp().RateLimitKeeper)
+	err := migrator.MigrateQuotaMode(s.chainA.GetContext())
+	s.Require().NoError(err)
+
+	// Existing rate limits should remain unchanged, using fixed windows without absolute thresholds
+	migratedRateLimits := s.chainA.GetSimApp().RateLimitKeeper.GetAllRateLimits(s.chainA.GetContext())
+	s.Require().Equal(rateLimits, migratedRateLimits)
+	for _, rateLimit := range migratedRateLimits {
+		s.Require().Equal(types.FIXED_WINDOW, rateLimit.Quota.Mode)
+		s.Require().Zero(rateLimit.Quota.BucketDurationMinutes)
+		s.Require().True(rateLimit.Quota.MaxAmountSend.IsZero())
+		s.Require().True(rateLimit.Quota.MaxAmountRecv.IsZero())
+		s.Require().Equal(types.TOKEN_AMOUNT, rateLimit.Quota.Unit)
+	}
+}
@@ -28,12 +28,15 @@ var (
 	}
 
 	updateRateLimitMsg = types.MsgUpdateRateLimit{
-		Signer:            authority,
-		Denom:             "denom",
-		ChannelOrClientId: "channel-0",
-		MaxPercentRecv:    sdkmath.NewInt(20),
-		MaxPercentSend:    sdkmath.NewInt(30),
-		DurationHours:     40,
+		Signer:                  authority,
+		Denom:                   "denom",
+		ChannelOrClientId:       "channel-0",
+		MaxPercentRecv:          sdkmath.NewInt(20),
+		MaxPercentSend:          sdkmath.NewInt(30),
+		DurationHours:           40,
+		MaxAmountRecv:           sdkmath.NewInt(2000),
+		MaxAmountSend:           sdkmath.NewInt(3000),
+		MaxPercentSendPerSender: sdkmath.NewInt(5),
 	}
 
 	removeRateLimitMsg = types.MsgRemoveRateLimit{
@@ -143,9 +146,12 @@ func (s *KeeperTestSuite) TestMsgServer_UpdateRateLimit() {
 	updatedRateLimit, found := s.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(s.chainA.GetContext(), denom, channelID)
 	s.Require().True(found)
 	s.Require().Equal(updatedRateLimit.Quota, &types.Quota{
-		MaxPercentSend: updateRateLimitMsg.MaxPercentSend,
-		MaxPercentRecv: updateRateLimitMsg.MaxPercentRecv,
-		DurationHours:  updateRateLimitMsg.DurationHours,
+		MaxPercentSend:          updateRateLimitMsg.MaxPercentSend,
+		MaxPercentRecv:          updateRateLimitMsg.MaxPercentRecv,
+		DurationHours:           updateRateLimitMsg.DurationHours,
+		MaxAmountSend:           updateRateLimitMsg.MaxAmountSend,
+		MaxAmountRecv:           updateRateLimitMsg.MaxAmountRecv,
+		MaxPercentSendPerSender: updateRateLimitMsg.MaxPercentSendPerSender,
 	})
 
 	// Attempt to update a rate limit that has invalid authority
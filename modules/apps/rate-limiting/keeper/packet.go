@@ -146,40 +146,72 @@ func ParseDenomFromRecvPacket(packet channeltypes.Packet, packetData transfertyp
 // For a RECEIVE packet, the channelID is the DESTINATION channel
 //
 // The Source and Destination are defined from the perspective of a packet recipient.
-func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection) (RateLimitedPacketInfo, error) {
-	var packetData transfertypes.FungibleTokenPacketData
-	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
-		return RateLimitedPacketInfo{}, err
+//
+// Packets transferring multiple tokens (ICS20-V2) return the packet info of each token
+func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection) ([]RateLimitedPacketInfo, error) {
+	packetDatas, err := parseTransferPacketData(packet.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	packetInfos := make([]RateLimitedPacketInfo, 0, len(packetDatas))
+	for _, packetData := range packetDatas {
+		var channelID, denom string
+		if direction == types.PACKET_SEND {
+			channelID = packet.GetSourceChannel()
+			denom = ParseDenomFromSendPacket(packetData)
+		} else {
+			channelID = packet.GetDestChannel()
+			denom = ParseDenomFromRecvPacket(packet, packetData)
+		}
+
+		amount, ok := sdkmath.NewIntFromString(packetData.Amount)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Unable to cast packet amount '%s' to sdkmath.Int", packetData.Amount)
+		}
+
+		packetInfos = append(packetInfos, RateLimitedPacketInfo{
+			ChannelID: channelID,
+			Denom:     denom,
+			Amount:    amount,
+			Sender:    packetData.Sender,
+			Receiver:  packetData.Receiver,
+		})
 	}
 
-	var channelID, denom string
-	if direction == types.PACKET_SEND {
-		channelID = packet.GetSourceChannel()
-		denom = ParseDenomFromSendPacket(packetData)
-	} else {
-		channelID = packet.GetDestChannel()
-		denom = ParseDenomFromRecvPacket(packet, packetData)
+	return packetInfos, nil
+}
+
+// Unmarshals the transfer packet data, which is either JSON encoded ICS20-V1 packet data or
+// protobuf encoded ICS20-V2 packet data
+// Each token of ICS20-V2 packet data is returned as separate ICS20-V1 packet data, so that the
+// denom of each token can be parsed the same way as for ICS20-V1 packets
+func parseTransferPacketData(bz []byte) ([]transfertypes.FungibleTokenPacketData, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	err := json.Unmarshal(bz, &packetData)
+	if err == nil {
+		return []transfertypes.FungibleTokenPacketData{packetData}, nil
 	}
 
-	amount, ok := sdkmath.NewIntFromString(packetData.Amount)
-	if !ok {
-		return RateLimitedPacketInfo{},
-			errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Unable to cast packet amount '%s' to sdkmath.Int", packetData.Amount)
+	transferRepresentation, errV2 := transfertypes.UnmarshalPacketData(bz, transfertypes.V2, transfertypes.EncodingProtobuf)
+	if errV2 != nil {
+		// return the error of the ICS20-V1 packet data, as it is the most common packet data
+		return nil, err
 	}
 
-	packetInfo := RateLimitedPacketInfo{
-		ChannelID: channelID,
-		Denom:     denom,
-		Amount:    amount,
-		Sender:    packetData.Sender,
-		Receiver:  packetData.Receiver,
+	packetDatas := make([]transfertypes.FungibleTokenPacketData, 0, len(transferRepresentation.Tokens))
+	for _, token := range transferRepresentation.Tokens {
+		packetDatas = append(packetDatas, transfertypes.NewFungibleTokenPacketData(
+			token.Denom.Path(), token.Amount, transferRepresentation.Sender, transferRepresentation.Receiver, transferRepresentation.Memo,
+		))
 	}
 
-	return packetInfo, nil
+	return packetDatas, nil
 }
 
 // Middleware implementation for SendPacket with rate limiting
 // Checks whether the rate limit has been exceeded - and if it hasn't, sends the packet
+// For packets transferring multiple tokens, the packet is only sent if none of the rate limits are exceeded
 func (k *Keeper) SendRateLimitedPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) error {
 	seq, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -195,30 +227,62 @@ func (k *Keeper) SendRateLimitedPacket(ctx sdk.Context, sourcePort, sourceChanne
 		Data:             data,
 	}
 
-	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
-	if err != nil {
-		return err
-	}
+	return k.RateLimitSendPacket(ctx, packet)
+}
 
-	// Check if the packet would exceed the outflow rate limit
-	updatedFlow, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, packetInfo)
+// RateLimitSendPacket checks the outflow of a packet which is about to be sent against the rate limits
+// of its tokens. Unlike SendRateLimitedPacket, the sequence of the packet must already be set, e.g. by
+// the IBC v2 middleware, which is passed the sequence of the packet
+func (k *Keeper) RateLimitSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetInfos, err := k.packetInfoExtractor.ExtractPacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
 	}
 
-	// Store the sequence number of the packet so that if the transfer fails,
-	// we can identify if it was sent during this quota and can revert the outflow
-	if updatedFlow {
-		k.SetPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+	for _, packetInfo := range packetInfos {
+		// Check if the packet would exceed the outflow rate limit
+		updatedFlow, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, packetInfo)
+		if err != nil {
+			return err
+		}
+
+		// Store the sequence number of the packet so that if the transfer fails,
+		// we can identify if it was sent during this quota and can revert the outflow
+		// For sliding windows, the bucket the packet was sent in is stored as well
+		if updatedFlow {
+			// Check if the packet would exceed the outflow rate limit of the sender
+			if err := k.CheckSenderRateLimitAndUpdateFlow(ctx, packetInfo); err != nil {
+				return err
+			}
+
+			k.setPendingSendPacket(ctx, packetInfo, packet.Sequence)
+		}
 	}
 
 	return nil
 }
 
+// Stores the pending packet of a token sent on a rate limited channel
+// The pending packet is shared by all tokens of the packet. Since fixed windows only check whether the
+// packet is pending, the bucket of the first token sent on a sliding window rate limit takes precedence
+func (k *Keeper) setPendingSendPacket(ctx sdk.Context, packetInfo RateLimitedPacketInfo, sequence uint64) {
+	rateLimit, _ := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+	if rateLimit.Quota.IsSlidingWindow() {
+		if _, found := k.GetPendingSendPacketBucket(ctx, packetInfo.ChannelID, sequence); !found {
+			k.SetPendingSendPacketBucket(ctx, packetInfo.ChannelID, sequence, rateLimit.Quota.BucketNumber(ctx.BlockTime()))
+		}
+		return
+	}
+
+	if !k.CheckPacketSentDuringCurrentQuota(ctx, packetInfo.ChannelID, sequence) {
+		k.SetPendingSendPacket(ctx, packetInfo.ChannelID, sequence)
+	}
+}
+
 // Middleware implementation for RecvPacket with rate limiting
 // Checks whether the rate limit has been exceeded - and if it hasn't, allows the packet
 func (k *Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
-	packetInfo, err := ParsePacketInfo(packet, types.PACKET_RECV)
+	packetInfos, err := k.packetInfoExtractor.ExtractPacketInfo(packet, types.PACKET_RECV)
 	if err != nil {
 		// If the packet data is unparseable, we can't apply rate limiting.
 		// Log the error and allow the packet to proceed to the underlying app
@@ -227,10 +291,16 @@ func (k *Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.P
 		return nil // Returning nil allows the packet to continue down the stack
 	}
 
-	// If parsing was successful, check the rate limit
-	_, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_RECV, packetInfo)
+	// If parsing was successful, check the rate limit of each token
 	// If CheckRateLimitAndUpdateFlow returns an error (e.g., quota exceeded), return it to generate an error ack.
-	return err
+	// The inflows of the previous tokens are reverted together with the error ack
+	for _, packetInfo := range packetInfos {
+		if _, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_RECV, packetInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // AcknowledgeRateLimitedPacket implements for OnAckPacket for porttypes.Middleware.
@@ -242,28 +312,30 @@ func (k *Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltyp
 	}
 
 	// Parse the denom, channelId, and amount from the packet
-	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
+	packetInfos, err := k.packetInfoExtractor.ExtractPacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
 	}
 
 	// If the ack was successful, remove the pending packet
 	if ackSuccess {
-		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		for _, packetInfo := range packetInfos {
+			k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		}
 		return nil
 	}
 
 	// If the ack failed, undo the change to the rate limit Outflow
-	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	return k.undoSendPackets(ctx, packet.Sequence, packetInfos)
 }
 
 // Middleware implementation for OnAckPacket with rate limiting
 // The Outflow should be decremented from the failed packet
 func (k *Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
-	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
+	packetInfos, err := k.packetInfoExtractor.ExtractPacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
 	}
 
-	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	return k.undoSendPackets(ctx, packet.Sequence, packetInfos)
 }
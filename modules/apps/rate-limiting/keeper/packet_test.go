@@ -199,7 +199,7 @@ func (s *KeeperTestSuite) TestParsePacketInfo() {
 	}
 	actualSendPacketInfo, err := keeper.ParsePacketInfo(packet, types.PACKET_SEND)
 	s.Require().NoError(err, "no error expected when parsing send packet")
-	s.Require().Equal(expectedSendPacketInfo, actualSendPacketInfo, "send packet")
+	s.Require().Equal([]keeper.RateLimitedPacketInfo{expectedSendPacketInfo}, actualSendPacketInfo, "send packet")
 
 	// Receive 'denom' from channel-100 -> channel-200 (stride)
 	// The stride channel (channel-200) should be tacked onto the end and the denom should be hashed
@@ -212,7 +212,53 @@ func (s *KeeperTestSuite) TestParsePacketInfo() {
 	}
 	actualRecvPacketInfo, err := keeper.ParsePacketInfo(packet, types.PACKET_RECV)
 	s.Require().NoError(err, "no error expected when parsing recv packet")
-	s.Require().Equal(expectedRecvPacketInfo, actualRecvPacketInfo, "recv packet")
+	s.Require().Equal([]keeper.RateLimitedPacketInfo{expectedRecvPacketInfo}, actualRecvPacketInfo, "recv packet")
+}
+
+func (s *KeeperTestSuite) TestParsePacketInfo_MultipleTokens() {
+	sourceChannel := "channel-100"
+	destinationChannel := "channel-200"
+	sender := "sender"
+	receiver := "receiver"
+
+	packetData := transfertypes.NewFungibleTokenPacketDataV2(
+		[]transfertypes.Token{
+			{Denom: transfertypes.NewDenom("denomA"), Amount: "100"},
+			{Denom: transfertypes.NewDenom("denomB", transfertypes.NewHop(transferPort, sourceChannel)), Amount: "200"},
+		},
+		sender, receiver, "",
+	)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData.GetBytes(),
+	}
+
+	// The native 'denomA' is kept as is, while 'denomB' is hashed with its trace
+	expectedSendPacketInfos := []keeper.RateLimitedPacketInfo{
+		{ChannelID: sourceChannel, Denom: "denomA", Amount: sdkmath.NewInt(100), Sender: sender, Receiver: receiver},
+		{ChannelID: sourceChannel, Denom: hashDenomTrace(fmt.Sprintf("transfer/%s/denomB", sourceChannel)), Amount: sdkmath.NewInt(200), Sender: sender, Receiver: receiver},
+	}
+	actualSendPacketInfos, err := keeper.ParsePacketInfo(packet, types.PACKET_SEND)
+	s.Require().NoError(err, "no error expected when parsing send packet")
+	s.Require().Equal(expectedSendPacketInfos, actualSendPacketInfos, "send packet")
+
+	// On receive, 'denomA' is prefixed with the destination channel, while 'denomB' returns to its origin
+	expectedRecvPacketInfos := []keeper.RateLimitedPacketInfo{
+		{ChannelID: destinationChannel, Denom: hashDenomTrace(fmt.Sprintf("transfer/%s/denomA", destinationChannel)), Amount: sdkmath.NewInt(100), Sender: sender, Receiver: receiver},
+		{ChannelID: destinationChannel, Denom: "denomB", Amount: sdkmath.NewInt(200), Sender: sender, Receiver: receiver},
+	}
+	actualRecvPacketInfos, err := keeper.ParsePacketInfo(packet, types.PACKET_RECV)
+	s.Require().NoError(err, "no error expected when parsing recv packet")
+	s.Require().Equal(expectedRecvPacketInfos, actualRecvPacketInfos, "recv packet")
+
+	// Invalid packet data can't be parsed
+	packet.Data = []byte("invalid")
+	_, err = keeper.ParsePacketInfo(packet, types.PACKET_SEND)
+	s.Require().Error(err)
 }
 
 func (s *KeeperTestSuite) TestCheckAcknowledgementSucceeded() {
@@ -346,6 +392,138 @@ func (s *KeeperTestSuite) TestSendRateLimitedPacket() {
 	s.Require().True(found, "pending send packet")
 }
 
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_PerSender() {
+	denom := ustrd
+	sourceChannel := channelOnStride
+	senderA, senderB := "senderA", "senderB"
+
+	// Create a rate limit where each sender can only send 10% of the 50% channel quota
+	s.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(s.chainA.GetContext(), types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelOrClientId: sourceChannel},
+		Quota: &types.Quota{
+			MaxPercentSend:          sdkmath.NewInt(50),
+			MaxPercentRecv:          sdkmath.NewInt(50),
+			DurationHours:           1,
+			MaxPercentSendPerSender: sdkmath.NewInt(10),
+		},
+		Flow: &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+	})
+
+	sendPacket := func(sender string, amount int64, sequence uint64) ([]byte, error) {
+		packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: sdkmath.NewInt(amount).String(), Sender: sender})
+		s.Require().NoError(err)
+
+		s.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.chainA.GetContext(), transferPort, sourceChannel, sequence)
+		return packetData, s.chainA.GetSimApp().RateLimitKeeper.SendRateLimitedPacket(s.chainA.GetContext(), transferPort, sourceChannel, clienttypes.Height{}, 0, packetData)
+	}
+	checkSenderOutflow := func(sender string, expectedOutflow int64) {
+		senderFlow := s.chainA.GetSimApp().RateLimitKeeper.GetSenderFlow(s.chainA.GetContext(), denom, sourceChannel, sender)
+		s.Require().Equal(expectedOutflow, senderFlow.Outflow.Int64(), "outflow of %s", sender)
+	}
+
+	// The first sender can send up to their own threshold
+	packetData, err := sendPacket(senderA, 8, 1)
+	s.Require().NoError(err)
+	checkSenderOutflow(senderA, 8)
+
+	_, err = sendPacket(senderA, 3, 2)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	s.Require().ErrorContains(err, "Sender outflow exceeds quota")
+	checkSenderOutflow(senderA, 8)
+
+	// Other senders are not affected by the first sender
+	_, err = sendPacket(senderB, 10, 3)
+	s.Require().NoError(err)
+	checkSenderOutflow(senderB, 10)
+
+	// If the packet of the first sender times out, their outflow should be decremented
+	err = s.chainA.GetSimApp().RateLimitKeeper.TimeoutRateLimitedPacket(s.chainA.GetContext(), channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: channelOnHost,
+		Data:               packetData,
+		Sequence:           1,
+	})
+	s.Require().NoError(err)
+	checkSenderOutflow(senderA, 0)
+	checkSenderOutflow(senderB, 10)
+
+	// Resetting the rate limit resets the outflow of all senders
+	err = s.chainA.GetSimApp().RateLimitKeeper.ResetRateLimit(s.chainA.GetContext(), denom, sourceChannel)
+	s.Require().NoError(err)
+	checkSenderOutflow(senderB, 0)
+	s.Require().Empty(s.chainA.GetSimApp().RateLimitKeeper.GetAllSenderFlows(s.chainA.GetContext()))
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_PacketCount() {
+	sourceChannel := channelOnStride
+
+	// Rate limit the number of packets sent on the channel, regardless of the packet data
+	rateLimitKeeper := s.chainA.GetSimApp().RateLimitKeeper
+	rateLimitKeeper.SetPacketInfoExtractor(keeper.PacketCountInfoExtractor{})
+	defer rateLimitKeeper.SetPacketInfoExtractor(keeper.TransferPacketInfoExtractor{})
+
+	err := rateLimitKeeper.AddRateLimit(s.chainA.GetContext(), &types.MsgAddRateLimit{
+		Denom:             types.PacketCountDenom,
+		ChannelOrClientId: sourceChannel,
+		MaxPercentSend:    sdkmath.ZeroInt(),
+		MaxPercentRecv:    sdkmath.ZeroInt(),
+		DurationHours:     1,
+		MaxAmountSend:     sdkmath.NewInt(2),
+		MaxAmountRecv:     sdkmath.ZeroInt(),
+		Unit:              types.PACKET_COUNT,
+	})
+	s.Require().ErrorIs(err, types.ErrChannelNotFound, "channel must exist")
+
+	// Open the channel on a non-transfer port
+	s.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), "icahost", sourceChannel, channeltypes.Channel{})
+	err = rateLimitKeeper.AddRateLimit(s.chainA.GetContext(), &types.MsgAddRateLimit{
+		Denom:             types.PacketCountDenom,
+		ChannelOrClientId: sourceChannel,
+		MaxPercentSend:    sdkmath.ZeroInt(),
+		MaxPercentRecv:    sdkmath.ZeroInt(),
+		DurationHours:     1,
+		MaxAmountSend:     sdkmath.NewInt(2),
+		MaxAmountRecv:     sdkmath.ZeroInt(),
+		Unit:              types.PACKET_COUNT,
+	})
+	s.Require().NoError(err, "rate limits on a zero channel value are allowed for packet counts")
+
+	sendPacket := func(sequence uint64) error {
+		s.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetNextSequenceSend(s.chainA.GetContext(), "icahost", sourceChannel, sequence)
+		return rateLimitKeeper.SendRateLimitedPacket(s.chainA.GetContext(), "icahost", sourceChannel, clienttypes.Height{}, 0, []byte("non-transfer packet data"))
+	}
+	checkOutflow := func(expectedOutflow int64) {
+		rateLimit, found := rateLimitKeeper.GetRateLimit(s.chainA.GetContext(), types.PacketCountDenom, sourceChannel)
+		s.Require().True(found)
+		s.Require().Equal(expectedOutflow, rateLimit.Flow.Outflow.Int64())
+	}
+
+	s.Require().NoError(sendPacket(1))
+	s.Require().NoError(sendPacket(2))
+	checkOutflow(2)
+
+	err = sendPacket(3)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	checkOutflow(2)
+
+	// If a packet times out, the packet count should be decremented
+	err = rateLimitKeeper.TimeoutRateLimitedPacket(s.chainA.GetContext(), channeltypes.Packet{
+		SourcePort:         "icahost",
+		SourceChannel:      sourceChannel,
+		DestinationPort:    "icacontroller",
+		DestinationChannel: channelOnHost,
+		Data:               []byte("non-transfer packet data"),
+		Sequence:           1,
+	})
+	s.Require().NoError(err)
+	checkOutflow(1)
+
+	s.Require().NoError(sendPacket(3))
+	checkOutflow(2)
+}
+
 func (s *KeeperTestSuite) TestReceiveRateLimitedPacket() {
 	// For receive packets, the source will be the host and the destination will be stride
 	packetDenom := uosmo
@@ -78,3 +78,52 @@ func (k *Keeper) RemoveAllChannelPendingSendPackets(ctx sdk.Context, channelID s
 		store.Delete(iterator.Key())
 	}
 }
+
+// Sets the sequence number of a packet that was just sent on a sliding window rate limit,
+// together with the bucket it was sent in
+func (k *Keeper) SetPendingSendPacketBucket(ctx sdk.Context, channelID string, sequence uint64, bucketNumber uint64) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingSendPacketPrefix)
+	key := types.PendingSendPacketKey(channelID, sequence)
+	store.Set(key, sdk.Uint64ToBigEndian(bucketNumber))
+}
+
+// Returns the sliding window bucket a pending packet was sent in
+// Returns false if the packet is not pending or was not sent on a sliding window rate limit
+func (k *Keeper) GetPendingSendPacketBucket(ctx sdk.Context, channelID string, sequence uint64) (uint64, bool) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingSendPacketPrefix)
+	key := types.PendingSendPacketKey(channelID, sequence)
+	valueBz := store.Get(key)
+	if len(valueBz) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(valueBz), true
+}
+
+// Get the buckets of all pending packets that were sent on sliding window rate limits
+func (k *Keeper) GetAllPendingSendPacketBuckets(ctx sdk.Context) []types.PendingSendPacketBucket {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingSendPacketPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingPacketBuckets := make([]types.PendingSendPacketBucket, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		value := iterator.Value()
+		if len(value) != 8 {
+			continue
+		}
+
+		key := iterator.Key()
+		channelID := strings.TrimRight(string(key[:types.PendingSendPacketChannelLength]), "\x00")
+		pendingPacketBuckets = append(pendingPacketBuckets, types.PendingSendPacketBucket{
+			ChannelOrClientId: channelID,
+			Sequence:          binary.BigEndian.Uint64(key[types.PendingSendPacketChannelLength:]),
+			BucketNumber:      binary.BigEndian.Uint64(value),
+		})
+	}
+
+	return pendingPacketBuckets
+}
@@ -8,7 +8,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
-	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
@@ -29,6 +28,9 @@ func (k *Keeper) RemoveRateLimit(ctx sdk.Context, denom string, channelID string
 	store := prefix.NewStore(adapter, types.RateLimitKeyPrefix)
 	rateLimitKey := types.RateLimitItemKey(denom, channelID)
 	store.Delete(rateLimitKey)
+
+	k.RemoveRateLimitFlowBuckets(ctx, denom, channelID)
+	k.RemoveAllSenderFlows(ctx, denom, channelID)
 }
 
 // Grabs and returns a rate limit object from the store using denom and channel-id
@@ -71,9 +73,10 @@ func (k *Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
 }
 
 // Adds a new rate limit. Fails if the rate limit already exists or the channel value is 0
+// The channel value is not required for packet count quotas, as they don't track a token supply
 func (k *Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error {
 	channelValue := k.GetChannelValue(ctx, msg.Denom)
-	if channelValue.IsZero() {
+	if channelValue.IsZero() && msg.Unit != types.PACKET_COUNT {
 		return types.ErrZeroChannelValue
 	}
 
@@ -83,7 +86,8 @@ func (k *Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 	}
 
 	// Confirm the channel or client exists
-	_, found = k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.ChannelOrClientId)
+	// The channel can be bound to any port, to support rate limiting applications other than transfer
+	_, found = k.getChannelPortID(ctx, msg.ChannelOrClientId)
 	if !found {
 		// Check if the channelId is actually a clientId
 		status := k.clientKeeper.GetClientStatus(ctx, msg.ChannelOrClientId)
@@ -101,9 +105,16 @@ func (k *Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 		ChannelOrClientId: msg.ChannelOrClientId,
 	}
 	quota := types.Quota{
-		MaxPercentSend: msg.MaxPercentSend,
-		MaxPercentRecv: msg.MaxPercentRecv,
-		DurationHours:  msg.DurationHours,
+		MaxPercentSend:          msg.MaxPercentSend,
+		MaxPercentRecv:          msg.MaxPercentRecv,
+		DurationHours:           msg.DurationHours,
+		Mode:                    msg.Mode,
+		BucketDurationMinutes:   msg.BucketDurationMinutes,
+		MaxAmountSend:           msg.MaxAmountSend,
+		MaxAmountRecv:           msg.MaxAmountRecv,
+		MaxPercentSendPerSender: msg.MaxPercentSendPerSender,
+		Unit:                    msg.Unit,
+		CircuitBreakerThreshold: msg.CircuitBreakerThreshold,
 	}
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
@@ -134,9 +145,16 @@ func (k *Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		ChannelOrClientId: msg.ChannelOrClientId,
 	}
 	quota := types.Quota{
-		MaxPercentSend: msg.MaxPercentSend,
-		MaxPercentRecv: msg.MaxPercentRecv,
-		DurationHours:  msg.DurationHours,
+		MaxPercentSend:          msg.MaxPercentSend,
+		MaxPercentRecv:          msg.MaxPercentRecv,
+		DurationHours:           msg.DurationHours,
+		Mode:                    msg.Mode,
+		BucketDurationMinutes:   msg.BucketDurationMinutes,
+		MaxAmountSend:           msg.MaxAmountSend,
+		MaxAmountRecv:           msg.MaxAmountRecv,
+		MaxPercentSendPerSender: msg.MaxPercentSendPerSender,
+		Unit:                    msg.Unit,
+		CircuitBreakerThreshold: msg.CircuitBreakerThreshold,
 	}
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
@@ -149,6 +167,8 @@ func (k *Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		Quota: &quota,
 		Flow:  &flow,
 	})
+	k.RemoveRateLimitFlowBuckets(ctx, msg.Denom, msg.ChannelOrClientId)
+	k.RemoveAllSenderFlows(ctx, msg.Denom, msg.ChannelOrClientId)
 
 	return nil
 }
@@ -170,6 +190,8 @@ func (k *Keeper) ResetRateLimit(ctx sdk.Context, denom string, channelID string)
 	rateLimit.Flow = &flow
 
 	k.SetRateLimit(ctx, rateLimit)
+	k.RemoveRateLimitFlowBuckets(ctx, denom, channelID)
+	k.RemoveAllSenderFlows(ctx, denom, channelID)
 	k.RemoveAllChannelPendingSendPackets(ctx, channelID)
 	return nil
 }
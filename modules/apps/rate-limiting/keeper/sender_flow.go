@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// Stores/Updates the outflow of a sender on a rate limit
+func (k *Keeper) SetSenderFlow(ctx sdk.Context, senderFlow types.SenderFlow) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.SenderFlowKeyPrefix)
+
+	key := types.SenderFlowKey(senderFlow.Path.Denom, senderFlow.Path.ChannelOrClientId, senderFlow.Sender)
+	store.Set(key, k.cdc.MustMarshal(&senderFlow))
+}
+
+// Grabs and returns the outflow of a sender on a rate limit using denom, channel-id and sender
+// If the sender has not sent any packets during the current window, a zero outflow is returned
+func (k *Keeper) GetSenderFlow(ctx sdk.Context, denom string, channelID string, sender string) types.SenderFlow {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.SenderFlowKeyPrefix)
+
+	senderFlow := types.SenderFlow{
+		Path:    types.Path{Denom: denom, ChannelOrClientId: channelID},
+		Sender:  sender,
+		Outflow: sdkmath.ZeroInt(),
+	}
+
+	bz := store.Get(types.SenderFlowKey(denom, channelID, sender))
+	if len(bz) == 0 {
+		return senderFlow
+	}
+
+	k.cdc.MustUnmarshal(bz, &senderFlow)
+	return senderFlow
+}
+
+// Removes the outflows of all senders on a rate limit
+func (k *Keeper) RemoveAllSenderFlows(ctx sdk.Context, denom string, channelID string) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.SenderFlowKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SenderFlowPathPrefix(denom, channelID))
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// Returns the outflows of all senders on all rate limits
+func (k *Keeper) GetAllSenderFlows(ctx sdk.Context) []types.SenderFlow {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.SenderFlowKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allSenderFlows := []types.SenderFlow{}
+	for ; iterator.Valid(); iterator.Next() {
+		senderFlow := types.SenderFlow{}
+		k.cdc.MustUnmarshal(iterator.Value(), &senderFlow)
+		allSenderFlows = append(allSenderFlows, senderFlow)
+	}
+
+	return allSenderFlows
+}
+
+// Checks whether the packet will exceed the per sender outflow threshold of the rate limit,
+// and if it doesn't, adds the amount to the outflow of the sender
+func (k *Keeper) CheckSenderRateLimitAndUpdateFlow(ctx sdk.Context, packetInfo RateLimitedPacketInfo) error {
+	rateLimit, found := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+	if !found || !rateLimit.Quota.HasSenderThreshold() {
+		return nil
+	}
+
+	senderFlow := k.GetSenderFlow(ctx, packetInfo.Denom, packetInfo.ChannelID, packetInfo.Sender)
+	outflow := senderFlow.Outflow.Add(packetInfo.Amount)
+
+	if rateLimit.Quota.CheckExceedsSenderQuota(outflow, rateLimit.Flow.ChannelValue) {
+		err := errorsmod.Wrapf(types.ErrQuotaExceeded, "Sender outflow exceeds quota - Sender: %s, Outflow: %v, Channel Value: %v, Threshold: %v%%",
+			packetInfo.Sender, outflow, rateLimit.Flow.ChannelValue, rateLimit.Quota.MaxPercentSendPerSender)
+		EmitTransferDeniedEvent(ctx, types.EventSenderRateLimitExceeded, packetInfo.Denom, packetInfo.ChannelID, types.PACKET_SEND, packetInfo.Amount, err)
+		return err
+	}
+
+	senderFlow.Outflow = outflow
+	k.SetSenderFlow(ctx, senderFlow)
+	return nil
+}
+
+// If a SendPacket fails or times out, undo the outflow increment of the sender
+// The outflow never drops below zero, in case the sender flows were reset since the packet was sent
+func (k *Keeper) undoSenderOutflow(ctx sdk.Context, rateLimit types.RateLimit, sender string, amount sdkmath.Int) {
+	if !rateLimit.Quota.HasSenderThreshold() {
+		return
+	}
+
+	senderFlow := k.GetSenderFlow(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId, sender)
+	if senderFlow.Outflow.IsZero() {
+		return
+	}
+
+	senderFlow.Outflow = sdkmath.MaxInt(senderFlow.Outflow.Sub(amount), sdkmath.ZeroInt())
+	k.SetSenderFlow(ctx, senderFlow)
+}
@@ -6,6 +6,8 @@ var (
 	EventRateLimitExceeded = "rate_limit_exceeded"
 	EventBlacklistedDenom  = "blacklisted_denom"
 
+	EventSenderRateLimitExceeded = "sender_rate_limit_exceeded"
+
 	AttributeKeyReason          = "reason"
 	AttributeKeyModule          = "module"
 	AttributeKeyAction          = "action"
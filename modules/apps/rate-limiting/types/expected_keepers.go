@@ -21,6 +21,7 @@ type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetChannelClientState(ctx sdk.Context, portID, channelID string) (clientID string, clientState exported.ClientState, err error)
 	GetNextSequenceSend(ctx sdk.Context, sourcePort, sourceChannel string) (uint64, bool)
+	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
 }
 
 // ClientKeeper defines the expected IBC client keeper
@@ -28,3 +29,8 @@ type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
 }
+
+// CircuitBreakerKeeper defines the expected IBC circuit breaker keeper
+type CircuitBreakerKeeper interface {
+	ReportCircuitBreakerAnomaly(ctx sdk.Context, id string, epoch, threshold uint64)
+}
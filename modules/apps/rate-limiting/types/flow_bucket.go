@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// Initializes a new, empty flow bucket
+func NewFlowBucket(bucketNumber uint64) FlowBucket {
+	return FlowBucket{
+		BucketNumber: bucketNumber,
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+	}
+}
+
+// PruneBuckets removes the buckets that are no longer part of the sliding window ending with the current bucket
+func (fb *RateLimitFlowBuckets) PruneBuckets(quota Quota, currentBucketNumber uint64) {
+	buckets := make([]FlowBucket, 0, len(fb.Buckets))
+	for _, bucket := range fb.Buckets {
+		if quota.IsBucketInWindow(bucket.BucketNumber, currentBucketNumber) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	fb.Buckets = buckets
+}
+
+// TotalFlow returns the sum of the inflows and outflows of all buckets
+func (fb *RateLimitFlowBuckets) TotalFlow() (sdkmath.Int, sdkmath.Int) {
+	inflow, outflow := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, bucket := range fb.Buckets {
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+	return inflow, outflow
+}
+
+// GetBucket returns a pointer to the bucket with the given number, if it exists
+func (fb *RateLimitFlowBuckets) GetBucket(bucketNumber uint64) (*FlowBucket, bool) {
+	for i := range fb.Buckets {
+		if fb.Buckets[i].BucketNumber == bucketNumber {
+			return &fb.Buckets[i], true
+		}
+	}
+	return nil, false
+}
+
+// AddFlow adds an amount to the inflow or outflow of the given bucket, creating the bucket if necessary
+// Buckets are only ever created for the current block time, so they remain ordered from oldest to newest
+func (fb *RateLimitFlowBuckets) AddFlow(bucketNumber uint64, direction PacketDirection, amount sdkmath.Int) {
+	bucket, found := fb.GetBucket(bucketNumber)
+	if !found {
+		fb.Buckets = append(fb.Buckets, NewFlowBucket(bucketNumber))
+		bucket = &fb.Buckets[len(fb.Buckets)-1]
+	}
+
+	if direction == PACKET_SEND {
+		bucket.Outflow = bucket.Outflow.Add(amount)
+	} else {
+		bucket.Inflow = bucket.Inflow.Add(amount)
+	}
+}
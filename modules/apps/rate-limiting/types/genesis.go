@@ -35,6 +35,9 @@ func DefaultGenesis() *GenesisState {
 		WhitelistedAddressPairs:          []WhitelistedAddressPair{},
 		BlacklistedDenoms:                make([]string, 0),
 		PendingSendPacketSequenceNumbers: make([]string, 0),
+		FlowBuckets:                      []RateLimitFlowBuckets{},
+		PendingSendPacketBuckets:         []PendingSendPacketBucket{},
+		SenderFlows:                      []SenderFlow{},
 		HourEpoch: HourEpoch{
 			EpochNumber: 0,
 			Duration:    time.Hour,
@@ -51,6 +54,45 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, rateLimit := range gs.RateLimits {
+		if rateLimit.Quota == nil {
+			continue
+		}
+		if err := ValidateQuotaMode(rateLimit.Quota.Mode, rateLimit.Quota.DurationHours, rateLimit.Quota.BucketDurationMinutes); err != nil {
+			return err
+		}
+		if err := ValidateMaxAmounts(rateLimit.Quota.MaxAmountSend, rateLimit.Quota.MaxAmountRecv); err != nil {
+			return err
+		}
+		if err := ValidateMaxPercentSendPerSender(rateLimit.Quota.MaxPercentSendPerSender); err != nil {
+			return err
+		}
+		if rateLimit.Quota.Unit != TOKEN_AMOUNT {
+			if err := ValidateQuotaUnit(rateLimit.Quota.Unit, rateLimit.Quota.MaxPercentSend, rateLimit.Quota.MaxPercentRecv,
+				rateLimit.Quota.MaxAmountSend, rateLimit.Quota.MaxAmountRecv, rateLimit.Quota.MaxPercentSendPerSender); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, flowBuckets := range gs.FlowBuckets {
+		if flowBuckets.Path.Denom == "" || flowBuckets.Path.ChannelOrClientId == "" {
+			return errors.New("flow buckets must specify the denom and channel-id or client-id of the rate limit")
+		}
+	}
+
+	for _, senderFlow := range gs.SenderFlows {
+		if senderFlow.Path.Denom == "" || senderFlow.Path.ChannelOrClientId == "" || senderFlow.Sender == "" {
+			return errors.New("sender flow must specify the denom, channel-id or client-id, and sender")
+		}
+	}
+
+	for _, pendingPacketBucket := range gs.PendingSendPacketBuckets {
+		if pendingPacketBucket.ChannelOrClientId == "" {
+			return errors.New("pending send packet bucket must specify the channel-id or client-id")
+		}
+	}
+
 	// Verify the epoch hour duration is specified
 	if gs.HourEpoch.Duration == 0 {
 		return errors.New("hour epoch duration must be specified")
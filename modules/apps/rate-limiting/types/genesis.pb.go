@@ -25,11 +25,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ratelimit module's genesis state.
 type GenesisState struct {
-	RateLimits                       []RateLimit              `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
-	WhitelistedAddressPairs          []WhitelistedAddressPair `protobuf:"bytes,2,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs"`
-	BlacklistedDenoms                []string                 `protobuf:"bytes,3,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
-	PendingSendPacketSequenceNumbers []string                 `protobuf:"bytes,4,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
-	HourEpoch                        HourEpoch                `protobuf:"bytes,5,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch"`
+	RateLimits                       []RateLimit               `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	WhitelistedAddressPairs          []WhitelistedAddressPair  `protobuf:"bytes,2,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs"`
+	BlacklistedDenoms                []string                  `protobuf:"bytes,3,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
+	PendingSendPacketSequenceNumbers []string                  `protobuf:"bytes,4,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
+	HourEpoch                        HourEpoch                 `protobuf:"bytes,5,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch"`
+	FlowBuckets                      []RateLimitFlowBuckets    `protobuf:"bytes,6,rep,name=flow_buckets,json=flowBuckets,proto3" json:"flow_buckets"`
+	PendingSendPacketBuckets         []PendingSendPacketBucket `protobuf:"bytes,7,rep,name=pending_send_packet_buckets,json=pendingSendPacketBuckets,proto3" json:"pending_send_packet_buckets"`
+	SenderFlows                      []SenderFlow              `protobuf:"bytes,8,rep,name=sender_flows,json=senderFlows,proto3" json:"sender_flows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +103,27 @@ func (m *GenesisState) GetHourEpoch() HourEpoch {
 	return HourEpoch{}
 }
 
+func (m *GenesisState) GetFlowBuckets() []RateLimitFlowBuckets {
+	if m != nil {
+		return m.FlowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPacketBuckets() []PendingSendPacketBucket {
+	if m != nil {
+		return m.PendingSendPacketBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSenderFlows() []SenderFlow {
+	if m != nil {
+		return m.SenderFlows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.rate_limiting.v1.GenesisState")
 }
@@ -109,32 +133,38 @@ func init() {
 }
 
 var fileDescriptor_0f0dbc611075e553 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3d, 0x6f, 0xd4, 0x30,
-	0x18, 0x4e, 0x48, 0x41, 0xaa, 0xcb, 0x42, 0x84, 0x44, 0xe8, 0x10, 0x02, 0x62, 0xe8, 0xc0, 0xc5,
-	0x1c, 0x88, 0x81, 0x81, 0x01, 0x04, 0x82, 0x01, 0x55, 0xe5, 0x32, 0x54, 0x62, 0xb1, 0x1c, 0xfb,
-	0x55, 0x62, 0x35, 0xb1, 0x8d, 0x5f, 0xe7, 0x2a, 0xc4, 0x9f, 0xe0, 0xb7, 0xf0, 0x2b, 0x3a, 0x76,
-	0x64, 0x42, 0xe8, 0xee, 0x8f, 0xa0, 0x7c, 0xd0, 0x0f, 0xa9, 0xd2, 0xdd, 0x66, 0xbf, 0xcf, 0xd7,
-	0x6b, 0xeb, 0x21, 0x54, 0x95, 0x82, 0x72, 0x6b, 0x1b, 0x25, 0xb8, 0x57, 0x46, 0x23, 0x75, 0xdc,
-	0x03, 0x6b, 0x54, 0xab, 0xbc, 0xd2, 0x15, 0x5d, 0xce, 0x69, 0x05, 0x1a, 0x50, 0x61, 0x6e, 0x9d,
-	0xf1, 0x26, 0x7e, 0xac, 0x4a, 0x91, 0x5f, 0x15, 0xe4, 0xd7, 0x04, 0xf9, 0x72, 0xbe, 0x7f, 0xbf,
-	0x32, 0x95, 0x19, 0xd8, 0xb4, 0x3f, 0x8d, 0xc2, 0xfd, 0x57, 0x9b, 0x93, 0xae, 0x3b, 0x0d, 0xb2,
-	0x27, 0xbf, 0x22, 0x72, 0xf7, 0xe3, 0xb8, 0x41, 0xe1, 0xb9, 0x87, 0xb8, 0x20, 0x7b, 0x97, 0x3c,
-	0x4c, 0xc2, 0x2c, 0x3a, 0xd8, 0x7b, 0xf1, 0x2c, 0xdf, 0xb8, 0x56, 0xbe, 0xe0, 0x1e, 0x3e, 0xf7,
-	0xf7, 0x77, 0x3b, 0x67, 0x7f, 0x1e, 0x05, 0x0b, 0xe2, 0xfe, 0x0f, 0x30, 0xfe, 0x41, 0x1e, 0x9e,
-	0xd6, 0xca, 0x43, 0xa3, 0xd0, 0x83, 0x64, 0x5c, 0x4a, 0x07, 0x88, 0xcc, 0x72, 0xe5, 0x30, 0xb9,
-	0x35, 0x44, 0xbc, 0xde, 0x22, 0xe2, 0xf8, 0xd2, 0xe3, 0xed, 0x68, 0x71, 0xc4, 0x95, 0x9b, 0xf2,
-	0x1e, 0x9c, 0xde, 0x88, 0x62, 0x3c, 0x23, 0x71, 0xd9, 0x70, 0x71, 0x32, 0x85, 0x4b, 0xd0, 0xa6,
-	0xc5, 0x24, 0xca, 0xa2, 0x83, 0xdd, 0xc5, 0xbd, 0x2b, 0xc8, 0xfb, 0x01, 0x88, 0x0f, 0xc9, 0x53,
-	0x0b, 0x5a, 0x2a, 0x5d, 0x31, 0x04, 0x2d, 0x99, 0xe5, 0xe2, 0x04, 0x3c, 0x43, 0xf8, 0xd6, 0x81,
-	0x16, 0xc0, 0x74, 0xd7, 0x96, 0xe0, 0x30, 0xd9, 0x19, 0x0c, 0xb2, 0x89, 0x5b, 0x80, 0x96, 0x47,
-	0x03, 0xb3, 0x98, 0x88, 0x87, 0x23, 0x2f, 0xfe, 0x42, 0x48, 0x6d, 0x3a, 0xc7, 0xc0, 0x1a, 0x51,
-	0x27, 0xb7, 0xb3, 0x70, 0xcb, 0xff, 0xfc, 0x64, 0x3a, 0xf7, 0xa1, 0xd7, 0x4c, 0xef, 0xdb, 0xad,
-	0x2f, 0x06, 0xc7, 0x67, 0xab, 0x34, 0x3c, 0x5f, 0xa5, 0xe1, 0xdf, 0x55, 0x1a, 0xfe, 0x5c, 0xa7,
-	0xc1, 0xf9, 0x3a, 0x0d, 0x7e, 0xaf, 0xd3, 0xe0, 0xeb, 0x9b, 0x4a, 0xf9, 0xba, 0x2b, 0x73, 0x61,
-	0x5a, 0x2a, 0x0c, 0xb6, 0x06, 0xfb, 0x06, 0xce, 0x2a, 0x43, 0x97, 0xf3, 0xe7, 0xb4, 0x35, 0xb2,
-	0x6b, 0x00, 0xfb, 0x9a, 0x8c, 0xf5, 0x98, 0x5d, 0xd4, 0xc3, 0x7f, 0xb7, 0x80, 0xe5, 0x9d, 0xa1,
-	0x14, 0x2f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x74, 0x8b, 0x54, 0x89, 0xb7, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x13, 0xd2, 0x16, 0xba, 0xc9, 0x85, 0x15, 0x12, 0xa6, 0x48, 0x26, 0x20, 0x0e, 0x3d,
+	0x10, 0x9b, 0x80, 0x10, 0x02, 0x89, 0x03, 0x11, 0xff, 0x0e, 0xa8, 0x0a, 0xb1, 0x44, 0x25, 0x2e,
+	0xcb, 0xda, 0x9e, 0xda, 0xab, 0xda, 0xbb, 0x66, 0x67, 0x9d, 0x08, 0x71, 0xe0, 0x15, 0x78, 0xac,
+	0x1e, 0x7b, 0xe4, 0x84, 0x50, 0x72, 0xe6, 0x1d, 0x90, 0xd7, 0x4e, 0xd3, 0xa2, 0x48, 0x0d, 0x37,
+	0x7b, 0xe6, 0xfb, 0xe6, 0x37, 0x33, 0xab, 0x21, 0xbe, 0x08, 0x23, 0x9f, 0x17, 0x45, 0x26, 0x22,
+	0x6e, 0x84, 0x92, 0xe8, 0x6b, 0x6e, 0x80, 0x65, 0x22, 0x17, 0x46, 0xc8, 0xc4, 0x9f, 0x0e, 0xfd,
+	0x04, 0x24, 0xa0, 0x40, 0xaf, 0xd0, 0xca, 0x28, 0x7a, 0x57, 0x84, 0x91, 0x77, 0xde, 0xe0, 0x5d,
+	0x30, 0x78, 0xd3, 0xe1, 0xde, 0x8d, 0x44, 0x25, 0xca, 0xaa, 0xfd, 0xea, 0xab, 0x36, 0xee, 0x3d,
+	0xb9, 0x9c, 0x74, 0xb1, 0x92, 0xb5, 0xdd, 0xfb, 0xb3, 0x4d, 0x7a, 0x6f, 0xeb, 0x0e, 0x02, 0xc3,
+	0x0d, 0xd0, 0x80, 0x74, 0x57, 0x3a, 0x74, 0xda, 0xfd, 0xce, 0x7e, 0xf7, 0xd1, 0x03, 0xef, 0xd2,
+	0xb6, 0xbc, 0x09, 0x37, 0xf0, 0xbe, 0xfa, 0x1f, 0x6d, 0x9d, 0xfc, 0xba, 0xd3, 0x9a, 0x10, 0xbd,
+	0x0c, 0x20, 0xfd, 0x46, 0x6e, 0xcd, 0x52, 0x61, 0x20, 0x13, 0x68, 0x20, 0x66, 0x3c, 0x8e, 0x35,
+	0x20, 0xb2, 0x82, 0x0b, 0x8d, 0xce, 0x15, 0x8b, 0x78, 0xb6, 0x01, 0xe2, 0x70, 0x55, 0xe3, 0x65,
+	0x5d, 0x62, 0xcc, 0x85, 0x6e, 0x78, 0x37, 0x67, 0x6b, 0xb3, 0x48, 0x07, 0x84, 0x86, 0x19, 0x8f,
+	0x8e, 0x1b, 0x78, 0x0c, 0x52, 0xe5, 0xe8, 0x74, 0xfa, 0x9d, 0xfd, 0xdd, 0xc9, 0xf5, 0x73, 0x99,
+	0x57, 0x36, 0x41, 0x0f, 0xc8, 0xfd, 0x02, 0x64, 0x2c, 0x64, 0xc2, 0x10, 0x64, 0xcc, 0x0a, 0x1e,
+	0x1d, 0x83, 0x61, 0x08, 0x5f, 0x4a, 0x90, 0x11, 0x30, 0x59, 0xe6, 0x21, 0x68, 0x74, 0xb6, 0x6c,
+	0x81, 0x7e, 0xa3, 0x0d, 0x40, 0xc6, 0x63, 0xab, 0x0c, 0x1a, 0xe1, 0x41, 0xad, 0xa3, 0x1f, 0x08,
+	0x49, 0x55, 0xa9, 0x19, 0x14, 0x2a, 0x4a, 0x9d, 0xed, 0x7e, 0x7b, 0xc3, 0x7d, 0xbe, 0x53, 0xa5,
+	0x7e, 0x5d, 0x79, 0x9a, 0xf9, 0x76, 0xd3, 0x65, 0x80, 0x7e, 0x26, 0xbd, 0xa3, 0x4c, 0xcd, 0x58,
+	0x58, 0x56, 0x40, 0x74, 0x76, 0xec, 0x06, 0x9f, 0xfe, 0xcf, 0x23, 0xbd, 0xc9, 0xd4, 0x6c, 0x54,
+	0xdb, 0x9b, 0xfa, 0xdd, 0xa3, 0x55, 0x88, 0x7e, 0x27, 0xb7, 0xd7, 0x2d, 0x61, 0x09, 0xbc, 0x6a,
+	0x81, 0xcf, 0x37, 0x00, 0x8e, 0xff, 0x5d, 0x4f, 0x4d, 0x68, 0x98, 0x4e, 0xb1, 0x3e, 0x8d, 0xf4,
+	0x23, 0xe9, 0x55, 0x60, 0xd0, 0xac, 0x6a, 0x0b, 0x9d, 0x6b, 0x96, 0x38, 0xd8, 0x80, 0x18, 0x58,
+	0x9b, 0x9d, 0xaf, 0x19, 0x0c, 0xcf, 0x22, 0x38, 0x3a, 0x3c, 0x99, 0xbb, 0xed, 0xd3, 0xb9, 0xdb,
+	0xfe, 0x3d, 0x77, 0xdb, 0x3f, 0x16, 0x6e, 0xeb, 0x74, 0xe1, 0xb6, 0x7e, 0x2e, 0xdc, 0xd6, 0xa7,
+	0x17, 0x89, 0x30, 0x69, 0x19, 0x7a, 0x91, 0xca, 0xfd, 0x48, 0x61, 0xae, 0xb0, 0x3a, 0xde, 0x41,
+	0xa2, 0xfc, 0xe9, 0xf0, 0xa1, 0x9f, 0xab, 0xb8, 0xcc, 0x00, 0xab, 0x0b, 0xab, 0x2f, 0x6b, 0x70,
+	0x76, 0x59, 0xe6, 0x6b, 0x01, 0x18, 0xee, 0xd8, 0x7b, 0x7a, 0xfc, 0x77, 0x00, 0x72, 0xe2, 0xfe,
+	0x42, 0xf2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -157,6 +187,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SenderFlows) > 0 {
+		for iNdEx := len(m.SenderFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SenderFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingSendPacketBuckets) > 0 {
+		for iNdEx := len(m.PendingSendPacketBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPacketBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FlowBuckets) > 0 {
+		for iNdEx := len(m.FlowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.HourEpoch.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -259,6 +331,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.HourEpoch.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FlowBuckets) > 0 {
+		for _, e := range m.FlowBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPacketBuckets) > 0 {
+		for _, e := range m.PendingSendPacketBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SenderFlows) > 0 {
+		for _, e := range m.SenderFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -462,6 +552,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowBuckets = append(m.FlowBuckets, RateLimitFlowBuckets{})
+			if err := m.FlowBuckets[len(m.FlowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPacketBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPacketBuckets = append(m.PendingSendPacketBuckets, PendingSendPacketBucket{})
+			if err := m.PendingSendPacketBuckets[len(m.PendingSendPacketBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderFlows = append(m.SenderFlows, SenderFlow{})
+			if err := m.SenderFlows[len(m.SenderFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,6 +53,53 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expectedError: "unable to parse sequence number (X) from pending send packet",
 		},
+		{
+			name: "valid sliding window state",
+			genesisState: types.GenesisState{
+				RateLimits: []types.RateLimit{
+					{
+						Path:  &types.Path{Denom: "denom", ChannelOrClientId: "channel-0"},
+						Quota: &types.Quota{DurationHours: 1, Mode: types.SLIDING_WINDOW, BucketDurationMinutes: 15},
+					},
+				},
+				FlowBuckets: []types.RateLimitFlowBuckets{
+					{Path: types.Path{Denom: "denom", ChannelOrClientId: "channel-0"}},
+				},
+				PendingSendPacketBuckets: []types.PendingSendPacketBucket{
+					{ChannelOrClientId: "channel-0", Sequence: 1, BucketNumber: 1},
+				},
+				HourEpoch: types.HourEpoch{Duration: time.Minute},
+			},
+		},
+		{
+			name: "invalid sliding window - no bucket duration",
+			genesisState: types.GenesisState{
+				RateLimits: []types.RateLimit{
+					{
+						Path:  &types.Path{Denom: "denom", ChannelOrClientId: "channel-0"},
+						Quota: &types.Quota{DurationHours: 1, Mode: types.SLIDING_WINDOW},
+					},
+				},
+				HourEpoch: types.HourEpoch{Duration: time.Minute},
+			},
+			expectedError: "bucket duration can not be zero for sliding windows",
+		},
+		{
+			name: "invalid flow buckets - no path",
+			genesisState: types.GenesisState{
+				FlowBuckets: []types.RateLimitFlowBuckets{{}},
+				HourEpoch:   types.HourEpoch{Duration: time.Minute},
+			},
+			expectedError: "flow buckets must specify the denom and channel-id or client-id of the rate limit",
+		},
+		{
+			name: "invalid pending send packet bucket - no channel",
+			genesisState: types.GenesisState{
+				PendingSendPacketBuckets: []types.PendingSendPacketBucket{{Sequence: 1}},
+				HourEpoch:                types.HourEpoch{Duration: time.Minute},
+			},
+			expectedError: "pending send packet bucket must specify the channel-id or client-id",
+		},
 		{
 			name: "invalid hour epoch - no duration",
 			genesisState: types.GenesisState{
@@ -29,6 +29,8 @@ var (
 	// TODO: Fix IBCGO-2368
 	AddressWhitelistKeyPrefix = bytes("address-blacklist")
 	HourEpochKey              = bytes("hour-epoch")
+	FlowBucketsKeyPrefix      = bytes("flow-buckets")
+	SenderFlowKeyPrefix       = bytes("sender-flow")
 
 	PendingSendPacketChannelLength = 16
 )
@@ -55,3 +57,17 @@ func PendingSendPacketKey(channelID string, sequenceNumber uint64) []byte {
 func AddressWhitelistKey(sender, receiver string) []byte {
 	return append(bytes(sender), bytes(receiver)...)
 }
+
+// Get the key prefix of the sender flows of a rate limit, built from the denom and channelId
+// Both are length prefixed so that the prefix of one rate limit can never match another
+func SenderFlowPathPrefix(denom string, channelID string) []byte {
+	key := binary.BigEndian.AppendUint16(nil, uint16(len(denom))) //nolint:gosec
+	key = append(key, bytes(denom)...)
+	key = binary.BigEndian.AppendUint16(key, uint16(len(channelID))) //nolint:gosec
+	return append(key, bytes(channelID)...)
+}
+
+// Get the sender flow key from the denom, channelId and sender address
+func SenderFlowKey(denom string, channelID string, sender string) []byte {
+	return append(SenderFlowPathPrefix(denom, channelID), bytes(sender)...)
+}
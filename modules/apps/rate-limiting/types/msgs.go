@@ -62,16 +62,23 @@ func (msg *MsgAddRateLimit) ValidateBasic() error {
 			"max-percent-recv percent must be between 0 and 100 (inclusively), Provided: %v", msg.MaxPercentRecv)
 	}
 
-	if msg.MaxPercentRecv.IsZero() && msg.MaxPercentSend.IsZero() {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
-			"either the max send or max receive threshold must be greater than 0")
+	if err := ValidateQuotaUnit(msg.Unit, msg.MaxPercentSend, msg.MaxPercentRecv, msg.MaxAmountSend, msg.MaxAmountRecv, msg.MaxPercentSendPerSender); err != nil {
+		return err
 	}
 
 	if msg.DurationHours == 0 {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
-	return nil
+	if err := ValidateMaxAmounts(msg.MaxAmountSend, msg.MaxAmountRecv); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxPercentSendPerSender(msg.MaxPercentSendPerSender); err != nil {
+		return err
+	}
+
+	return ValidateQuotaMode(msg.Mode, msg.DurationHours, msg.BucketDurationMinutes)
 }
 
 // ----------------------------------------------
@@ -117,16 +124,23 @@ func (msg *MsgUpdateRateLimit) ValidateBasic() error {
 			"max-percent-recv percent must be between 0 and 100 (inclusively), Provided: %v", msg.MaxPercentRecv)
 	}
 
-	if msg.MaxPercentRecv.IsZero() && msg.MaxPercentSend.IsZero() {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
-			"either the max send or max receive threshold must be greater than 0")
+	if err := ValidateQuotaUnit(msg.Unit, msg.MaxPercentSend, msg.MaxPercentRecv, msg.MaxAmountSend, msg.MaxAmountRecv, msg.MaxPercentSendPerSender); err != nil {
+		return err
 	}
 
 	if msg.DurationHours == 0 {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
-	return nil
+	if err := ValidateMaxAmounts(msg.MaxAmountSend, msg.MaxAmountRecv); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxPercentSendPerSender(msg.MaxPercentSendPerSender); err != nil {
+		return err
+	}
+
+	return ValidateQuotaMode(msg.Mode, msg.DurationHours, msg.BucketDurationMinutes)
 }
 
 // ----------------------------------------------
@@ -144,6 +144,196 @@ func (s *MsgsTestSuite) TestMsgAddRateLimit() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid add msg with sliding window",
+			msg: &types.MsgAddRateLimit{
+				Signer:                s.authority,
+				Denom:                 "uatom",
+				ChannelOrClientId:     s.validChannelID,
+				MaxPercentSend:        sdkmath.NewInt(10),
+				MaxPercentRecv:        sdkmath.NewInt(10),
+				DurationHours:         24,
+				Mode:                  types.SLIDING_WINDOW,
+				BucketDurationMinutes: 60,
+			},
+			expPass: true,
+		},
+		{
+			name: "sliding window without bucket duration",
+			msg: &types.MsgAddRateLimit{
+				Signer:                s.authority,
+				Denom:                 "uatom",
+				ChannelOrClientId:     s.validChannelID,
+				MaxPercentSend:        sdkmath.NewInt(10),
+				MaxPercentRecv:        sdkmath.NewInt(10),
+				DurationHours:         24,
+				Mode:                  types.SLIDING_WINDOW,
+				BucketDurationMinutes: 0,
+			},
+			expPass: false,
+		},
+		{
+			name: "sliding window with bucket duration that does not divide the window",
+			msg: &types.MsgAddRateLimit{
+				Signer:                s.authority,
+				Denom:                 "uatom",
+				ChannelOrClientId:     s.validChannelID,
+				MaxPercentSend:        sdkmath.NewInt(10),
+				MaxPercentRecv:        sdkmath.NewInt(10),
+				DurationHours:         24,
+				Mode:                  types.SLIDING_WINDOW,
+				BucketDurationMinutes: 7,
+			},
+			expPass: false,
+		},
+		{
+			name: "sliding window with too many buckets",
+			msg: &types.MsgAddRateLimit{
+				Signer:                s.authority,
+				Denom:                 "uatom",
+				ChannelOrClientId:     s.validChannelID,
+				MaxPercentSend:        sdkmath.NewInt(10),
+				MaxPercentRecv:        sdkmath.NewInt(10),
+				DurationHours:         48,
+				Mode:                  types.SLIDING_WINDOW,
+				BucketDurationMinutes: 1,
+			},
+			expPass: false,
+		},
+		{
+			name: "fixed window with bucket duration",
+			msg: &types.MsgAddRateLimit{
+				Signer:                s.authority,
+				Denom:                 "uatom",
+				ChannelOrClientId:     s.validChannelID,
+				MaxPercentSend:        sdkmath.NewInt(10),
+				MaxPercentRecv:        sdkmath.NewInt(10),
+				DurationHours:         24,
+				Mode:                  types.FIXED_WINDOW,
+				BucketDurationMinutes: 60,
+			},
+			expPass: false,
+		},
+		{
+			name: "valid add msg with absolute thresholds",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             "uatom",
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.NewInt(10),
+				MaxPercentRecv:    sdkmath.NewInt(10),
+				DurationHours:     24,
+				MaxAmountSend:     sdkmath.NewInt(1000),
+				MaxAmountRecv:     sdkmath.ZeroInt(),
+			},
+			expPass: true,
+		},
+		{
+			name: "max amount send is negative",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             "uatom",
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.NewInt(10),
+				MaxPercentRecv:    sdkmath.NewInt(10),
+				DurationHours:     24,
+				MaxAmountSend:     sdkmath.NewInt(-1),
+			},
+			expPass: false,
+		},
+		{
+			name: "max amount recv is negative",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             "uatom",
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.NewInt(10),
+				MaxPercentRecv:    sdkmath.NewInt(10),
+				DurationHours:     24,
+				MaxAmountRecv:     sdkmath.NewInt(-1),
+			},
+			expPass: false,
+		},
+		{
+			name: "valid add msg with per sender threshold",
+			msg: &types.MsgAddRateLimit{
+				Signer:                  s.authority,
+				Denom:                   "uatom",
+				ChannelOrClientId:       s.validChannelID,
+				MaxPercentSend:          sdkmath.NewInt(10),
+				MaxPercentRecv:          sdkmath.NewInt(10),
+				DurationHours:           24,
+				MaxPercentSendPerSender: sdkmath.NewInt(1),
+			},
+			expPass: true,
+		},
+		{
+			name: "max percent send per sender > 100",
+			msg: &types.MsgAddRateLimit{
+				Signer:                  s.authority,
+				Denom:                   "uatom",
+				ChannelOrClientId:       s.validChannelID,
+				MaxPercentSend:          sdkmath.NewInt(10),
+				MaxPercentRecv:          sdkmath.NewInt(10),
+				DurationHours:           24,
+				MaxPercentSendPerSender: sdkmath.NewInt(101),
+			},
+			expPass: false,
+		},
+		{
+			name: "valid add msg with packet count unit",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             types.PacketCountDenom,
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.ZeroInt(),
+				MaxPercentRecv:    sdkmath.ZeroInt(),
+				DurationHours:     24,
+				MaxAmountSend:     sdkmath.NewInt(100),
+				Unit:              types.PACKET_COUNT,
+			},
+			expPass: true,
+		},
+		{
+			name: "packet count unit with percentage threshold",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             types.PacketCountDenom,
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.NewInt(10),
+				MaxPercentRecv:    sdkmath.ZeroInt(),
+				DurationHours:     24,
+				MaxAmountSend:     sdkmath.NewInt(100),
+				Unit:              types.PACKET_COUNT,
+			},
+			expPass: false,
+		},
+		{
+			name: "packet count unit without absolute threshold",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             types.PacketCountDenom,
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.ZeroInt(),
+				MaxPercentRecv:    sdkmath.ZeroInt(),
+				DurationHours:     24,
+				Unit:              types.PACKET_COUNT,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid quota unit",
+			msg: &types.MsgAddRateLimit{
+				Signer:            s.authority,
+				Denom:             "uatom",
+				ChannelOrClientId: s.validChannelID,
+				MaxPercentSend:    sdkmath.NewInt(10),
+				MaxPercentRecv:    sdkmath.NewInt(10),
+				DurationHours:     24,
+				Unit:              types.QuotaUnit(2),
+			},
+			expPass: false,
+		},
 		{
 			name: "duration is zero hours",
 			msg: &types.MsgAddRateLimit{
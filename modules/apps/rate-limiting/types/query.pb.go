@@ -397,6 +397,114 @@ func (m *QueryRateLimitsByChannelOrClientIDResponse) GetRateLimits() []RateLimit
 	return nil
 }
 
+// Queries the flow buckets of a sliding window rate limit by channel ID and denom
+type QueryRateLimitFlowBucketsRequest struct {
+	Denom             string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+}
+
+func (m *QueryRateLimitFlowBucketsRequest) Reset()         { *m = QueryRateLimitFlowBucketsRequest{} }
+func (m *QueryRateLimitFlowBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitFlowBucketsRequest) ProtoMessage()    {}
+func (*QueryRateLimitFlowBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f55a91bf266ae0f7, []int{8}
+}
+func (m *QueryRateLimitFlowBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitFlowBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitFlowBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitFlowBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitFlowBucketsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitFlowBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitFlowBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitFlowBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitFlowBucketsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitFlowBucketsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryRateLimitFlowBucketsRequest) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+// QueryRateLimitFlowBucketsResponse returns the flow buckets of the current window,
+// ordered from oldest to newest.
+type QueryRateLimitFlowBucketsResponse struct {
+	Buckets []FlowBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	// CurrentBucketNumber is the number of the bucket of the current block time
+	CurrentBucketNumber uint64 `protobuf:"varint,2,opt,name=current_bucket_number,json=currentBucketNumber,proto3" json:"current_bucket_number,omitempty"`
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) Reset()         { *m = QueryRateLimitFlowBucketsResponse{} }
+func (m *QueryRateLimitFlowBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitFlowBucketsResponse) ProtoMessage()    {}
+func (*QueryRateLimitFlowBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f55a91bf266ae0f7, []int{9}
+}
+func (m *QueryRateLimitFlowBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitFlowBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitFlowBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitFlowBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitFlowBucketsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitFlowBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitFlowBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitFlowBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitFlowBucketsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitFlowBucketsResponse) GetBuckets() []FlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) GetCurrentBucketNumber() uint64 {
+	if m != nil {
+		return m.CurrentBucketNumber
+	}
+	return 0
+}
+
 // Queries all blacklisted denoms
 type QueryAllBlacklistedDenomsRequest struct {
 }
@@ -405,7 +513,7 @@ func (m *QueryAllBlacklistedDenomsRequest) Reset()         { *m = QueryAllBlackl
 func (m *QueryAllBlacklistedDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsRequest) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f55a91bf266ae0f7, []int{8}
+	return fileDescriptor_f55a91bf266ae0f7, []int{10}
 }
 func (m *QueryAllBlacklistedDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +551,7 @@ func (m *QueryAllBlacklistedDenomsResponse) Reset()         { *m = QueryAllBlack
 func (m *QueryAllBlacklistedDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsResponse) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f55a91bf266ae0f7, []int{9}
+	return fileDescriptor_f55a91bf266ae0f7, []int{11}
 }
 func (m *QueryAllBlacklistedDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +595,7 @@ func (m *QueryAllWhitelistedAddressesRequest) Reset()         { *m = QueryAllWhi
 func (m *QueryAllWhitelistedAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesRequest) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f55a91bf266ae0f7, []int{10}
+	return fileDescriptor_f55a91bf266ae0f7, []int{12}
 }
 func (m *QueryAllWhitelistedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +633,7 @@ func (m *QueryAllWhitelistedAddressesResponse) Reset()         { *m = QueryAllWh
 func (m *QueryAllWhitelistedAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesResponse) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f55a91bf266ae0f7, []int{11}
+	return fileDescriptor_f55a91bf266ae0f7, []int{13}
 }
 func (m *QueryAllWhitelistedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +678,8 @@ func init() {
 	proto.RegisterType((*QueryRateLimitsByChainIDResponse)(nil), "ibc.applications.rate_limiting.v1.QueryRateLimitsByChainIDResponse")
 	proto.RegisterType((*QueryRateLimitsByChannelOrClientIDRequest)(nil), "ibc.applications.rate_limiting.v1.QueryRateLimitsByChannelOrClientIDRequest")
 	proto.RegisterType((*QueryRateLimitsByChannelOrClientIDResponse)(nil), "ibc.applications.rate_limiting.v1.QueryRateLimitsByChannelOrClientIDResponse")
+	proto.RegisterType((*QueryRateLimitFlowBucketsRequest)(nil), "ibc.applications.rate_limiting.v1.QueryRateLimitFlowBucketsRequest")
+	proto.RegisterType((*QueryRateLimitFlowBucketsResponse)(nil), "ibc.applications.rate_limiting.v1.QueryRateLimitFlowBucketsResponse")
 	proto.RegisterType((*QueryAllBlacklistedDenomsRequest)(nil), "ibc.applications.rate_limiting.v1.QueryAllBlacklistedDenomsRequest")
 	proto.RegisterType((*QueryAllBlacklistedDenomsResponse)(nil), "ibc.applications.rate_limiting.v1.QueryAllBlacklistedDenomsResponse")
 	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "ibc.applications.rate_limiting.v1.QueryAllWhitelistedAddressesRequest")
@@ -581,54 +691,60 @@ func init() {
 }
 
 var fileDescriptor_f55a91bf266ae0f7 = []byte{
-	// 742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0xf2, 0x7d, 0xf0, 0x7d, 0x7d, 0x91, 0x83, 0x63, 0x45, 0x58, 0xb5, 0xc0, 0x2a, 0x11,
-	0x8d, 0xed, 0x0a, 0xc6, 0x28, 0x0a, 0x31, 0xb4, 0x04, 0x25, 0x22, 0x62, 0x4d, 0x24, 0x31, 0xc6,
-	0x66, 0xba, 0x3b, 0x69, 0x27, 0x6e, 0x77, 0x96, 0x9d, 0x2d, 0xa4, 0x31, 0x1c, 0xf4, 0xcc, 0xc1,
-	0xc4, 0x9f, 0xe3, 0x1f, 0xe0, 0x48, 0xe2, 0x85, 0x93, 0x31, 0xc5, 0x93, 0x07, 0x7f, 0x83, 0xd9,
-	0xd9, 0xe9, 0x96, 0xc2, 0xb6, 0xb4, 0x45, 0x6e, 0xdd, 0x9d, 0xf7, 0x7d, 0xde, 0xe7, 0x79, 0xf6,
-	0x9d, 0x27, 0x85, 0x14, 0x2d, 0x18, 0x3a, 0x76, 0x1c, 0x8b, 0x1a, 0xd8, 0xa3, 0xcc, 0xe6, 0xba,
-	0x8b, 0x3d, 0x92, 0xb7, 0x68, 0x99, 0x7a, 0xd4, 0x2e, 0xea, 0x9b, 0xd3, 0xfa, 0x46, 0x85, 0xb8,
-	0xd5, 0xb4, 0xe3, 0x32, 0x8f, 0xa1, 0x09, 0x5a, 0x30, 0xd2, 0x87, 0xcb, 0xd3, 0x4d, 0xe5, 0xe9,
-	0xcd, 0x69, 0x35, 0x51, 0x64, 0x45, 0x26, 0xaa, 0x75, 0xff, 0x57, 0xd0, 0xa8, 0x5e, 0x29, 0x32,
-	0x56, 0xb4, 0x88, 0x8e, 0x1d, 0xaa, 0x63, 0xdb, 0x66, 0x9e, 0x6c, 0x0f, 0x4e, 0xef, 0x9d, 0xcc,
-	0xa2, 0x79, 0x8e, 0x68, 0xd3, 0x2e, 0xc3, 0xe8, 0x4b, 0x9f, 0xdc, 0x82, 0x65, 0xe5, 0xb0, 0x47,
-	0x56, 0xfc, 0x53, 0x9e, 0x23, 0x1b, 0x15, 0xc2, 0x3d, 0x6d, 0x03, 0xd4, 0xa8, 0x43, 0xee, 0x30,
-	0x9b, 0x13, 0xf4, 0x0a, 0x06, 0x1b, 0x88, 0x7c, 0x44, 0x19, 0xff, 0x67, 0x6a, 0x70, 0xe6, 0x76,
-	0xfa, 0x44, 0x79, 0xe9, 0x10, 0x2b, 0xf3, 0xef, 0xee, 0xf7, 0xb1, 0x58, 0x0e, 0xdc, 0x10, 0x5c,
-	0x7b, 0x07, 0x17, 0xc5, 0xc8, 0xb0, 0x46, 0x72, 0x41, 0x09, 0xe8, 0x37, 0x89, 0xcd, 0xca, 0x23,
-	0xca, 0xb8, 0x32, 0x15, 0xcf, 0x05, 0x0f, 0x48, 0x87, 0x84, 0x51, 0xc2, 0xb6, 0x4d, 0xac, 0x3c,
-	0x73, 0xf3, 0x86, 0x45, 0x89, 0xed, 0xe5, 0xa9, 0x39, 0xd2, 0x27, 0x8a, 0xce, 0xcb, 0xb3, 0x17,
-	0x6e, 0x56, 0x9c, 0x2c, 0x9b, 0x1a, 0x81, 0xe1, 0xa3, 0xf8, 0x52, 0xce, 0x33, 0x80, 0x06, 0x53,
-	0x31, 0xa5, 0x4b, 0x35, 0xb9, 0x78, 0xa8, 0x43, 0x9b, 0x83, 0xb1, 0xe6, 0x31, 0x3c, 0x53, 0xcd,
-	0x96, 0x30, 0xb5, 0x97, 0x17, 0xeb, 0x82, 0x46, 0xe1, 0x7f, 0xc3, 0x7f, 0xe3, 0xd3, 0x0d, 0x34,
-	0xfd, 0x27, 0x9e, 0x97, 0x4d, 0x6d, 0x0b, 0xc6, 0x5b, 0x77, 0x9f, 0xa5, 0xfb, 0x6f, 0xe1, 0x66,
-	0xd4, 0xe0, 0x26, 0x0f, 0x43, 0x01, 0xad, 0xbc, 0x57, 0x5a, 0x79, 0xff, 0x51, 0x81, 0x5b, 0x9d,
-	0xc0, 0x9f, 0xa5, 0x42, 0x4d, 0x5a, 0xbb, 0x60, 0x59, 0x19, 0x0b, 0x1b, 0xef, 0x2d, 0xca, 0x3d,
-	0x62, 0x2e, 0xfa, 0xcb, 0x14, 0xae, 0xfd, 0x23, 0x98, 0x68, 0x53, 0x23, 0xd9, 0x0d, 0xc3, 0x80,
-	0x58, 0xc1, 0x80, 0x58, 0x3c, 0x27, 0x9f, 0xb4, 0x49, 0xb8, 0x56, 0x6f, 0x5e, 0x2f, 0x51, 0x8f,
-	0x04, 0xcd, 0x0b, 0xa6, 0xe9, 0x12, 0xce, 0x49, 0x38, 0x63, 0x47, 0x81, 0xeb, 0xed, 0xeb, 0xe4,
-	0x1c, 0x13, 0x86, 0x70, 0xf0, 0x32, 0xef, 0x60, 0xea, 0xd6, 0x7d, 0x98, 0xed, 0xc0, 0x87, 0xe3,
-	0xb8, 0x6b, 0x98, 0xba, 0xd2, 0x94, 0x73, 0xb8, 0xf1, 0x8a, 0xcf, 0xfc, 0x02, 0xe8, 0x17, 0x74,
-	0xd0, 0x57, 0x05, 0x86, 0x9a, 0xee, 0x3b, 0x9a, 0xeb, 0x60, 0x54, 0xcb, 0x0c, 0x51, 0xe7, 0x7b,
-	0xec, 0x0e, 0xe4, 0x6b, 0xa9, 0x4f, 0xdf, 0x7e, 0x7e, 0xe9, 0xbb, 0x81, 0x26, 0x75, 0x99, 0x6f,
-	0x41, 0xae, 0xa5, 0x8e, 0xe6, 0x5a, 0xb0, 0x24, 0x68, 0x5f, 0x81, 0x78, 0x88, 0x82, 0x1e, 0x74,
-	0x3a, 0xfb, 0x68, 0xda, 0xa8, 0xb3, 0x3d, 0x74, 0x4a, 0xc6, 0xaf, 0x05, 0xe3, 0x35, 0xb4, 0xda,
-	0x09, 0xe3, 0x43, 0xbf, 0x3e, 0x44, 0x5d, 0xa6, 0x6d, 0xbd, 0x50, 0xcd, 0x07, 0x51, 0x57, 0x53,
-	0xe0, 0x42, 0x44, 0x20, 0xa0, 0x4c, 0xd7, 0x54, 0x8f, 0x65, 0x91, 0x9a, 0x3d, 0x15, 0x86, 0x14,
-	0x9e, 0x11, 0xc2, 0xe7, 0xd0, 0xc3, 0xee, 0x84, 0x73, 0xa1, 0x5c, 0xe4, 0xe0, 0x36, 0xda, 0xe9,
-	0x83, 0xab, 0x6d, 0xd3, 0x01, 0xad, 0xf4, 0x48, 0x35, 0x32, 0xc3, 0xd4, 0xe7, 0x7f, 0x09, 0x4d,
-	0x5a, 0xb0, 0x2a, 0x2c, 0x78, 0x8a, 0x96, 0x7a, 0xb1, 0xe0, 0xf8, 0xc7, 0xf7, 0xbf, 0x79, 0x22,
-	0x2a, 0x85, 0x50, 0xb6, 0x8b, 0x5b, 0xd5, 0x2a, 0xe7, 0xd4, 0xc5, 0xd3, 0x81, 0x48, 0xcd, 0x8f,
-	0x85, 0xe6, 0x59, 0x74, 0xbf, 0x23, 0xcd, 0x85, 0x06, 0x4e, 0xb0, 0xd7, 0x1c, 0xfd, 0x56, 0xe0,
-	0x52, 0x8b, 0x14, 0x44, 0x4b, 0x5d, 0x50, 0x6c, 0x13, 0xb7, 0xea, 0x93, 0x53, 0xe3, 0xf4, 0xb4,
-	0xe4, 0x5b, 0x0d, 0xa8, 0x3c, 0xae, 0x63, 0x65, 0xd6, 0x77, 0x6b, 0x49, 0x65, 0xaf, 0x96, 0x54,
-	0x7e, 0xd4, 0x92, 0xca, 0xe7, 0x83, 0x64, 0x6c, 0xef, 0x20, 0x19, 0xdb, 0x3f, 0x48, 0xc6, 0xde,
-	0xcc, 0x17, 0xa9, 0x57, 0xaa, 0x14, 0xd2, 0x06, 0x2b, 0xeb, 0x06, 0xe3, 0x65, 0xc6, 0xfd, 0x31,
-	0xa9, 0x22, 0xd3, 0x37, 0xa7, 0xef, 0xe8, 0x65, 0x66, 0x56, 0x2c, 0xc2, 0xa3, 0xa6, 0x7a, 0x55,
-	0x87, 0xf0, 0xc2, 0x80, 0xf8, 0x4f, 0x77, 0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x19, 0xfa,
-	0xfe, 0xd6, 0x92, 0x0a, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xce, 0x94, 0xfd, 0x20, 0xef, 0xee, 0x1e, 0x98, 0xcd, 0x2e, 0x5d, 0x03, 0xd9, 0xd6, 0xb0,
+	0xa2, 0x20, 0x12, 0xd3, 0x22, 0x04, 0x85, 0x56, 0xa8, 0x69, 0x54, 0xa8, 0x68, 0x4b, 0x31, 0x88,
+	0x4a, 0x15, 0xc2, 0xf2, 0xc7, 0x90, 0x8c, 0xea, 0x78, 0x52, 0x8f, 0xd3, 0x28, 0xaa, 0x7a, 0x80,
+	0x73, 0x0f, 0x48, 0xfc, 0x07, 0xfe, 0x04, 0x7f, 0xa0, 0xc7, 0x4a, 0x5c, 0x7a, 0x42, 0x28, 0xe5,
+	0xc4, 0x81, 0x7f, 0x80, 0x84, 0x3c, 0x9e, 0x38, 0x4d, 0xe2, 0xa4, 0x4e, 0xd2, 0xde, 0xe2, 0x79,
+	0xdf, 0xf7, 0x79, 0x9f, 0xe7, 0x49, 0xe6, 0x71, 0xa0, 0x40, 0x2d, 0x5b, 0x33, 0xeb, 0x75, 0x97,
+	0xda, 0x66, 0x40, 0x99, 0xc7, 0x35, 0xdf, 0x0c, 0x88, 0xe1, 0xd2, 0x1a, 0x0d, 0xa8, 0x57, 0xd1,
+	0x8e, 0x16, 0xb5, 0xc3, 0x06, 0xf1, 0x5b, 0xc5, 0xba, 0xcf, 0x02, 0x86, 0xe7, 0xa9, 0x65, 0x17,
+	0xaf, 0xb6, 0x17, 0x7b, 0xda, 0x8b, 0x47, 0x8b, 0x4a, 0xae, 0xc2, 0x2a, 0x4c, 0x74, 0x6b, 0xe1,
+	0xa7, 0x68, 0x50, 0x79, 0xbd, 0xc2, 0x58, 0xc5, 0x25, 0x9a, 0x59, 0xa7, 0x9a, 0xe9, 0x79, 0x2c,
+	0x90, 0xe3, 0x51, 0xf5, 0xc3, 0xeb, 0x59, 0xf4, 0xee, 0x11, 0x63, 0xea, 0x6b, 0xf0, 0xec, 0xeb,
+	0x90, 0xdc, 0x9a, 0xeb, 0xea, 0x66, 0x40, 0xb6, 0xc2, 0x2a, 0xd7, 0xc9, 0x61, 0x83, 0xf0, 0x40,
+	0x3d, 0x04, 0x25, 0xa9, 0xc8, 0xeb, 0xcc, 0xe3, 0x04, 0x7f, 0x03, 0x0f, 0xba, 0x88, 0x7c, 0x16,
+	0xcd, 0xbd, 0xb4, 0xf0, 0x60, 0xe9, 0xbd, 0xe2, 0xb5, 0xf2, 0x8a, 0x31, 0x56, 0xe9, 0xce, 0xd9,
+	0x9f, 0xcf, 0x33, 0x3a, 0xf8, 0x31, 0xb8, 0xfa, 0x03, 0x3c, 0x11, 0x2b, 0xe3, 0x1e, 0xc9, 0x05,
+	0xe7, 0xe0, 0xae, 0x43, 0x3c, 0x56, 0x9b, 0x45, 0x73, 0x68, 0x21, 0xab, 0x47, 0x0f, 0x58, 0x83,
+	0x9c, 0x5d, 0x35, 0x3d, 0x8f, 0xb8, 0x06, 0xf3, 0x0d, 0xdb, 0xa5, 0xc4, 0x0b, 0x0c, 0xea, 0xcc,
+	0xce, 0x88, 0xa6, 0x57, 0x64, 0xed, 0x2b, 0x7f, 0x5d, 0x54, 0x36, 0x1d, 0x95, 0xc0, 0xd3, 0x7e,
+	0x7c, 0x29, 0xe7, 0x4b, 0x80, 0x2e, 0x53, 0xb1, 0x65, 0x4c, 0x35, 0x7a, 0x36, 0xd6, 0xa1, 0xae,
+	0xc0, 0xf3, 0xde, 0x35, 0xbc, 0xd4, 0x5a, 0xaf, 0x9a, 0xd4, 0xdb, 0x2c, 0x77, 0x04, 0x3d, 0x83,
+	0x97, 0xed, 0xf0, 0x24, 0xa4, 0x1b, 0x69, 0xba, 0x2f, 0x9e, 0x37, 0x1d, 0xb5, 0x09, 0x73, 0xc3,
+	0xa7, 0x6f, 0xd3, 0xfd, 0xef, 0xe1, 0x9d, 0xa4, 0xc5, 0x3d, 0x1e, 0xc6, 0x02, 0x86, 0x79, 0x8f,
+	0x86, 0x79, 0xff, 0x13, 0x82, 0x77, 0xd3, 0xc0, 0xdf, 0xa6, 0x42, 0xda, 0x6f, 0xed, 0x86, 0xcb,
+	0x9a, 0xa5, 0x86, 0x7d, 0x40, 0x02, 0x7e, 0xc3, 0x3f, 0xb5, 0xdf, 0x10, 0xcc, 0x8f, 0xd8, 0x25,
+	0x55, 0x6e, 0xc3, 0x7d, 0x2b, 0x3a, 0x92, 0x0a, 0x0b, 0x29, 0x14, 0x76, 0x81, 0xa4, 0xc4, 0x0e,
+	0x06, 0x5e, 0x82, 0x27, 0x76, 0xc3, 0xf7, 0x43, 0x6e, 0xd1, 0x91, 0xe1, 0x35, 0x6a, 0x16, 0xf1,
+	0x05, 0xcd, 0x3b, 0xfa, 0x63, 0x59, 0x8c, 0x86, 0x77, 0x44, 0x49, 0x55, 0xa5, 0x27, 0x6b, 0xae,
+	0x5b, 0x72, 0x4d, 0xfb, 0xc0, 0xa5, 0x3c, 0x20, 0x4e, 0x39, 0x54, 0x1d, 0x47, 0xc1, 0xa7, 0x30,
+	0x3f, 0xa2, 0x47, 0x6a, 0x79, 0x0a, 0xf7, 0x84, 0x57, 0x91, 0x94, 0xac, 0x2e, 0x9f, 0xd4, 0x17,
+	0xf0, 0x66, 0x67, 0x78, 0xaf, 0x4a, 0x03, 0x12, 0x0d, 0xaf, 0x39, 0x8e, 0x4f, 0x38, 0x27, 0xf1,
+	0x8e, 0x53, 0x04, 0x6f, 0x8d, 0xee, 0x93, 0x7b, 0x1c, 0x78, 0x64, 0x46, 0x87, 0x46, 0xdd, 0xa4,
+	0x7e, 0xc7, 0xb9, 0xe5, 0x14, 0xce, 0x0d, 0xe2, 0xee, 0x9a, 0xd4, 0x97, 0x2e, 0x3e, 0x34, 0xbb,
+	0x47, 0x7c, 0xe9, 0x9f, 0x87, 0x70, 0x57, 0xd0, 0xc1, 0xbf, 0x23, 0x78, 0xd4, 0x93, 0x81, 0x78,
+	0x25, 0xc5, 0xaa, 0xa1, 0xb9, 0xaa, 0xac, 0x4e, 0x38, 0x1d, 0xc9, 0x57, 0x0b, 0x3f, 0xff, 0xf1,
+	0xf7, 0xaf, 0x33, 0x6f, 0xe3, 0x17, 0x9a, 0xcc, 0xfc, 0x28, 0xeb, 0x0b, 0xfd, 0x59, 0xef, 0x46,
+	0x5c, 0x2f, 0x10, 0x64, 0x63, 0x14, 0xfc, 0x71, 0xda, 0xdd, 0xfd, 0x09, 0xac, 0x2c, 0x4f, 0x30,
+	0x29, 0x19, 0x7f, 0x27, 0x18, 0xef, 0xe2, 0x9d, 0x34, 0x8c, 0xaf, 0x7c, 0x3a, 0x4e, 0xba, 0x71,
+	0x27, 0x9a, 0xd5, 0x32, 0xa2, 0x3b, 0xd9, 0x46, 0xf0, 0x38, 0x21, 0x24, 0x71, 0x69, 0x6c, 0xaa,
+	0x03, 0xf9, 0xac, 0xac, 0x4f, 0x85, 0x21, 0x85, 0x97, 0x84, 0xf0, 0x15, 0xfc, 0xc9, 0x78, 0xc2,
+	0xb9, 0x76, 0xdc, 0x79, 0x37, 0x9c, 0xe0, 0xd3, 0x19, 0x78, 0x63, 0x64, 0x62, 0xe2, 0xad, 0x09,
+	0xa9, 0x26, 0xe6, 0xba, 0xb2, 0x7d, 0x43, 0x68, 0xd2, 0x82, 0x1d, 0x61, 0xc1, 0x17, 0x78, 0x63,
+	0x12, 0x0b, 0x06, 0xbf, 0x7c, 0xfc, 0x1f, 0x82, 0x5c, 0x52, 0xa2, 0xe2, 0xf1, 0xbf, 0xb0, 0xc1,
+	0xec, 0x57, 0xca, 0xd3, 0x81, 0x48, 0xcd, 0xfb, 0x42, 0xf3, 0xb7, 0x58, 0xbf, 0x99, 0xdf, 0xfb,
+	0x8f, 0x2e, 0x6b, 0x1a, 0x9d, 0x84, 0x6f, 0x23, 0xc8, 0x25, 0xa5, 0x70, 0x7a, 0xfd, 0x23, 0x72,
+	0x5e, 0x29, 0x4f, 0x07, 0x22, 0xf5, 0x7f, 0x26, 0xf4, 0x2f, 0xe3, 0x8f, 0x52, 0xe9, 0xb7, 0xba,
+	0x38, 0xd1, 0xbd, 0xe6, 0xf8, 0x5f, 0x04, 0xaf, 0x0e, 0x79, 0x0b, 0xe0, 0x8d, 0x31, 0x28, 0x8e,
+	0x78, 0xdd, 0x28, 0x9f, 0x4f, 0x8d, 0x33, 0xd1, 0x25, 0x6f, 0x76, 0xa1, 0x0c, 0xb3, 0x83, 0x55,
+	0xda, 0x3b, 0x6b, 0xe7, 0xd1, 0x79, 0x3b, 0x8f, 0xfe, 0x6a, 0xe7, 0xd1, 0x2f, 0x97, 0xf9, 0xcc,
+	0xf9, 0x65, 0x3e, 0x73, 0x71, 0x99, 0xcf, 0xec, 0xaf, 0x56, 0x68, 0x50, 0x6d, 0x58, 0x45, 0x9b,
+	0xd5, 0x34, 0x9b, 0xf1, 0x1a, 0xe3, 0xe1, 0x9a, 0x42, 0x85, 0x69, 0x47, 0x8b, 0xef, 0x6b, 0x35,
+	0xe6, 0x34, 0x5c, 0xc2, 0x93, 0xb6, 0x06, 0xad, 0x3a, 0xe1, 0xd6, 0x3d, 0xf1, 0x3f, 0xff, 0x83,
+	0xff, 0x07, 0x00, 0x92, 0x28, 0xa4, 0x67, 0xa6, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -653,6 +769,8 @@ type QueryClient interface {
 	RateLimitsByChainID(ctx context.Context, in *QueryRateLimitsByChainIDRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIDResponse, error)
 	// Queries all the rate limits for a given channel ID
 	RateLimitsByChannelOrClientID(ctx context.Context, in *QueryRateLimitsByChannelOrClientIDRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelOrClientIDResponse, error)
+	// Queries the flow buckets of the current window of a sliding window rate limit
+	RateLimitFlowBuckets(ctx context.Context, in *QueryRateLimitFlowBucketsRequest, opts ...grpc.CallOption) (*QueryRateLimitFlowBucketsResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
 	// Queries all whitelisted address pairs
@@ -703,6 +821,15 @@ func (c *queryClient) RateLimitsByChannelOrClientID(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) RateLimitFlowBuckets(ctx context.Context, in *QueryRateLimitFlowBucketsRequest, opts ...grpc.CallOption) (*QueryRateLimitFlowBucketsResponse, error) {
+	out := new(QueryRateLimitFlowBucketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.rate_limiting.v1.Query/RateLimitFlowBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error) {
 	out := new(QueryAllBlacklistedDenomsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.rate_limiting.v1.Query/AllBlacklistedDenoms", in, out, opts...)
@@ -733,6 +860,8 @@ type QueryServer interface {
 	RateLimitsByChainID(context.Context, *QueryRateLimitsByChainIDRequest) (*QueryRateLimitsByChainIDResponse, error)
 	// Queries all the rate limits for a given channel ID
 	RateLimitsByChannelOrClientID(context.Context, *QueryRateLimitsByChannelOrClientIDRequest) (*QueryRateLimitsByChannelOrClientIDResponse, error)
+	// Queries the flow buckets of the current window of a sliding window rate limit
+	RateLimitFlowBuckets(context.Context, *QueryRateLimitFlowBucketsRequest) (*QueryRateLimitFlowBucketsResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
 	// Queries all whitelisted address pairs
@@ -755,6 +884,9 @@ func (*UnimplementedQueryServer) RateLimitsByChainID(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) RateLimitsByChannelOrClientID(ctx context.Context, req *QueryRateLimitsByChannelOrClientIDRequest) (*QueryRateLimitsByChannelOrClientIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChannelOrClientID not implemented")
 }
+func (*UnimplementedQueryServer) RateLimitFlowBuckets(ctx context.Context, req *QueryRateLimitFlowBucketsRequest) (*QueryRateLimitFlowBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitFlowBuckets not implemented")
+}
 func (*UnimplementedQueryServer) AllBlacklistedDenoms(ctx context.Context, req *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
 }
@@ -838,6 +970,24 @@ func _Query_RateLimitsByChannelOrClientID_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimitFlowBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitFlowBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitFlowBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.rate_limiting.v1.Query/RateLimitFlowBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitFlowBuckets(ctx, req.(*QueryRateLimitFlowBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllBlacklistedDenomsRequest)
 	if err := dec(in); err != nil {
@@ -895,6 +1045,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimitsByChannelOrClientID",
 			Handler:    _Query_RateLimitsByChannelOrClientID_Handler,
 		},
+		{
+			MethodName: "RateLimitFlowBuckets",
+			Handler:    _Query_RateLimitFlowBuckets_Handler,
+		},
 		{
 			MethodName: "AllBlacklistedDenoms",
 			Handler:    _Query_AllBlacklistedDenoms_Handler,
@@ -1174,6 +1328,85 @@ func (m *QueryRateLimitsByChannelOrClientIDResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRateLimitFlowBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitFlowBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitFlowBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentBucketNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentBucketNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1410,6 +1643,41 @@ func (m *QueryRateLimitsByChannelOrClientIDResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRateLimitFlowBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitFlowBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentBucketNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentBucketNumber))
+	}
+	return n
+}
+
 func (m *QueryAllBlacklistedDenomsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2130,6 +2398,223 @@ func (m *QueryRateLimitsByChannelOrClientIDResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *QueryRateLimitFlowBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitFlowBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitFlowBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitFlowBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitFlowBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitFlowBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, FlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBucketNumber", wireType)
+			}
+			m.CurrentBucketNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBucketNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllBlacklistedDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
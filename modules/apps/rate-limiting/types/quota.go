@@ -1,11 +1,28 @@
 package types
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // CheckExceedsQuota checks if new in/out flow is going to reach the max in/out or not
+// The quota is exceeded if either the absolute or the percentage threshold is exceeded
 func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount sdkmath.Int, totalValue sdkmath.Int) bool {
+	// The absolute threshold does not depend on the channel value
+	maxAmount := q.MaxAmount(direction)
+	if maxAmount.IsPositive() && amount.GT(maxAmount) {
+		return true
+	}
+
+	// Packet count quotas only have absolute thresholds
+	if q.IsPacketCount() {
+		return false
+	}
+
 	// If there's no channel value (this should be almost impossible), it means there is no
 	// supply of the asset, so we shouldn't prevent inflows/outflows
 	if totalValue.IsZero() {
@@ -21,3 +38,147 @@ func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount sdkmath.Int,
 	// Revert to GT check as in the original reference module
 	return amount.GT(threshold)
 }
+
+// PacketCountDenom is the denom used by the PacketCountInfoExtractor to rate limit all packets on a channel
+const PacketCountDenom = "packets"
+
+// IsPacketCount returns true if the quota limits the number of packets instead of the amount of tokens
+func (q *Quota) IsPacketCount() bool {
+	return q != nil && q.Unit == PACKET_COUNT
+}
+
+// ValidateQuotaUnit checks that the thresholds are valid for the given quota unit
+// Token amount quotas must have a percentage threshold, while packet count quotas only
+// support the absolute thresholds
+func ValidateQuotaUnit(unit QuotaUnit, maxPercentSend, maxPercentRecv, maxAmountSend, maxAmountRecv, maxPercentSendPerSender sdkmath.Int) error {
+	isZero := func(i sdkmath.Int) bool { return i.IsNil() || i.IsZero() }
+
+	switch unit {
+	case TOKEN_AMOUNT:
+		if isZero(maxPercentRecv) && isZero(maxPercentSend) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"either the max send or max receive threshold must be greater than 0")
+		}
+	case PACKET_COUNT:
+		if !isZero(maxPercentSend) || !isZero(maxPercentRecv) || !isZero(maxPercentSendPerSender) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"packet count quotas only support the max-amount-send and max-amount-recv thresholds")
+		}
+		if isZero(maxAmountSend) && isZero(maxAmountRecv) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"either the max amount send or max amount receive threshold must be greater than 0")
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid quota unit (%d)", unit)
+	}
+
+	return nil
+}
+
+// MaxAmount returns the absolute threshold for the given direction
+// Zero indicates that there is no absolute threshold
+func (q *Quota) MaxAmount(direction PacketDirection) sdkmath.Int {
+	maxAmount := q.MaxAmountSend
+	if direction == PACKET_RECV {
+		maxAmount = q.MaxAmountRecv
+	}
+	// Quotas stored before the absolute thresholds were added have no amount set
+	if maxAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return maxAmount
+}
+
+// HasSenderThreshold returns true if the outflow of each sender is limited
+func (q *Quota) HasSenderThreshold() bool {
+	return q != nil && !q.MaxPercentSendPerSender.IsNil() && q.MaxPercentSendPerSender.IsPositive()
+}
+
+// CheckExceedsSenderQuota checks if the new outflow of a sender is going to reach the max per sender outflow
+func (q *Quota) CheckExceedsSenderQuota(amount sdkmath.Int, totalValue sdkmath.Int) bool {
+	if !q.HasSenderThreshold() || totalValue.IsZero() {
+		return false
+	}
+	threshold := totalValue.Mul(q.MaxPercentSendPerSender).Quo(sdkmath.NewInt(100))
+	return amount.GT(threshold)
+}
+
+// ValidateMaxPercentSendPerSender checks that the per sender threshold is a valid percentage
+func ValidateMaxPercentSendPerSender(maxPercentSendPerSender sdkmath.Int) error {
+	if maxPercentSendPerSender.IsNil() {
+		return nil
+	}
+	if maxPercentSendPerSender.GT(sdkmath.NewInt(100)) || maxPercentSendPerSender.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"max-percent-send-per-sender percent must be between 0 and 100 (inclusively), Provided: %v", maxPercentSendPerSender)
+	}
+	return nil
+}
+
+// ValidateMaxAmounts checks that the absolute thresholds are not negative
+func ValidateMaxAmounts(maxAmountSend, maxAmountRecv sdkmath.Int) error {
+	if !maxAmountSend.IsNil() && maxAmountSend.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-amount-send can not be negative, Provided: %v", maxAmountSend)
+	}
+	if !maxAmountRecv.IsNil() && maxAmountRecv.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-amount-recv can not be negative, Provided: %v", maxAmountRecv)
+	}
+	return nil
+}
+
+// MaxFlowBuckets is the maximum number of buckets a sliding window can be divided into
+const MaxFlowBuckets = 1440
+
+// IsSlidingWindow returns true if the quota is enforced over a sliding window
+func (q *Quota) IsSlidingWindow() bool {
+	return q != nil && q.Mode == SLIDING_WINDOW
+}
+
+// BucketDuration returns the duration of a single bucket of a sliding window
+func (q *Quota) BucketDuration() time.Duration {
+	return time.Duration(q.BucketDurationMinutes) * time.Minute
+}
+
+// WindowBuckets returns the number of buckets that make up a sliding window
+func (q *Quota) WindowBuckets() uint64 {
+	if q.BucketDurationMinutes == 0 {
+		return 0
+	}
+	return q.DurationHours * 60 / q.BucketDurationMinutes
+}
+
+// BucketNumber returns the number of the sliding window bucket the given time falls in
+func (q *Quota) BucketNumber(blockTime time.Time) uint64 {
+	return uint64(blockTime.Unix()) / uint64(q.BucketDuration().Seconds()) //nolint:gosec
+}
+
+// IsBucketInWindow returns true if the bucket is part of the sliding window ending with the current bucket
+func (q *Quota) IsBucketInWindow(bucketNumber, currentBucketNumber uint64) bool {
+	return bucketNumber <= currentBucketNumber && currentBucketNumber-bucketNumber < q.WindowBuckets()
+}
+
+// ValidateQuotaMode checks that the bucket duration is valid for the given quota mode and duration
+func ValidateQuotaMode(mode QuotaMode, durationHours, bucketDurationMinutes uint64) error {
+	switch mode {
+	case FIXED_WINDOW:
+		if bucketDurationMinutes != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bucket duration must be zero for fixed windows, Provided: %d", bucketDurationMinutes)
+		}
+	case SLIDING_WINDOW:
+		if bucketDurationMinutes == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bucket duration can not be zero for sliding windows")
+		}
+		if (durationHours*60)%bucketDurationMinutes != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"bucket duration (%d minutes) must evenly divide the window (%d hours)", bucketDurationMinutes, durationHours)
+		}
+		if durationHours*60/bucketDurationMinutes > MaxFlowBuckets {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"sliding window can not be divided into more than %d buckets, Provided: %d", MaxFlowBuckets, durationHours*60/bucketDurationMinutes)
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid quota mode (%d)", mode)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -78,3 +79,134 @@ func TestCheckExceedsQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckExceedsQuota_MaxAmount(t *testing.T) {
+	totalValue := sdkmath.NewInt(1000)
+	quota := types.Quota{
+		MaxPercentRecv: sdkmath.NewInt(10),
+		MaxPercentSend: sdkmath.NewInt(10),
+		DurationHours:  uint64(1),
+		MaxAmountSend:  sdkmath.NewInt(50),
+		MaxAmountRecv:  sdkmath.ZeroInt(),
+	}
+
+	tests := []struct {
+		name       string
+		direction  types.PacketDirection
+		amount     sdkmath.Int
+		totalValue sdkmath.Int
+		exceeded   bool
+	}{
+		{
+			name:       "outflow under both thresholds",
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(50),
+			totalValue: totalValue,
+			exceeded:   false,
+		},
+		{
+			name:       "outflow exceeded absolute threshold before percentage threshold",
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(51),
+			totalValue: totalValue,
+			exceeded:   true,
+		},
+		{
+			name:       "outflow exceeded percentage threshold before absolute threshold",
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(11),
+			totalValue: sdkmath.NewInt(100),
+			exceeded:   true,
+		},
+		{
+			name:       "outflow exceeded absolute threshold with zero channel value",
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(51),
+			totalValue: sdkmath.ZeroInt(),
+			exceeded:   true,
+		},
+		{
+			name:       "inflow without absolute threshold",
+			direction:  types.PACKET_RECV,
+			amount:     sdkmath.NewInt(100),
+			totalValue: totalValue,
+			exceeded:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := quota.CheckExceedsQuota(test.direction, test.amount, test.totalValue)
+			require.Equal(t, test.exceeded, res, "test: %s", test.name)
+		})
+	}
+
+	// Quotas without absolute thresholds set should only use the percentage threshold
+	quota = types.Quota{MaxPercentSend: sdkmath.NewInt(10)}
+	require.True(t, quota.MaxAmount(types.PACKET_SEND).IsZero())
+	require.False(t, quota.CheckExceedsQuota(types.PACKET_SEND, sdkmath.NewInt(100), totalValue))
+}
+
+func TestCheckExceedsQuota_PacketCount(t *testing.T) {
+	quota := types.Quota{
+		MaxPercentSend: sdkmath.ZeroInt(),
+		MaxPercentRecv: sdkmath.ZeroInt(),
+		DurationHours:  uint64(1),
+		MaxAmountSend:  sdkmath.NewInt(2),
+		MaxAmountRecv:  sdkmath.ZeroInt(),
+		Unit:           types.PACKET_COUNT,
+	}
+	require.True(t, quota.IsPacketCount())
+
+	// Packet count quotas only use the absolute threshold, and don't require a channel value
+	require.False(t, quota.CheckExceedsQuota(types.PACKET_SEND, sdkmath.NewInt(2), sdkmath.ZeroInt()))
+	require.True(t, quota.CheckExceedsQuota(types.PACKET_SEND, sdkmath.NewInt(3), sdkmath.ZeroInt()))
+	require.False(t, quota.CheckExceedsQuota(types.PACKET_RECV, sdkmath.NewInt(100), sdkmath.ZeroInt()))
+}
+
+func TestCheckExceedsSenderQuota(t *testing.T) {
+	quota := types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentSendPerSender: sdkmath.NewInt(2)}
+	require.True(t, quota.HasSenderThreshold())
+	require.False(t, quota.CheckExceedsSenderQuota(sdkmath.NewInt(2), sdkmath.NewInt(100)))
+	require.True(t, quota.CheckExceedsSenderQuota(sdkmath.NewInt(3), sdkmath.NewInt(100)))
+	require.False(t, quota.CheckExceedsSenderQuota(sdkmath.NewInt(3), sdkmath.ZeroInt()))
+
+	// Without a per sender threshold, the sender quota is never exceeded
+	quota = types.Quota{MaxPercentSend: sdkmath.NewInt(10)}
+	require.False(t, quota.HasSenderThreshold())
+	require.False(t, quota.CheckExceedsSenderQuota(sdkmath.NewInt(100), sdkmath.NewInt(100)))
+}
+
+func TestSlidingWindowBuckets(t *testing.T) {
+	quota := types.Quota{
+		DurationHours:         2,
+		Mode:                  types.SLIDING_WINDOW,
+		BucketDurationMinutes: 30,
+	}
+	require.True(t, quota.IsSlidingWindow())
+	require.Equal(t, uint64(4), quota.WindowBuckets())
+
+	windowStart := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentBucketNumber := quota.BucketNumber(windowStart)
+	require.Equal(t, currentBucketNumber, quota.BucketNumber(windowStart.Add(29*time.Minute)))
+	require.Equal(t, currentBucketNumber+1, quota.BucketNumber(windowStart.Add(30*time.Minute)))
+
+	require.True(t, quota.IsBucketInWindow(currentBucketNumber, currentBucketNumber))
+	require.True(t, quota.IsBucketInWindow(currentBucketNumber-3, currentBucketNumber))
+	require.False(t, quota.IsBucketInWindow(currentBucketNumber-4, currentBucketNumber))
+	require.False(t, quota.IsBucketInWindow(currentBucketNumber+1, currentBucketNumber))
+
+	flowBuckets := types.RateLimitFlowBuckets{}
+	flowBuckets.AddFlow(currentBucketNumber-4, types.PACKET_SEND, sdkmath.NewInt(1))
+	flowBuckets.AddFlow(currentBucketNumber-1, types.PACKET_SEND, sdkmath.NewInt(2))
+	flowBuckets.AddFlow(currentBucketNumber, types.PACKET_RECV, sdkmath.NewInt(3))
+	flowBuckets.AddFlow(currentBucketNumber, types.PACKET_SEND, sdkmath.NewInt(4))
+	require.Len(t, flowBuckets.Buckets, 3)
+
+	flowBuckets.PruneBuckets(quota, currentBucketNumber)
+	require.Len(t, flowBuckets.Buckets, 2)
+
+	inflow, outflow := flowBuckets.TotalFlow()
+	require.Equal(t, int64(3), inflow.Int64())
+	require.Equal(t, int64(6), outflow.Int64())
+}
@@ -56,6 +56,64 @@ func (PacketDirection) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bf22d2adece00654, []int{0}
 }
 
+// QuotaMode defines how the flow of a rate limit is tracked against its quota
+type QuotaMode int32
+
+const (
+	// FIXED_WINDOW resets the flow at the end of every window of duration_hours
+	FIXED_WINDOW QuotaMode = 0
+	// SLIDING_WINDOW tracks the flow in buckets of bucket_duration_minutes and enforces the quota
+	// over the buckets of the last duration_hours
+	SLIDING_WINDOW QuotaMode = 1
+)
+
+var QuotaMode_name = map[int32]string{
+	0: "FIXED_WINDOW",
+	1: "SLIDING_WINDOW",
+}
+
+var QuotaMode_value = map[string]int32{
+	"FIXED_WINDOW":   0,
+	"SLIDING_WINDOW": 1,
+}
+
+func (x QuotaMode) String() string {
+	return proto.EnumName(QuotaMode_name, int32(x))
+}
+
+func (QuotaMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{1}
+}
+
+// QuotaUnit defines what the flow of a rate limit measures
+type QuotaUnit int32
+
+const (
+	// TOKEN_AMOUNT measures the amount of tokens of the denom that are transferred
+	TOKEN_AMOUNT QuotaUnit = 0
+	// PACKET_COUNT measures the number of packets that are sent and received, for applications
+	// without a fungible value. The thresholds are set with max_amount_send and max_amount_recv
+	PACKET_COUNT QuotaUnit = 1
+)
+
+var QuotaUnit_name = map[int32]string{
+	0: "TOKEN_AMOUNT",
+	1: "PACKET_COUNT",
+}
+
+var QuotaUnit_value = map[string]int32{
+	"TOKEN_AMOUNT": 0,
+	"PACKET_COUNT": 1,
+}
+
+func (x QuotaUnit) String() string {
+	return proto.EnumName(QuotaUnit_name, int32(x))
+}
+
+func (QuotaUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{2}
+}
+
 // Path holds the denom and channelID that define the rate limited route
 type Path struct {
 	Denom             string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -120,6 +178,30 @@ type Quota struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,3,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// Mode specifies whether the quota is enforced over fixed or sliding windows
+	Mode QuotaMode `protobuf:"varint,4,opt,name=mode,proto3,enum=ibc.applications.rate_limiting.v1.QuotaMode" json:"mode,omitempty"`
+	// BucketDurationMinutes specifies the duration of the buckets a sliding window
+	// is divided into. It must evenly divide the window and must be zero for fixed windows
+	BucketDurationMinutes uint64 `protobuf:"varint,5,opt,name=bucket_duration_minutes,json=bucketDurationMinutes,proto3" json:"bucket_duration_minutes,omitempty"`
+	// MaxAmountSend defines an absolute threshold for outflows, in base units of the denom
+	// The quota is exceeded once either the percentage or the absolute threshold is reached
+	// Zero indicates that there is no absolute threshold
+	MaxAmountSend cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_send"`
+	// MaxAmountRecv defines an absolute threshold for inflows, in base units of the denom
+	// The quota is exceeded once either the percentage or the absolute threshold is reached
+	// Zero indicates that there is no absolute threshold
+	MaxAmountRecv cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_recv"`
+	// MaxPercentSendPerSender defines the threshold for the outflows of a single sender
+	// The threshold is defined as a percentage of the channel value (e.g. 1 indicates 1%)
+	// The outflow of each sender is reset at the end of every window of duration_hours
+	// Zero indicates that there is no per sender threshold
+	MaxPercentSendPerSender cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=max_percent_send_per_sender,json=maxPercentSendPerSender,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send_per_sender"`
+	// Unit specifies whether the flow measures token amounts or the number of packets
+	Unit QuotaUnit `protobuf:"varint,9,opt,name=unit,proto3,enum=ibc.applications.rate_limiting.v1.QuotaUnit" json:"unit,omitempty"`
+	// CircuitBreakerThreshold defines the number of times the receive quota can be exceeded
+	// within a window of duration_hours before the IBC circuit breaker of the channel or client is tripped
+	// Zero indicates that the circuit breaker is never tripped
+	CircuitBreakerThreshold uint64 `protobuf:"varint,10,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3" json:"circuit_breaker_threshold,omitempty"`
 }
 
 func (m *Quota) Reset()         { *m = Quota{} }
@@ -162,6 +244,34 @@ func (m *Quota) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *Quota) GetMode() QuotaMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FIXED_WINDOW
+}
+
+func (m *Quota) GetBucketDurationMinutes() uint64 {
+	if m != nil {
+		return m.BucketDurationMinutes
+	}
+	return 0
+}
+
+func (m *Quota) GetUnit() QuotaUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return TOKEN_AMOUNT
+}
+
+func (m *Quota) GetCircuitBreakerThreshold() uint64 {
+	if m != nil {
+		return m.CircuitBreakerThreshold
+	}
+	return 0
+}
+
 // Flow tracks all the inflows and outflows of a channel.
 type Flow struct {
 	// Inflow defines the total amount of inbound transfers for the given
@@ -210,6 +320,228 @@ func (m *Flow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flow proto.InternalMessageInfo
 
+// FlowBucket tracks the inflows and outflows of a sliding window rate limit
+// during a single bucket of the window
+type FlowBucket struct {
+	// BucketNumber identifies the bucket. It is the block time (in unix seconds)
+	// divided by the bucket duration
+	BucketNumber uint64 `protobuf:"varint,1,opt,name=bucket_number,json=bucketNumber,proto3" json:"bucket_number,omitempty"`
+	// Inflow defines the total amount of inbound transfers during the bucket
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// Outflow defines the total amount of outbound transfers during the bucket
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+}
+
+func (m *FlowBucket) Reset()         { *m = FlowBucket{} }
+func (m *FlowBucket) String() string { return proto.CompactTextString(m) }
+func (*FlowBucket) ProtoMessage()    {}
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{3}
+}
+func (m *FlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBucket.Merge(m, src)
+}
+func (m *FlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBucket proto.InternalMessageInfo
+
+func (m *FlowBucket) GetBucketNumber() uint64 {
+	if m != nil {
+		return m.BucketNumber
+	}
+	return 0
+}
+
+// RateLimitFlowBuckets stores the buckets of the current window of a sliding window rate limit
+type RateLimitFlowBuckets struct {
+	Path    Path         `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Buckets []FlowBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *RateLimitFlowBuckets) Reset()         { *m = RateLimitFlowBuckets{} }
+func (m *RateLimitFlowBuckets) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlowBuckets) ProtoMessage()    {}
+func (*RateLimitFlowBuckets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{4}
+}
+func (m *RateLimitFlowBuckets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlowBuckets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlowBuckets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlowBuckets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlowBuckets.Merge(m, src)
+}
+func (m *RateLimitFlowBuckets) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlowBuckets) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlowBuckets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlowBuckets proto.InternalMessageInfo
+
+func (m *RateLimitFlowBuckets) GetPath() Path {
+	if m != nil {
+		return m.Path
+	}
+	return Path{}
+}
+
+func (m *RateLimitFlowBuckets) GetBuckets() []FlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// PendingSendPacketBucket stores the bucket a pending send packet of a sliding window
+// rate limit was sent in, so that the outflow can be reverted if the transfer fails
+type PendingSendPacketBucket struct {
+	ChannelOrClientId string `protobuf:"bytes,1,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	Sequence          uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	BucketNumber      uint64 `protobuf:"varint,3,opt,name=bucket_number,json=bucketNumber,proto3" json:"bucket_number,omitempty"`
+}
+
+func (m *PendingSendPacketBucket) Reset()         { *m = PendingSendPacketBucket{} }
+func (m *PendingSendPacketBucket) String() string { return proto.CompactTextString(m) }
+func (*PendingSendPacketBucket) ProtoMessage()    {}
+func (*PendingSendPacketBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{5}
+}
+func (m *PendingSendPacketBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendPacketBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendPacketBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSendPacketBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendPacketBucket.Merge(m, src)
+}
+func (m *PendingSendPacketBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendPacketBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendPacketBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendPacketBucket proto.InternalMessageInfo
+
+func (m *PendingSendPacketBucket) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *PendingSendPacketBucket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingSendPacketBucket) GetBucketNumber() uint64 {
+	if m != nil {
+		return m.BucketNumber
+	}
+	return 0
+}
+
+// SenderFlow tracks the outflow of a single sender on a rate limit with a per sender threshold
+type SenderFlow struct {
+	Path   Path   `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Outflow defines the total amount of outbound transfers of the sender in the current window
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+}
+
+func (m *SenderFlow) Reset()         { *m = SenderFlow{} }
+func (m *SenderFlow) String() string { return proto.CompactTextString(m) }
+func (*SenderFlow) ProtoMessage()    {}
+func (*SenderFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf22d2adece00654, []int{6}
+}
+func (m *SenderFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderFlow.Merge(m, src)
+}
+func (m *SenderFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderFlow proto.InternalMessageInfo
+
+func (m *SenderFlow) GetPath() Path {
+	if m != nil {
+		return m.Path
+	}
+	return Path{}
+}
+
+func (m *SenderFlow) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // RateLimit stores all the context about a given rate limit, including
 // the relevant denom and channel, rate limit thresholds, and current
 // progress towards the limits
@@ -223,7 +555,7 @@ func (m *RateLimit) Reset()         { *m = RateLimit{} }
 func (m *RateLimit) String() string { return proto.CompactTextString(m) }
 func (*RateLimit) ProtoMessage()    {}
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf22d2adece00654, []int{3}
+	return fileDescriptor_bf22d2adece00654, []int{7}
 }
 func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +616,7 @@ func (m *WhitelistedAddressPair) Reset()         { *m = WhitelistedAddressPair{}
 func (m *WhitelistedAddressPair) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedAddressPair) ProtoMessage()    {}
 func (*WhitelistedAddressPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf22d2adece00654, []int{4}
+	return fileDescriptor_bf22d2adece00654, []int{8}
 }
 func (m *WhitelistedAddressPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +671,7 @@ func (m *HourEpoch) Reset()         { *m = HourEpoch{} }
 func (m *HourEpoch) String() string { return proto.CompactTextString(m) }
 func (*HourEpoch) ProtoMessage()    {}
 func (*HourEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf22d2adece00654, []int{5}
+	return fileDescriptor_bf22d2adece00654, []int{9}
 }
 func (m *HourEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,9 +730,15 @@ func (m *HourEpoch) GetEpochStartHeight() int64 {
 
 func init() {
 	proto.RegisterEnum("ibc.applications.rate_limiting.v1.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterEnum("ibc.applications.rate_limiting.v1.QuotaMode", QuotaMode_name, QuotaMode_value)
+	proto.RegisterEnum("ibc.applications.rate_limiting.v1.QuotaUnit", QuotaUnit_name, QuotaUnit_value)
 	proto.RegisterType((*Path)(nil), "ibc.applications.rate_limiting.v1.Path")
 	proto.RegisterType((*Quota)(nil), "ibc.applications.rate_limiting.v1.Quota")
 	proto.RegisterType((*Flow)(nil), "ibc.applications.rate_limiting.v1.Flow")
+	proto.RegisterType((*FlowBucket)(nil), "ibc.applications.rate_limiting.v1.FlowBucket")
+	proto.RegisterType((*RateLimitFlowBuckets)(nil), "ibc.applications.rate_limiting.v1.RateLimitFlowBuckets")
+	proto.RegisterType((*PendingSendPacketBucket)(nil), "ibc.applications.rate_limiting.v1.PendingSendPacketBucket")
+	proto.RegisterType((*SenderFlow)(nil), "ibc.applications.rate_limiting.v1.SenderFlow")
 	proto.RegisterType((*RateLimit)(nil), "ibc.applications.rate_limiting.v1.RateLimit")
 	proto.RegisterType((*WhitelistedAddressPair)(nil), "ibc.applications.rate_limiting.v1.WhitelistedAddressPair")
 	proto.RegisterType((*HourEpoch)(nil), "ibc.applications.rate_limiting.v1.HourEpoch")
@@ -411,52 +749,74 @@ func init() {
 }
 
 var fileDescriptor_bf22d2adece00654 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0xc2, 0x82, 0x30, 0xcb, 0x8f, 0x75, 0x82, 0x64, 0xdd, 0xc4, 0x2e, 0x6c, 0x62, 0xdc,
-	0x18, 0x68, 0x05, 0x43, 0x8c, 0x31, 0x9a, 0xb0, 0xb0, 0x0a, 0x11, 0x71, 0x2d, 0x08, 0x89, 0x97,
-	0x66, 0x76, 0x3a, 0xb4, 0x13, 0xda, 0x4e, 0x9d, 0x4e, 0x17, 0x38, 0x7b, 0xf1, 0xc8, 0xd1, 0xbb,
-	0xff, 0x85, 0x27, 0x2f, 0x26, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe0, 0xe6, 0x5f, 0x61, 0x66, 0xda,
-	0x22, 0xe0, 0x81, 0xf5, 0x36, 0xf3, 0xde, 0xf7, 0x7d, 0xf3, 0xe6, 0xcd, 0xf7, 0x06, 0x2c, 0xd0,
-	0x0e, 0x36, 0x51, 0x14, 0xf9, 0x14, 0x23, 0x41, 0x59, 0x18, 0x9b, 0x1c, 0x09, 0x62, 0xfb, 0x34,
-	0xa0, 0x82, 0x86, 0xae, 0xd9, 0x9d, 0xbb, 0x1c, 0x30, 0x22, 0xce, 0x04, 0x83, 0xd3, 0xb4, 0x83,
-	0x8d, 0x8b, 0x34, 0xe3, 0x32, 0xaa, 0x3b, 0x57, 0x9d, 0x70, 0x99, 0xcb, 0x14, 0xda, 0x94, 0xab,
-	0x94, 0x58, 0xd5, 0x5d, 0xc6, 0x5c, 0x9f, 0x98, 0x6a, 0xd7, 0x49, 0x76, 0x4c, 0x27, 0xe1, 0x4a,
-	0x21, 0xcb, 0xd7, 0xae, 0xe6, 0x05, 0x0d, 0x48, 0x2c, 0x50, 0x10, 0xa5, 0x80, 0xfa, 0x2b, 0x50,
-	0x6c, 0x23, 0xe1, 0xc1, 0x09, 0x30, 0xe0, 0x90, 0x90, 0x05, 0x15, 0x6d, 0x4a, 0x6b, 0x0c, 0x5b,
-	0xe9, 0x06, 0x9a, 0x60, 0x02, 0x7b, 0x28, 0x0c, 0x89, 0x6f, 0x33, 0x6e, 0x63, 0x9f, 0x92, 0x50,
-	0xd8, 0xd4, 0xa9, 0xf4, 0x29, 0xd0, 0xcd, 0x2c, 0xf7, 0x9a, 0x2f, 0xa9, 0xcc, 0xaa, 0x53, 0xff,
-	0xaa, 0x81, 0x81, 0x37, 0x09, 0x13, 0x08, 0xbe, 0x00, 0xe5, 0x00, 0xed, 0xdb, 0x11, 0xe1, 0x58,
-	0x92, 0x62, 0x12, 0x3a, 0xa9, 0x76, 0xf3, 0xce, 0xd1, 0x49, 0xad, 0xf0, 0xe3, 0xa4, 0x76, 0x0b,
-	0xb3, 0x38, 0x60, 0x71, 0xec, 0xec, 0x1a, 0x94, 0x99, 0x01, 0x12, 0x9e, 0xb1, 0x1a, 0x0a, 0x6b,
-	0x2c, 0x40, 0xfb, 0xed, 0x94, 0xb5, 0x41, 0x42, 0xe7, 0xaa, 0x10, 0x27, 0xb8, 0x9b, 0x9e, 0xff,
-	0x1f, 0x42, 0x16, 0xc1, 0x5d, 0x78, 0x17, 0x8c, 0xe5, 0xdd, 0xb1, 0x3d, 0x96, 0xf0, 0xb8, 0xd2,
-	0x3f, 0xa5, 0x35, 0x8a, 0xd6, 0x68, 0x1e, 0x5d, 0x91, 0xc1, 0xfa, 0x17, 0x0d, 0x14, 0x9f, 0xfb,
-	0x6c, 0x0f, 0x2e, 0x80, 0x41, 0x1a, 0xee, 0xf8, 0x6c, 0xaf, 0xb7, 0xba, 0x33, 0x30, 0x7c, 0x04,
-	0x6e, 0xb0, 0x44, 0x28, 0x5e, 0x4f, 0x65, 0xe6, 0x68, 0xd8, 0x04, 0xa3, 0x79, 0xb3, 0xbb, 0xc8,
-	0x4f, 0x88, 0x2a, 0xef, 0x5a, 0xfa, 0x48, 0xc6, 0xd9, 0x92, 0x94, 0xfa, 0x37, 0x0d, 0x0c, 0x5b,
-	0x48, 0x90, 0x35, 0xe9, 0x1c, 0xf8, 0x04, 0x14, 0x23, 0x24, 0x3c, 0x55, 0x7f, 0x69, 0xfe, 0x9e,
-	0x71, 0xad, 0xcb, 0x0c, 0xe9, 0x05, 0x4b, 0x91, 0xe0, 0x33, 0x30, 0xf0, 0x5e, 0xbe, 0xa4, 0xba,
-	0x45, 0x69, 0xbe, 0xd1, 0x03, 0x5b, 0xbd, 0xbc, 0x95, 0xd2, 0xe4, 0xe1, 0xaa, 0x09, 0xfd, 0x3d,
-	0x1f, 0x2e, 0xbb, 0x6e, 0x29, 0x52, 0x7d, 0x0d, 0x4c, 0x6e, 0x7b, 0x54, 0x10, 0x9f, 0xc6, 0x82,
-	0x38, 0x8b, 0x8e, 0xc3, 0x49, 0x1c, 0xb7, 0x11, 0xe5, 0x70, 0x12, 0x0c, 0x4a, 0x2f, 0x11, 0x9e,
-	0x39, 0x35, 0xdb, 0xc1, 0x2a, 0x18, 0xe2, 0x04, 0x13, 0xda, 0x25, 0x3c, 0xb3, 0xe7, 0xf9, 0xbe,
-	0xfe, 0xa1, 0x0f, 0x0c, 0xcb, 0xc7, 0x6d, 0x45, 0x0c, 0x7b, 0x70, 0x1a, 0x8c, 0x10, 0xb9, 0xb0,
-	0xc3, 0x24, 0xe8, 0x64, 0x3a, 0x45, 0xab, 0xa4, 0x62, 0xeb, 0x2a, 0x04, 0xdf, 0x82, 0xa1, 0xdc,
-	0x14, 0xd9, 0xf5, 0x6f, 0x1b, 0xe9, 0x24, 0x19, 0xf9, 0x24, 0x19, 0xcb, 0x19, 0xa0, 0xa9, 0xcb,
-	0x07, 0xfa, 0x7d, 0x52, 0x83, 0x39, 0x65, 0x86, 0x05, 0x54, 0x90, 0x20, 0x12, 0x07, 0x9f, 0x7e,
-	0xd6, 0x34, 0xeb, 0x5c, 0x0a, 0xae, 0x83, 0x72, 0x7a, 0x72, 0x2c, 0x10, 0x17, 0xb6, 0x9c, 0xc5,
-	0xac, 0x3d, 0xd5, 0x7f, 0xe4, 0x37, 0xf3, 0x41, 0x6d, 0x0e, 0x49, 0xfd, 0x43, 0xa9, 0x34, 0xa6,
-	0xd8, 0x1b, 0x92, 0x2c, 0xd3, 0x70, 0x06, 0xc0, 0x8b, 0x7a, 0x1e, 0xa1, 0xae, 0x27, 0x2a, 0xc5,
-	0x29, 0xad, 0xd1, 0x6f, 0x95, 0xff, 0x62, 0x57, 0x54, 0xfc, 0xfe, 0x63, 0x30, 0xde, 0x46, 0x78,
-	0x97, 0x88, 0x65, 0xca, 0x09, 0x56, 0x05, 0x8d, 0x83, 0x52, 0x7b, 0x71, 0xe9, 0x65, 0x6b, 0xd3,
-	0xde, 0x68, 0xad, 0x2f, 0x97, 0x0b, 0x17, 0x02, 0x56, 0x6b, 0x69, 0xab, 0xac, 0x55, 0x8b, 0x1f,
-	0x3f, 0xeb, 0x85, 0xe6, 0xf6, 0xd1, 0xa9, 0xae, 0x1d, 0x9f, 0xea, 0xda, 0xaf, 0x53, 0x5d, 0x3b,
-	0x3c, 0xd3, 0x0b, 0xc7, 0x67, 0x7a, 0xe1, 0xfb, 0x99, 0x5e, 0x78, 0xf7, 0xd4, 0xa5, 0xc2, 0x4b,
-	0x3a, 0x06, 0x66, 0x81, 0x99, 0x1a, 0xd4, 0xa4, 0x1d, 0x3c, 0xeb, 0x32, 0xb3, 0x3b, 0xf7, 0xc0,
-	0x0c, 0x98, 0x93, 0xf8, 0x24, 0x96, 0x3f, 0x62, 0xfa, 0x13, 0xce, 0x9e, 0xff, 0x84, 0xe2, 0x20,
-	0x22, 0x71, 0x67, 0x50, 0xdd, 0xf7, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x14, 0x0f,
-	0x18, 0x38, 0x05, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0xce, 0x87, 0x5f, 0xbe, 0xcc, 0x28, 0x6d, 0xb6, 0x46, 0x38, 0xa9, 0x11, 0x22,
+	0xaa, 0x92, 0x5d, 0x12, 0x14, 0x2a, 0x40, 0x20, 0xe2, 0xd8, 0x6d, 0xad, 0x26, 0x8e, 0xd9, 0x24,
+	0x0d, 0x82, 0xc3, 0x6a, 0xbd, 0x3b, 0xf5, 0x8e, 0xb2, 0xbb, 0xe3, 0xce, 0xce, 0xba, 0xe9, 0x99,
+	0x0b, 0x12, 0x97, 0x1e, 0x39, 0x82, 0x7a, 0xe1, 0x6f, 0xe0, 0x8e, 0xd4, 0x63, 0x8f, 0x88, 0x43,
+	0x40, 0xc9, 0x8d, 0xbf, 0x02, 0xcd, 0xec, 0x47, 0xec, 0x44, 0x15, 0x4e, 0xc4, 0x6d, 0xe7, 0xbd,
+	0xf7, 0xfb, 0xcd, 0xbc, 0xdf, 0x9b, 0x37, 0x6f, 0x61, 0x93, 0x74, 0x6c, 0xdd, 0xea, 0xf5, 0x3c,
+	0x62, 0x5b, 0x9c, 0xd0, 0x20, 0xd4, 0x99, 0xc5, 0xb1, 0xe9, 0x11, 0x9f, 0x70, 0x12, 0x74, 0xf5,
+	0xfe, 0xfa, 0xb0, 0x41, 0xeb, 0x31, 0xca, 0x29, 0xba, 0x4b, 0x3a, 0xb6, 0x36, 0x08, 0xd3, 0x86,
+	0xa3, 0xfa, 0xeb, 0xe5, 0x85, 0x2e, 0xed, 0x52, 0x19, 0xad, 0x8b, 0xaf, 0x18, 0x58, 0xae, 0x74,
+	0x29, 0xed, 0x7a, 0x58, 0x97, 0xab, 0x4e, 0xf4, 0x54, 0x77, 0x22, 0x26, 0x19, 0x12, 0xff, 0xd2,
+	0x65, 0x3f, 0x27, 0x3e, 0x0e, 0xb9, 0xe5, 0xf7, 0xe2, 0x80, 0xea, 0x2e, 0x14, 0xda, 0x16, 0x77,
+	0xd1, 0x02, 0x8c, 0x3b, 0x38, 0xa0, 0xbe, 0xaa, 0x2c, 0x2b, 0x2b, 0x45, 0x23, 0x5e, 0x20, 0x1d,
+	0x16, 0x6c, 0xd7, 0x0a, 0x02, 0xec, 0x99, 0x94, 0x99, 0xb6, 0x47, 0x70, 0xc0, 0x4d, 0xe2, 0xa8,
+	0x63, 0x32, 0xe8, 0x9d, 0xc4, 0xb7, 0xc7, 0xb6, 0xa5, 0xa7, 0xe9, 0x54, 0x5f, 0x8d, 0xc3, 0xf8,
+	0xd7, 0x11, 0xe5, 0x16, 0x7a, 0x08, 0x25, 0xdf, 0x3a, 0x31, 0x7b, 0x98, 0xd9, 0x02, 0x14, 0xe2,
+	0xc0, 0x89, 0xb9, 0x6b, 0xef, 0xbd, 0x3e, 0x5d, 0xca, 0xfd, 0x79, 0xba, 0x74, 0xcb, 0xa6, 0xa1,
+	0x4f, 0xc3, 0xd0, 0x39, 0xd6, 0x08, 0xd5, 0x7d, 0x8b, 0xbb, 0x5a, 0x33, 0xe0, 0xc6, 0x9c, 0x6f,
+	0x9d, 0xb4, 0x63, 0xd4, 0x3e, 0x0e, 0x9c, 0xcb, 0x44, 0x0c, 0xdb, 0x7d, 0x75, 0xec, 0x9a, 0x44,
+	0x06, 0xb6, 0xfb, 0xe8, 0x03, 0x98, 0x4b, 0xd5, 0x31, 0x5d, 0x1a, 0xb1, 0x50, 0xcd, 0x2f, 0x2b,
+	0x2b, 0x05, 0x63, 0x36, 0xb5, 0x3e, 0x12, 0x46, 0xf4, 0x15, 0x14, 0x7c, 0xea, 0x60, 0xb5, 0xb0,
+	0xac, 0xac, 0xcc, 0x6d, 0xac, 0x6a, 0xff, 0x59, 0x1a, 0x4d, 0x26, 0xbc, 0x4b, 0x1d, 0x6c, 0x48,
+	0x24, 0xfa, 0x04, 0x16, 0x3b, 0x91, 0x7d, 0x8c, 0xb9, 0x99, 0xed, 0xe7, 0x93, 0x20, 0xe2, 0x38,
+	0x54, 0xc7, 0xe5, 0x8e, 0xb7, 0x62, 0x77, 0x3d, 0xf1, 0xee, 0xc6, 0x4e, 0xd4, 0x80, 0x79, 0x91,
+	0xa9, 0xe5, 0xd3, 0x28, 0x55, 0x6c, 0x62, 0x94, 0x44, 0x67, 0x7d, 0xeb, 0x64, 0x4b, 0x82, 0xa4,
+	0x60, 0xc3, 0x34, 0x52, 0xaf, 0xc9, 0xeb, 0xd1, 0x48, 0xb9, 0xbe, 0x83, 0x77, 0x2f, 0x17, 0x50,
+	0x2c, 0xe4, 0x07, 0x66, 0xea, 0xd4, 0x28, 0x94, 0x8b, 0xc3, 0xb5, 0x6c, 0x63, 0xb6, 0x2f, 0xd1,
+	0x42, 0xe4, 0x28, 0x20, 0x5c, 0x2d, 0x5e, 0x4f, 0xe4, 0xc3, 0x80, 0x70, 0x43, 0x22, 0xd1, 0x67,
+	0x70, 0xc7, 0x26, 0xcc, 0x8e, 0x08, 0x37, 0x3b, 0x0c, 0x5b, 0xc7, 0x98, 0x99, 0xdc, 0x65, 0x38,
+	0x74, 0xa9, 0xe7, 0xa8, 0x20, 0x65, 0x5e, 0x4c, 0x02, 0x6a, 0xb1, 0xff, 0x20, 0x75, 0x57, 0x7f,
+	0x53, 0xa0, 0xf0, 0xc0, 0xa3, 0xcf, 0xd1, 0x26, 0x4c, 0x90, 0xe0, 0xa9, 0x47, 0x9f, 0x8f, 0x76,
+	0x35, 0x93, 0x60, 0x74, 0x1f, 0x26, 0x69, 0xc4, 0x25, 0x6e, 0xa4, 0x9b, 0x98, 0x46, 0xa3, 0x1a,
+	0xcc, 0xa6, 0xfd, 0xd4, 0xb7, 0xbc, 0x08, 0xab, 0xf9, 0x51, 0xe0, 0x33, 0x09, 0xe6, 0x89, 0x80,
+	0x54, 0x7f, 0x51, 0x00, 0xc4, 0xe1, 0x6b, 0xf2, 0x0e, 0xa1, 0xf7, 0x61, 0x36, 0xb9, 0x6c, 0x41,
+	0xe4, 0x77, 0x30, 0x93, 0x99, 0x14, 0x8c, 0x99, 0xd8, 0xd8, 0x92, 0xb6, 0x81, 0x3c, 0xc7, 0x6e,
+	0x98, 0x67, 0xfe, 0x3a, 0x79, 0x56, 0x7f, 0x55, 0x60, 0xc1, 0xb0, 0x38, 0xde, 0x11, 0x05, 0xbc,
+	0x38, 0x6c, 0x88, 0xb6, 0xa0, 0xd0, 0xb3, 0xb8, 0x2b, 0x0f, 0x39, 0xbd, 0xf1, 0xe1, 0x08, 0x75,
+	0x17, 0xaf, 0x53, 0xad, 0x20, 0xf6, 0x35, 0x24, 0x14, 0xed, 0xc2, 0x64, 0x9c, 0x5b, 0xa8, 0x8e,
+	0x2d, 0xe7, 0x57, 0xa6, 0x37, 0xd6, 0x46, 0x60, 0xb9, 0x38, 0x43, 0xc2, 0x95, 0x72, 0x54, 0x7f,
+	0x54, 0x60, 0xb1, 0x8d, 0x03, 0x87, 0x04, 0x5d, 0x79, 0x45, 0x2d, 0x19, 0x14, 0x6b, 0xfb, 0xb6,
+	0xe7, 0x4f, 0x79, 0xcb, 0xf3, 0x87, 0xca, 0x30, 0x15, 0xe2, 0x67, 0x11, 0x0e, 0x6c, 0x2c, 0x95,
+	0x2e, 0x18, 0xd9, 0xfa, 0x6a, 0xa1, 0xf2, 0x57, 0x0b, 0x55, 0xfd, 0x59, 0x01, 0x88, 0x5b, 0x44,
+	0xde, 0xcf, 0xff, 0x41, 0xae, 0xdb, 0x30, 0x91, 0x74, 0x6c, 0xfc, 0x68, 0x27, 0xab, 0x9b, 0xd7,
+	0xf6, 0x77, 0x05, 0x8a, 0x59, 0x6d, 0xd1, 0xe7, 0x37, 0x3a, 0x61, 0x72, 0xb6, 0x2f, 0x61, 0xfc,
+	0x99, 0x68, 0x6b, 0x79, 0xb4, 0xe9, 0x8d, 0x95, 0x51, 0x9f, 0x01, 0x23, 0x86, 0x89, 0xcd, 0xb3,
+	0x04, 0x46, 0xdb, 0x5c, 0xa8, 0x6a, 0x48, 0x50, 0x75, 0x07, 0x6e, 0x1f, 0xb9, 0x84, 0x63, 0x8f,
+	0x84, 0x1c, 0x3b, 0x5b, 0x8e, 0xc3, 0x70, 0x18, 0xb6, 0x2d, 0xc2, 0x06, 0x24, 0x53, 0x86, 0x24,
+	0x2b, 0xc3, 0x14, 0xc3, 0x36, 0x26, 0xfd, 0x4c, 0xcc, 0x6c, 0x5d, 0xfd, 0x7e, 0x0c, 0x8a, 0x62,
+	0x7e, 0x34, 0x7a, 0xd4, 0x76, 0xd1, 0x5d, 0x98, 0xc1, 0xe2, 0x63, 0xb8, 0x27, 0xa7, 0xa5, 0x2d,
+	0x69, 0xc9, 0x43, 0x98, 0x4a, 0xa7, 0x43, 0x92, 0xfe, 0x1d, 0x2d, 0x1e, 0xd6, 0x5a, 0x3a, 0xac,
+	0xb5, 0x74, 0x40, 0xd4, 0x2a, 0xa2, 0x36, 0xff, 0x9c, 0x2e, 0xa1, 0x14, 0xb2, 0x4a, 0x7d, 0xc2,
+	0xb1, 0xdf, 0xe3, 0x2f, 0x7e, 0xfa, 0x6b, 0x49, 0x31, 0x32, 0x2a, 0xd4, 0x82, 0x52, 0xbc, 0x73,
+	0xc8, 0x2d, 0xc6, 0x4d, 0x31, 0xee, 0x13, 0x79, 0xca, 0x57, 0xe8, 0x0f, 0xd2, 0x7f, 0x81, 0xda,
+	0x94, 0xe0, 0x7f, 0x29, 0x98, 0xe6, 0x24, 0x7a, 0x5f, 0x80, 0x85, 0x1b, 0xad, 0x02, 0x1a, 0xe4,
+	0x73, 0x31, 0xe9, 0xba, 0x5c, 0xce, 0xc6, 0xbc, 0x51, 0xba, 0x88, 0x7d, 0x24, 0xed, 0xf7, 0x3e,
+	0x85, 0xf9, 0xb8, 0x81, 0xea, 0x84, 0x61, 0x5b, 0x1e, 0x68, 0x1e, 0xa6, 0xdb, 0x5b, 0xdb, 0x8f,
+	0x1b, 0x07, 0xe6, 0x7e, 0xa3, 0x55, 0x2f, 0xe5, 0x06, 0x0c, 0x46, 0x63, 0xfb, 0x49, 0x49, 0x29,
+	0x17, 0x7e, 0x78, 0x55, 0xc9, 0xdd, 0xbb, 0x0f, 0xc5, 0x6c, 0x8e, 0xa2, 0x12, 0xcc, 0x3c, 0x68,
+	0x7e, 0xd3, 0xa8, 0x9b, 0x47, 0xcd, 0x56, 0x7d, 0xef, 0xa8, 0x94, 0x43, 0x08, 0xe6, 0xf6, 0x77,
+	0x9a, 0xf5, 0x66, 0xeb, 0x61, 0x6a, 0x4b, 0x81, 0x9b, 0x50, 0xcc, 0x66, 0x83, 0x00, 0x1e, 0xec,
+	0x3d, 0x6e, 0xb4, 0xcc, 0xad, 0xdd, 0xbd, 0xc3, 0xd6, 0x41, 0x29, 0x27, 0x2c, 0xc9, 0x76, 0xdb,
+	0xd2, 0x92, 0xc0, 0x6a, 0x47, 0xaf, 0xcf, 0x2a, 0xca, 0x9b, 0xb3, 0x8a, 0xf2, 0xf7, 0x59, 0x45,
+	0x79, 0x79, 0x5e, 0xc9, 0xbd, 0x39, 0xaf, 0xe4, 0xfe, 0x38, 0xaf, 0xe4, 0xbe, 0xfd, 0xa2, 0x4b,
+	0xb8, 0x1b, 0x75, 0x34, 0x9b, 0xfa, 0x7a, 0xdc, 0x0b, 0x3a, 0xe9, 0xd8, 0x6b, 0x5d, 0xaa, 0xf7,
+	0xd7, 0x3f, 0xd2, 0x7d, 0xea, 0x44, 0x1e, 0x0e, 0xc5, 0x4f, 0x5e, 0xfc, 0x73, 0xb7, 0x96, 0xfd,
+	0xdc, 0xf1, 0x17, 0x3d, 0x1c, 0x76, 0x26, 0xa4, 0xbe, 0x1f, 0xff, 0x3b, 0x00, 0x64, 0x51, 0x60,
+	0x99, 0x0b, 0x0a, 0x00, 0x00,
 }
 
 func (m *Path) Marshal() (dAtA []byte, err error) {
@@ -516,6 +876,56 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerThreshold != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.CircuitBreakerThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Unit != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxPercentSendPerSender.Size()
+		i -= size
+		if _, err := m.MaxPercentSendPerSender.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.BucketDurationMinutes != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.BucketDurationMinutes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mode != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintRateLimiting(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -597,7 +1007,7 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+func (m *FlowBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -607,56 +1017,45 @@ func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *FlowBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Flow != nil {
-		{
-			size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
 	}
-	if m.Quota != nil {
-		{
-			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
 	}
-	if m.Path != nil {
-		{
-			size, err := m.Path.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.BucketNumber != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.BucketNumber))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WhitelistedAddressPair) Marshal() (dAtA []byte, err error) {
+func (m *RateLimitFlowBuckets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -666,7 +1065,203 @@ func (m *WhitelistedAddressPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WhitelistedAddressPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateLimitFlowBuckets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlowBuckets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Path.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSendPacketBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSendPacketBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSendPacketBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketNumber != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.BucketNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRateLimiting(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintRateLimiting(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRateLimiting(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Path.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Flow != nil {
+		{
+			size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Path != nil {
+		{
+			size, err := m.Path.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimiting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistedAddressPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistedAddressPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -718,20 +1313,20 @@ func (m *HourEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintRateLimiting(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintRateLimiting(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintRateLimiting(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintRateLimiting(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if m.EpochNumber != 0 {
@@ -783,6 +1378,24 @@ func (m *Quota) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovRateLimiting(uint64(m.DurationHours))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovRateLimiting(uint64(m.Mode))
+	}
+	if m.BucketDurationMinutes != 0 {
+		n += 1 + sovRateLimiting(uint64(m.BucketDurationMinutes))
+	}
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	l = m.MaxPercentSendPerSender.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	if m.Unit != 0 {
+		n += 1 + sovRateLimiting(uint64(m.Unit))
+	}
+	if m.CircuitBreakerThreshold != 0 {
+		n += 1 + sovRateLimiting(uint64(m.CircuitBreakerThreshold))
+	}
 	return n
 }
 
@@ -801,6 +1414,75 @@ func (m *Flow) Size() (n int) {
 	return n
 }
 
+func (m *FlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketNumber != 0 {
+		n += 1 + sovRateLimiting(uint64(m.BucketNumber))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlowBuckets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Path.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovRateLimiting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingSendPacketBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovRateLimiting(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRateLimiting(uint64(m.Sequence))
+	}
+	if m.BucketNumber != 0 {
+		n += 1 + sovRateLimiting(uint64(m.BucketNumber))
+	}
+	return n
+}
+
+func (m *SenderFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Path.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRateLimiting(uint64(l))
+	}
+	l = m.Outflow.Size()
+	n += 1 + l + sovRateLimiting(uint64(l))
+	return n
+}
+
 func (m *RateLimit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1094,17 +1776,195 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRateLimiting(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRateLimiting
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= QuotaMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketDurationMinutes", wireType)
+			}
+			m.BucketDurationMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketDurationMinutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSendPerSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSendPerSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= QuotaUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			m.CircuitBreakerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1267,6 +2127,529 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FlowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketNumber", wireType)
+			}
+			m.BucketNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlowBuckets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlowBuckets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlowBuckets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, FlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSendPacketBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSendPacketBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSendPacketBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketNumber", wireType)
+			}
+			m.BucketNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
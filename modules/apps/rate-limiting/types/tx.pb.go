@@ -50,6 +50,28 @@ type MsgAddRateLimit struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// Mode specifies whether the quota is enforced over fixed or sliding windows
+	Mode QuotaMode `protobuf:"varint,7,opt,name=mode,proto3,enum=ibc.applications.rate_limiting.v1.QuotaMode" json:"mode,omitempty"`
+	// BucketDurationMinutes specifies the duration of the buckets a sliding window
+	// is divided into. It must evenly divide the window and must be zero for fixed windows
+	BucketDurationMinutes uint64 `protobuf:"varint,8,opt,name=bucket_duration_minutes,json=bucketDurationMinutes,proto3" json:"bucket_duration_minutes,omitempty"`
+	// MaxAmountSend defines an absolute threshold for outflows, in base units of the denom
+	// Zero indicates that there is no absolute threshold
+	MaxAmountSend cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_send"`
+	// MaxAmountRecv defines an absolute threshold for inflows, in base units of the denom
+	// Zero indicates that there is no absolute threshold
+	MaxAmountRecv cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_recv"`
+	// MaxPercentSendPerSender defines the threshold for the outflows of a single sender
+	// The threshold is defined as a percentage (e.g. 1 indicates 1%)
+	// Zero indicates that there is no per sender threshold
+	MaxPercentSendPerSender cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=max_percent_send_per_sender,json=maxPercentSendPerSender,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send_per_sender"`
+	// Unit specifies whether the flow measures token amounts or the number of packets
+	// Packet count quotas only support the max_amount_send and max_amount_recv thresholds
+	Unit QuotaUnit `protobuf:"varint,12,opt,name=unit,proto3,enum=ibc.applications.rate_limiting.v1.QuotaUnit" json:"unit,omitempty"`
+	// CircuitBreakerThreshold defines the number of times the receive quota can be exceeded
+	// within a window before the IBC circuit breaker of the channel or client is tripped
+	// Zero indicates that the circuit breaker is never tripped
+	CircuitBreakerThreshold uint64 `protobuf:"varint,13,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3" json:"circuit_breaker_threshold,omitempty"`
 }
 
 func (m *MsgAddRateLimit) Reset()         { *m = MsgAddRateLimit{} }
@@ -113,6 +135,34 @@ func (m *MsgAddRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgAddRateLimit) GetMode() QuotaMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FIXED_WINDOW
+}
+
+func (m *MsgAddRateLimit) GetBucketDurationMinutes() uint64 {
+	if m != nil {
+		return m.BucketDurationMinutes
+	}
+	return 0
+}
+
+func (m *MsgAddRateLimit) GetUnit() QuotaUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return TOKEN_AMOUNT
+}
+
+func (m *MsgAddRateLimit) GetCircuitBreakerThreshold() uint64 {
+	if m != nil {
+		return m.CircuitBreakerThreshold
+	}
+	return 0
+}
+
 // MsgAddRateLimitResponse is the return type for AddRateLimit function.
 type MsgAddRateLimitResponse struct {
 }
@@ -168,6 +218,28 @@ type MsgUpdateRateLimit struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// Mode specifies whether the quota is enforced over fixed or sliding windows
+	Mode QuotaMode `protobuf:"varint,7,opt,name=mode,proto3,enum=ibc.applications.rate_limiting.v1.QuotaMode" json:"mode,omitempty"`
+	// BucketDurationMinutes specifies the duration of the buckets a sliding window
+	// is divided into. It must evenly divide the window and must be zero for fixed windows
+	BucketDurationMinutes uint64 `protobuf:"varint,8,opt,name=bucket_duration_minutes,json=bucketDurationMinutes,proto3" json:"bucket_duration_minutes,omitempty"`
+	// MaxAmountSend defines an absolute threshold for outflows, in base units of the denom
+	// Zero indicates that there is no absolute threshold
+	MaxAmountSend cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_send"`
+	// MaxAmountRecv defines an absolute threshold for inflows, in base units of the denom
+	// Zero indicates that there is no absolute threshold
+	MaxAmountRecv cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_recv"`
+	// MaxPercentSendPerSender defines the threshold for the outflows of a single sender
+	// The threshold is defined as a percentage (e.g. 1 indicates 1%)
+	// Zero indicates that there is no per sender threshold
+	MaxPercentSendPerSender cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=max_percent_send_per_sender,json=maxPercentSendPerSender,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send_per_sender"`
+	// Unit specifies whether the flow measures token amounts or the number of packets
+	// Packet count quotas only support the max_amount_send and max_amount_recv thresholds
+	Unit QuotaUnit `protobuf:"varint,12,opt,name=unit,proto3,enum=ibc.applications.rate_limiting.v1.QuotaUnit" json:"unit,omitempty"`
+	// CircuitBreakerThreshold defines the number of times the receive quota can be exceeded
+	// within a window before the IBC circuit breaker of the channel or client is tripped
+	// Zero indicates that the circuit breaker is never tripped
+	CircuitBreakerThreshold uint64 `protobuf:"varint,13,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3" json:"circuit_breaker_threshold,omitempty"`
 }
 
 func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
@@ -231,6 +303,34 @@ func (m *MsgUpdateRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateRateLimit) GetMode() QuotaMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FIXED_WINDOW
+}
+
+func (m *MsgUpdateRateLimit) GetBucketDurationMinutes() uint64 {
+	if m != nil {
+		return m.BucketDurationMinutes
+	}
+	return 0
+}
+
+func (m *MsgUpdateRateLimit) GetUnit() QuotaUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return TOKEN_AMOUNT
+}
+
+func (m *MsgUpdateRateLimit) GetCircuitBreakerThreshold() uint64 {
+	if m != nil {
+		return m.CircuitBreakerThreshold
+	}
+	return 0
+}
+
 // MsgUpdateRateLimitResponse is the return type for UpdateRateLimit.
 type MsgUpdateRateLimitResponse struct {
 }
@@ -488,47 +588,57 @@ func init() {
 }
 
 var fileDescriptor_5bbfc0abda512109 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xf6, 0x17, 0x38, 0x68, 0x6b, 0x97, 0x48, 0x37, 0xdb, 0xba, 0xad, 0x01, 0xa1, 0x46,
-	0xbb, 0xd3, 0x56, 0xbd, 0x14, 0x7b, 0x68, 0x3d, 0x68, 0xc1, 0xa2, 0x6c, 0x11, 0xc1, 0xcb, 0xb2,
-	0xd9, 0x19, 0x36, 0x83, 0x99, 0x99, 0x65, 0x67, 0xb2, 0xc4, 0x8b, 0x88, 0x08, 0x82, 0x27, 0xff,
-	0x0b, 0xaf, 0x39, 0x78, 0x11, 0xff, 0x81, 0x1e, 0x8b, 0x27, 0xf1, 0x50, 0x24, 0x39, 0xe4, 0xe6,
-	0xc9, 0x3f, 0x40, 0xf6, 0x47, 0x42, 0x32, 0x41, 0x9a, 0xe6, 0xd4, 0x83, 0x97, 0x90, 0xf9, 0xde,
-	0xfb, 0xde, 0x7e, 0x6f, 0xbe, 0x99, 0x79, 0xa0, 0x42, 0xaa, 0x3e, 0xf4, 0xc2, 0xb0, 0x4e, 0x7c,
-	0x4f, 0x12, 0xce, 0x04, 0x8c, 0x3c, 0x89, 0xdd, 0x3a, 0xa1, 0x44, 0x12, 0x16, 0xc0, 0x78, 0x0b,
-	0xca, 0xa6, 0x1d, 0x46, 0x5c, 0x72, 0xfd, 0x06, 0xa9, 0xfa, 0xf6, 0x60, 0xae, 0x3d, 0x94, 0x6b,
-	0xc7, 0x5b, 0xe6, 0xa2, 0x47, 0x09, 0xe3, 0x30, 0xfd, 0xcd, 0x58, 0xe6, 0x92, 0xcf, 0x05, 0xe5,
-	0x02, 0x52, 0x91, 0x56, 0xa3, 0x22, 0xc8, 0x03, 0xa5, 0x2c, 0xe0, 0xa6, 0x2b, 0x98, 0x2d, 0xf2,
-	0x50, 0x31, 0xe0, 0x01, 0xcf, 0xf0, 0xe4, 0x5f, 0x86, 0x96, 0x7f, 0x4f, 0x81, 0x85, 0x43, 0x11,
-	0xec, 0x21, 0xe4, 0x78, 0x12, 0x3f, 0x49, 0x3e, 0xab, 0x6f, 0x82, 0x39, 0x41, 0x02, 0x86, 0x23,
-	0x43, 0x5b, 0xd3, 0xd6, 0x2f, 0xed, 0x1b, 0xdf, 0xbf, 0x6c, 0x14, 0xf3, 0x5a, 0x7b, 0x08, 0x45,
-	0x58, 0x88, 0x23, 0x19, 0x11, 0x16, 0x38, 0x79, 0x9e, 0x5e, 0x04, 0xb3, 0x08, 0x33, 0x4e, 0x8d,
-	0xa9, 0x84, 0xe0, 0x64, 0x0b, 0x1d, 0x82, 0xa2, 0x5f, 0xf3, 0x18, 0xc3, 0x75, 0x97, 0x47, 0xae,
-	0x5f, 0x27, 0x98, 0x49, 0x97, 0x20, 0x63, 0x3a, 0x4d, 0x5a, 0xcc, 0x63, 0x4f, 0xa3, 0x87, 0x69,
-	0xe4, 0x00, 0xe9, 0x8f, 0xc0, 0x55, 0xea, 0x35, 0xdd, 0x10, 0x47, 0x7e, 0x92, 0x2a, 0x30, 0x43,
-	0xc6, 0x4c, 0x2a, 0xe1, 0xfa, 0xf1, 0xe9, 0x6a, 0xe1, 0xe7, 0xe9, 0xea, 0xb5, 0x4c, 0x86, 0x40,
-	0xaf, 0x6c, 0xc2, 0x21, 0xf5, 0x64, 0xcd, 0x3e, 0x60, 0xd2, 0x99, 0xa7, 0x5e, 0xf3, 0x59, 0xc6,
-	0x3a, 0xc2, 0x6c, 0xa4, 0x50, 0x84, 0xfd, 0xd8, 0x98, 0x3d, 0x67, 0x21, 0x07, 0xfb, 0xb1, 0x7e,
-	0x13, 0xcc, 0xa3, 0x46, 0x94, 0x3a, 0xe3, 0xd6, 0x78, 0x23, 0x12, 0xc6, 0xdc, 0x9a, 0xb6, 0x3e,
-	0xe3, 0x5c, 0xe9, 0xa1, 0x8f, 0x13, 0x70, 0xe7, 0xd6, 0xbb, 0x6e, 0xab, 0x92, 0x6f, 0xc6, 0xc7,
-	0x6e, 0xab, 0x52, 0x4a, 0x4c, 0x4c, 0x3d, 0x84, 0xca, 0xe6, 0x96, 0x4b, 0x60, 0x49, 0x81, 0x1c,
-	0x2c, 0x42, 0xce, 0x04, 0x2e, 0xff, 0x99, 0x02, 0xfa, 0xa1, 0x08, 0x9e, 0x87, 0xc8, 0x93, 0xf8,
-	0xbf, 0x1d, 0x93, 0xdb, 0x71, 0x47, 0xb1, 0x63, 0x65, 0xc8, 0x0e, 0x65, 0x7f, 0xcb, 0x2b, 0xc0,
-	0x1c, 0x45, 0xfb, 0xa6, 0x7c, 0xd3, 0x52, 0x53, 0x1c, 0x4c, 0x79, 0x7c, 0x01, 0x4c, 0x39, 0xa3,
-	0x37, 0x45, 0x66, 0xde, 0x9b, 0x82, 0xf6, 0x7b, 0xfb, 0xaa, 0x81, 0xc5, 0x34, 0x2c, 0xb0, 0xbc,
-	0x00, 0xad, 0xdd, 0x56, 0x5a, 0x5b, 0x56, 0x5a, 0x1b, 0x54, 0x59, 0x5e, 0x06, 0xa5, 0x11, 0xb0,
-	0xd7, 0xd8, 0xf6, 0xe7, 0x19, 0x30, 0x7d, 0x28, 0x02, 0xfd, 0x0d, 0xb8, 0x3c, 0xf4, 0xb2, 0x6d,
-	0xdb, 0x67, 0x3e, 0xb7, 0xb6, 0x72, 0x3b, 0xcd, 0x9d, 0xf3, 0x73, 0x7a, 0x3a, 0xf4, 0x0f, 0x1a,
-	0x58, 0x50, 0xaf, 0xf3, 0xfd, 0xf1, 0xea, 0x29, 0x34, 0x73, 0x77, 0x22, 0xda, 0x90, 0x12, 0xf5,
-	0x0c, 0x8f, 0xa9, 0x44, 0xa1, 0x8d, 0xab, 0xe4, 0x1f, 0x87, 0x4e, 0x7f, 0xaf, 0x81, 0x79, 0xe5,
-	0xc4, 0xdd, 0x1b, 0xb7, 0xe2, 0x20, 0xcb, 0x7c, 0x30, 0x09, 0xab, 0x27, 0xc3, 0x9c, 0x7d, 0xdb,
-	0x6d, 0x55, 0xb4, 0xfd, 0x17, 0xc7, 0x6d, 0x4b, 0x3b, 0x69, 0x5b, 0xda, 0xaf, 0xb6, 0xa5, 0x7d,
-	0xea, 0x58, 0x85, 0x93, 0x8e, 0x55, 0xf8, 0xd1, 0xb1, 0x0a, 0x2f, 0x77, 0x03, 0x22, 0x6b, 0x8d,
-	0xaa, 0xed, 0x73, 0x9a, 0x0f, 0x52, 0x48, 0xaa, 0xfe, 0x46, 0xc0, 0x61, 0xbc, 0xb5, 0x09, 0x29,
-	0x47, 0x8d, 0x3a, 0x16, 0xc9, 0x98, 0xcf, 0xc6, 0xfb, 0x46, 0x7f, 0xbc, 0xcb, 0xd7, 0x21, 0x16,
-	0xd5, 0xb9, 0x74, 0xbe, 0xde, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x13, 0x14, 0xda, 0xc9, 0x0d,
-	0x08, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xfe, 0x28, 0xfd, 0xc9, 0x08, 0x45, 0x36, 0x25, 0xdd, 0x2e, 0x58, 0xb0, 0x89, 0x09,
+	0x56, 0xd8, 0x05, 0x14, 0x0f, 0x44, 0x12, 0x41, 0x8d, 0x92, 0xd8, 0x88, 0x8b, 0xc4, 0x44, 0x0f,
+	0x9b, 0xed, 0xee, 0x64, 0x3b, 0xa1, 0x33, 0xd3, 0xcc, 0xcc, 0x36, 0xf5, 0x62, 0x8c, 0x31, 0x31,
+	0xf1, 0xe4, 0x7f, 0xe1, 0x95, 0x83, 0x17, 0xe3, 0x3f, 0xc0, 0x11, 0xbd, 0x68, 0x3c, 0x10, 0x03,
+	0x07, 0xfe, 0x0d, 0xb3, 0xb3, 0xdb, 0x86, 0x2e, 0x31, 0xb4, 0x9c, 0x38, 0x70, 0xd9, 0xec, 0xbc,
+	0x6f, 0xbe, 0x37, 0xdf, 0x7b, 0x3b, 0xdf, 0xe6, 0x81, 0x32, 0xaa, 0xba, 0xa6, 0xd3, 0x68, 0xd4,
+	0x91, 0xeb, 0x08, 0x44, 0x09, 0x37, 0x99, 0x23, 0xa0, 0x5d, 0x47, 0x18, 0x09, 0x44, 0x7c, 0xb3,
+	0xb9, 0x60, 0x8a, 0x96, 0xd1, 0x60, 0x54, 0x50, 0xf5, 0x1a, 0xaa, 0xba, 0xc6, 0xf1, 0xbd, 0x46,
+	0xd7, 0x5e, 0xa3, 0xb9, 0xa0, 0x8f, 0x39, 0x18, 0x11, 0x6a, 0xca, 0x67, 0xc4, 0xd2, 0xf3, 0x2e,
+	0xe5, 0x98, 0x72, 0x13, 0x73, 0x99, 0x0d, 0x73, 0x3f, 0x06, 0x0a, 0x11, 0x60, 0xcb, 0x95, 0x19,
+	0x2d, 0x62, 0x28, 0xe7, 0x53, 0x9f, 0x46, 0xf1, 0xf0, 0x2d, 0x8e, 0x2e, 0x9d, 0xae, 0xb5, 0x5b,
+	0x90, 0xa4, 0x95, 0xbe, 0x67, 0xc0, 0x68, 0x85, 0xfb, 0xab, 0x9e, 0x67, 0x39, 0x02, 0x3e, 0x09,
+	0x41, 0x75, 0x1e, 0x64, 0x38, 0xf2, 0x09, 0x64, 0x9a, 0x32, 0xad, 0xcc, 0x0c, 0xad, 0x69, 0x3f,
+	0xbe, 0xcc, 0xe5, 0x62, 0x09, 0xab, 0x9e, 0xc7, 0x20, 0xe7, 0x9b, 0x82, 0x21, 0xe2, 0x5b, 0xf1,
+	0x3e, 0x35, 0x07, 0x06, 0x3d, 0x48, 0x28, 0xd6, 0xfe, 0x0b, 0x09, 0x56, 0xb4, 0x50, 0x4d, 0x90,
+	0x73, 0x6b, 0x0e, 0x21, 0xb0, 0x6e, 0x53, 0x66, 0xbb, 0x75, 0x04, 0x89, 0xb0, 0x91, 0xa7, 0x0d,
+	0xc8, 0x4d, 0x63, 0x31, 0xf6, 0x94, 0xdd, 0x97, 0xc8, 0xba, 0xa7, 0x3e, 0x02, 0x57, 0xb0, 0xd3,
+	0xb2, 0x1b, 0x90, 0xb9, 0xe1, 0x56, 0x0e, 0x89, 0xa7, 0xa5, 0xa5, 0x84, 0xab, 0xbb, 0xfb, 0x53,
+	0xa9, 0xdf, 0xfb, 0x53, 0xe3, 0x91, 0x0c, 0xee, 0x6d, 0x1b, 0x88, 0x9a, 0xd8, 0x11, 0x35, 0x63,
+	0x9d, 0x08, 0x2b, 0x8b, 0x9d, 0xd6, 0x46, 0xc4, 0xda, 0x84, 0xe4, 0x44, 0x22, 0x06, 0xdd, 0xa6,
+	0x36, 0xd8, 0x67, 0x22, 0x0b, 0xba, 0x4d, 0xf5, 0x3a, 0xc8, 0x7a, 0x01, 0x93, 0x0d, 0xb5, 0x6b,
+	0x34, 0x60, 0x5c, 0xcb, 0x4c, 0x2b, 0x33, 0x69, 0x6b, 0xa4, 0x1d, 0x7d, 0x1c, 0x06, 0xd5, 0x7b,
+	0x20, 0x8d, 0xa9, 0x07, 0xb5, 0xff, 0xa7, 0x95, 0x99, 0xec, 0xe2, 0xac, 0x71, 0xea, 0x5d, 0x30,
+	0x9e, 0x05, 0x54, 0x38, 0x15, 0xea, 0x41, 0x4b, 0x32, 0xd5, 0x3b, 0x20, 0x5f, 0x0d, 0xdc, 0x6d,
+	0x28, 0xec, 0xce, 0x79, 0x18, 0x91, 0x40, 0x40, 0xae, 0x5d, 0x92, 0x27, 0x8e, 0x47, 0xf0, 0x83,
+	0x18, 0xad, 0x44, 0xa0, 0xfa, 0x10, 0x8c, 0x86, 0x95, 0x3a, 0x98, 0x06, 0xed, 0x8e, 0x0d, 0xf5,
+	0x52, 0xe8, 0x08, 0x76, 0x5a, 0xab, 0x92, 0x24, 0x1b, 0xd6, 0x9d, 0x46, 0xf6, 0x0b, 0xf4, 0x97,
+	0x46, 0xb6, 0xeb, 0x15, 0x98, 0x48, 0x7e, 0xc0, 0x70, 0x21, 0x5f, 0x20, 0xd3, 0x2e, 0xf7, 0x92,
+	0x32, 0xdf, 0xfd, 0x2d, 0x37, 0x20, 0xdb, 0x94, 0xec, 0xb0, 0xc9, 0x01, 0x41, 0x42, 0x1b, 0xee,
+	0xaf, 0xc9, 0x5b, 0x04, 0x09, 0x4b, 0x32, 0xd5, 0x65, 0x50, 0x70, 0x11, 0x73, 0x03, 0x24, 0xec,
+	0x2a, 0x83, 0xce, 0x36, 0x64, 0xb6, 0xa8, 0x31, 0xc8, 0x6b, 0xb4, 0xee, 0x69, 0x23, 0xb2, 0xcd,
+	0xf9, 0x78, 0xc3, 0x5a, 0x84, 0x3f, 0x6f, 0xc3, 0xcb, 0x37, 0xde, 0x1d, 0xed, 0x94, 0xe3, 0xfb,
+	0xfe, 0xf1, 0x68, 0xa7, 0x5c, 0x08, 0x4f, 0x93, 0x87, 0x99, 0x09, 0xff, 0x94, 0x0a, 0x20, 0x9f,
+	0x08, 0x59, 0x90, 0x37, 0x28, 0xe1, 0xb0, 0xf4, 0x33, 0x03, 0xd4, 0x0a, 0xf7, 0xb7, 0x1a, 0x9e,
+	0x23, 0xe0, 0x85, 0xe3, 0x2e, 0x1c, 0x77, 0xe1, 0xb8, 0x7f, 0x3a, 0x6e, 0x36, 0xe1, 0xb8, 0xc9,
+	0x2e, 0xc7, 0x25, 0x2c, 0x54, 0x9a, 0x04, 0xfa, 0xc9, 0x68, 0xc7, 0x77, 0xdf, 0x14, 0xe9, 0x3b,
+	0x0b, 0x62, 0xda, 0x3c, 0x07, 0xbe, 0x3b, 0xa5, 0xb6, 0x84, 0xcc, 0xb8, 0xb6, 0x44, 0xb4, 0x53,
+	0xdb, 0x57, 0x05, 0x8c, 0x49, 0x98, 0x43, 0x71, 0x0e, 0x4a, 0xbb, 0x99, 0x28, 0x6d, 0x22, 0x51,
+	0xda, 0x71, 0x95, 0xa5, 0x09, 0x50, 0x38, 0x11, 0x6c, 0x17, 0xb6, 0xf8, 0x39, 0x0d, 0x06, 0x2a,
+	0xdc, 0x57, 0xdf, 0x80, 0xe1, 0xae, 0xf9, 0x64, 0xb1, 0x87, 0x8b, 0x98, 0xf8, 0x01, 0xeb, 0xcb,
+	0xfd, 0x73, 0xda, 0x3a, 0xd4, 0x0f, 0x0a, 0x18, 0x4d, 0xfe, 0xb1, 0x97, 0x7a, 0xcb, 0x97, 0xa0,
+	0xe9, 0x2b, 0x67, 0xa2, 0x75, 0x29, 0x49, 0xde, 0xe1, 0x1e, 0x95, 0x24, 0x68, 0xfa, 0xca, 0x99,
+	0x68, 0x1d, 0x25, 0xef, 0x15, 0x90, 0x4d, 0xdc, 0xb8, 0xdb, 0xbd, 0x66, 0x3c, 0xce, 0xd2, 0xef,
+	0x9e, 0x85, 0xd5, 0x96, 0xa1, 0x0f, 0xbe, 0x3d, 0xda, 0x29, 0x2b, 0x6b, 0x2f, 0x76, 0x0f, 0x8a,
+	0xca, 0xde, 0x41, 0x51, 0xf9, 0x73, 0x50, 0x54, 0x3e, 0x1d, 0x16, 0x53, 0x7b, 0x87, 0xc5, 0xd4,
+	0xaf, 0xc3, 0x62, 0xea, 0xe5, 0x8a, 0x8f, 0x44, 0x2d, 0xa8, 0x1a, 0x2e, 0xc5, 0xf1, 0x14, 0x6d,
+	0xa2, 0xaa, 0x3b, 0xe7, 0x53, 0xb3, 0xb9, 0x30, 0x6f, 0x62, 0xea, 0x05, 0x75, 0xc8, 0xc3, 0xb9,
+	0x39, 0x9a, 0x97, 0xe7, 0x3a, 0xf3, 0xb2, 0x78, 0xdd, 0x80, 0xbc, 0x9a, 0x91, 0x53, 0xf2, 0xad,
+	0xbf, 0x03, 0x00, 0x96, 0x74, 0x8d, 0x5c, 0x0a, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -748,6 +858,56 @@ func (m *MsgAddRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CircuitBreakerThreshold))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Unit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxPercentSendPerSender.Size()
+		i -= size
+		if _, err := m.MaxPercentSendPerSender.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BucketDurationMinutes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BucketDurationMinutes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -840,6 +1000,56 @@ func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CircuitBreakerThreshold))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Unit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxPercentSendPerSender.Size()
+		i -= size
+		if _, err := m.MaxPercentSendPerSender.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BucketDurationMinutes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BucketDurationMinutes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -1082,6 +1292,24 @@ func (m *MsgAddRateLimit) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovTx(uint64(m.DurationHours))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	if m.BucketDurationMinutes != 0 {
+		n += 1 + sovTx(uint64(m.BucketDurationMinutes))
+	}
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentSendPerSender.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Unit != 0 {
+		n += 1 + sovTx(uint64(m.Unit))
+	}
+	if m.CircuitBreakerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.CircuitBreakerThreshold))
+	}
 	return n
 }
 
@@ -1119,6 +1347,24 @@ func (m *MsgUpdateRateLimit) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovTx(uint64(m.DurationHours))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	if m.BucketDurationMinutes != 0 {
+		n += 1 + sovTx(uint64(m.BucketDurationMinutes))
+	}
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentSendPerSender.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Unit != 0 {
+		n += 1 + sovTx(uint64(m.Unit))
+	}
+	if m.CircuitBreakerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.CircuitBreakerThreshold))
+	}
 	return n
 }
 
@@ -1409,6 +1655,184 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= QuotaMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketDurationMinutes", wireType)
+			}
+			m.BucketDurationMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketDurationMinutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSendPerSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSendPerSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= QuotaUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			m.CircuitBreakerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1692,6 +2116,184 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= QuotaMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketDurationMinutes", wireType)
+			}
+			m.BucketDurationMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketDurationMinutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSendPerSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSendPerSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= QuotaUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			m.CircuitBreakerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
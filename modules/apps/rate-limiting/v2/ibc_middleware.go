@@ -2,10 +2,13 @@ package v2
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -13,49 +16,72 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/api"
 )
 
-var _ api.IBCModule = (*IBCMiddleware)(nil)
+var (
+	_ api.Middleware                = (*IBCMiddleware)(nil)
+	_ api.PacketUnmarshalerModuleV2 = (*IBCMiddleware)(nil)
+)
 
 type IBCMiddleware struct {
-	app    api.IBCModule
-	keeper keeper.Keeper
+	app             api.PacketUnmarshalerModuleV2
+	writeAckWrapper api.WriteAcknowledgementWrapper
+	keeper          *keeper.Keeper
 }
 
-func NewIBCMiddleware(k keeper.Keeper, app api.IBCModule) IBCMiddleware {
-	return IBCMiddleware{
-		app:    app,
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper. The underlying application and the
+// WriteAcknowledgementWrapper are set when the middleware is composed into a stack with the api.IBCStackBuilder.
+func NewIBCMiddleware(k *keeper.Keeper) *IBCMiddleware {
+	return &IBCMiddleware{
 		keeper: k,
 	}
 }
 
-func (im IBCMiddleware) OnSendPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
-	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
-	if err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 rate limiting OnSendPacket failed to convert v2 packet to v1 packet", "error", err)
-		return err
+// SetUnderlyingApplication sets the underlying IBC v2 module.
+// The underlying application must implement the PacketUnmarshalerModuleV2 interface.
+func (im *IBCMiddleware) SetUnderlyingApplication(app api.IBCModule) {
+	if im.app != nil {
+		panic(errors.New("underlying application already set"))
 	}
-	if err := im.keeper.SendRateLimitedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data); err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 packet send was denied", "error", err)
-		return err
+
+	packetDataUnmarshalerApp, ok := app.(api.PacketUnmarshalerModuleV2)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*api.PacketUnmarshalerModuleV2)(nil)))
 	}
-	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+
+	im.app = packetDataUnmarshalerApp
 }
 
-func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
-	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
-	if err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 rate limiting OnRecvPacket failed to convert v2 packet to v1 packet", "error", err)
-		return channeltypesv2.RecvPacketResult{
-			Status:          channeltypesv2.PacketStatus_Failure,
-			Acknowledgement: channeltypes.NewErrorAcknowledgement(err).Acknowledgement(),
+// SetWriteAckWrapper sets the WriteAcknowledgementWrapper the asynchronous acknowledgements of the
+// underlying application are passed on to.
+func (im *IBCMiddleware) SetWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	if writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
+	im.writeAckWrapper = writeAckWrapper
+}
+
+func (im *IBCMiddleware) OnSendPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	packet, ok := im.rateLimitedPacket(ctx, payload, sourceClient, destinationClient, sequence, types.PACKET_SEND)
+	if ok {
+		if err := im.keeper.RateLimitSendPacket(ctx, packet); err != nil {
+			im.keeper.Logger(ctx).Error("ICS20 packet send was denied", "error", err)
+			return err
 		}
 	}
-	// Check if the packet would cause the rate limit to be exceeded,
-	// and if so, return an ack error
-	if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet); err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 packet receive was denied", "error", err)
-		return channeltypesv2.RecvPacketResult{
-			Status:          channeltypesv2.PacketStatus_Failure,
-			Acknowledgement: channeltypes.NewErrorAcknowledgement(err).Acknowledgement(),
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+func (im *IBCMiddleware) OnRecvPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	packet, ok := im.rateLimitedPacket(ctx, payload, sourceClient, destinationClient, sequence, types.PACKET_RECV)
+	if ok {
+		// Check if the packet would cause the rate limit to be exceeded,
+		// and if so, return an ack error
+		if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet); err != nil {
+			im.keeper.Logger(ctx).Error("ICS20 packet receive was denied", "error", err)
+			return channeltypesv2.RecvPacketResult{
+				Status:          channeltypesv2.PacketStatus_Failure,
+				Acknowledgement: channeltypes.NewErrorAcknowledgement(err).Acknowledgement(),
+			}
 		}
 	}
 
@@ -63,50 +89,74 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, sourceClient string, desti
 	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
 }
 
-func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
-	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
-	if err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 rate limiting OnTimeoutPacket failed to convert v2 packet to v1 packet", "error", err)
-		return err
-	}
-	if err := im.keeper.TimeoutRateLimitedPacket(ctx, packet); err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 RateLimited OnTimeoutPacket failed", "error", err)
-		return err
+func (im *IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	packet, ok := im.rateLimitedPacket(ctx, payload, sourceClient, destinationClient, sequence, types.PACKET_SEND)
+	if ok {
+		if err := im.keeper.TimeoutRateLimitedPacket(ctx, packet); err != nil {
+			im.keeper.Logger(ctx).Error("ICS20 RateLimited OnTimeoutPacket failed", "error", err)
+			return err
+		}
 	}
 	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
 }
 
-func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
-	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
-	if err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 rate limiting OnAckPacketfailed to convert v2 packet to v1 packet", "error", err)
-		return err
-	}
-	if err := im.keeper.AcknowledgeRateLimitedPacket(ctx, packet, acknowledgement); err != nil {
-		im.keeper.Logger(ctx).Error("ICS20 RateLimited OnAckPacket failed", "error", err)
-		return err
+func (im *IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	packet, ok := im.rateLimitedPacket(ctx, payload, sourceClient, destinationClient, sequence, types.PACKET_SEND)
+	if ok {
+		if err := im.keeper.AcknowledgeRateLimitedPacket(ctx, packet, acknowledgement); err != nil {
+			im.keeper.Logger(ctx).Error("ICS20 RateLimited OnAckPacket failed", "error", err)
+			return err
+		}
 	}
 	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
 }
 
-// TODO: Something looks off about this, please review carefully
-func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
-	transferRepresentation, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+// rateLimitedPacket converts the payload to the v1 packet the keeper rate limits on, and returns whether the
+// PacketInfoExtractor of the keeper recognises it. Payloads which are not recognised, e.g. the payloads of
+// interchain accounts when wrapping transfer with the default extractor, are passed on without being rate limited.
+func (im *IBCMiddleware) rateLimitedPacket(ctx sdk.Context, payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64, direction types.PacketDirection) (channeltypes.Packet, bool) {
+	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
 	if err != nil {
-		return channeltypes.Packet{}, err
+		im.keeper.Logger(ctx).Error("rate limiting failed to convert v2 payload to v1 packet, skipping rate limit", "error", err)
+		return channeltypes.Packet{}, false
 	}
 
-	packetData := transfertypes.FungibleTokenPacketData{
-		Denom:    transferRepresentation.Token.Denom.Path(),
-		Amount:   transferRepresentation.Token.Amount,
-		Sender:   transferRepresentation.Sender,
-		Receiver: transferRepresentation.Receiver,
-		Memo:     transferRepresentation.Memo,
+	if _, err := im.keeper.ExtractPacketInfo(packet, direction); err != nil {
+		im.keeper.Logger(ctx).Debug("payload not recognised by the packet info extractor, skipping rate limit", "port", payload.SourcePort, "version", payload.Version, "error", err)
+		return channeltypes.Packet{}, false
 	}
 
-	packetDataBz, err := json.Marshal(packetData)
-	if err != nil {
-		return channeltypes.Packet{}, err
+	return packet, true
+}
+
+// WriteAcknowledgement implements the WriteAcknowledgementWrapper interface.
+func (im *IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	return im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+// WritePayloadAcknowledgement implements the WriteAcknowledgementWrapper interface.
+func (im *IBCMiddleware) WritePayloadAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, payloadIndex uint32, appAck []byte) error {
+	return im.writeAckWrapper.WritePayloadAcknowledgement(ctx, clientID, sequence, payloadIndex, appAck)
+}
+
+// UnmarshalPacketData implements PacketDataUnmarshaler.
+func (im *IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.UnmarshalPacketData(payload)
+}
+
+// v2ToV1Packet converts a v2 payload to the v1 packet the keeper rate limits on. The value of the payload is
+// used as the packet data as is, so that it can be parsed by any PacketInfoExtractor. ICS-20 payloads may
+// use encodings the v1 packet data parsing does not support, e.g. ABI, and are therefore re-encoded:
+// payloads transferring multiple tokens to protobuf encoded ICS20-V2 packet data, all others to JSON
+// encoded ICS20-V1 packet data
+func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
+	packetDataBz := payload.Value
+	if payload.Version == transfertypes.V1 || payload.Version == transfertypes.V2 {
+		var err error
+		packetDataBz, err = transferPacketData(payload)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
 	}
 
 	return channeltypes.Packet{
@@ -120,3 +170,27 @@ func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClien
 		TimeoutTimestamp:   0,
 	}, nil
 }
+
+// transferPacketData re-encodes the value of an ICS-20 payload to packet data parsed by the v1 packet data parsing
+func transferPacketData(payload channeltypesv2.Payload) ([]byte, error) {
+	transferRepresentation, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transferRepresentation.Tokens) == 1 {
+		token := transferRepresentation.Tokens[0]
+		packetData := transfertypes.FungibleTokenPacketData{
+			Denom:    token.Denom.Path(),
+			Amount:   token.Amount,
+			Sender:   transferRepresentation.Sender,
+			Receiver: transferRepresentation.Receiver,
+			Memo:     transferRepresentation.Memo,
+		}
+
+		return json.Marshal(packetData)
+	}
+
+	packetData := transfertypes.NewFungibleTokenPacketDataV2(transferRepresentation.Tokens, transferRepresentation.Sender, transferRepresentation.Receiver, transferRepresentation.Memo)
+	return transfertypes.MarshalPacketDataV2(packetData, transfertypes.EncodingProtobuf)
+}
@@ -0,0 +1,98 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ratelimitingv2 "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/v2"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	mockv1 "github.com/cosmos/ibc-go/v10/testing/mock"
+	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+)
+
+func setupMiddleware(t *testing.T) (*ibctesting.TestChain, *ibctesting.Path, *ratelimitingv2.IBCMiddleware) {
+	t.Helper()
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	path.SetupV2()
+
+	middleware := ratelimitingv2.NewIBCMiddleware(path.EndpointA.Chain.GetSimApp().RateLimitKeeper)
+	middleware.SetUnderlyingApplication(mockv2.NewIBCModule())
+
+	return path.EndpointA.Chain, path, middleware
+}
+
+// Payloads which are not recognised by the packet info extractor, e.g. those of interchain accounts
+// with the default transfer extractor, are passed on to the underlying application
+func TestUnrecognisedPayloadPassesThrough(t *testing.T) {
+	chain, path, middleware := setupMiddleware(t)
+
+	ctx := chain.GetContext()
+	sourceClient, destinationClient := path.EndpointA.ClientID, path.EndpointB.ClientID
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	err := middleware.OnSendPacket(ctx, sourceClient, destinationClient, 1, payload, chain.SenderAccount.GetAddress())
+	require.NoError(t, err)
+
+	res := middleware.OnRecvPacket(ctx, destinationClient, sourceClient, 1, payload, chain.SenderAccount.GetAddress())
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+
+	err = middleware.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, 1, mockv1.MockAcknowledgement.Acknowledgement(), payload, chain.SenderAccount.GetAddress())
+	require.NoError(t, err)
+
+	err = middleware.OnTimeoutPacket(ctx, sourceClient, destinationClient, 1, payload, chain.SenderAccount.GetAddress())
+	require.NoError(t, err)
+}
+
+// Payloads of applications other than transfer are rate limited with the extractor set on the keeper
+func TestPayloadRateLimitedWithExtractor(t *testing.T) {
+	chain, path, middleware := setupMiddleware(t)
+
+	rateLimitKeeper := chain.GetSimApp().RateLimitKeeper
+	rateLimitKeeper.SetPacketInfoExtractor(keeper.PacketCountInfoExtractor{})
+
+	sourceClient, destinationClient := path.EndpointA.ClientID, path.EndpointB.ClientID
+	err := rateLimitKeeper.AddRateLimit(chain.GetContext(), &types.MsgAddRateLimit{
+		Denom:             types.PacketCountDenom,
+		ChannelOrClientId: sourceClient,
+		MaxPercentSend:    sdkmath.ZeroInt(),
+		MaxPercentRecv:    sdkmath.ZeroInt(),
+		DurationHours:     1,
+		MaxAmountSend:     sdkmath.NewInt(2),
+		MaxAmountRecv:     sdkmath.ZeroInt(),
+		Unit:              types.PACKET_COUNT,
+	})
+	require.NoError(t, err)
+
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	sendPacket := func(sequence uint64) error {
+		return middleware.OnSendPacket(chain.GetContext(), sourceClient, destinationClient, sequence, payload, chain.SenderAccount.GetAddress())
+	}
+	checkOutflow := func(expectedOutflow int64) {
+		rateLimit, found := rateLimitKeeper.GetRateLimit(chain.GetContext(), types.PacketCountDenom, sourceClient)
+		require.True(t, found)
+		require.Equal(t, expectedOutflow, rateLimit.Flow.Outflow.Int64())
+	}
+
+	require.NoError(t, sendPacket(1))
+	require.NoError(t, sendPacket(2))
+	checkOutflow(2)
+
+	require.ErrorIs(t, sendPacket(3), types.ErrQuotaExceeded)
+	checkOutflow(2)
+
+	// the outflow of a packet which timed out is reverted using the v2 sequence of the packet
+	err = middleware.OnTimeoutPacket(chain.GetContext(), sourceClient, destinationClient, 1, payload, chain.SenderAccount.GetAddress())
+	require.NoError(t, err)
+	checkOutflow(1)
+
+	require.NoError(t, sendPacket(3))
+	checkOutflow(2)
+}
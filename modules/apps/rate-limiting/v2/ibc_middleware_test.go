@@ -8,6 +8,8 @@ import (
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
 )
 
 func TestV2ToV1Packet_WithJSONEncoding(t *testing.T) {
@@ -136,3 +138,33 @@ func TestV2ToV1Packet_WithEmptyPayload(t *testing.T) {
 	_, err := v2ToV1Packet(payload, "sourceClient", "destinationClient", 1)
 	require.Error(t, err)
 }
+
+func TestV2ToV1Packet_WithNonTransferPayload(t *testing.T) {
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	v1Packet, err := v2ToV1Packet(payload, "sourceClient", "destinationClient", 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v1Packet.Sequence)
+	require.Equal(t, payload.SourcePort, v1Packet.SourcePort)
+	require.Equal(t, "sourceClient", v1Packet.SourceChannel)
+	require.Equal(t, payload.DestinationPort, v1Packet.DestinationPort)
+	require.Equal(t, "destinationClient", v1Packet.DestinationChannel)
+	require.Equal(t, payload.Value, v1Packet.Data)
+}
+
+func TestIBCStackBuilder(t *testing.T) {
+	baseApp := mockv2.NewIBCModule()
+	middleware := NewIBCMiddleware(nil)
+
+	stack := api.NewIBCStackBuilder(mockv2.NewMiddleware()).Base(baseApp).Next(middleware).Build()
+	require.Equal(t, middleware, stack)
+	require.Equal(t, baseApp, middleware.app)
+	require.NotNil(t, middleware.writeAckWrapper)
+
+	require.PanicsWithError(t, "underlying application already set", func() {
+		middleware.SetUnderlyingApplication(baseApp)
+	})
+	require.PanicsWithError(t, "write acknowledgement wrapper cannot be nil", func() {
+		middleware.SetWriteAckWrapper(nil)
+	})
+}
@@ -0,0 +1,77 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// IBC v2 transfers are rate limited by the rate limiting middleware at the top of the transfer v2 stack
+func TestTransferRateLimited(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupV2()
+
+	rateLimitKeeper := chainA.GetSimApp().RateLimitKeeper
+	err := rateLimitKeeper.AddRateLimit(chainA.GetContext(), &types.MsgAddRateLimit{
+		Denom:             sdk.DefaultBondDenom,
+		ChannelOrClientId: path.EndpointA.ClientID,
+		MaxPercentSend:    sdkmath.NewInt(10),
+		MaxPercentRecv:    sdkmath.NewInt(10),
+		DurationHours:     1,
+		MaxAmountSend:     sdkmath.NewInt(150),
+		MaxAmountRecv:     sdkmath.ZeroInt(),
+	})
+	require.NoError(t, err)
+
+	transferPayload := func(amount string) channeltypesv2.Payload {
+		packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount, chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(), "")
+		bz, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, transfertypes.EncodingABI)
+		require.NoError(t, err)
+
+		return channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, transfertypes.V1, transfertypes.EncodingABI, bz)
+	}
+	checkOutflow := func(expectedOutflow int64) {
+		rateLimit, found := rateLimitKeeper.GetRateLimit(chainA.GetContext(), sdk.DefaultBondDenom, path.EndpointA.ClientID)
+		require.True(t, found)
+		require.Equal(t, expectedOutflow, rateLimit.Flow.Outflow.Int64())
+	}
+
+	timeoutTimestamp := uint64(chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	// the transfer is received on chainB and its acknowledgement relayed back to chainA
+	packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, transferPayload("100"))
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointA.RelayPacket(packet))
+	checkOutflow(100)
+
+	voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ClientID))
+	balance := chainB.GetSimApp().BankKeeper.GetBalance(chainB.GetContext(), chainB.SenderAccount.GetAddress(), voucherDenom.IBCDenom())
+	require.Equal(t, sdkmath.NewInt(100), balance.Amount)
+
+	// the next transfer would exceed the outflow quota
+	_, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, transferPayload("100"))
+	ibctesting.RequireErrorIsOrContains(t, err, types.ErrQuotaExceeded)
+	checkOutflow(100)
+
+	// the outflow of a transfer which timed out is reverted
+	packet, err = path.EndpointA.MsgSendPacket(uint64(chainB.GetContext().BlockTime().Add(time.Second).Unix()), transferPayload("50"))
+	require.NoError(t, err)
+	checkOutflow(150)
+
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.MsgTimeoutPacket(packet))
+	checkOutflow(100)
+}
@@ -18,10 +18,18 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenom(),
 		GetCmdQueryDenoms(),
+		GetCmdQueryDenomsByBaseDenom(),
+		GetCmdQueryDenomsByHop(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryEscrowReconciliation(),
+		GetCmdQueryScheduledTransfer(),
+		GetCmdQueryScheduledTransfers(),
+		GetCmdQueryDenomTransferEnabled(),
+		GetCmdQueryChannelTransferEnabled(),
+		GetCmdQueryDenomMetadata(),
 	)
 
 	return queryCmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -82,6 +83,199 @@ func GetCmdQueryDenoms() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomsByBaseDenom defines the command to query all the denominations with a given base denomination.
+func GetCmdQueryDenomsByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-by-base-denom [base-denom]",
+		Short:   "Query for all token denominations with the given base denomination",
+		Long:    "Query for all token denominations with the given base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denoms-by-base-denom uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomsByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations")
+
+	return cmd
+}
+
+// GetCmdQueryDenomsByHop defines the command to query all the denominations whose trace contains a given hop.
+func GetCmdQueryDenomsByHop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-by-hop [port-id] [channel-id]",
+		Short:   "Query for all token denominations whose trace contains the given hop",
+		Long:    "Query for all token denominations whose trace contains the given port and channel (or client) identifier pair",
+		Example: fmt.Sprintf("%s query ibc-transfer denoms-by-hop transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsByHopRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomsByHop(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTransferEnabled defines the command to query all per denomination transfer enablement overrides.
+func GetCmdQueryDenomTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-transfer-enabled",
+		Short:   "Query for all per denomination transfer enablement overrides",
+		Long:    "Query for all per denomination transfer enablement overrides",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-transfer-enabled", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTransferEnabledRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom transfer enabled overrides")
+
+	return cmd
+}
+
+// GetCmdQueryChannelTransferEnabled defines the command to query all per channel or client transfer enablement overrides.
+func GetCmdQueryChannelTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-transfer-enabled",
+		Short:   "Query for all per channel or client transfer enablement overrides",
+		Long:    "Query for all per channel or client transfer enablement overrides",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-transfer-enabled", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelTransferEnabledRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelTransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel transfer enabled overrides")
+
+	return cmd
+}
+
+// GetCmdQueryDenomMetadata defines the command to query all registered IBC voucher denomination metadata.
+func GetCmdQueryDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-metadata",
+		Short:   "Query for all registered IBC voucher denomination metadata",
+		Long:    "Query for all registered IBC voucher denomination metadata",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-metadata", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomMetadataRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denomination metadata")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -200,3 +394,107 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryEscrowReconciliation defines the command to compare the escrow account balances with the tracked total escrow
+func GetCmdQueryEscrowReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-reconciliation",
+		Short:   "Query the escrow account balances and their discrepancies with the tracked total escrow",
+		Long:    "Query the balances of all escrow accounts and the denominations for which their sum differs from the tracked total escrow",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-reconciliation", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowReconciliation(cmd.Context(), &types.QueryEscrowReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryScheduledTransfer defines the command to query a scheduled transfer by its identifier.
+func GetCmdQueryScheduledTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-transfer [id]",
+		Short:   "Query a scheduled transfer by its identifier",
+		Long:    "Query a scheduled transfer which has not been dispatched or cancelled by its identifier",
+		Example: fmt.Sprintf("%s query ibc-transfer scheduled-transfer 1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledTransferRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.ScheduledTransfer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryScheduledTransfers defines the command to query all scheduled transfers.
+func GetCmdQueryScheduledTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-transfers",
+		Short:   "Query for all scheduled transfers",
+		Long:    "Query for all scheduled transfers which have not been dispatched or cancelled",
+		Example: fmt.Sprintf("%s query ibc-transfer scheduled-transfers", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledTransfersRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ScheduledTransfers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled transfers")
+
+	return cmd
+}
@@ -23,6 +23,7 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagUnwind                 = "unwind"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -89,6 +90,11 @@ using the {packet-timeout-timestamp} flag. If no timeout value is set then a def
 				return err
 			}
 
+			unwind, err := cmd.Flags().GetBool(flagUnwind)
+			if err != nil {
+				return err
+			}
+
 			// NOTE: relative timeouts using block height are not supported.
 			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
 			if !absoluteTimeouts {
@@ -112,6 +118,7 @@ using the {packet-timeout-timestamp} flag. If no timeout value is set then a def
 			msg := types.NewMsgTransfer(
 				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
+			msg.Unwind = unwind
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -121,6 +128,7 @@ using the {packet-timeout-timestamp} flag. If no timeout value is set then a def
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. On IBC v1 protocol, either timeout timestamp or timeout height must be set. On IBC v2 protocol timeout timestamp must be set.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().Bool(flagUnwind, false, "Send the tokens back along their denomination trace to their origin chain before delivering them to the receiver. The src-port and src-channel must be empty strings.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
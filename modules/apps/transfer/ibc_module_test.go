@@ -652,7 +652,7 @@ func (s *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 
 				if v1PacketData, ok := initialPacketData.(types.FungibleTokenPacketData); ok {
 					// Note: testing of the denom trace parsing/conversion should be done as part of testing internal conversion functions
-					s.Require().Equal(v1PacketData.Amount, v2PacketData.Token.Amount)
+					s.Require().Equal(v1PacketData.Amount, v2PacketData.Tokens[0].Amount)
 					s.Require().Equal(v1PacketData.Sender, v2PacketData.Sender)
 					s.Require().Equal(v1PacketData.Receiver, v2PacketData.Receiver)
 					s.Require().Equal(v1PacketData.Memo, v2PacketData.Memo)
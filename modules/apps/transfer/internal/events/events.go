@@ -12,15 +12,18 @@ import (
 )
 
 // EmitTransferEvent emits a ibc transfer event on successful transfers.
-func EmitTransferEvent(ctx sdk.Context, sender, receiver string, token types.Token, memo string) {
+func EmitTransferEvent(ctx sdk.Context, sender, receiver string, tokens types.Tokens, memo string) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(tokens)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, memo))
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom.Path()),
-			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+			eventAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -34,11 +37,12 @@ func EmitOnRecvPacketEvent(ctx sdk.Context, packetData types.InternalTransferRep
 	eventAttributes := []sdk.Attribute{
 		sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, packetData.Token.Denom.Path()),
-		sdk.NewAttribute(types.AttributeKeyAmount, packetData.Token.Amount),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(packetData.Tokens)...)
+	eventAttributes = append(eventAttributes,
 		sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
-	}
+	)
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -58,15 +62,20 @@ func EmitOnRecvPacketEvent(ctx sdk.Context, packetData types.InternalTransferRep
 
 // EmitOnAcknowledgementPacketEvent emits a fungible token packet event in the OnAcknowledgementPacket callback
 func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, packetData types.InternalTransferRepresentation, ack channeltypes.Acknowledgement) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, packetData.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(packetData.Tokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeySender, packetData.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, packetData.Token.Denom.Path()),
-			sdk.NewAttribute(types.AttributeKeyAmount, packetData.Token.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -94,14 +103,19 @@ func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, packetData types.Internal
 
 // EmitOnTimeoutEvent emits a fungible token packet event in the OnTimeoutPacket callback
 func EmitOnTimeoutEvent(ctx sdk.Context, packetData types.InternalTransferRepresentation) {
-	tokenStr := mustMarshalJSON(packetData.Token)
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Sender),
+	}
+	// a refund tokens attribute is emitted for each token refunded
+	for _, token := range packetData.Tokens {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyRefundTokens, mustMarshalJSON(token)))
+	}
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundTokens, tokenStr),
-			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+			eventAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -123,6 +137,116 @@ func EmitDenomEvent(ctx sdk.Context, token types.Token) {
 	)
 }
 
+// EmitReconcileEscrowEvent emits a reconcile escrow event for a denomination whose tracked total escrow was corrected.
+func EmitReconcileEscrowEvent(ctx sdk.Context, discrepancy types.EscrowDiscrepancy) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReconcile,
+			sdk.NewAttribute(types.AttributeKeyDenom, discrepancy.Tracked.Denom),
+			sdk.NewAttribute(types.AttributeKeyTrackedAmount, discrepancy.Tracked.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowedAmount, discrepancy.Escrowed.Amount.String()),
+		),
+	)
+}
+
+// EmitScheduleTransferEvent emits a schedule transfer event
+func EmitScheduleTransferEvent(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyScheduledID, strconv.FormatUint(scheduledTransfer.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, scheduledTransfer.Transfer.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, scheduledTransfer.Transfer.Receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, scheduledTransfer.Transfer.GetCoins().String()),
+		sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatUint(scheduledTransfer.ExecuteHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyExecuteTime, strconv.FormatUint(scheduledTransfer.ExecuteTimestamp, 10)),
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleTransfer,
+			eventAttributes...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitCancelScheduledTransferEvent emits a cancel scheduled transfer event
+func EmitCancelScheduledTransferEvent(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledTransfer,
+			sdk.NewAttribute(types.AttributeKeyScheduledID, strconv.FormatUint(scheduledTransfer.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, scheduledTransfer.Transfer.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, scheduledTransfer.Transfer.GetCoins().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitDispatchScheduledTransferEvent emits a dispatch scheduled transfer event. If the dispatch
+// failed, the error is emitted and the escrowed tokens have been refunded to the sender.
+func EmitDispatchScheduledTransferEvent(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer, sequence uint64, dispatchErr error) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyScheduledID, strconv.FormatUint(scheduledTransfer.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, scheduledTransfer.Transfer.Sender),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(dispatchErr == nil)),
+	}
+
+	if dispatchErr != nil {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyError, dispatchErr.Error()))
+	} else {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDispatchScheduledTransfer,
+			eventAttributes...,
+		),
+	)
+}
+
+// EmitReceiveHookEvent emits a receive hook event once the handler of a receive hook has been
+// invoked successfully with the received coins.
+func EmitReceiveHookEvent(ctx sdk.Context, handler string, transfer types.ReceivedTransfer) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReceiveHook,
+			sdk.NewAttribute(types.AttributeKeyHandler, handler),
+			sdk.NewAttribute(types.AttributeKeySender, transfer.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, transfer.Receiver),
+			sdk.NewAttribute(types.AttributeKeyIntermediate, transfer.IntermediateAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Coins.String()),
+		),
+	)
+}
+
+// tokenAttributes returns a denom and amount attribute for each of the given tokens.
+func tokenAttributes(tokens types.Tokens) []sdk.Attribute {
+	// packet data which could not be unmarshaled has no tokens, empty attributes are emitted in that case
+	if len(tokens) == 0 {
+		return []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyDenom, ""),
+			sdk.NewAttribute(types.AttributeKeyAmount, ""),
+		}
+	}
+
+	attributes := make([]sdk.Attribute, 0, 2*len(tokens))
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom.Path()),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount),
+		)
+	}
+
+	return attributes
+}
+
 // mustMarshalJSON json marshals the given type and panics on failure.
 func mustMarshalJSON(v any) string {
 	bz, err := json.Marshal(v)
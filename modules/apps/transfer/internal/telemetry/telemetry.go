@@ -13,7 +13,14 @@ import (
 	coremetrics "github.com/cosmos/ibc-go/v10/modules/core/metrics"
 )
 
-func ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChannel string, token types.Token) {
+// ReportTransfer reports the telemetry of each token sent in a transfer.
+func ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChannel string, tokens types.Tokens) {
+	for _, token := range tokens {
+		reportTransferToken(sourcePort, sourceChannel, destinationPort, destinationChannel, token)
+	}
+}
+
+func reportTransferToken(sourcePort, sourceChannel, destinationPort, destinationChannel string, token types.Token) {
 	labels := []metrics.Label{
 		telemetry.NewLabel(coremetrics.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coremetrics.LabelDestinationChannel, destinationChannel),
@@ -37,7 +44,14 @@ func ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChann
 	)
 }
 
-func ReportOnRecvPacket(sourcePort, sourceChannel, destinationPort, destinationChannel string, token types.Token) {
+// ReportOnRecvPacket reports the telemetry of each token received in a transfer.
+func ReportOnRecvPacket(sourcePort, sourceChannel, destinationPort, destinationChannel string, tokens types.Tokens) {
+	for _, token := range tokens {
+		reportOnRecvPacketToken(sourcePort, sourceChannel, destinationPort, destinationChannel, token)
+	}
+}
+
+func reportOnRecvPacketToken(sourcePort, sourceChannel, destinationPort, destinationChannel string, token types.Token) {
 	labels := []metrics.Label{
 		telemetry.NewLabel(coremetrics.LabelSourcePort, sourcePort),
 		telemetry.NewLabel(coremetrics.LabelSourceChannel, sourceChannel),
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	internaltypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/internal/types"
@@ -29,6 +32,12 @@ func (k *Keeper) GetAllDenomTraces(ctx sdk.Context) []internaltypes.DenomTrace {
 }
 
 // CreatePacketDataBytesFromVersion is a wrapper around createPacketDataBytesFromVersion for testing purposes
-func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, token types.Token) ([]byte, error) {
-	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, token)
+func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens) ([]byte, error) {
+	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens)
+}
+
+// SetDenomWithoutIndexes is a wrapper around the denom store to set a denom without indexing it.
+func (k *Keeper) SetDenomWithoutIndexes(ctx sdk.Context, denom types.Denom) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomKey)
+	store.Set(denom.Hash(), k.cdc.MustMarshal(&denom))
 }
@@ -12,7 +12,7 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	for _, denom := range state.Denoms {
 		k.SetDenom(ctx, denom)
-		k.SetDenomMetadata(ctx, denom)
+		k.SetDefaultDenomMetadata(ctx, denom)
 	}
 
 	k.SetParams(ctx, state.Params)
@@ -22,14 +22,40 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, denomTransferEnabled := range state.DenomTransferEnabled {
+		k.SetDenomTransferEnabled(ctx, denomTransferEnabled)
+	}
+
+	for _, channelTransferEnabled := range state.ChannelTransferEnabled {
+		k.SetChannelTransferEnabled(ctx, channelTransferEnabled)
+	}
+
+	// registered metadata replaces the default metadata set for the denoms above
+	for _, metadata := range state.DenomMetadata {
+		k.SetRegisteredDenomMetadata(ctx, metadata)
+	}
+
+	for _, scheduledTransfer := range state.ScheduledTransfers {
+		k.SetScheduledTransfer(ctx, scheduledTransfer)
+	}
+
+	if state.NextScheduledTransferId != 0 {
+		k.SetNextScheduledTransferID(ctx, state.NextScheduledTransferId)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		Denoms:        k.GetAllDenoms(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:                  k.GetPort(ctx),
+		Denoms:                  k.GetAllDenoms(ctx),
+		Params:                  k.GetParams(ctx),
+		TotalEscrowed:           k.GetAllTotalEscrowed(ctx),
+		DenomTransferEnabled:    k.GetAllDenomTransferEnabled(ctx),
+		ChannelTransferEnabled:  k.GetAllChannelTransferEnabled(ctx),
+		DenomMetadata:           k.GetAllRegisteredDenomMetadata(ctx),
+		ScheduledTransfers:      k.GetAllScheduledTransfers(ctx),
+		NextScheduledTransferId: k.GetNextScheduledTransferID(ctx),
 	}
 }
@@ -42,18 +42,48 @@ func (s *KeeperTestSuite) TestGenesis() {
 		s.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(s.chainA.GetContext(), escrow)
 	}
 
+	denomOverrides := []types.DenomTransferEnabled{types.NewDenomTransferEnabled(denoms[0].IBCDenom(), false, true)}
+	channelOverrides := []types.ChannelTransferEnabled{types.NewChannelTransferEnabled("channel-0", true, false)}
+	s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), denomOverrides[0])
+	s.chainA.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainA.GetContext(), channelOverrides[0])
+
+	registeredMetadata := []types.DenomMetadata{types.NewDenomMetadata(denoms[1], "Atom", "ATOM", "atom", 6, "", "")}
+	s.chainA.GetSimApp().TransferKeeper.SetRegisteredDenomMetadata(s.chainA.GetContext(), registeredMetadata[0])
+
+	transfer := types.NewMsgTransfer(types.PortID, "channel-0", sdk.NewInt64Coin("uatom", 10), s.chainA.SenderAccount.GetAddress().String(), "receiver", s.chainB.GetTimeoutHeight(), 0, "")
+	scheduledTransfers := []types.ScheduledTransfer{types.NewScheduledTransfer(1, *transfer, 100, 0)}
+	s.chainA.GetSimApp().TransferKeeper.SetScheduledTransfer(s.chainA.GetContext(), scheduledTransfers[0])
+	s.chainA.GetSimApp().TransferKeeper.SetNextScheduledTransferID(s.chainA.GetContext(), 2)
+
 	genesis := s.chainA.GetSimApp().TransferKeeper.ExportGenesis(s.chainA.GetContext())
 
 	s.Require().Equal(types.PortID, genesis.PortId)
 	s.Require().Equal(denoms.Sort(), genesis.Denoms)
 	s.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	s.Require().Equal(denomOverrides, genesis.DenomTransferEnabled)
+	s.Require().Equal(channelOverrides, genesis.ChannelTransferEnabled)
+	s.Require().Equal(registeredMetadata, genesis.DenomMetadata)
+	s.Require().Equal(scheduledTransfers, genesis.ScheduledTransfers)
+	s.Require().Equal(uint64(2), genesis.NextScheduledTransferId)
 
+	s.SetupTest() // reset
 	s.Require().NotPanics(func() {
 		s.chainA.GetSimApp().TransferKeeper.InitGenesis(s.chainA.GetContext(), *genesis)
 	})
 
+	s.Require().Equal(denomOverrides, s.chainA.GetSimApp().TransferKeeper.GetAllDenomTransferEnabled(s.chainA.GetContext()))
+	s.Require().Equal(channelOverrides, s.chainA.GetSimApp().TransferKeeper.GetAllChannelTransferEnabled(s.chainA.GetContext()))
+	s.Require().Equal(scheduledTransfers, s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(s.chainA.GetContext()))
+	s.Require().Equal(uint64(2), s.chainA.GetSimApp().TransferKeeper.GetNextScheduledTransferID(s.chainA.GetContext()))
+
 	for _, denom := range denoms {
 		_, found := s.chainA.GetSimApp().BankKeeper.GetDenomMetaData(s.chainA.GetContext(), denom.IBCDenom())
 		s.Require().True(found)
 	}
+
+	// registered metadata takes precedence over the default metadata
+	s.Require().Equal(registeredMetadata, s.chainA.GetSimApp().TransferKeeper.GetAllRegisteredDenomMetadata(s.chainA.GetContext()))
+	bankMetadata, found := s.chainA.GetSimApp().BankKeeper.GetDenomMetaData(s.chainA.GetContext(), denoms[1].IBCDenom())
+	s.Require().True(found)
+	s.Require().Equal(registeredMetadata[0].BankMetadata(), bankMetadata)
 }
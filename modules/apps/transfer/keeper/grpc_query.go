@@ -76,6 +76,156 @@ func (k *Keeper) Denoms(ctx context.Context, req *types.QueryDenomsRequest) (*ty
 	}, nil
 }
 
+// DenomsByBaseDenom implements the Query/DenomsByBaseDenom gRPC method
+func (k *Keeper) DenomsByBaseDenom(goCtx context.Context, req *types.QueryDenomsByBaseDenomRequest) (*types.QueryDenomsByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denoms, pageRes, err := k.paginateDenomIndex(ctx, types.DenomByBaseDenomPrefix(req.BaseDenom), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByBaseDenomResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomsByHop implements the Query/DenomsByHop gRPC method
+func (k *Keeper) DenomsByHop(goCtx context.Context, req *types.QueryDenomsByHopRequest) (*types.QueryDenomsByHopResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denoms, pageRes, err := k.paginateDenomIndex(ctx, types.DenomByHopPrefix(req.PortId, req.ChannelId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByHopResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateDenomIndex paginates over the denom hashes indexed under the given key prefix
+// and returns the corresponding denoms.
+func (k *Keeper) paginateDenomIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) (types.Denoms, *query.PageResponse, error) {
+	var denoms types.Denoms
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), indexPrefix)
+
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		denom, found := k.GetDenom(ctx, value)
+		if !found {
+			return status.Error(codes.Internal, errorsmod.Wrapf(types.ErrDenomNotFound, "indexed denom hash %X", value).Error())
+		}
+
+		denoms = append(denoms, denom)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return denoms, pageRes, nil
+}
+
+// DenomTransferEnabled implements the Query/DenomTransferEnabled gRPC method
+func (k *Keeper) DenomTransferEnabled(ctx context.Context, req *types.QueryDenomTransferEnabledRequest) (*types.QueryDenomTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var overrides []types.DenomTransferEnabled
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferEnabledKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denomTransferEnabled types.DenomTransferEnabled
+		if err := k.cdc.Unmarshal(value, &denomTransferEnabled); err != nil {
+			return err
+		}
+
+		overrides = append(overrides, denomTransferEnabled)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTransferEnabledResponse{
+		Denoms:     overrides,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ChannelTransferEnabled implements the Query/ChannelTransferEnabled gRPC method
+func (k *Keeper) ChannelTransferEnabled(ctx context.Context, req *types.QueryChannelTransferEnabledRequest) (*types.QueryChannelTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var overrides []types.ChannelTransferEnabled
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferEnabledKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var channelTransferEnabled types.ChannelTransferEnabled
+		if err := k.cdc.Unmarshal(value, &channelTransferEnabled); err != nil {
+			return err
+		}
+
+		overrides = append(overrides, channelTransferEnabled)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelTransferEnabledResponse{
+		Channels:   overrides,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (k *Keeper) DenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var metadata []types.DenomMetadata
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomMetadataKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denomMetadata types.DenomMetadata
+		if err := k.cdc.Unmarshal(value, &denomMetadata); err != nil {
+			return err
+		}
+
+		metadata = append(metadata, denomMetadata)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomMetadataResponse{
+		Metadata:   metadata,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k *Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -158,3 +308,68 @@ func (k *Keeper) TotalEscrowForDenom(goCtx context.Context, req *types.QueryTota
 		Amount: amount,
 	}, nil
 }
+
+// EscrowReconciliation implements the EscrowReconciliation gRPC method
+func (k *Keeper) EscrowReconciliation(c context.Context, req *types.QueryEscrowReconciliationRequest) (*types.QueryEscrowReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrowAccounts := k.GetEscrowAccounts(ctx)
+
+	return &types.QueryEscrowReconciliationResponse{
+		EscrowAccounts: escrowAccounts,
+		Discrepancies:  k.GetEscrowDiscrepancies(ctx, escrowAccounts),
+	}, nil
+}
+
+// ScheduledTransfer implements the ScheduledTransfer gRPC method
+func (k *Keeper) ScheduledTransfer(c context.Context, req *types.QueryScheduledTransferRequest) (*types.QueryScheduledTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scheduledTransfer, found := k.GetScheduledTransfer(ctx, req.Id)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrScheduledTransferNotFound, "id %d", req.Id).Error(),
+		)
+	}
+
+	return &types.QueryScheduledTransferResponse{
+		ScheduledTransfer: scheduledTransfer,
+	}, nil
+}
+
+// ScheduledTransfers implements the ScheduledTransfers gRPC method
+func (k *Keeper) ScheduledTransfers(c context.Context, req *types.QueryScheduledTransfersRequest) (*types.QueryScheduledTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var scheduledTransfers []types.ScheduledTransfer
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledTransferKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var scheduledTransfer types.ScheduledTransfer
+		if err := k.cdc.Unmarshal(value, &scheduledTransfer); err != nil {
+			return err
+		}
+
+		scheduledTransfers = append(scheduledTransfers, scheduledTransfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledTransfersResponse{
+		ScheduledTransfers: scheduledTransfers,
+		Pagination:         pageRes,
+	}, nil
+}
@@ -4,12 +4,17 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
@@ -163,6 +168,393 @@ func (s *KeeperTestSuite) TestQueryDenoms() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryDenomsByBaseDenom() {
+	var (
+		req       *types.QueryDenomsByBaseDenomRequest
+		expDenoms types.Denoms
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no denoms",
+			func() {
+				req = &types.QueryDenomsByBaseDenomRequest{BaseDenom: "uatom"}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				expDenoms = types.Denoms{
+					types.NewDenom("uatom", types.NewHop("transfer", "channelToB")),
+					types.NewDenom("uatom", types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB")),
+				}
+
+				for _, denom := range expDenoms {
+					s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), denom)
+				}
+
+				// denoms with a different base denom are not returned
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), types.NewDenom("uatom2", types.NewHop("transfer", "channelToB")))
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), types.NewDenom("uosmo", types.NewHop("transfer", "channelToB")))
+
+				req = &types.QueryDenomsByBaseDenomRequest{
+					BaseDenom: "uatom",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: base denom with slashes",
+			func() {
+				expDenoms = types.Denoms{types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channelToB"))}
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), expDenoms[0])
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), types.NewDenom("gamm", types.NewHop("transfer", "channelToB")))
+
+				req = &types.QueryDenomsByBaseDenomRequest{BaseDenom: "gamm/pool/1"}
+			},
+			nil,
+		},
+		{
+			"failure: empty base denom",
+			func() {
+				req = &types.QueryDenomsByBaseDenomRequest{BaseDenom: "  "}
+			},
+			status.Error(codes.InvalidArgument, "base denom cannot be empty"),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest() // reset
+			expDenoms = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.DenomsByBaseDenom(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().ElementsMatch(expDenoms, res.Denoms)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenomsByHop() {
+	var (
+		req       *types.QueryDenomsByHopRequest
+		expDenoms types.Denoms
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no denoms",
+			func() {
+				req = &types.QueryDenomsByHopRequest{PortId: "transfer", ChannelId: "channelToB"}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				expDenoms = types.Denoms{
+					types.NewDenom("uatom", types.NewHop("transfer", "channelToB")),
+					types.NewDenom("uosmo", types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB")),
+					types.NewDenom("ujuno", types.NewHop("transfer", "channelToB"), types.NewHop("transfer", "channelToC")),
+				}
+
+				for _, denom := range expDenoms {
+					s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), denom)
+				}
+
+				// denoms without the hop in their trace are not returned
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), types.NewDenom("uatom", types.NewHop("transfer", "channelToA")))
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), types.NewDenom("uatom", types.NewHop("transfer", "channelToB1")))
+
+				req = &types.QueryDenomsByHopRequest{
+					PortId:    "transfer",
+					ChannelId: "channelToB",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: invalid port ID",
+			func() {
+				req = &types.QueryDenomsByHopRequest{PortId: "", ChannelId: "channelToB"}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req = &types.QueryDenomsByHopRequest{PortId: "transfer", ChannelId: ""}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest() // reset
+			expDenoms = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.DenomsByHop(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().ElementsMatch(expDenoms, res.Denoms)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenomTransferEnabled() {
+	var (
+		req          *types.QueryDenomTransferEnabledRequest
+		expOverrides []types.DenomTransferEnabled
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryDenomTransferEnabledRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				denom := types.NewDenom("uatom", types.NewHop("transfer", "channelToB"))
+				expOverrides = []types.DenomTransferEnabled{
+					types.NewDenomTransferEnabled(denom.IBCDenom(), false, false),
+					types.NewDenomTransferEnabled(sdk.DefaultBondDenom, false, true),
+				}
+
+				for _, override := range expOverrides {
+					s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), override)
+				}
+
+				req = &types.QueryDenomTransferEnabledRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest() // reset
+			expOverrides = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.DenomTransferEnabled(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expOverrides, res.Denoms)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryChannelTransferEnabled() {
+	var (
+		req          *types.QueryChannelTransferEnabledRequest
+		expOverrides []types.ChannelTransferEnabled
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryChannelTransferEnabledRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				expOverrides = []types.ChannelTransferEnabled{
+					types.NewChannelTransferEnabled(ibctesting.FirstClientID, false, false),
+					types.NewChannelTransferEnabled(ibctesting.FirstChannelID, true, false),
+				}
+
+				for _, override := range expOverrides {
+					s.chainA.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainA.GetContext(), override)
+				}
+
+				req = &types.QueryChannelTransferEnabledRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest() // reset
+			expOverrides = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.ChannelTransferEnabled(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expOverrides, res.Channels)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenomMetadata() {
+	var (
+		req         *types.QueryDenomMetadataRequest
+		expMetadata []types.DenomMetadata
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryDenomMetadataRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				denom := types.NewDenom("uatom", types.NewHop("transfer", "channelToB"))
+				expMetadata = []types.DenomMetadata{types.NewDenomMetadata(denom, "Atom", "ATOM", "atom", 6, "", "")}
+
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), denom)
+				s.chainA.GetSimApp().TransferKeeper.SetRegisteredDenomMetadata(s.chainA.GetContext(), expMetadata[0])
+
+				req = &types.QueryDenomMetadataRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest() // reset
+			expMetadata = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.DenomMetadata(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expMetadata, res.Metadata)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryParams() {
 	ctx := s.chainA.GetContext()
 	expParams := types.DefaultParams()
@@ -396,3 +788,217 @@ func (s *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEscrowReconciliation() {
+	var (
+		req              *types.QueryEscrowReconciliationRequest
+		expAccounts      []types.EscrowAccount
+		expDiscrepancies []types.EscrowDiscrepancy
+	)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		msg      string
+		malleate func(path *ibctesting.Path)
+		expErr   error
+	}{
+		{
+			"success: no escrow accounts",
+			func(_ *ibctesting.Path) {},
+			nil,
+		},
+		{
+			"success: escrow balance matches tracked total escrow",
+			func(path *ibctesting.Path) {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				s.Require().NoError(banktestutil.FundAccount(s.chainA.GetContext(), s.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(coin)))
+				s.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(s.chainA.GetContext(), coin)
+
+				expAccounts = []types.EscrowAccount{{ChannelId: path.EndpointA.ChannelID, Address: escrowAddress.String(), Balances: sdk.NewCoins(coin)}}
+			},
+			nil,
+		},
+		{
+			"success: channel and client escrow balances differ from tracked total escrow",
+			func(path *ibctesting.Path) {
+				channelEscrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				s.Require().NoError(banktestutil.FundAccount(s.chainA.GetContext(), s.chainA.GetSimApp().BankKeeper, channelEscrowAddress, sdk.NewCoins(coin)))
+
+				clientEscrowAddress := types.GetEscrowAddress(types.PortID, path.EndpointA.ClientID)
+				s.Require().NoError(banktestutil.FundAccount(s.chainA.GetContext(), s.chainA.GetSimApp().BankKeeper, clientEscrowAddress, sdk.NewCoins(coin)))
+
+				trackedCoin := sdk.NewCoin("atom", sdkmath.NewInt(50))
+				s.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(s.chainA.GetContext(), trackedCoin)
+
+				expAccounts = []types.EscrowAccount{
+					{ChannelId: path.EndpointA.ChannelID, Address: channelEscrowAddress.String(), Balances: sdk.NewCoins(coin)},
+					{ChannelId: path.EndpointA.ClientID, Address: clientEscrowAddress.String(), Balances: sdk.NewCoins(coin)},
+				}
+				expDiscrepancies = []types.EscrowDiscrepancy{
+					{Tracked: trackedCoin, Escrowed: sdk.NewCoin("atom", sdkmath.ZeroInt())},
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt()), Escrowed: coin.Add(coin)},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func(_ *ibctesting.Path) {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			req = &types.QueryEscrowReconciliationRequest{}
+			expAccounts = nil
+			expDiscrepancies = nil
+
+			tc.malleate(path)
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expAccounts, res.EscrowAccounts)
+				s.Require().Equal(expDiscrepancies, res.Discrepancies)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryScheduledTransfer() {
+	var (
+		req                  *types.QueryScheduledTransferRequest
+		expScheduledTransfer types.ScheduledTransfer
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				transfer := types.NewMsgTransfer(types.PortID, ibctesting.FirstChannelID, ibctesting.TestCoin, s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, s.chainB.GetTimeoutHeight(), 0, "")
+				expScheduledTransfer = types.NewScheduledTransfer(1, *transfer, 100, 0)
+				s.chainA.GetSimApp().TransferKeeper.SetScheduledTransfer(s.chainA.GetContext(), expScheduledTransfer)
+
+				req = &types.QueryScheduledTransferRequest{Id: 1}
+			},
+			nil,
+		},
+		{
+			"failure: scheduled transfer not found",
+			func() {
+				req = &types.QueryScheduledTransferRequest{Id: 1}
+			},
+			status.Error(codes.NotFound, "id 1: scheduled transfer not found"),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.ScheduledTransfer(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expScheduledTransfer, res.ScheduledTransfer)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryScheduledTransfers() {
+	var (
+		req                   *types.QueryScheduledTransfersRequest
+		expScheduledTransfers []types.ScheduledTransfer
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: empty",
+			func() {
+				req = &types.QueryScheduledTransfersRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				heightTransfer := types.NewMsgTransfer(types.PortID, ibctesting.FirstChannelID, ibctesting.TestCoin, s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, s.chainB.GetTimeoutHeight(), 0, "")
+				timestampTransfer := types.NewMsgTransfer(types.PortID, ibctesting.FirstChannelID, ibctesting.TestCoin, s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, clienttypes.ZeroHeight(), 200, "")
+				expScheduledTransfers = []types.ScheduledTransfer{
+					types.NewScheduledTransfer(1, *heightTransfer, 100, 0),
+					types.NewScheduledTransfer(2, *timestampTransfer, 0, 100),
+				}
+				for _, scheduledTransfer := range expScheduledTransfers {
+					s.chainA.GetSimApp().TransferKeeper.SetScheduledTransfer(s.chainA.GetContext(), scheduledTransfer)
+				}
+
+				req = &types.QueryScheduledTransfersRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+			expScheduledTransfers = nil
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.ScheduledTransfers(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expScheduledTransfers, res.ScheduledTransfers)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
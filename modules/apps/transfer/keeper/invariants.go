@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom", TotalEscrowPerDenomInvariants(k))
+}
+
+// AllInvariants runs all invariants of the transfer module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalEscrowPerDenomInvariants(k)(ctx)
+	}
+}
+
+// TotalEscrowPerDenomInvariants checks that the sum of the balances of all escrow accounts
+// is not smaller than the total escrow tracked for each denomination. The balances may be
+// larger, since anyone can send tokens directly to an escrow account.
+func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		for _, discrepancy := range k.GetEscrowDiscrepancies(ctx, k.GetEscrowAccounts(ctx)) {
+			if discrepancy.Escrowed.IsLT(discrepancy.Tracked) {
+				msg += fmt.Sprintf("\tdenom %s: tracked total escrow %s, escrow account balances %s\n", discrepancy.Tracked.Denom, discrepancy.Tracked.Amount, discrepancy.Escrowed.Amount)
+			}
+		}
+
+		broken := msg != ""
+		return sdk.FormatInvariant(
+			types.ModuleName, "total escrow per denom",
+			fmt.Sprintf("found denom(s) with escrow account balances lower than the tracked total escrow:\n%s", msg),
+		), broken
+	}
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	corestore "cosmossdk.io/core/store"
@@ -30,10 +32,16 @@ type Keeper struct {
 
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	msgRouter     types.MessageRouter
 	AuthKeeper    types.AccountKeeper
 	BankKeeper    types.BankKeeper
 
+	// validators of the top-level memo keys owned by the middlewares of the transfer stack
+	memoKeyValidators map[string]types.MemoKeyValidator
+	// handlers of received transfers, by the name used in the receive hook memo
+	receiveHookHandlers map[string]types.ReceiveHookHandler
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -44,6 +52,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestore.KVStoreService,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
 	msgRouter types.MessageRouter,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -63,10 +72,17 @@ func NewKeeper(
 		storeService:  storeService,
 		ics4Wrapper:   channelKeeper, // default ICS4Wrapper is the channel keeper
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		msgRouter:     msgRouter,
 		AuthKeeper:    authKeeper,
 		BankKeeper:    bankKeeper,
 		authority:     authority,
+
+		// the receive hook memo key is owned by the transfer module itself
+		memoKeyValidators: map[string]types.MemoKeyValidator{
+			types.ReceiveHookKey: types.ValidateReceiveHookMemo,
+		},
+		receiveHookHandlers: make(map[string]types.ReceiveHookHandler),
 	}
 }
 
@@ -157,10 +173,24 @@ func (k *Keeper) HasDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) bool {
 
 // SetDenom sets a new {denom hash -> denom } pair to the store.
 // This allows for reverse lookup of the denom given the hash.
+// The denom hash is also indexed by the base denom and by each hop of the trace.
 func (k *Keeper) SetDenom(ctx sdk.Context, denom types.Denom) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomKey)
 	bz := k.cdc.MustMarshal(&denom)
 	store.Set(denom.Hash(), bz)
+
+	k.setDenomIndexes(ctx, denom)
+}
+
+// setDenomIndexes indexes the denom hash by the base denom and by each hop of the trace.
+func (k *Keeper) setDenomIndexes(ctx sdk.Context, denom types.Denom) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	hash := denom.Hash()
+
+	store.Set(append(types.DenomByBaseDenomPrefix(denom.Base), hash...), hash)
+	for _, hop := range denom.Trace {
+		store.Set(append(types.DenomByHopPrefix(hop.PortId, hop.ChannelId), hash...), hash)
+	}
 }
 
 // GetAllDenoms returns all the denominations.
@@ -190,8 +220,8 @@ func (k *Keeper) IterateDenoms(ctx sdk.Context, cb func(denom types.Denom) bool)
 	}
 }
 
-// SetDenomMetadata sets an IBC token's denomination metadata
-func (k *Keeper) SetDenomMetadata(ctx sdk.Context, denom types.Denom) {
+// SetDefaultDenomMetadata sets an IBC token's default denomination metadata
+func (k *Keeper) SetDefaultDenomMetadata(ctx sdk.Context, denom types.Denom) {
 	metadata := banktypes.Metadata{
 		Description: fmt.Sprintf("IBC token from %s", denom.Path()),
 		DenomUnits: []*banktypes.DenomUnit{
@@ -212,6 +242,136 @@ func (k *Keeper) SetDenomMetadata(ctx sdk.Context, denom types.Denom) {
 	k.BankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
+// GetDenomTransferEnabled returns the transfer enablement override for the given
+// denomination. If no override is stored, a DenomTransferEnabled with both sending
+// and receiving enabled is returned.
+func (k *Keeper) GetDenomTransferEnabled(ctx sdk.Context, denom string) types.DenomTransferEnabled {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferEnabledKey)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.NewDenomTransferEnabled(denom, true, true)
+	}
+
+	var denomTransferEnabled types.DenomTransferEnabled
+	k.cdc.MustUnmarshal(bz, &denomTransferEnabled)
+
+	return denomTransferEnabled
+}
+
+// SetDenomTransferEnabled stores the transfer enablement override for a denomination.
+// The override is deleted if it enables both sending and receiving.
+func (k *Keeper) SetDenomTransferEnabled(ctx sdk.Context, denomTransferEnabled types.DenomTransferEnabled) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferEnabledKey)
+	if denomTransferEnabled.IsDefault() {
+		store.Delete([]byte(denomTransferEnabled.Denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&denomTransferEnabled)
+	store.Set([]byte(denomTransferEnabled.Denom), bz)
+}
+
+// GetAllDenomTransferEnabled returns all the per denomination transfer enablement overrides.
+func (k *Keeper) GetAllDenomTransferEnabled(ctx sdk.Context) []types.DenomTransferEnabled {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomTransferEnabledKey)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var overrides []types.DenomTransferEnabled
+	for ; iterator.Valid(); iterator.Next() {
+		var denomTransferEnabled types.DenomTransferEnabled
+		k.cdc.MustUnmarshal(iterator.Value(), &denomTransferEnabled)
+		overrides = append(overrides, denomTransferEnabled)
+	}
+
+	return overrides
+}
+
+// GetChannelTransferEnabled returns the transfer enablement override for the given
+// channel (IBC v1) or client (IBC v2) identifier. If no override is stored, a
+// ChannelTransferEnabled with both sending and receiving enabled is returned.
+func (k *Keeper) GetChannelTransferEnabled(ctx sdk.Context, channelID string) types.ChannelTransferEnabled {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferEnabledKey)
+	bz := store.Get([]byte(channelID))
+	if len(bz) == 0 {
+		return types.NewChannelTransferEnabled(channelID, true, true)
+	}
+
+	var channelTransferEnabled types.ChannelTransferEnabled
+	k.cdc.MustUnmarshal(bz, &channelTransferEnabled)
+
+	return channelTransferEnabled
+}
+
+// SetChannelTransferEnabled stores the transfer enablement override for a channel or client.
+// The override is deleted if it enables both sending and receiving.
+func (k *Keeper) SetChannelTransferEnabled(ctx sdk.Context, channelTransferEnabled types.ChannelTransferEnabled) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferEnabledKey)
+	if channelTransferEnabled.IsDefault() {
+		store.Delete([]byte(channelTransferEnabled.ChannelId))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&channelTransferEnabled)
+	store.Set([]byte(channelTransferEnabled.ChannelId), bz)
+}
+
+// GetAllChannelTransferEnabled returns all the per channel or client transfer enablement overrides.
+func (k *Keeper) GetAllChannelTransferEnabled(ctx sdk.Context) []types.ChannelTransferEnabled {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelTransferEnabledKey)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var overrides []types.ChannelTransferEnabled
+	for ; iterator.Valid(); iterator.Next() {
+		var channelTransferEnabled types.ChannelTransferEnabled
+		k.cdc.MustUnmarshal(iterator.Value(), &channelTransferEnabled)
+		overrides = append(overrides, channelTransferEnabled)
+	}
+
+	return overrides
+}
+
+// GetRegisteredDenomMetadata returns the registered metadata of the IBC voucher with the given denomination hash.
+func (k *Keeper) GetRegisteredDenomMetadata(ctx sdk.Context, denomHash cmtbytes.HexBytes) (types.DenomMetadata, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomMetadataKey)
+	bz := store.Get(denomHash)
+	if len(bz) == 0 {
+		return types.DenomMetadata{}, false
+	}
+
+	var metadata types.DenomMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetRegisteredDenomMetadata stores the registered metadata of an IBC voucher and sets it
+// as the voucher's bank denomination metadata, replacing the default metadata.
+func (k *Keeper) SetRegisteredDenomMetadata(ctx sdk.Context, metadata types.DenomMetadata) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomMetadataKey)
+	bz := k.cdc.MustMarshal(&metadata)
+	store.Set(metadata.Denom.Hash(), bz)
+
+	k.BankKeeper.SetDenomMetaData(ctx, metadata.BankMetadata())
+}
+
+// GetAllRegisteredDenomMetadata returns the registered metadata of all IBC vouchers.
+func (k *Keeper) GetAllRegisteredDenomMetadata(ctx sdk.Context) []types.DenomMetadata {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomMetadataKey)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var metadata []types.DenomMetadata
+	for ; iterator.Valid(); iterator.Next() {
+		var denomMetadata types.DenomMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &denomMetadata)
+		metadata = append(metadata, denomMetadata)
+	}
+
+	return metadata
+}
+
 // GetTotalEscrowForDenom gets the total amount of source chain tokens that
 // are in escrow, keyed by the denomination.
 //
@@ -294,6 +454,71 @@ func (k *Keeper) IterateTokensInEscrow(ctx sdk.Context, storeprefix []byte, cb f
 	}
 }
 
+// GetEscrowAccounts returns the balances of all non-empty escrow accounts of the transfer
+// channels (IBC v1) and clients (IBC v2).
+func (k *Keeper) GetEscrowAccounts(ctx sdk.Context) []types.EscrowAccount {
+	portID := k.GetPort(ctx)
+
+	var escrowAccounts []types.EscrowAccount
+	addEscrowAccount := func(channelID string) {
+		escrowAddress := types.GetEscrowAddress(portID, channelID)
+		balances := k.BankKeeper.GetAllBalances(ctx, escrowAddress)
+		if balances.IsZero() {
+			return
+		}
+
+		escrowAccounts = append(escrowAccounts, types.EscrowAccount{
+			ChannelId: channelID,
+			Address:   escrowAddress.String(),
+			Balances:  balances,
+		})
+	}
+
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		addEscrowAccount(channel.ChannelId)
+	}
+
+	k.clientKeeper.IterateClientStates(ctx, nil, func(clientID string, _ exported.ClientState) bool {
+		addEscrowAccount(clientID)
+		return false
+	})
+
+	return escrowAccounts
+}
+
+// GetEscrowDiscrepancies compares the sum of the balances of all escrow accounts with the
+// total escrow tracked for each denomination, and returns the denominations for which they differ.
+func (k *Keeper) GetEscrowDiscrepancies(ctx sdk.Context, escrowAccounts []types.EscrowAccount) []types.EscrowDiscrepancy {
+	var escrowed sdk.Coins
+	for _, escrowAccount := range escrowAccounts {
+		escrowed = escrowed.Add(escrowAccount.Balances...)
+	}
+
+	tracked := k.GetAllTotalEscrowed(ctx)
+
+	// collect the denominations which are either tracked or escrowed, in sorted order
+	denoms := make(map[string]struct{})
+	for _, coin := range escrowed.Add(tracked...) {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	var discrepancies []types.EscrowDiscrepancy
+	for _, denom := range slices.Sorted(maps.Keys(denoms)) {
+		trackedAmount := tracked.AmountOf(denom)
+		escrowedAmount := escrowed.AmountOf(denom)
+		if trackedAmount.Equal(escrowedAmount) {
+			continue
+		}
+
+		discrepancies = append(discrepancies, types.EscrowDiscrepancy{
+			Tracked:  sdk.NewCoin(denom, trackedAmount),
+			Escrowed: sdk.NewCoin(denom, escrowedAmount),
+		})
+	}
+
+	return discrepancies
+}
+
 // IsBlockedAddr checks if the given address is allowed to send or receive tokens.
 // The module account is always allowed to send and receive tokens.
 func (k *Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
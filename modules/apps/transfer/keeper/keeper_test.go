@@ -59,6 +59,7 @@ func (s *KeeperTestSuite) TestNewKeeper() {
 				s.chainA.GetSimApp().AppCodec(),
 				runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(types.StoreKey)),
 				s.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				s.chainA.GetSimApp().IBCKeeper.ClientKeeper,
 				s.chainA.GetSimApp().MsgServiceRouter(),
 				s.chainA.GetSimApp().AccountKeeper,
 				s.chainA.GetSimApp().BankKeeper,
@@ -70,6 +71,7 @@ func (s *KeeperTestSuite) TestNewKeeper() {
 				s.chainA.GetSimApp().AppCodec(),
 				runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(types.StoreKey)),
 				s.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				s.chainA.GetSimApp().IBCKeeper.ClientKeeper,
 				s.chainA.GetSimApp().MsgServiceRouter(),
 				authkeeper.AccountKeeper{}, // empty account keeper
 				s.chainA.GetSimApp().BankKeeper,
@@ -81,6 +83,7 @@ func (s *KeeperTestSuite) TestNewKeeper() {
 				s.chainA.GetSimApp().AppCodec(),
 				runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(types.StoreKey)),
 				s.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				s.chainA.GetSimApp().IBCKeeper.ClientKeeper,
 				s.chainA.GetSimApp().MsgServiceRouter(),
 				s.chainA.GetSimApp().AccountKeeper,
 				s.chainA.GetSimApp().BankKeeper,
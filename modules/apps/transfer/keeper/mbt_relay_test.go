@@ -150,10 +150,10 @@ func FungibleTokenPacketFromTla(packet TlaFungibleTokenPacket) FungibleTokenPack
 		DestChannel:   packet.DestChannel,
 		DestPort:      packet.DestPort,
 		Data: types.NewInternalTransferRepresentation(
-			types.Token{
+			types.Tokens{types.Token{
 				Denom:  denom,
 				Amount: packet.Data.Amount,
-			},
+			}},
 			AddressFromString(packet.Data.Sender),
 			AddressFromString(packet.Data.Receiver),
 			"",
@@ -314,8 +314,8 @@ func (s *KeeperTestSuite) TestModelBasedRelay() {
 		for i, tlaTc := range tlaTestCases {
 			tc := OnRecvPacketTestCaseFromTla(tlaTc)
 			registerDenomFn := func() {
-				if !s.chainB.GetSimApp().TransferKeeper.HasDenom(s.chainB.GetContext(), tc.packet.Data.Token.Denom.Hash()) {
-					s.chainB.GetSimApp().TransferKeeper.SetDenom(s.chainB.GetContext(), tc.packet.Data.Token.Denom)
+				if !s.chainB.GetSimApp().TransferKeeper.HasDenom(s.chainB.GetContext(), tc.packet.Data.Tokens[0].Denom.Hash()) {
+					s.chainB.GetSimApp().TransferKeeper.SetDenom(s.chainB.GetContext(), tc.packet.Data.Tokens[0].Denom)
 				}
 			}
 
@@ -339,10 +339,10 @@ func (s *KeeperTestSuite) TestModelBasedRelay() {
 						panic(errors.New("MBT failed to convert sender address"))
 					}
 					registerDenomFn()
-					denom := tc.packet.Data.Token.Denom.IBCDenom()
+					denom := tc.packet.Data.Tokens[0].Denom.IBCDenom()
 					err = sdk.ValidateDenom(denom)
 					if err == nil {
-						amount, ok := sdkmath.NewIntFromString(tc.packet.Data.Token.Amount)
+						amount, ok := sdkmath.NewIntFromString(tc.packet.Data.Tokens[0].Amount)
 						if !ok {
 							panic(errors.New("MBT failed to parse amount from string"))
 						}
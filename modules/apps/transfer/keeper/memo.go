@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// RegisterMemoKeyValidator registers the validator of a top-level memo key owned by a
+// middleware of the transfer stack, e.g. the forward key of the packet forward middleware.
+// It panics if the key is empty or has already been registered.
+func (k *Keeper) RegisterMemoKeyValidator(key string, validator types.MemoKeyValidator) {
+	if strings.TrimSpace(key) == "" {
+		panic(errors.New("memo key cannot be empty"))
+	}
+	if validator == nil {
+		panic(fmt.Errorf("memo key validator for key %s cannot be nil", key))
+	}
+	if _, found := k.memoKeyValidators[key]; found {
+		panic(fmt.Errorf("memo key validator for key %s has already been registered", key))
+	}
+
+	k.memoKeyValidators[key] = validator
+}
+
+// ValidateMemo runs the registered validators on the top-level keys of the memo. If the
+// StrictMemoKeys param is enabled, keys without a registered validator are rejected. Memos
+// which are not JSON objects are not validated.
+func (k *Keeper) ValidateMemo(ctx sdk.Context, memo string) error {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]any)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	strict := k.GetParams(ctx).StrictMemoKeys
+
+	// keys are validated in sorted order for the returned error to be deterministic
+	for _, key := range slices.Sorted(maps.Keys(jsonObject)) {
+		validator, found := k.memoKeyValidators[key]
+		if !found {
+			if strict {
+				return errorsmod.Wrapf(types.ErrInvalidMemo, "memo key %s is not registered", key)
+			}
+			continue
+		}
+
+		if err := validator(jsonObject[key]); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidMemo, "invalid value for memo key %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const testMemoKey = "test_key"
+
+var errTestMemoKey = errors.New("value must be a string")
+
+func validateTestMemoKey(value any) error {
+	if _, ok := value.(string); !ok {
+		return errTestMemoKey
+	}
+	return nil
+}
+
+func (s *KeeperTestSuite) TestRegisterMemoKeyValidator() {
+	testCases := []struct {
+		name      string
+		key       string
+		validator types.MemoKeyValidator
+		panicMsg  string
+	}{
+		{
+			"success",
+			testMemoKey,
+			validateTestMemoKey,
+			"",
+		},
+		{
+			"failure: empty key",
+			" ",
+			validateTestMemoKey,
+			"memo key cannot be empty",
+		},
+		{
+			"failure: nil validator",
+			testMemoKey,
+			nil,
+			fmt.Sprintf("memo key validator for key %s cannot be nil", testMemoKey),
+		},
+		{
+			"failure: key already registered",
+			packetforwardtypes.ForwardMetadataKey,
+			validateTestMemoKey,
+			fmt.Sprintf("memo key validator for key %s has already been registered", packetforwardtypes.ForwardMetadataKey),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			registerFn := func() {
+				s.chainA.GetSimApp().TransferKeeper.RegisterMemoKeyValidator(tc.key, tc.validator)
+			}
+
+			if tc.panicMsg == "" {
+				s.Require().NotPanics(registerFn)
+			} else {
+				s.Require().PanicsWithError(tc.panicMsg, registerFn)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestValidateMemo() {
+	var (
+		memo   string
+		strict bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: empty memo",
+			func() {
+				memo = ""
+			},
+			nil,
+		},
+		{
+			"success: memo is not a JSON object",
+			func() {
+				memo = "memo"
+			},
+			nil,
+		},
+		{
+			"success: valid value for registered key",
+			func() {
+				memo = fmt.Sprintf(`{"%s": "value"}`, testMemoKey)
+			},
+			nil,
+		},
+		{
+			"success: unregistered key with strict memo keys disabled",
+			func() {
+				memo = fmt.Sprintf(`{"%s": "value", "unregistered": {}}`, testMemoKey)
+			},
+			nil,
+		},
+		{
+			"success: registered keys with strict memo keys enabled",
+			func() {
+				strict = true
+				memo = fmt.Sprintf(`{"%s": "value", "%s": {"receiver": "%s", "port": "%s", "channel": "%s"}}`, testMemoKey, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress, ibctesting.TransferPort, ibctesting.FirstChannelID)
+			},
+			nil,
+		},
+		{
+			"success: plain text memo with strict memo keys enabled",
+			func() {
+				strict = true
+				memo = "memo"
+			},
+			nil,
+		},
+		{
+			"failure: invalid value for registered key",
+			func() {
+				memo = fmt.Sprintf(`{"%s": 1}`, testMemoKey)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid forward metadata",
+			func() {
+				memo = fmt.Sprintf(`{"%s": {"receiver": "%s", "port": "%s"}}`, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress, ibctesting.TransferPort)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid forward metadata of next hop",
+			func() {
+				memo = fmt.Sprintf(`{"%s": {"receiver": "%s", "port": "%s", "channel": "%s", "next": {"%s": {"receiver": "%s", "port": "%s"}}}}`, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress, ibctesting.TransferPort, ibctesting.FirstChannelID, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress, ibctesting.TransferPort)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: unregistered key with strict memo keys enabled",
+			func() {
+				strict = true
+				memo = fmt.Sprintf(`{"%s": "value", "unregistered": {}}`, testMemoKey)
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			strict = false
+
+			s.chainA.GetSimApp().TransferKeeper.RegisterMemoKeyValidator(testMemoKey, validateTestMemoKey)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			params := s.chainA.GetSimApp().TransferKeeper.GetParams(ctx)
+			params.StrictMemoKeys = strict
+			s.chainA.GetSimApp().TransferKeeper.SetParams(ctx, params)
+
+			err := s.chainA.GetSimApp().TransferKeeper.ValidateMemo(ctx, memo)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
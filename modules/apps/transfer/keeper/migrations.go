@@ -155,3 +155,17 @@ func (k *Keeper) setDenomMetadataWithDenomTrace(ctx sdk.Context, denomTrace inte
 
 	k.BankKeeper.SetDenomMetaData(ctx, metadata)
 }
+
+// MigrateDenomIndexes indexes all denominations by their base denomination and by
+// each hop of their trace.
+func (m Migrator) MigrateDenomIndexes(ctx sdk.Context) error {
+	var count int
+	m.keeper.IterateDenoms(ctx, func(denom types.Denom) bool {
+		m.keeper.setDenomIndexes(ctx, denom)
+		count++
+		return false
+	})
+
+	m.keeper.Logger(ctx).Info("successfully indexed denominations", "number of denominations", count)
+	return nil
+}
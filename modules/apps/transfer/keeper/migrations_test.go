@@ -447,3 +447,37 @@ func (s *KeeperTestSuite) TestMigratorMigrateMetadata() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigratorMigrateDenomIndexes() {
+	denoms := transfertypes.Denoms{
+		transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0")),
+		transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-1"), transfertypes.NewHop("transfer", "channel-0")),
+		transfertypes.NewDenom("uosmo", transfertypes.NewHop("transfer", "channel-1")),
+	}
+
+	s.SetupTest() // reset
+
+	ctx := s.chainA.GetContext()
+	for _, denom := range denoms {
+		s.chainA.GetSimApp().TransferKeeper.SetDenomWithoutIndexes(ctx, denom)
+	}
+
+	res, err := s.chainA.GetSimApp().TransferKeeper.DenomsByBaseDenom(ctx, &transfertypes.QueryDenomsByBaseDenomRequest{BaseDenom: "uatom"})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Denoms)
+
+	migrator := transferkeeper.NewMigrator(*s.chainA.GetSimApp().TransferKeeper)
+	s.Require().NoError(migrator.MigrateDenomIndexes(ctx))
+
+	res, err = s.chainA.GetSimApp().TransferKeeper.DenomsByBaseDenom(ctx, &transfertypes.QueryDenomsByBaseDenomRequest{BaseDenom: "uatom"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(denoms[:2], res.Denoms)
+
+	hopRes, err := s.chainA.GetSimApp().TransferKeeper.DenomsByHop(ctx, &transfertypes.QueryDenomsByHopRequest{PortId: "transfer", ChannelId: "channel-1"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(denoms[1:], hopRes.Denoms)
+
+	hopRes, err = s.chainA.GetSimApp().TransferKeeper.DenomsByHop(ctx, &transfertypes.QueryDenomsByHopRequest{PortId: "transfer", ChannelId: "channel-0"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(denoms[:2], hopRes.Denoms)
+}
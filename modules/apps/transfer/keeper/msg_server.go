@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -27,30 +28,41 @@ func (k *Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types
 		return nil, types.ErrSendDisabled
 	}
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
-	if err != nil {
-		return nil, err
-	}
+	if msg.Unwind {
+		unwound, err := k.unwindTransfer(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 
-	coin := msg.Token
+		msg = unwound
+	}
 
-	// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
-	if coin.Amount.Equal(types.UnboundedSpendLimit()) {
-		coin.Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
-		if coin.Amount.IsZero() {
-			return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
-		}
+	if err := k.ValidateMemo(ctx, msg.Memo); err != nil {
+		return nil, err
 	}
 
-	token, err := k.TokenFromCoin(ctx, coin)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	packetData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, sender.String(), msg.Receiver, msg.Memo)
+	coins := msg.GetCoins()
+	tokens := make(types.Tokens, 0, len(coins))
+	for _, coin := range coins {
+		// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
+		if coin.Amount.Equal(types.UnboundedSpendLimit()) {
+			coin.Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
+			if coin.Amount.IsZero() {
+				return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
+			}
+		}
+
+		token, err := k.TokenFromCoin(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
 
-	if err := packetData.ValidateBasic(); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
+		tokens = append(tokens, token)
 	}
 
 	// if the channel does not exist, or we are using channel aliasing then use IBC V2 protocol
@@ -62,55 +74,84 @@ func (k *Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types
 	if isIBCV2 {
 		// otherwise try to send an IBC V2 packet, if the sourceChannel is not a IBC V2 client
 		// then core IBC will return a CounterpartyNotFound error
-		sequence, err = k.transferV2Packet(ctx, msg.Encoding, msg.SourceChannel, msg.TimeoutTimestamp, packetData)
+		sequence, err = k.transferV2Packet(ctx, msg.Encoding, msg.SourceChannel, msg.TimeoutTimestamp, sender.String(), msg.Receiver, msg.Memo, tokens)
 	} else {
 		// if a V1 channel exists for the source channel, then use IBC V1 protocol
-		sequence, err = k.transferV1Packet(ctx, msg.SourceChannel, token, msg.TimeoutHeight, msg.TimeoutTimestamp, packetData)
+		sequence, err = k.transferV1Packet(ctx, msg.SourceChannel, channel.Version, msg.TimeoutHeight, msg.TimeoutTimestamp, sender.String(), msg.Receiver, msg.Memo, tokens)
 		// telemetry for transfer occurs here, in IBC V2 this is done in the onSendPacket callback
-		telemetry.ReportTransfer(msg.SourcePort, msg.SourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, token)
+		telemetry.ReportTransfer(msg.SourcePort, msg.SourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, tokens)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", coin, "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins, "sender", msg.Sender, "receiver", msg.Receiver)
 
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
-func (k *Keeper) transferV1Packet(ctx sdk.Context, sourceChannel string, token types.Token, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, packetData types.FungibleTokenPacketData) (uint64, error) {
-	if err := k.SendTransfer(ctx, types.PortID, sourceChannel, token, sdk.MustAccAddressFromBech32(packetData.Sender)); err != nil {
+// transferV1Packet escrows or burns the tokens and sends an IBC V1 packet. Multiple tokens can
+// only be transferred over channels which negotiated the ICS20-V2 application version.
+func (k *Keeper) transferV1Packet(ctx sdk.Context, sourceChannel, appVersion string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, sender, receiver, memo string, tokens types.Tokens) (uint64, error) {
+	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.SendTransfer(ctx, types.PortID, sourceChannel, tokens, sdk.MustAccAddressFromBech32(sender)); err != nil {
 		return 0, err
 	}
 
-	packetDataBytes := packetData.GetBytes()
 	sequence, err := k.ics4Wrapper.SendPacket(ctx, types.PortID, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
 	if err != nil {
 		return 0, err
 	}
 
-	events.EmitTransferEvent(ctx, packetData.Sender, packetData.Receiver, token, packetData.Memo)
+	events.EmitTransferEvent(ctx, sender, receiver, tokens, memo)
 
 	return sequence, nil
 }
 
-func (k *Keeper) transferV2Packet(ctx sdk.Context, encoding, sourceChannel string, timeoutTimestamp uint64, packetData types.FungibleTokenPacketData) (uint64, error) {
+// transferV2Packet sends an IBC V2 packet through the msg router. A single token is sent in an
+// ICS20-V1 payload, while multiple tokens are sent atomically in an ICS20-V2 payload.
+func (k *Keeper) transferV2Packet(ctx sdk.Context, encoding, sourceChannel string, timeoutTimestamp uint64, sender, receiver, memo string, tokens types.Tokens) (uint64, error) {
 	if encoding == "" {
 		encoding = types.EncodingJSON
 	}
 
-	data, err := types.MarshalPacketData(packetData, types.V1, encoding)
+	var (
+		version string
+		data    []byte
+		err     error
+	)
+	if len(tokens) == 1 {
+		version = types.V1
+		packetData := types.NewFungibleTokenPacketData(tokens[0].Denom.Path(), tokens[0].Amount, sender, receiver, memo)
+		if err := packetData.ValidateBasic(); err != nil {
+			return 0, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
+		}
+
+		data, err = types.MarshalPacketData(packetData, types.V1, encoding)
+	} else {
+		version = types.V2
+		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, memo)
+		if err := packetData.ValidateBasic(); err != nil {
+			return 0, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
+		}
+
+		data, err = types.MarshalPacketDataV2(packetData, encoding)
+	}
 	if err != nil {
 		return 0, err
 	}
 
 	payload := channeltypesv2.NewPayload(
 		types.PortID, types.PortID,
-		types.V1, encoding, data,
+		version, encoding, data,
 	)
 	msg := channeltypesv2.NewMsgSendPacket(
 		sourceChannel, timeoutTimestamp,
-		packetData.Sender, payload,
+		sender, payload,
 	)
 
 	handler := k.msgRouter.Handler(msg)
@@ -150,3 +191,160 @@ func (k *Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetTransferEnabled defines an rpc handler method for MsgSetTransferEnabled. Sets or removes
+// the per denomination and per channel (or client) transfer enablement overrides.
+func (k *Keeper) SetTransferEnabled(goCtx context.Context, msg *types.MsgSetTransferEnabled) (*types.MsgSetTransferEnabledResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, denomTransferEnabled := range msg.Denoms {
+		k.SetDenomTransferEnabled(ctx, denomTransferEnabled)
+	}
+
+	for _, channelTransferEnabled := range msg.Channels {
+		k.SetChannelTransferEnabled(ctx, channelTransferEnabled)
+	}
+
+	return &types.MsgSetTransferEnabledResponse{}, nil
+}
+
+// SetDenomMetadata defines an rpc handler method for MsgSetDenomMetadata. Registers the bank
+// metadata of an IBC voucher. The signer must be the module authority or the denom metadata
+// registrar set in the module parameters.
+func (k *Keeper) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registrar := k.GetParams(ctx).DenomMetadataRegistrar
+	if k.GetAuthority() != msg.Signer && (registrar == "" || registrar != msg.Signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or denom metadata registrar, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	denom, found := k.GetDenom(ctx, msg.Metadata.Denom.Hash())
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrDenomNotFound, msg.Metadata.Denom.Path())
+	}
+
+	// use the denom as stored to guarantee the registered metadata matches the received voucher
+	metadata := msg.Metadata
+	metadata.Denom = denom
+	k.SetRegisteredDenomMetadata(ctx, metadata)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// ReconcileEscrow defines an rpc handler method for MsgReconcileEscrow. Sets the tracked total
+// escrow of each of the given denominations to the sum of the balances of all escrow accounts.
+func (k *Keeper) ReconcileEscrow(goCtx context.Context, msg *types.MsgReconcileEscrow) (*types.MsgReconcileEscrowResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var escrowed sdk.Coins
+	for _, escrowAccount := range k.GetEscrowAccounts(ctx) {
+		escrowed = escrowed.Add(escrowAccount.Balances...)
+	}
+
+	var reconciled []types.EscrowDiscrepancy
+	for _, denom := range msg.Denoms {
+		tracked := k.GetTotalEscrowForDenom(ctx, denom)
+		escrowedCoin := sdk.NewCoin(denom, escrowed.AmountOf(denom))
+		if tracked.Amount.Equal(escrowedCoin.Amount) {
+			continue
+		}
+
+		k.SetTotalEscrowForDenom(ctx, escrowedCoin)
+
+		discrepancy := types.EscrowDiscrepancy{Tracked: tracked, Escrowed: escrowedCoin}
+		reconciled = append(reconciled, discrepancy)
+		events.EmitReconcileEscrowEvent(ctx, discrepancy)
+	}
+
+	return &types.MsgReconcileEscrowResponse{Reconciled: reconciled}, nil
+}
+
+// ScheduleTransfer defines an rpc handler method for MsgScheduleTransfer. Escrows the tokens
+// of the transfer in the module account and stores the transfer, which is dispatched at the
+// end of the first block reaching the execution height or timestamp.
+func (k *Keeper) ScheduleTransfer(goCtx context.Context, msg *types.MsgScheduleTransfer) (*types.MsgScheduleTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.ExecuteHeight != 0 && msg.ExecuteHeight <= uint64(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScheduledTransfer, "execute height %d must be after the current height %d", msg.ExecuteHeight, ctx.BlockHeight())
+	}
+	if msg.ExecuteTimestamp != 0 && msg.ExecuteTimestamp <= uint64(ctx.BlockTime().UnixNano()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScheduledTransfer, "execute timestamp %d must be after the current block time %d", msg.ExecuteTimestamp, ctx.BlockTime().UnixNano())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Transfer.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// the escrowed tokens must be refundable to the sender
+	if k.IsBlockedAddr(sender) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to schedule transfers", sender)
+	}
+
+	// the amounts of the escrowed tokens are fixed when the transfer is scheduled
+	coins := slices.Clone(msg.Transfer.GetCoins())
+	for i, coin := range coins {
+		if coin.Amount.Equal(types.UnboundedSpendLimit()) {
+			coins[i].Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
+			if coins[i].Amount.IsZero() {
+				return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
+			}
+		}
+	}
+
+	transfer := msg.Transfer
+	if len(transfer.Tokens) > 0 {
+		transfer.Tokens = coins
+	} else {
+		transfer.Token = coins[0]
+	}
+
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	id := k.GetNextScheduledTransferID(ctx)
+	k.SetNextScheduledTransferID(ctx, id+1)
+
+	scheduledTransfer := types.NewScheduledTransfer(id, transfer, msg.ExecuteHeight, msg.ExecuteTimestamp)
+	k.SetScheduledTransfer(ctx, scheduledTransfer)
+
+	events.EmitScheduleTransferEvent(ctx, scheduledTransfer)
+
+	return &types.MsgScheduleTransferResponse{Id: id}, nil
+}
+
+// CancelScheduledTransfer defines an rpc handler method for MsgCancelScheduledTransfer. Removes
+// the scheduled transfer and refunds its escrowed tokens to the sender.
+func (k *Keeper) CancelScheduledTransfer(goCtx context.Context, msg *types.MsgCancelScheduledTransfer) (*types.MsgCancelScheduledTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduledTransfer, found := k.GetScheduledTransfer(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledTransferNotFound, "id %d", msg.Id)
+	}
+
+	if scheduledTransfer.Transfer.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", scheduledTransfer.Transfer.Sender, msg.Sender)
+	}
+
+	k.deleteScheduledTransfer(ctx, scheduledTransfer)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, scheduledTransfer.Transfer.GetCoins()); err != nil {
+		return nil, err
+	}
+
+	events.EmitCancelScheduledTransferEvent(ctx, scheduledTransfer)
+
+	return &types.MsgCancelScheduledTransferResponse{}, nil
+}
@@ -2,15 +2,20 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
@@ -61,6 +66,24 @@ func (s *KeeperTestSuite) TestMsgTransfer() {
 			},
 			types.ErrSendDisabled,
 		},
+		{
+			"failure: invalid value for registered memo key",
+			func() {
+				msg.Memo = fmt.Sprintf(`{"%s": {"receiver": "%s"}}`, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: unregistered memo key with strict memo keys enabled",
+			func() {
+				params := s.chainA.GetSimApp().TransferKeeper.GetParams(s.chainA.GetContext())
+				params.StrictMemoKeys = true
+				s.chainA.GetSimApp().TransferKeeper.SetParams(s.chainA.GetContext(), params)
+
+				msg.Memo = `{"unregistered": {}}`
+			},
+			types.ErrInvalidMemo,
+		},
 		{
 			"failure: invalid sender",
 			func() {
@@ -203,6 +226,24 @@ func (s *KeeperTestSuite) TestMsgTransferIBCV2() {
 			},
 			types.ErrSendDisabled,
 		},
+		{
+			"failure: invalid value for registered memo key",
+			func() {
+				msg.Memo = fmt.Sprintf(`{"%s": {"receiver": "%s"}}`, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: unregistered memo key with strict memo keys enabled",
+			func() {
+				params := s.chainA.GetSimApp().TransferKeeper.GetParams(s.chainA.GetContext())
+				params.StrictMemoKeys = true
+				s.chainA.GetSimApp().TransferKeeper.SetParams(s.chainA.GetContext(), params)
+
+				msg.Memo = `{"unregistered": {}}`
+			},
+			types.ErrInvalidMemo,
+		},
 		{
 			"failure: invalid sender",
 			func() {
@@ -350,3 +391,232 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestSetTransferEnabled tests SetTransferEnabled rpc handler
+func (s *KeeperTestSuite) TestSetTransferEnabled() {
+	signer := s.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	denoms := []types.DenomTransferEnabled{types.NewDenomTransferEnabled(sdk.DefaultBondDenom, false, true)}
+	channels := []types.ChannelTransferEnabled{types.NewChannelTransferEnabled(ibctesting.FirstChannelID, true, false)}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetTransferEnabled
+		expErr error
+	}{
+		{
+			"success: valid signer",
+			types.NewMsgSetTransferEnabled(signer, denoms, channels),
+			nil,
+		},
+		{
+			"failure: malformed signer address",
+			types.NewMsgSetTransferEnabled(ibctesting.InvalidID, denoms, channels),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, denoms, channels),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.chainA.GetContext()
+			_, err := s.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(ctx, tc.msg)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(denoms, s.chainA.GetSimApp().TransferKeeper.GetAllDenomTransferEnabled(ctx))
+				s.Require().Equal(channels, s.chainA.GetSimApp().TransferKeeper.GetAllChannelTransferEnabled(ctx))
+
+				// enabling both send and receive removes the overrides
+				msg := types.NewMsgSetTransferEnabled(
+					signer,
+					[]types.DenomTransferEnabled{types.NewDenomTransferEnabled(sdk.DefaultBondDenom, true, true)},
+					[]types.ChannelTransferEnabled{types.NewChannelTransferEnabled(ibctesting.FirstChannelID, true, true)},
+				)
+				_, err = s.chainA.GetSimApp().TransferKeeper.SetTransferEnabled(ctx, msg)
+				s.Require().NoError(err)
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllDenomTransferEnabled(ctx))
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllChannelTransferEnabled(ctx))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllDenomTransferEnabled(ctx))
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllChannelTransferEnabled(ctx))
+			}
+		})
+	}
+}
+
+// TestSetDenomMetadata tests SetDenomMetadata rpc handler
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	var (
+		signer   string
+		metadata types.DenomMetadata
+	)
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+	registrar := s.chainA.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authority",
+			func() {},
+			nil,
+		},
+		{
+			"success: denom metadata registrar",
+			func() {
+				params := s.chainA.GetSimApp().TransferKeeper.GetParams(s.chainA.GetContext())
+				params.DenomMetadataRegistrar = registrar
+				s.chainA.GetSimApp().TransferKeeper.SetParams(s.chainA.GetContext(), params)
+
+				signer = registrar
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer without registrar",
+			func() {
+				signer = registrar
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: empty signer without registrar",
+			func() {
+				signer = ""
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unauthorized signer with registrar",
+			func() {
+				params := s.chainA.GetSimApp().TransferKeeper.GetParams(s.chainA.GetContext())
+				params.DenomMetadataRegistrar = registrar
+				s.chainA.GetSimApp().TransferKeeper.SetParams(s.chainA.GetContext(), params)
+
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denom not found",
+			func() {
+				metadata.Denom = types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+			},
+			types.ErrDenomNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.chainA.GetContext()
+
+			s.chainA.GetSimApp().TransferKeeper.SetDenom(ctx, denom)
+			s.chainA.GetSimApp().TransferKeeper.SetDefaultDenomMetadata(ctx, denom)
+
+			signer = s.chainA.GetSimApp().TransferKeeper.GetAuthority()
+			metadata = types.NewDenomMetadata(denom, "Stake", "STAKE", "stake", 6, "https://ibc.cosmos.network", "")
+
+			tc.malleate()
+
+			_, err := s.chainA.GetSimApp().TransferKeeper.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(signer, metadata))
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+
+				registered, found := s.chainA.GetSimApp().TransferKeeper.GetRegisteredDenomMetadata(ctx, denom.Hash())
+				s.Require().True(found)
+				s.Require().Equal(metadata, registered)
+
+				bankMetadata, found := s.chainA.GetSimApp().BankKeeper.GetDenomMetaData(ctx, denom.IBCDenom())
+				s.Require().True(found)
+				s.Require().Equal(metadata.BankMetadata(), bankMetadata)
+				s.Require().NoError(bankMetadata.Validate())
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllRegisteredDenomMetadata(ctx))
+			}
+		})
+	}
+}
+
+// TestReconcileEscrow tests ReconcileEscrow rpc handler
+func (s *KeeperTestSuite) TestReconcileEscrow() {
+	var (
+		signer string
+		denoms []string
+	)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	trackedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expReconciled []types.EscrowDiscrepancy
+		expErr        error
+	}{
+		{
+			"success: tracked total escrow set to escrow balance",
+			func() {},
+			[]types.EscrowDiscrepancy{{Tracked: trackedCoin, Escrowed: coin}},
+			nil,
+		},
+		{
+			"success: denom without discrepancy is left unchanged",
+			func() {
+				denoms = []string{"atom"}
+			},
+			nil,
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				signer = ibctesting.TestAccAddress
+			},
+			nil,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			ctx := s.chainA.GetContext()
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			s.Require().NoError(banktestutil.FundAccount(ctx, s.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(coin)))
+			s.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, trackedCoin)
+
+			signer = s.chainA.GetSimApp().TransferKeeper.GetAuthority()
+			denoms = []string{sdk.DefaultBondDenom}
+
+			tc.malleate()
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.ReconcileEscrow(ctx, types.NewMsgReconcileEscrow(signer, denoms))
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expReconciled, res.Reconciled)
+
+				expTracked := trackedCoin
+				if len(tc.expReconciled) > 0 {
+					expTracked = coin
+				}
+				s.Require().Equal(expTracked, s.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(trackedCoin, s.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// RegisterReceiveHookHandler registers a handler of received transfers under the given name, which
+// transfers name in their receive hook memo. It panics if the name is empty or has already been
+// registered.
+func (k *Keeper) RegisterReceiveHookHandler(name string, handler types.ReceiveHookHandler) {
+	if strings.TrimSpace(name) == "" {
+		panic(errors.New("receive hook handler name cannot be empty"))
+	}
+	if handler == nil {
+		panic(fmt.Errorf("receive hook handler %s cannot be nil", name))
+	}
+	if _, found := k.receiveHookHandlers[name]; found {
+		panic(fmt.Errorf("receive hook handler %s has already been registered", name))
+	}
+
+	k.receiveHookHandlers[name] = handler
+}
+
+// executeReceiveHook invokes the handler named by the receive hook memo with the received transfer.
+// The state changes of the handler are only written if it succeeds. Any coins the handler leaves in
+// the intermediate account are then sent to the receiver, so that they are not stranded.
+func (k *Keeper) executeReceiveHook(ctx sdk.Context, hookMemo types.ReceiveHookMemo, transfer types.ReceivedTransfer, receiver sdk.AccAddress) error {
+	handler, found := k.receiveHookHandlers[hookMemo.Handler]
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidReceiveHook, "receive hook handler %s is not registered", hookMemo.Handler)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := handler.OnTransferReceived(cacheCtx, transfer); err != nil {
+		return errorsmod.Wrapf(types.ErrReceiveHookFailed, "receive hook handler %s failed: %s", hookMemo.Handler, err)
+	}
+
+	if remaining := k.BankKeeper.GetAllBalances(cacheCtx, transfer.IntermediateAddress); !remaining.IsZero() {
+		if err := k.BankKeeper.SendCoins(cacheCtx, transfer.IntermediateAddress, receiver, remaining); err != nil {
+			return errorsmod.Wrapf(err, "failed to send the coins left by receive hook handler %s to the receiver", hookMemo.Handler)
+		}
+	}
+
+	writeFn()
+
+	events.EmitReceiveHookEvent(ctx, hookMemo.Handler, transfer)
+
+	return nil
+}
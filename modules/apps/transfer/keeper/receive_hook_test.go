@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const testReceiveHookHandler = "test_handler"
+
+var errReceiveHookHandler = errors.New("receive hook handler failed")
+
+// mockReceiveHookHandler records the transfers it is invoked with and calls onTransferReceived.
+type mockReceiveHookHandler struct {
+	transfers          []types.ReceivedTransfer
+	onTransferReceived func(ctx sdk.Context, transfer types.ReceivedTransfer) error
+}
+
+func (h *mockReceiveHookHandler) OnTransferReceived(ctx sdk.Context, transfer types.ReceivedTransfer) error {
+	h.transfers = append(h.transfers, transfer)
+	return h.onTransferReceived(ctx, transfer)
+}
+
+func (s *KeeperTestSuite) TestRegisterReceiveHookHandler() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		handler  types.ReceiveHookHandler
+		panicMsg string
+	}{
+		{
+			"success",
+			func() {},
+			&mockReceiveHookHandler{},
+			"",
+		},
+		{
+			"failure: handler is nil",
+			func() {},
+			nil,
+			fmt.Sprintf("receive hook handler %s cannot be nil", testReceiveHookHandler),
+		},
+		{
+			"failure: handler already registered",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.RegisterReceiveHookHandler(testReceiveHookHandler, &mockReceiveHookHandler{})
+			},
+			&mockReceiveHookHandler{},
+			fmt.Sprintf("receive hook handler %s has already been registered", testReceiveHookHandler),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			tc.malleate()
+
+			registerFn := func() {
+				s.chainA.GetSimApp().TransferKeeper.RegisterReceiveHookHandler(testReceiveHookHandler, tc.handler)
+			}
+
+			if tc.panicMsg == "" {
+				s.Require().NotPanics(registerFn)
+			} else {
+				s.Require().PanicsWithError(tc.panicMsg, registerFn)
+			}
+		})
+	}
+
+	s.Run("failure: empty name", func() {
+		s.SetupTest() // reset
+
+		s.Require().PanicsWithError("receive hook handler name cannot be empty", func() {
+			s.chainA.GetSimApp().TransferKeeper.RegisterReceiveHookHandler(" ", &mockReceiveHookHandler{})
+		})
+	})
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacketReceiveHook() {
+	var (
+		packetData types.InternalTransferRepresentation
+		handler    *mockReceiveHookHandler
+	)
+
+	depositAddress := sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expMsg   json.RawMessage
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			json.RawMessage(`{"deposit":{}}`),
+			nil,
+		},
+		{
+			"success: without msg",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"receive_hook": {"handler": "%s"}}`, testReceiveHookHandler)
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: coins left by the handler are sent to the receiver",
+			func() {
+				handler.onTransferReceived = func(_ sdk.Context, _ types.ReceivedTransfer) error {
+					return nil
+				}
+			},
+			json.RawMessage(`{"deposit":{}}`),
+			nil,
+		},
+		{
+			"failure: handler returns an error",
+			func() {
+				deposit := handler.onTransferReceived
+				handler.onTransferReceived = func(ctx sdk.Context, transfer types.ReceivedTransfer) error {
+					// the deposit is reverted as the handler fails
+					s.Require().NoError(deposit(ctx, transfer))
+					return errReceiveHookHandler
+				}
+			},
+			json.RawMessage(`{"deposit":{}}`),
+			types.ErrReceiveHookFailed,
+		},
+		{
+			"failure: handler is not registered",
+			func() {
+				packetData.Memo = `{"receive_hook": {"handler": "unregistered"}}`
+			},
+			nil,
+			types.ErrInvalidReceiveHook,
+		},
+		{
+			"failure: invalid receive hook memo",
+			func() {
+				packetData.Memo = `{"receive_hook": {"handler": ""}}`
+			},
+			nil,
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			handler = &mockReceiveHookHandler{
+				// the handler deposits the received coins to the deposit address
+				onTransferReceived: func(ctx sdk.Context, transfer types.ReceivedTransfer) error {
+					return s.chainB.GetSimApp().BankKeeper.SendCoins(ctx, transfer.IntermediateAddress, depositAddress, transfer.Coins)
+				},
+			}
+			s.chainB.GetSimApp().TransferKeeper.RegisterReceiveHookHandler(testReceiveHookHandler, handler)
+
+			sender := s.chainA.SenderAccount.GetAddress().String()
+			receiver := s.chainB.SenderAccount.GetAddress().String()
+
+			token := types.Token{Denom: types.NewDenom(ibctesting.TestCoin.Denom), Amount: ibctesting.TestCoin.Amount.String()}
+			memo := fmt.Sprintf(`{"receive_hook": {"handler": "%s", "msg": {"deposit": {}}}}`, testReceiveHookHandler)
+			packetData = types.NewInternalTransferRepresentation(types.Tokens{token}, sender, receiver, memo)
+
+			tc.malleate()
+
+			ctx := s.chainB.GetContext()
+			err := s.chainB.GetSimApp().TransferKeeper.OnRecvPacket(
+				ctx,
+				packetData,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+			)
+
+			voucherDenom := types.NewDenom(token.Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+			intermediateAddress := types.GetReceiveHookIntermediateAddress(path.EndpointB.ChannelID, sender)
+			receivedCoins := sdk.NewCoins(sdk.NewCoin(voucherDenom, ibctesting.TestCoin.Amount))
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+
+				s.Require().Len(handler.transfers, 1)
+				s.Require().Equal(types.ReceivedTransfer{
+					SourcePort:          path.EndpointA.ChannelConfig.PortID,
+					SourceChannel:       path.EndpointA.ChannelID,
+					DestinationPort:     path.EndpointB.ChannelConfig.PortID,
+					DestinationChannel:  path.EndpointB.ChannelID,
+					Sender:              sender,
+					Receiver:            receiver,
+					IntermediateAddress: intermediateAddress,
+					Coins:               receivedCoins,
+					Msg:                 tc.expMsg,
+				}, handler.transfers[0])
+
+				// the coins are received by the deposit address, or by the receiver if the handler leaves them
+				// in the intermediate account
+				deposited := s.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, depositAddress)
+				leftOver := receivedCoins.Sub(deposited...)
+				s.Require().True(s.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, intermediateAddress).IsZero())
+				receiverBalance := s.chainB.GetSimApp().BankKeeper.GetBalance(ctx, s.chainB.SenderAccount.GetAddress(), voucherDenom)
+				s.Require().True(leftOver.Equal(sdk.NewCoins(receiverBalance)))
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+
+				// the receiver never receives the tokens of a failed transfer with a receive hook
+				s.Require().True(s.chainB.GetSimApp().BankKeeper.GetBalance(ctx, s.chainB.SenderAccount.GetAddress(), voucherDenom).IsZero())
+
+				s.Require().True(s.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, depositAddress).IsZero())
+			}
+		})
+	}
+}
+
+// TestReceiveHookErrorAcknowledgement tests that a failing receive hook handler results in an error
+// acknowledgement, reverting the receive of the tokens, and in the refund of the sender.
+func (s *KeeperTestSuite) TestReceiveHookErrorAcknowledgement() {
+	s.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	path.Setup()
+
+	handler := &mockReceiveHookHandler{
+		onTransferReceived: func(_ sdk.Context, _ types.ReceivedTransfer) error {
+			return errReceiveHookHandler
+		},
+	}
+	s.chainB.GetSimApp().TransferKeeper.RegisterReceiveHookHandler(testReceiveHookHandler, handler)
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	senderBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, ibctesting.TestCoin.Denom)
+
+	memo := fmt.Sprintf(`{"receive_hook": {"handler": "%s"}}`, testReceiveHookHandler)
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, sender.String(), s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, memo)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	_, ack, err := path.RelayPacketWithResults(packet)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrReceiveHookFailed).Acknowledgement(), ack)
+	s.Require().Len(handler.transfers, 1)
+
+	// the tokens minted to the intermediate account are reverted
+	intermediateAddress := types.GetReceiveHookIntermediateAddress(path.EndpointB.ChannelID, sender.String())
+	s.Require().True(s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), intermediateAddress).IsZero())
+
+	// the sender is refunded
+	s.Require().Equal(senderBalance, s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, ibctesting.TestCoin.Denom))
+}
@@ -47,30 +47,65 @@ import (
 // 4. A -> C : sender chain is sink zone. Denom upon receiving: 'C/B/denom'
 // 5. C -> B : sender chain is sink zone. Denom upon receiving: 'B/denom'
 // 6. B -> A : sender chain is sink zone. Denom upon receiving: 'denom'
+//
+// Multiple tokens can be transferred in a single packet. Each token is escrowed or
+// burned independently, and the transfer fails as a whole if any of them fails.
 func (k *Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token types.Token,
+	tokens types.Tokens,
 	sender sdk.AccAddress,
 ) error {
 	if !k.GetParams(ctx).SendEnabled {
 		return types.ErrSendDisabled
 	}
 
+	if !k.GetChannelTransferEnabled(ctx, sourceChannel).SendEnabled {
+		return errorsmod.Wrapf(types.ErrSendDisabled, "transfers over %s are disabled", sourceChannel)
+	}
+
 	if k.IsBlockedAddr(sender) {
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
-	coin, err := token.ToCoin()
+	coins, err := tokens.ToCoins()
 	if err != nil {
 		return err
 	}
 
-	if err := k.BankKeeper.IsSendEnabledCoins(ctx, coin); err != nil {
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
 		return errorsmod.Wrap(types.ErrSendDisabled, err.Error())
 	}
 
+	for _, coin := range coins {
+		if !k.GetDenomTransferEnabled(ctx, coin.Denom).SendEnabled {
+			return errorsmod.Wrapf(types.ErrSendDisabled, "transfers of %s are disabled", coin.Denom)
+		}
+	}
+
+	for _, token := range tokens {
+		if err := k.sendTransferToken(ctx, sourcePort, sourceChannel, token, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendTransferToken escrows or burns a single token sent in a transfer.
+func (k *Keeper) sendTransferToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token types.Token,
+	sender sdk.AccAddress,
+) error {
+	coin, err := token.ToCoin()
+	if err != nil {
+		return err
+	}
+
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
@@ -110,6 +145,10 @@ func (k *Keeper) SendTransfer(
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
+//
+// If the packet transfers multiple tokens and any of them cannot be received, an error
+// is returned and the state changes of the tokens received before are discarded
+// together with the error acknowledgement.
 func (k *Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	data types.InternalTransferRepresentation,
@@ -127,6 +166,14 @@ func (k *Keeper) OnRecvPacket(
 		return types.ErrReceiveDisabled
 	}
 
+	if !k.GetChannelTransferEnabled(ctx, destChannel).ReceiveEnabled {
+		return errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers over %s are disabled", destChannel)
+	}
+
+	if err := k.ValidateMemo(ctx, data.Memo); err != nil {
+		return err
+	}
+
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to decode receiver address: %s", data.Receiver)
@@ -136,14 +183,65 @@ func (k *Keeper) OnRecvPacket(
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 	}
 
-	token := data.Token
+	hookMemo, hasReceiveHook, err := types.GetReceiveHookMemo(data.Memo)
+	if err != nil {
+		return err
+	}
+
+	// if the memo has a receive hook, the tokens are received by the intermediate account
+	// and the handler of the hook is invoked with them
+	recipient := receiver
+	if hasReceiveHook {
+		recipient = types.GetReceiveHookIntermediateAddress(destChannel, data.Sender)
+	}
+
+	coins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := k.receiveToken(ctx, token, recipient, sourcePort, sourceChannel, destPort, destChannel)
+		if err != nil {
+			return err
+		}
+
+		coins = append(coins, coin)
+	}
+
+	if hasReceiveHook {
+		return k.executeReceiveHook(ctx, hookMemo, types.ReceivedTransfer{
+			SourcePort:          sourcePort,
+			SourceChannel:       sourceChannel,
+			DestinationPort:     destPort,
+			DestinationChannel:  destChannel,
+			Sender:              data.Sender,
+			Receiver:            data.Receiver,
+			IntermediateAddress: recipient,
+			Coins:               sdk.NewCoins(coins...),
+			Msg:                 hookMemo.Msg,
+		}, receiver)
+	}
+
+	// The ibc_module.go module will return the proper ack.
+	return nil
+}
 
+// receiveToken unescrows or mints a single token received in a transfer and sends it to the receiver.
+// It returns the received coin.
+func (k *Keeper) receiveToken(
+	ctx sdk.Context,
+	token types.Token,
+	receiver sdk.AccAddress,
+	sourcePort string,
+	sourceChannel string,
+	destPort string,
+	destChannel string,
+) (sdk.Coin, error) {
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
 	}
 
+	var received sdk.Coin
+
 	// This is the prefix that would have been prefixed to the denomination
 	// on sender chain IF and only if the token originally came from the
 	// receiving chain.
@@ -158,11 +256,16 @@ func (k *Keeper) OnRecvPacket(
 		token.Denom.Trace = token.Denom.Trace[1:]
 
 		coin := sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)
+		if !k.GetDenomTransferEnabled(ctx, coin.Denom).ReceiveEnabled {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled", coin.Denom)
+		}
 
 		escrowAddress := types.GetEscrowAddress(destPort, destChannel)
 		if err := k.UnescrowCoin(ctx, escrowAddress, receiver, coin); err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
+
+		received = coin
 	} else {
 		// sender chain is the source, mint vouchers
 
@@ -170,13 +273,17 @@ func (k *Keeper) OnRecvPacket(
 		trace := []types.Hop{types.NewHop(destPort, destChannel)}
 		token.Denom.Trace = append(trace, token.Denom.Trace...)
 
+		voucherDenom := token.Denom.IBCDenom()
+		if !k.GetDenomTransferEnabled(ctx, voucherDenom).ReceiveEnabled {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled", voucherDenom)
+		}
+
 		if !k.HasDenom(ctx, token.Denom.Hash()) {
 			k.SetDenom(ctx, token.Denom)
 		}
 
-		voucherDenom := token.Denom.IBCDenom()
 		if !k.BankKeeper.HasDenomMetaData(ctx, voucherDenom) {
-			k.SetDenomMetadata(ctx, token.Denom)
+			k.SetDefaultDenomMetadata(ctx, token.Denom)
 		}
 
 		events.EmitDenomEvent(ctx, token)
@@ -187,7 +294,7 @@ func (k *Keeper) OnRecvPacket(
 		if err := k.BankKeeper.MintCoins(
 			ctx, types.ModuleName, sdk.NewCoins(voucher),
 		); err != nil {
-			return errorsmod.Wrap(err, "failed to mint IBC tokens")
+			return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint IBC tokens")
 		}
 
 		// send to receiver
@@ -195,12 +302,13 @@ func (k *Keeper) OnRecvPacket(
 		if err := k.BankKeeper.SendCoins(
 			ctx, moduleAddr, receiver, sdk.NewCoins(voucher),
 		); err != nil {
-			return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 		}
+
+		received = voucher
 	}
 
-	// The ibc_module.go module will return the proper ack.
-	return nil
+	return received, nil
 }
 
 // OnAcknowledgementPacket responds to the success or failure of a packet acknowledgment
@@ -240,10 +348,10 @@ func (k *Keeper) OnTimeoutPacket(
 	return k.refundPacketTokens(ctx, sourcePort, sourceChannel, data)
 }
 
-// refundPacketTokens will unescrow and send back the token back to sender
-// if the sending chain was the source chain. Otherwise, the sent token
+// refundPacketTokens will unescrow and send back the tokens back to sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
+// the sending address. All tokens of the packet are refunded together.
 func (k *Keeper) refundPacketTokens(
 	ctx sdk.Context,
 	sourcePort string,
@@ -264,28 +372,29 @@ func (k *Keeper) refundPacketTokens(
 	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
 	moduleAccountAddr := k.AuthKeeper.GetModuleAddress(types.ModuleName)
-	token := data.Token
-	coin, err := token.ToCoin()
-	if err != nil {
-		return err
-	}
-
-	// if the token we must refund is prefixed by the source port and channel
-	// then the tokens were burnt when the packet was sent and we must mint new tokens
-	if token.Denom.HasPrefix(sourcePort, sourceChannel) {
-		// mint vouchers back to sender
-		if err := k.BankKeeper.MintCoins(
-			ctx, types.ModuleName, sdk.NewCoins(coin),
-		); err != nil {
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
 			return err
 		}
 
-		if err := k.BankKeeper.SendCoins(ctx, moduleAccountAddr, sender, sdk.NewCoins(coin)); err != nil {
-			panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %w", err))
-		}
-	} else {
-		if err := k.UnescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
-			return err
+		// if the token we must refund is prefixed by the source port and channel
+		// then the tokens were burnt when the packet was sent and we must mint new tokens
+		if token.Denom.HasPrefix(sourcePort, sourceChannel) {
+			// mint vouchers back to sender
+			if err := k.BankKeeper.MintCoins(
+				ctx, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				return err
+			}
+
+			if err := k.BankKeeper.SendCoins(ctx, moduleAccountAddr, sender, sdk.NewCoins(coin)); err != nil {
+				panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %w", err))
+			}
+		} else {
+			if err := k.UnescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -380,15 +489,29 @@ func (k *Keeper) DenomPathFromHash(ctx sdk.Context, ibcDenom string) (string, er
 }
 
 // createPacketDataBytesFromVersion creates the packet data bytes to be sent based on the application version.
-func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, token types.Token) ([]byte, error) {
+func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens) ([]byte, error) {
 	switch appVersion {
 	case types.V1:
+		// ICS20-V1 only supports a single token per packet
+		if len(tokens) != 1 {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot transfer multiple tokens with %s", types.V1)
+		}
+
+		token := tokens[0]
 		packetData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, sender, receiver, memo)
 
 		if err := packetData.ValidateBasic(); err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
 		}
 
+		return packetData.GetBytes(), nil
+	case types.V2:
+		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, memo)
+
+		if err := packetData.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V2)
+		}
+
 		return packetData.GetBytes(), nil
 	default:
 		return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "app version must be one of %s", types.SupportedVersions)
@@ -16,6 +16,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
 	transferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -154,6 +155,44 @@ func (s *KeeperTestSuite) TestSendTransfer() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"successful transfer with receive disabled for denom",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), types.NewDenomTransferEnabled(coin.Denom, true, false))
+			},
+			nil,
+		},
+		{
+			"successful transfer with send disabled for another channel",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainA.GetContext(), types.NewChannelTransferEnabled(ibctesting.InvalidID, false, false))
+			},
+			nil,
+		},
+		{
+			"failure: send disabled for denom",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), types.NewDenomTransferEnabled(coin.Denom, false, true))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: send disabled for IBC token denom",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coin = sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+				s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), types.NewDenomTransferEnabled(coin.Denom, false, true))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: send disabled for channel",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainA.GetContext(), types.NewChannelTransferEnabled(path.EndpointA.ChannelID, false, true))
+			},
+			types.ErrSendDisabled,
+		},
 		{
 			"failure: bank send from sender account failed, insufficient balance",
 			func() {
@@ -317,7 +356,10 @@ func (s *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCToken
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.InternalTransferRepresentation
+	var (
+		packetData types.InternalTransferRepresentation
+		path       *ibctesting.Path
+	)
 
 	testCases := []struct {
 		msg      string
@@ -339,7 +381,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		{
 			"failure: mint zero coin",
 			func() {
-				packetData.Token.Amount = zeroAmount.String()
+				packetData.Tokens[0].Amount = zeroAmount.String()
 			},
 			types.ErrInvalidAmount,
 		},
@@ -367,13 +409,54 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"successful receive with send disabled for denom",
+			func() {
+				denom := types.NewDenom(packetData.Tokens[0].Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				s.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainB.GetContext(), types.NewDenomTransferEnabled(denom.IBCDenom(), false, true))
+			},
+			nil,
+		},
+		{
+			"failure: receive disabled for denom",
+			func() {
+				denom := types.NewDenom(packetData.Tokens[0].Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				s.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainB.GetContext(), types.NewDenomTransferEnabled(denom.IBCDenom(), true, false))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receive disabled for channel",
+			func() {
+				s.chainB.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainB.GetContext(), types.NewChannelTransferEnabled(path.EndpointB.ChannelID, true, false))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: invalid value for registered memo key",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"%s": {"receiver": "%s"}}`, packetforwardtypes.ForwardMetadataKey, ibctesting.TestAccAddress)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: unregistered memo key with strict memo keys enabled",
+			func() {
+				params := s.chainB.GetSimApp().TransferKeeper.GetParams(s.chainB.GetContext())
+				params.StrictMemoKeys = true
+				s.chainB.GetSimApp().TransferKeeper.SetParams(s.chainB.GetContext(), params)
+
+				packetData.Memo = `{"unregistered": {}}`
+			},
+			types.ErrInvalidMemo,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			s.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path = ibctesting.NewTransferPath(s.chainA, s.chainB)
 			path.Setup()
 
 			receiver := s.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
@@ -384,7 +467,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			s.Require().NoError(err) // message committed
 
 			token := types.Token{Denom: types.NewDenom(transferMsg.Token.Denom), Amount: transferMsg.Token.Amount.String()}
-			packetData = types.NewInternalTransferRepresentation(token, s.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packetData = types.NewInternalTransferRepresentation(types.Tokens{token}, s.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
 			destinationPort := path.EndpointB.ChannelConfig.PortID
@@ -454,7 +537,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 		{
 			"successful receive of half the amount",
 			func() {
-				packetData.Token.Amount = sdkmath.NewInt(50).String()
+				packetData.Tokens[0].Amount = sdkmath.NewInt(50).String()
 				// expect 50 remaining
 				expEscrowAmount = sdkmath.NewInt(50)
 			},
@@ -463,21 +546,21 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 		{
 			"failure: empty coin",
 			func() {
-				packetData.Token.Amount = zeroAmount.String()
+				packetData.Tokens[0].Amount = zeroAmount.String()
 			},
 			types.ErrInvalidAmount,
 		},
 		{
 			"failure: tries to unescrow more tokens than allowed",
 			func() {
-				packetData.Token.Amount = sdkmath.NewInt(1000000).String()
+				packetData.Tokens[0].Amount = sdkmath.NewInt(1000000).String()
 			},
 			sdkerrors.ErrInsufficientFunds,
 		},
 		{
 			"failure: empty denom",
 			func() {
-				packetData.Token.Denom = types.Denom{}
+				packetData.Tokens[0].Denom = types.Denom{}
 			},
 			types.ErrInvalidDenomForTransfer,
 		},
@@ -495,6 +578,13 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"failure: receive disabled for denom",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(s.chainA.GetContext(), types.NewDenomTransferEnabled(sdk.DefaultBondDenom, true, false))
+			},
+			types.ErrReceiveDisabled,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -513,7 +603,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 			s.Require().NoError(err) // message committed
 
 			token := types.Token{Denom: types.NewDenom(transferMsg.Token.Denom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)), Amount: transferMsg.Token.Amount.String()}
-			packetData = types.NewInternalTransferRepresentation(token, s.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packetData = types.NewInternalTransferRepresentation(types.Tokens{token}, s.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			sourcePort := path.EndpointB.ChannelConfig.PortID
 			sourceChannel := path.EndpointB.ChannelID
 			destinationPort := path.EndpointA.ChannelConfig.PortID
@@ -597,10 +687,10 @@ func (s *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCToken
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
+		types.Tokens{types.Token{
 			Denom:  denom,
 			Amount: amount.String(),
-		}, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), "")
+		}}, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), "")
 	sourcePort := path2.EndpointA.ChannelConfig.PortID
 	sourceChannel := path2.EndpointA.ChannelID
 	destinationPort := path2.EndpointB.ChannelConfig.PortID
@@ -726,10 +816,10 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			tc.malleate()
 
 			data := types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  denom,
 					Amount: amount.String(),
-				}, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), "")
+				}}, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
 			preAcknowledgementBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), denom.IBCDenom())
@@ -814,10 +904,10 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountForSou
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
+		types.Tokens{types.Token{
 			Denom:  denom,
 			Amount: amount.String(),
-		},
+		}},
 		s.chainB.SenderAccount.GetAddress().String(),
 		s.chainA.SenderAccount.GetAddress().String(),
 		"",
@@ -946,10 +1036,10 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 			tc.malleate()
 
 			data := types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  denom,
 					Amount: amount,
-				}, sender, s.chainB.SenderAccount.GetAddress().String(), "")
+				}}, sender, s.chainB.SenderAccount.GetAddress().String(), "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
 			preTimeoutBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), denom.IBCDenom())
@@ -1029,10 +1119,10 @@ func (s *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceIBCTo
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
+		types.Tokens{types.Token{
 			Denom:  denom,
 			Amount: amount.String(),
-		}, s.chainB.SenderAccount.GetAddress().String(), s.chainA.SenderAccount.GetAddress().String(), "")
+		}}, s.chainB.SenderAccount.GetAddress().String(), s.chainA.SenderAccount.GetAddress().String(), "")
 	sourcePort := path2.EndpointB.ChannelConfig.PortID
 	sourceChannel := path2.EndpointB.ChannelID
 
@@ -1152,7 +1242,7 @@ func (s *KeeperTestSuite) TestPacketForwardsCompatibility() {
 
 func (s *KeeperTestSuite) TestCreatePacketDataBytesFromVersion() {
 	var (
-		token            types.Token
+		tokens           types.Tokens
 		sender, receiver string
 	)
 
@@ -1173,12 +1263,43 @@ func (s *KeeperTestSuite) TestCreatePacketDataBytesFromVersion() {
 			},
 		},
 		{
-			"failure: version 2",
-			"ics20-2",
-			func() {},
+			"success: version 2",
+			types.V2,
+			func() {
+				tokens = append(tokens, types.Token{
+					Amount: ibctesting.TestCoin.Amount.String(),
+					Denom:  types.NewDenom(ibctesting.SecondaryDenom),
+				})
+			},
+			func(bz []byte, err error) {
+				expPacketData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, "")
+				s.Require().Equal(bz, expPacketData.GetBytes())
+				s.Require().NoError(err)
+			},
+		},
+		{
+			"failure: multiple tokens with version 1",
+			types.V1,
+			func() {
+				tokens = append(tokens, types.Token{
+					Amount: ibctesting.TestCoin.Amount.String(),
+					Denom:  types.NewDenom(ibctesting.SecondaryDenom),
+				})
+			},
 			func(bz []byte, err error) {
 				s.Require().Nil(bz)
-				s.Require().Error(err, ibcerrors.ErrInvalidVersion)
+				s.Require().ErrorIs(err, ibcerrors.ErrInvalidRequest)
+			},
+		},
+		{
+			"failure: fails v2 validation",
+			types.V2,
+			func() {
+				tokens = append(tokens, tokens[0])
+			},
+			func(bz []byte, err error) {
+				s.Require().Nil(bz)
+				s.Require().ErrorIs(err, types.ErrInvalidDenomForTransfer)
 			},
 		},
 		{
@@ -1210,17 +1331,17 @@ func (s *KeeperTestSuite) TestCreatePacketDataBytesFromVersion() {
 			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
 			path.Setup()
 
-			token = types.Token{
+			tokens = types.Tokens{{
 				Amount: ibctesting.TestCoin.Amount.String(),
 				Denom:  types.NewDenom(ibctesting.TestCoin.Denom),
-			}
+			}}
 
 			sender = s.chainA.SenderAccount.GetAddress().String()
 			receiver = s.chainB.SenderAccount.GetAddress().String()
 
 			tc.malleate()
 
-			bz, err := transferkeeper.CreatePacketDataBytesFromVersion(tc.appVersion, sender, receiver, "", token)
+			bz, err := transferkeeper.CreatePacketDataBytesFromVersion(tc.appVersion, sender, receiver, "", tokens)
 
 			tc.expResult(bz, err)
 		})
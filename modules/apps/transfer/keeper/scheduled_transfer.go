@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// GetNextScheduledTransferID returns the identifier of the next scheduled transfer.
+// Identifiers start at 1.
+func (k *Keeper) GetNextScheduledTransferID(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NextScheduledTransferIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextScheduledTransferID sets the identifier of the next scheduled transfer.
+func (k *Keeper) SetNextScheduledTransferID(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NextScheduledTransferIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// GetScheduledTransfer returns the scheduled transfer with the given identifier.
+func (k *Keeper) GetScheduledTransfer(ctx sdk.Context, id uint64) (types.ScheduledTransfer, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ScheduledTransferStoreKey(id))
+	if len(bz) == 0 {
+		return types.ScheduledTransfer{}, false
+	}
+
+	var scheduledTransfer types.ScheduledTransfer
+	k.cdc.MustUnmarshal(bz, &scheduledTransfer)
+
+	return scheduledTransfer, true
+}
+
+// SetScheduledTransfer stores the scheduled transfer and adds it to the queue of
+// transfers scheduled by height or by timestamp.
+func (k *Keeper) SetScheduledTransfer(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.ScheduledTransferStoreKey(scheduledTransfer.Id), k.cdc.MustMarshal(&scheduledTransfer))
+	store.Set(scheduledTransfer.QueueKey(), sdk.Uint64ToBigEndian(scheduledTransfer.Id))
+}
+
+// deleteScheduledTransfer removes the scheduled transfer and its queue entry from the store.
+func (k *Keeper) deleteScheduledTransfer(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.ScheduledTransferStoreKey(scheduledTransfer.Id))
+	store.Delete(scheduledTransfer.QueueKey())
+}
+
+// GetAllScheduledTransfers returns all scheduled transfers which have not been dispatched or cancelled.
+func (k *Keeper) GetAllScheduledTransfers(ctx sdk.Context) []types.ScheduledTransfer {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledTransferKey)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var scheduledTransfers []types.ScheduledTransfer
+	for ; iterator.Valid(); iterator.Next() {
+		var scheduledTransfer types.ScheduledTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &scheduledTransfer)
+		scheduledTransfers = append(scheduledTransfers, scheduledTransfer)
+	}
+
+	return scheduledTransfers
+}
+
+// queuedScheduledTransfer is an entry of the queue of transfers scheduled by height or by timestamp.
+type queuedScheduledTransfer struct {
+	queueKey []byte
+	id       uint64
+}
+
+// getDueScheduledTransfers returns at most limit entries of the queue with the given prefix which
+// are scheduled at or before executeAt, in order of execution height or timestamp.
+func (k *Keeper) getDueScheduledTransfers(ctx sdk.Context, queuePrefix []byte, executeAt uint64, limit int) []queuedScheduledTransfer {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	end := storetypes.PrefixEndBytes(binary.BigEndian.AppendUint64(slices.Clone(queuePrefix), executeAt))
+	iterator := store.Iterator(queuePrefix, end)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var queued []queuedScheduledTransfer
+	for ; iterator.Valid() && len(queued) < limit; iterator.Next() {
+		queued = append(queued, queuedScheduledTransfer{queueKey: slices.Clone(iterator.Key()), id: sdk.BigEndianToUint64(iterator.Value())})
+	}
+
+	return queued
+}
+
+// DispatchScheduledTransfers dispatches the transfers scheduled at or before the current block
+// height or timestamp, up to types.MaxScheduledTransfersPerBlock. Remaining due transfers are
+// dispatched in the following blocks. It is called in the EndBlocker and never fails: a transfer
+// which cannot be dispatched is logged and removed from the queue, its state changes are discarded
+// and the escrowed tokens can be returned to the sender by cancelling it.
+func (k *Keeper) DispatchScheduledTransfers(ctx sdk.Context) {
+	limit := types.MaxScheduledTransfersPerBlock
+
+	queued := k.getDueScheduledTransfers(ctx, types.ScheduledTransferByHeightKey, uint64(ctx.BlockHeight()), limit)
+	queued = append(queued, k.getDueScheduledTransfers(ctx, types.ScheduledTransferByTimestampKey, uint64(ctx.BlockTime().UnixNano()), limit-len(queued))...)
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, entry := range queued {
+		scheduledTransfer, found := k.GetScheduledTransfer(ctx, entry.id)
+		if !found {
+			k.Logger(ctx).Error("scheduled transfer is queued but not stored, removed from the queue", "id", entry.id)
+			store.Delete(entry.queueKey)
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.dispatchScheduledTransfer(cacheCtx, scheduledTransfer); err != nil {
+			k.Logger(ctx).Error("scheduled transfer could not be dispatched, removed from the queue", "id", scheduledTransfer.Id, "sender", scheduledTransfer.Transfer.Sender, "error", err)
+			store.Delete(entry.queueKey)
+			events.EmitDispatchScheduledTransferEvent(ctx, scheduledTransfer, 0, err)
+			continue
+		}
+
+		writeFn()
+	}
+}
+
+// dispatchScheduledTransfer returns the escrowed tokens of the scheduled transfer to its sender and
+// executes the transfer. If the transfer fails its state changes are discarded, which leaves the
+// tokens refunded to the sender.
+func (k *Keeper) dispatchScheduledTransfer(ctx sdk.Context, scheduledTransfer types.ScheduledTransfer) error {
+	k.deleteScheduledTransfer(ctx, scheduledTransfer)
+
+	transfer := scheduledTransfer.Transfer
+	sender, err := sdk.AccAddressFromBech32(transfer.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid sender of scheduled transfer %d", scheduledTransfer.Id)
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, transfer.GetCoins()); err != nil {
+		return errorsmod.Wrapf(err, "failed to unescrow tokens of scheduled transfer %d", scheduledTransfer.Id)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.Transfer(cacheCtx, &transfer)
+	if err != nil {
+		k.Logger(ctx).Error("scheduled transfer failed, tokens refunded to sender", "id", scheduledTransfer.Id, "sender", transfer.Sender, "error", err)
+		events.EmitDispatchScheduledTransferEvent(ctx, scheduledTransfer, 0, err)
+		return nil
+	}
+
+	writeFn()
+	events.EmitDispatchScheduledTransferEvent(ctx, scheduledTransfer, res.Sequence, nil)
+
+	return nil
+}
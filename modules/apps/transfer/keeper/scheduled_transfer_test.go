@@ -0,0 +1,343 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// TestScheduleTransfer tests ScheduleTransfer rpc handler
+func (s *KeeperTestSuite) TestScheduleTransfer() {
+	var msg *types.MsgScheduleTransfer
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expEscrow sdk.Coins
+		expErr    error
+	}{
+		{
+			"success: scheduled by height",
+			func() {},
+			sdk.NewCoins(coin),
+			nil,
+		},
+		{
+			"success: scheduled by timestamp",
+			func() {
+				msg.ExecuteHeight = 0
+				msg.ExecuteTimestamp = uint64(s.chainA.GetContext().BlockTime().UnixNano()) + 1
+				msg.Transfer.TimeoutHeight = clienttypes.ZeroHeight()
+				msg.Transfer.TimeoutTimestamp = s.chainB.GetTimeoutTimestamp()
+			},
+			sdk.NewCoins(coin),
+			nil,
+		},
+		{
+			"success: entire balance is escrowed",
+			func() {
+				msg.Transfer.Token.Amount = types.UnboundedSpendLimit()
+			},
+			sdk.NewCoins(s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)),
+			nil,
+		},
+		{
+			"failure: execute height is not in the future",
+			func() {
+				msg.ExecuteHeight = uint64(s.chainA.GetContext().BlockHeight())
+			},
+			nil,
+			types.ErrInvalidScheduledTransfer,
+		},
+		{
+			"failure: execute timestamp is not in the future",
+			func() {
+				msg.ExecuteHeight = 0
+				msg.ExecuteTimestamp = uint64(s.chainA.GetContext().BlockTime().UnixNano())
+			},
+			nil,
+			types.ErrInvalidScheduledTransfer,
+		},
+		{
+			"failure: sender is a blocked address",
+			func() {
+				msg.Transfer.Sender = authtypes.NewModuleAddress(minttypes.ModuleName).String()
+			},
+			nil,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: insufficient funds",
+			func() {
+				msg.Transfer.Token = sdk.NewCoin("uatom", sdkmath.NewInt(100))
+			},
+			nil,
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			ctx := s.chainA.GetContext()
+			transfer := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), s.chainB.GetTimeoutHeight(), 0, "")
+			msg = types.NewMsgScheduleTransfer(*transfer, uint64(ctx.BlockHeight())+1, 0)
+
+			tc.malleate()
+
+			moduleAddr := s.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+			res, err := s.chainA.GetSimApp().TransferKeeper.ScheduleTransfer(ctx, msg)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(1), res.Id)
+				s.Require().Equal(uint64(2), s.chainA.GetSimApp().TransferKeeper.GetNextScheduledTransferID(ctx))
+
+				scheduledTransfer, found := s.chainA.GetSimApp().TransferKeeper.GetScheduledTransfer(ctx, res.Id)
+				s.Require().True(found)
+				s.Require().Equal(tc.expEscrow, scheduledTransfer.Transfer.GetCoins())
+				s.Require().Equal(msg.ExecuteHeight, scheduledTransfer.ExecuteHeight)
+				s.Require().Equal(msg.ExecuteTimestamp, scheduledTransfer.ExecuteTimestamp)
+
+				s.Require().Equal(tc.expEscrow, s.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, moduleAddr))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx))
+				s.Require().True(s.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+			}
+		})
+	}
+}
+
+// TestCancelScheduledTransfer tests CancelScheduledTransfer rpc handler
+func (s *KeeperTestSuite) TestCancelScheduledTransfer() {
+	var msg *types.MsgCancelScheduledTransfer
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: scheduled transfer not found",
+			func() {
+				msg.Id = 2
+			},
+			types.ErrScheduledTransferNotFound,
+		},
+		{
+			"failure: signer is not the sender",
+			func() {
+				msg.Sender = s.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			ctx := s.chainA.GetContext()
+			sender := s.chainA.SenderAccount.GetAddress()
+			transfer := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), s.chainB.SenderAccount.GetAddress().String(), s.chainB.GetTimeoutHeight(), 0, "")
+
+			balance := s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			res, err := s.chainA.GetSimApp().TransferKeeper.ScheduleTransfer(ctx, types.NewMsgScheduleTransfer(*transfer, uint64(ctx.BlockHeight())+1, 0))
+			s.Require().NoError(err)
+
+			msg = types.NewMsgCancelScheduledTransfer(sender.String(), res.Id)
+
+			tc.malleate()
+
+			_, err = s.chainA.GetSimApp().TransferKeeper.CancelScheduledTransfer(ctx, msg)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx))
+				s.Require().Equal(balance, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Len(s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx), 1)
+				s.Require().Equal(balance.Sub(coin), s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+// TestDispatchScheduledTransfers tests that scheduled transfers are dispatched in the EndBlocker
+// once due, and that the escrowed tokens are refunded to the sender if the transfer fails.
+func (s *KeeperTestSuite) TestDispatchScheduledTransfers() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgScheduleTransfer
+	)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expDispatch bool
+	}{
+		{
+			"success: scheduled by height",
+			func() {},
+			true,
+		},
+		{
+			"success: scheduled by timestamp",
+			func() {
+				msg.ExecuteHeight = 0
+				msg.ExecuteTimestamp = uint64(s.chainA.GetContext().BlockTime().UnixNano()) + 1
+				msg.Transfer.TimeoutHeight = clienttypes.ZeroHeight()
+				msg.Transfer.TimeoutTimestamp = s.chainB.GetTimeoutTimestamp()
+			},
+			true,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				msg.Transfer.SourceChannel = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"failure: send disabled after scheduling",
+			func() {
+				s.chainA.GetSimApp().TransferKeeper.SetChannelTransferEnabled(s.chainA.GetContext(), types.NewChannelTransferEnabled(path.EndpointA.ChannelID, false, true))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path = ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			ctx := s.chainA.GetContext()
+			sender := s.chainA.SenderAccount.GetAddress()
+			transfer := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), s.chainB.SenderAccount.GetAddress().String(), s.chainB.GetTimeoutHeight(), 0, "")
+			msg = types.NewMsgScheduleTransfer(*transfer, uint64(ctx.BlockHeight())+1, 0)
+
+			tc.malleate()
+
+			balance := s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			res, err := s.chainA.GetSimApp().TransferKeeper.ScheduleTransfer(ctx, msg)
+			s.Require().NoError(err)
+
+			// the transfer is not due in the current block
+			s.coordinator.CommitBlock(s.chainA)
+			_, found := s.chainA.GetSimApp().TransferKeeper.GetScheduledTransfer(s.chainA.GetContext(), res.Id)
+			s.Require().True(found)
+
+			s.coordinator.CommitBlock(s.chainA)
+			ctx = s.chainA.GetContext()
+			_, found = s.chainA.GetSimApp().TransferKeeper.GetScheduledTransfer(ctx, res.Id)
+			s.Require().False(found)
+
+			moduleAddr := s.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+			s.Require().True(s.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expDispatch {
+				s.Require().Equal(balance.Sub(coin), s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+				s.Require().Equal(coin, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom))
+				s.Require().Equal(coin, s.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+			} else {
+				s.Require().Equal(balance, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+				s.Require().True(s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).IsZero())
+			}
+		})
+	}
+}
+
+// TestDispatchScheduledTransfersLimit tests that at most MaxScheduledTransfersPerBlock transfers are
+// dispatched in a block, and that the remaining due transfers are dispatched in the following blocks.
+func (s *KeeperTestSuite) TestDispatchScheduledTransfersLimit() {
+	s.SetupTest()
+
+	ctx := s.chainA.GetContext()
+	sender := s.chainA.SenderAccount.GetAddress().String()
+	transfer := types.NewMsgTransfer(types.PortID, ibctesting.InvalidID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()), sender, sender, s.chainB.GetTimeoutHeight(), 0, "")
+
+	for range types.MaxScheduledTransfersPerBlock + 1 {
+		_, err := s.chainA.GetSimApp().TransferKeeper.ScheduleTransfer(ctx, types.NewMsgScheduleTransfer(*transfer, uint64(ctx.BlockHeight())+1, 0))
+		s.Require().NoError(err)
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.chainA.GetSimApp().TransferKeeper.DispatchScheduledTransfers(ctx)
+	scheduledTransfers := s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx)
+	s.Require().Len(scheduledTransfers, 1)
+	s.Require().Equal(uint64(types.MaxScheduledTransfersPerBlock+1), scheduledTransfers[0].Id)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.chainA.GetSimApp().TransferKeeper.DispatchScheduledTransfers(ctx)
+	s.Require().Empty(s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx))
+}
+
+// TestDispatchScheduledTransfersFailure tests that a scheduled transfer which cannot be dispatched is
+// removed from the queue without failing the EndBlocker, and can still be cancelled by its sender.
+func (s *KeeperTestSuite) TestDispatchScheduledTransfersFailure() {
+	s.SetupTest()
+	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	path.Setup()
+
+	ctx := s.chainA.GetContext()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	sender := s.chainA.SenderAccount.GetAddress()
+	transfer := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), s.chainB.SenderAccount.GetAddress().String(), s.chainB.GetTimeoutHeight(), 0, "")
+
+	var ids []uint64
+	for range 2 {
+		res, err := s.chainA.GetSimApp().TransferKeeper.ScheduleTransfer(ctx, types.NewMsgScheduleTransfer(*transfer, uint64(ctx.BlockHeight())+1, 0))
+		s.Require().NoError(err)
+		ids = append(ids, res.Id)
+	}
+
+	// the tokens escrowed for one of the transfers are missing from the module account
+	s.Require().NoError(s.chainA.GetSimApp().BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.Require().NotPanics(func() {
+		s.chainA.GetSimApp().TransferKeeper.DispatchScheduledTransfers(ctx)
+	})
+
+	// the first transfer is dispatched and the second one, which cannot be dispatched, is kept out of the queue
+	_, found := s.chainA.GetSimApp().TransferKeeper.GetScheduledTransfer(ctx, ids[0])
+	s.Require().False(found)
+	failed, found := s.chainA.GetSimApp().TransferKeeper.GetScheduledTransfer(ctx, ids[1])
+	s.Require().True(found)
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	s.Require().Equal(coin, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom))
+
+	// the failed transfer is not dispatched again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.chainA.GetSimApp().TransferKeeper.DispatchScheduledTransfers(ctx)
+	s.Require().Equal(coin, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom))
+	s.Require().Equal([]types.ScheduledTransfer{failed}, s.chainA.GetSimApp().TransferKeeper.GetAllScheduledTransfers(ctx))
+}
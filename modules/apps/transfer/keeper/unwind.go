@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// unwindTransfer returns the transfer which sends the tokens of an unwinding MsgTransfer back along
+// the hops of their denomination trace. It is sent over the first hop, and if there are further hops,
+// its memo forwards the tokens along them to the receiver on the origin chain of the tokens.
+func (k *Keeper) unwindTransfer(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgTransfer, error) {
+	var trace []types.Hop
+	for i, coin := range msg.GetCoins() {
+		token, err := k.TokenFromCoin(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			trace = token.Denom.Trace
+		} else if !slices.Equal(trace, token.Denom.Trace) {
+			return nil, errorsmod.Wrap(types.ErrInvalidUnwind, "all tokens must have the same denomination trace")
+		}
+	}
+
+	if len(trace) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidUnwind, "tokens have no denomination trace to unwind")
+	}
+
+	for _, hop := range trace {
+		if err := hop.Validate(); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidUnwind, "invalid hop %s: %s", hop, err)
+		}
+	}
+
+	if err := k.validateUnwindHop(ctx, trace[0]); err != nil {
+		return nil, err
+	}
+
+	unwound := *msg
+	unwound.SourcePort = trace[0].PortId
+	unwound.SourceChannel = trace[0].ChannelId
+	unwound.Unwind = false
+
+	if len(trace) > 1 {
+		memo, err := types.NewUnwindMemo(trace[1:], msg.Receiver, msg.Memo)
+		if err != nil {
+			return nil, err
+		}
+
+		unwound.Receiver = types.UnwindIntermediateReceiver
+		unwound.Memo = memo
+	}
+
+	return &unwound, nil
+}
+
+// validateUnwindHop returns an error if the channel of the first hop of an unwind is not open, or,
+// for IBC v2 hops, if the client is not active. The channels of the following hops are on the
+// counterparty chains, and are validated by their packet forward middleware.
+func (k *Keeper) validateUnwindHop(ctx sdk.Context, hop types.Hop) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, hop.PortId, hop.ChannelId)
+	if found {
+		if channel.State != channeltypes.OPEN {
+			return errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "channel %s of unwind hop must be %s, got %s", hop, channeltypes.OPEN, channel.State)
+		}
+
+		return nil
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, hop.ChannelId); status != ibcexported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client %s of unwind hop must be %s, got %s", hop.ChannelId, ibcexported.Active, status)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (s *KeeperTestSuite) TestMsgTransferUnwind() {
+	var (
+		path   *ibctesting.Path
+		msg    *types.MsgTransfer
+		denom  types.Denom
+		expMsg func() (string, string)
+	)
+
+	receiver := s.chainB.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: single hop",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple hops",
+			func() {
+				denom.Trace = append(denom.Trace, types.NewHop(ibctesting.TransferPort, "channel-7"), types.NewHop(ibctesting.TransferPort, "channel-9"))
+				msg.Memo = ""
+				expMsg = func() (string, string) {
+					return types.UnwindIntermediateReceiver, fmt.Sprintf(`{"forward":{"channel":"channel-7","next":{"forward":{"channel":"channel-9","port":"transfer","receiver":"%s"}},"port":"transfer","receiver":"pfm"}}`, receiver)
+				}
+			},
+			nil,
+		},
+		{
+			"success: multiple hops with forward memo",
+			func() {
+				denom.Trace = append(denom.Trace, types.NewHop(ibctesting.TransferPort, "channel-7"))
+				msg.Memo = fmt.Sprintf(`{"forward":{"channel":"channel-3","port":"transfer","receiver":"%s"}}`, receiver)
+				expMsg = func() (string, string) {
+					return types.UnwindIntermediateReceiver, fmt.Sprintf(`{"forward":{"channel":"channel-7","next":{"forward":{"channel":"channel-3","port":"transfer","receiver":"%s"}},"port":"transfer","receiver":"%s"}}`, receiver, receiver)
+				}
+			},
+			nil,
+		},
+		{
+			"failure: multiple hops with memo which is not forward metadata",
+			func() {
+				denom.Trace = append(denom.Trace, types.NewHop(ibctesting.TransferPort, "channel-7"))
+				msg.Memo = "memo"
+			},
+			types.ErrInvalidUnwind,
+		},
+		{
+			"failure: channel of first hop is closed",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"failure: client of first hop does not exist",
+			func() {
+				denom.Trace = []types.Hop{types.NewHop(ibctesting.TransferPort, "07-tendermint-99")}
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: token has no trace",
+			func() {
+				msg.Token = ibctesting.TestCoin
+			},
+			types.ErrInvalidUnwind,
+		},
+		{
+			"failure: tokens have different traces",
+			func() {
+				other := types.NewDenom("uatom", types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), types.NewHop(ibctesting.TransferPort, "channel-7"))
+				s.chainA.GetSimApp().TransferKeeper.SetDenom(s.chainA.GetContext(), other)
+				msg.Tokens = []sdk.Coin{sdk.NewCoin(denom.IBCDenom(), sdkmath.NewInt(100)), sdk.NewCoin(other.IBCDenom(), sdkmath.NewInt(100))}
+			},
+			types.ErrInvalidUnwind,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(s.chainA, s.chainB)
+			path.Setup()
+
+			denom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			msg = types.NewMsgTransfer("", "", sdk.Coin{}, s.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.Height{}, s.chainB.GetTimeoutTimestamp(), "memo")
+			msg.Unwind = true
+			expMsg = func() (string, string) {
+				return receiver, "memo"
+			}
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+
+			coin := sdk.NewCoin(denom.IBCDenom(), ibctesting.DefaultCoinAmount)
+			s.chainA.GetSimApp().TransferKeeper.SetDenom(ctx, denom)
+			s.Require().NoError(s.chainA.GetSimApp().BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)))
+			s.Require().NoError(s.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, s.chainA.SenderAccount.GetAddress(), sdk.NewCoins(coin)))
+
+			if msg.Token.IsNil() && len(msg.Tokens) == 0 {
+				msg.Token = coin
+			}
+
+			res, err := s.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+
+				packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+				s.Require().NoError(err)
+				s.Require().Equal(denom.Trace[0].PortId, packet.SourcePort)
+				s.Require().Equal(denom.Trace[0].ChannelId, packet.SourceChannel)
+
+				data, err := types.UnmarshalPacketData(packet.GetData(), types.V1, "")
+				s.Require().NoError(err)
+
+				expReceiver, expMemo := expMsg()
+				s.Require().Equal(expReceiver, data.Receiver)
+				s.Require().Equal(expMemo, data.Memo)
+
+				// the vouchers are burned as they are sent back to the chain they were received from
+				s.Require().True(s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, s.chainA.SenderAccount.GetAddress(), coin.Denom).IsZero())
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestUnwindRoundTrip tests that tokens received over a channel are sent back to their origin chain
+// by an unwinding transfer without specifying the source port and channel.
+func (s *KeeperTestSuite) TestUnwindRoundTrip() {
+	s.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	path.Setup()
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainB.SenderAccount.GetAddress()
+	senderBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, ibctesting.TestCoin.Denom)
+
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, sender.String(), receiver.String(), clienttypes.Height{}, s.chainB.GetTimeoutTimestamp(), "")
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+	s.Require().NoError(path.RelayPacket(packet))
+
+	voucher := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	coin := sdk.NewCoin(voucher.IBCDenom(), ibctesting.TestCoin.Amount)
+	s.Require().Equal(coin, s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), receiver, coin.Denom))
+
+	msg = types.NewMsgTransfer("", "", coin, receiver.String(), sender.String(), clienttypes.Height{}, s.chainA.GetTimeoutTimestamp(), "")
+	msg.Unwind = true
+	res, err = s.chainB.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+	s.Require().NoError(path.RelayPacket(packet))
+
+	// the tokens are unescrowed on their origin chain
+	s.Require().True(s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), receiver, coin.Denom).IsZero())
+	s.Require().Equal(senderBalance, s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, ibctesting.TestCoin.Denom))
+}
@@ -33,7 +33,9 @@ var (
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
+	_ module.HasInvariants       = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*IBCModule)(nil)
 )
@@ -121,6 +123,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 5, m.MigrateDenomTraceToDenom); err != nil {
 		panic(fmt.Errorf("failed to migrate transfer app from version 5 to 6 (migrate DenomTrace to Denom): %w", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.MigrateDenomIndexes); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 6 to 7 (index denoms by base denom and hop): %w", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -138,8 +144,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// RegisterInvariants implements the AppModule interface
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// EndBlock implements the appmodule.HasEndBlocker interface. It dispatches the
+// scheduled transfers which are due.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.DispatchScheduledTransfers(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion defining the current version of transfer.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // AppModuleSimulation functions
 
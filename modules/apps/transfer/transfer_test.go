@@ -104,7 +104,7 @@ func (s *TransferTestSuite) TestHandleMsgTransfer() {
 			// Get the packet data to determine the amount of tokens being transferred (needed for sending entire balance)
 			packetData, err := types.UnmarshalPacketData(packet.GetData(), pathAToB.EndpointA.GetChannel().Version, "")
 			s.Require().NoError(err)
-			transferAmount, ok := sdkmath.NewIntFromString(packetData.Token.Amount)
+			transferAmount, ok := sdkmath.NewIntFromString(packetData.Tokens[0].Amount)
 			s.Require().True(ok)
 
 			// relay send
@@ -199,3 +199,96 @@ func (s *TransferTestSuite) TestHandleMsgTransfer() {
 		})
 	}
 }
+
+// Constructs the following sends of multiple tokens in a single packet over an ICS20-V2 channel
+// 1 - from chainA to chainB
+// 2 - from chainB back to chainA
+// 3 - from chainA to chainB, which times out and refunds all tokens
+func (s *TransferTestSuite) TestHandleMsgTransferWithMultipleTokens() {
+	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	path.Setup()
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainB.SenderAccount.GetAddress()
+	coins := sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	originalBalances := s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender)
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, sender.String(), receiver.String(), s.chainB.GetTimeoutHeight(), 0, "")
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	packetData, err := types.UnmarshalPacketData(packet.GetData(), types.V2, "")
+	s.Require().NoError(err)
+	s.Require().Len(packetData.Tokens, 2)
+
+	err = path.RelayPacket(packet)
+	s.Require().NoError(err) // relay committed
+
+	// check that all tokens have been escrowed on chainA and vouchers exist on chainB
+	s.Require().Equal(originalBalances.Sub(coins...), s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender))
+	s.Require().Equal(coins, s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), escrowAddress))
+
+	trace := types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	var vouchers sdk.Coins
+	for _, coin := range coins {
+		voucherDenom := types.NewDenom(coin.Denom, trace).IBCDenom()
+		vouchers = vouchers.Add(sdk.NewCoin(voucherDenom, coin.Amount))
+		s.Require().Equal(sdk.NewCoin(voucherDenom, coin.Amount), s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), receiver, voucherDenom))
+	}
+
+	// send the vouchers back from chainB to chainA
+	msg = types.NewMsgTransferWithTokens(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, vouchers, receiver.String(), sender.String(), s.chainA.GetTimeoutHeight(), 0, "")
+	res, err = s.chainB.SendMsgs(msg)
+	s.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParseV1PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	s.Require().NoError(err) // relay committed
+
+	// check that all vouchers have been burned and the tokens unescrowed on chainA
+	for _, voucher := range vouchers {
+		s.Require().True(s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), receiver, voucher.Denom).IsZero())
+	}
+	s.Require().Equal(originalBalances, s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender))
+	s.Require().True(s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), escrowAddress).IsZero())
+
+	// send from chainA to chainB with a timeout which is reached before the packet is relayed
+	timeoutHeight := clienttypes.GetSelfHeight(s.chainB.GetContext())
+	msg = types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, sender.String(), receiver.String(), timeoutHeight, 0, "")
+	res, err = s.chainA.SendMsgs(msg)
+	s.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParseV1PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+	s.Require().Equal(originalBalances.Sub(coins...), s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender))
+
+	err = path.EndpointA.UpdateClient()
+	s.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	s.Require().NoError(err) // timeout committed
+
+	// check that all tokens have been refunded together
+	s.Require().Equal(originalBalances, s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender))
+	s.Require().True(s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), escrowAddress).IsZero())
+}
+
+func (s *TransferTestSuite) TestHandleMsgTransferWithMultipleTokensOverV1Channel() {
+	path := ibctesting.NewTransferPath(s.chainA, s.chainB)
+	path.Setup()
+
+	coins := sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+	msg := types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(), s.chainB.GetTimeoutHeight(), 0, "")
+
+	_, err := s.chainA.SendMsgs(msg)
+	s.Require().ErrorContains(err, "cannot transfer multiple tokens with ics20-1")
+}
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -109,18 +114,104 @@ func (m *Allocation) GetAllowedPacketData() []string {
 	return nil
 }
 
+// PeriodicAllocation defines a spend limit for a particular port and channel which is reset
+// periodically, in addition to the total spend limit of the allocation
+type PeriodicAllocation struct {
+	// the port, channel, receiver and memo restrictions and the total spend limit of the allocation
+	Allocation Allocation `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation"`
+	// the duration of a period, after which period_can_spend is reset to period_spend_limit
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// spend limitation on the channel for each period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// the amount left to be spent in the current period
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// the time at which the current period ends and a new one begins; if it is not set, the first
+	// period begins with the first transfer of the allocation
+	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicAllocation) Reset()         { *m = PeriodicAllocation{} }
+func (m *PeriodicAllocation) String() string { return proto.CompactTextString(m) }
+func (*PeriodicAllocation) ProtoMessage()    {}
+func (*PeriodicAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{1}
+}
+func (m *PeriodicAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicAllocation.Merge(m, src)
+}
+func (m *PeriodicAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicAllocation proto.InternalMessageInfo
+
+func (m *PeriodicAllocation) GetAllocation() Allocation {
+	if m != nil {
+		return m.Allocation
+	}
+	return Allocation{}
+}
+
+func (m *PeriodicAllocation) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicAllocation) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicAllocation) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicAllocation) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 // TransferAuthorization allows the grantee to spend up to spend_limit coins from
 // the granter's account for ibc transfer on a specific channel
 type TransferAuthorization struct {
 	// port and channel amounts
 	Allocations []Allocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
+	// port and channel amounts which are reset periodically
+	PeriodicAllocations []PeriodicAllocation `protobuf:"bytes,2,rep,name=periodic_allocations,json=periodicAllocations,proto3" json:"periodic_allocations"`
 }
 
 func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
 func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TransferAuthorization) ProtoMessage()    {}
 func (*TransferAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{1}
+	return fileDescriptor_b1a28b55d17325aa, []int{2}
 }
 func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,8 +247,16 @@ func (m *TransferAuthorization) GetAllocations() []Allocation {
 	return nil
 }
 
+func (m *TransferAuthorization) GetPeriodicAllocations() []PeriodicAllocation {
+	if m != nil {
+		return m.PeriodicAllocations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Allocation)(nil), "ibc.applications.transfer.v1.Allocation")
+	proto.RegisterType((*PeriodicAllocation)(nil), "ibc.applications.transfer.v1.PeriodicAllocation")
 	proto.RegisterType((*TransferAuthorization)(nil), "ibc.applications.transfer.v1.TransferAuthorization")
 }
 
@@ -166,35 +265,45 @@ func init() {
 }
 
 var fileDescriptor_b1a28b55d17325aa = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0x75, 0x20, 0xd5, 0x15, 0x48, 0x04, 0x90, 0xb2, 0x09, 0xd2, 0xaa, 0x12, 0x28,
-	0x97, 0xda, 0x0b, 0x5c, 0x10, 0xb7, 0x75, 0x1c, 0x77, 0xa8, 0x22, 0x4e, 0x5c, 0x22, 0xc7, 0x31,
-	0xad, 0x35, 0x37, 0x6f, 0x14, 0xbf, 0x09, 0x62, 0x9f, 0x82, 0x7d, 0x0d, 0xce, 0x7c, 0x88, 0x89,
-	0xd3, 0x8e, 0x9c, 0x00, 0xb5, 0x5f, 0x04, 0xc5, 0xf6, 0xa0, 0x08, 0x89, 0x53, 0xe2, 0xe7, 0x7d,
-	0xfc, 0xfe, 0xf9, 0xf9, 0x25, 0x89, 0x2a, 0x04, 0xe3, 0x75, 0xad, 0x95, 0xe0, 0xa8, 0xa0, 0x32,
-	0x0c, 0x1b, 0x5e, 0x99, 0xf7, 0xb2, 0x61, 0x5d, 0xca, 0x78, 0x8b, 0xeb, 0x4b, 0x5a, 0x37, 0x80,
-	0x10, 0x3e, 0x51, 0x85, 0xa0, 0xfb, 0x4e, 0x7a, 0xeb, 0xa4, 0x5d, 0x7a, 0x7c, 0x24, 0xc0, 0x6c,
-	0xc0, 0xe4, 0xd6, 0xcb, 0xdc, 0xc1, 0x5d, 0x3c, 0x7e, 0xb4, 0x82, 0x15, 0x38, 0xbd, 0xff, 0xf3,
-	0x6a, 0xec, 0x3c, 0xac, 0xe0, 0x46, 0xb2, 0x2e, 0x2d, 0x24, 0xf2, 0x94, 0x09, 0x50, 0x95, 0x8b,
-	0xcf, 0xae, 0x0e, 0x08, 0x39, 0xd5, 0x1a, 0x5c, 0xb1, 0x70, 0x42, 0xc6, 0x06, 0xda, 0x46, 0xc8,
-	0xbc, 0x86, 0x06, 0xa3, 0x60, 0x1a, 0x24, 0xa3, 0x8c, 0x38, 0x69, 0x09, 0x0d, 0x86, 0xcf, 0xc8,
-	0x7d, 0x6f, 0x10, 0x6b, 0x5e, 0x55, 0x52, 0x47, 0x07, 0xd6, 0x73, 0xcf, 0xa9, 0x67, 0x4e, 0x0c,
-	0x35, 0x19, 0x9b, 0x5a, 0x56, 0x65, 0xae, 0xd5, 0x46, 0x61, 0x34, 0x9c, 0x0e, 0x93, 0xf1, 0x8b,
-	0x23, 0xea, 0x1b, 0xee, 0x9b, 0xa1, 0xbe, 0x19, 0x7a, 0x06, 0xaa, 0x5a, 0x9c, 0x5c, 0x7f, 0x9f,
-	0x0c, 0x3e, 0xff, 0x98, 0x24, 0x2b, 0x85, 0xeb, 0xb6, 0xa0, 0x02, 0x36, 0x7e, 0x3a, 0xff, 0x99,
-	0x9b, 0xf2, 0x82, 0xe1, 0xc7, 0x5a, 0x1a, 0x7b, 0xc1, 0x64, 0xc4, 0xe6, 0x3f, 0xef, 0xd3, 0x87,
-	0x4f, 0x09, 0xe1, 0x5a, 0xc3, 0x87, 0x5c, 0x2b, 0x83, 0xd1, 0xe1, 0x74, 0x98, 0x8c, 0xb2, 0x91,
-	0x55, 0xce, 0x95, 0xc1, 0x90, 0x92, 0x87, 0xf6, 0x20, 0xcb, 0xbc, 0xe6, 0xe2, 0x42, 0x62, 0x5e,
-	0x72, 0xe4, 0xd1, 0x1d, 0xeb, 0x7b, 0xe0, 0x43, 0x4b, 0x1b, 0x79, 0xc3, 0x91, 0xcf, 0xae, 0x02,
-	0xf2, 0xf8, 0xad, 0x87, 0x7e, 0xda, 0xe2, 0x1a, 0x1a, 0x75, 0xe9, 0xf0, 0x2c, 0xc9, 0x98, 0xff,
-	0x86, 0x65, 0xa2, 0xc0, 0x8e, 0x95, 0xd0, 0xff, 0x3d, 0x19, 0xfd, 0x43, 0x77, 0x71, 0xd8, 0x4f,
-	0x99, 0xed, 0xa7, 0x78, 0xfd, 0xfc, 0xeb, 0x97, 0xf9, 0xcc, 0x63, 0x71, 0x6b, 0x70, 0xcb, 0xe5,
-	0xaf, 0xca, 0x8b, 0xec, 0x7a, 0x1b, 0x07, 0x37, 0xdb, 0x38, 0xf8, 0xb9, 0x8d, 0x83, 0x4f, 0xbb,
-	0x78, 0x70, 0xb3, 0x8b, 0x07, 0xdf, 0x76, 0xf1, 0xe0, 0xdd, 0xab, 0x7f, 0x91, 0xa9, 0x42, 0xcc,
-	0x57, 0xc0, 0xba, 0xf4, 0x84, 0x6d, 0xa0, 0x6c, 0xb5, 0x34, 0xfd, 0xee, 0xed, 0xed, 0x9c, 0x05,
-	0x59, 0xdc, 0xb5, 0x2b, 0xf0, 0xf2, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x77, 0x0f, 0x28,
-	0x9d, 0x02, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd4, 0x3c,
+	0x10, 0xde, 0x74, 0xdb, 0xea, 0xaf, 0xf3, 0x53, 0x81, 0x5b, 0xa4, 0xb4, 0x82, 0xec, 0x6a, 0x25,
+	0x50, 0x2e, 0xb5, 0xbb, 0xe5, 0x82, 0xe0, 0xd4, 0x6d, 0x25, 0x2e, 0x15, 0x5a, 0x85, 0x9e, 0xb8,
+	0x44, 0x8e, 0xe3, 0x66, 0xad, 0x26, 0x71, 0x14, 0x3b, 0x8b, 0xda, 0xa7, 0x28, 0x37, 0x9e, 0x81,
+	0x33, 0x0f, 0x51, 0x71, 0xea, 0x91, 0x03, 0xa2, 0xa8, 0x7d, 0x0d, 0x0e, 0x28, 0xb6, 0x57, 0x1b,
+	0x58, 0xa9, 0x07, 0x04, 0xa7, 0x78, 0x66, 0xbe, 0x99, 0x2f, 0xf3, 0x7d, 0x96, 0x41, 0xc0, 0x63,
+	0x8a, 0x49, 0x59, 0x66, 0x9c, 0x12, 0xc5, 0x45, 0x21, 0xb1, 0xaa, 0x48, 0x21, 0x4f, 0x58, 0x85,
+	0xa7, 0x43, 0x4c, 0x6a, 0x35, 0x39, 0x47, 0x65, 0x25, 0x94, 0x80, 0x8f, 0x78, 0x4c, 0x51, 0x1b,
+	0x89, 0x66, 0x48, 0x34, 0x1d, 0x6e, 0x6f, 0x51, 0x21, 0x73, 0x21, 0x23, 0x8d, 0xc5, 0x26, 0x30,
+	0x8d, 0xdb, 0x9b, 0xa9, 0x48, 0x85, 0xc9, 0x37, 0x27, 0x9b, 0xf5, 0x0d, 0x06, 0xc7, 0x44, 0x32,
+	0x3c, 0x1d, 0xc6, 0x4c, 0x91, 0x21, 0xa6, 0x82, 0x17, 0xb3, 0x7a, 0x2a, 0x44, 0x9a, 0x31, 0xac,
+	0xa3, 0xb8, 0x3e, 0xc1, 0x49, 0x5d, 0x69, 0x5e, 0x5b, 0xef, 0xfd, 0x5e, 0x57, 0x3c, 0x67, 0x52,
+	0x91, 0xbc, 0x34, 0x80, 0xc1, 0xfb, 0x25, 0x00, 0xf6, 0xb3, 0x4c, 0x98, 0xbf, 0x85, 0x3d, 0xe0,
+	0x4a, 0x51, 0x57, 0x94, 0x45, 0xa5, 0xa8, 0x94, 0xe7, 0xf4, 0x9d, 0x60, 0x2d, 0x04, 0x26, 0x35,
+	0x16, 0x95, 0x82, 0x4f, 0xc0, 0xba, 0x05, 0xd0, 0x09, 0x29, 0x0a, 0x96, 0x79, 0x4b, 0x1a, 0x73,
+	0xcf, 0x64, 0x0f, 0x4c, 0x12, 0x66, 0xc0, 0x95, 0x25, 0x2b, 0x92, 0x28, 0xe3, 0x39, 0x57, 0x5e,
+	0xb7, 0xdf, 0x0d, 0xdc, 0xbd, 0x2d, 0x64, 0x37, 0x6e, 0xb6, 0x41, 0x76, 0x1b, 0x74, 0x20, 0x78,
+	0x31, 0xda, 0xbd, 0xfc, 0xd6, 0xeb, 0x7c, 0xbc, 0xee, 0x05, 0x29, 0x57, 0x93, 0x3a, 0x46, 0x54,
+	0xe4, 0x56, 0x1e, 0xfb, 0xd9, 0x91, 0xc9, 0x29, 0x56, 0x67, 0x25, 0x93, 0xba, 0x41, 0x86, 0x40,
+	0xcf, 0x3f, 0x6a, 0xc6, 0xc3, 0xc7, 0x00, 0x90, 0x2c, 0x13, 0xef, 0xa2, 0x8c, 0x4b, 0xe5, 0x2d,
+	0xf7, 0xbb, 0xc1, 0x5a, 0xb8, 0xa6, 0x33, 0x47, 0x5c, 0x2a, 0x88, 0xc0, 0x86, 0x0e, 0x58, 0x12,
+	0x95, 0x84, 0x9e, 0x32, 0x15, 0x25, 0x44, 0x11, 0x6f, 0x45, 0xe3, 0x1e, 0xd8, 0xd2, 0x58, 0x57,
+	0x0e, 0x89, 0x22, 0x83, 0xaf, 0x5d, 0x00, 0xc7, 0xac, 0xe2, 0x22, 0xe1, 0xb4, 0xa5, 0xcd, 0x6b,
+	0xc3, 0x62, 0x22, 0x2d, 0x8d, 0xbb, 0x17, 0xa0, 0xbb, 0xfc, 0x46, 0xf3, 0xee, 0xd1, 0x72, 0xb3,
+	0x61, 0xd8, 0x9a, 0x00, 0x5f, 0x82, 0xd5, 0x52, 0xb3, 0x68, 0x09, 0x1b, 0x79, 0x8c, 0x59, 0x68,
+	0x66, 0x16, 0x3a, 0xb4, 0x66, 0x8e, 0xfe, 0x6b, 0x9a, 0x3f, 0x5c, 0xf7, 0x9c, 0xd0, 0xb6, 0xc0,
+	0x33, 0x00, 0xcd, 0x29, 0xfa, 0xc7, 0x3a, 0xdf, 0x37, 0x34, 0x6f, 0xe6, 0x6a, 0xd7, 0xc0, 0xe6,
+	0x22, 0x4a, 0x0a, 0x43, 0xef, 0x2d, 0xff, 0x7d, 0xe2, 0x75, 0x43, 0x72, 0x40, 0x0a, 0xcd, 0x0d,
+	0x5f, 0x81, 0xff, 0x2d, 0x6d, 0xc5, 0x24, 0x53, 0xde, 0x8a, 0x16, 0x6d, 0x7b, 0x41, 0xb4, 0xe3,
+	0xd9, 0x0d, 0x37, 0xaa, 0x5d, 0x34, 0xaa, 0xb9, 0xa6, 0x33, 0x6c, 0x1a, 0x07, 0x3f, 0x1c, 0xf0,
+	0xf0, 0xd8, 0x9a, 0xb4, 0x5f, 0xab, 0x89, 0xa8, 0xf8, 0xb9, 0x71, 0x64, 0x0c, 0xdc, 0xb9, 0x3f,
+	0xd2, 0x73, 0xfa, 0xdd, 0x3f, 0xb0, 0xb8, 0x3d, 0x02, 0x72, 0xb0, 0x59, 0xda, 0x9b, 0x14, 0xb5,
+	0x47, 0x2f, 0xe9, 0xd1, 0xbb, 0x77, 0x8f, 0x5e, 0xbc, 0x83, 0x96, 0x62, 0xa3, 0x5c, 0xa8, 0xc8,
+	0x17, 0x4f, 0x3f, 0x7f, 0xda, 0x19, 0x58, 0xfd, 0xcd, 0x8b, 0x34, 0x33, 0xe0, 0x97, 0x25, 0x47,
+	0xe1, 0xe5, 0x8d, 0xef, 0x5c, 0xdd, 0xf8, 0xce, 0xf7, 0x1b, 0xdf, 0xb9, 0xb8, 0xf5, 0x3b, 0x57,
+	0xb7, 0x7e, 0xe7, 0xcb, 0xad, 0xdf, 0x79, 0xfb, 0x7c, 0xd1, 0x1b, 0x1e, 0xd3, 0x9d, 0x54, 0xe0,
+	0xe9, 0x70, 0x17, 0xe7, 0x22, 0xa9, 0x33, 0x26, 0x9b, 0x67, 0xb0, 0xf5, 0xfc, 0x69, 0xc7, 0xe2,
+	0x55, 0xad, 0xfe, 0xb3, 0x9f, 0x03, 0x00, 0x92, 0xfa, 0xc6, 0xd5, 0x28, 0x05, 0x00, 0x00,
 }
 
 func (m *Allocation) Marshal() (dAtA []byte, err error) {
@@ -266,6 +375,83 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TransferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,6 +472,20 @@ func (m *TransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeriodicAllocations) > 0 {
+		for iNdEx := len(m.PeriodicAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodicAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -349,6 +549,33 @@ func (m *Allocation) Size() (n int) {
 	return n
 }
 
+func (m *PeriodicAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allocation.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
 func (m *TransferAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
@@ -361,6 +588,12 @@ func (m *TransferAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if len(m.PeriodicAllocations) > 0 {
+		for _, e := range m.PeriodicAllocations {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -582,6 +815,223 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeriodicAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TransferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -645,6 +1095,40 @@ func (m *TransferAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodicAllocations = append(m.PeriodicAllocations, PeriodicAllocation{})
+			if err := m.PeriodicAllocations[len(m.PeriodicAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
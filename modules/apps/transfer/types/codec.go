@@ -18,7 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{}, &MsgSetTransferEnabled{}, &MsgSetDenomMetadata{}, &MsgReconcileEscrow{}, &MsgScheduleTransfer{}, &MsgCancelScheduledTransfer{})
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -44,6 +44,31 @@ func (s *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			nil,
 		},
+		{
+			"success: MsgSetTransferEnabled",
+			sdk.MsgTypeURL(&types.MsgSetTransferEnabled{}),
+			nil,
+		},
+		{
+			"success: MsgSetDenomMetadata",
+			sdk.MsgTypeURL(&types.MsgSetDenomMetadata{}),
+			nil,
+		},
+		{
+			"success: MsgReconcileEscrow",
+			sdk.MsgTypeURL(&types.MsgReconcileEscrow{}),
+			nil,
+		},
+		{
+			"success: MsgScheduleTransfer",
+			sdk.MsgTypeURL(&types.MsgScheduleTransfer{}),
+			nil,
+		},
+		{
+			"success: MsgCancelScheduledTransfer",
+			sdk.MsgTypeURL(&types.MsgCancelScheduledTransfer{}),
+			nil,
+		},
 		{
 			"success: TransferAuthorization",
 			sdk.MsgTypeURL(&types.TransferAuthorization{}),
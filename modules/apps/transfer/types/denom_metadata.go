@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewDenomMetadata creates a new DenomMetadata instance
+func NewDenomMetadata(denom Denom, name, symbol, display string, decimals uint32, uri, uriHash string) DenomMetadata {
+	return DenomMetadata{
+		Denom:    denom,
+		Name:     name,
+		Symbol:   symbol,
+		Display:  display,
+		Decimals: decimals,
+		Uri:      uri,
+		UriHash:  uriHash,
+	}
+}
+
+// Validate performs a basic validation of the DenomMetadata fields. The denomination
+// must be an IBC voucher and the resulting bank metadata must be valid.
+func (m DenomMetadata) Validate() error {
+	if err := m.Denom.Validate(); err != nil {
+		return err
+	}
+
+	if m.Denom.IsNative() {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "metadata can only be registered for IBC vouchers, got native denom %s", m.Denom.Base)
+	}
+
+	if m.Decimals == 0 && strings.TrimSpace(m.Display) != "" {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, "display denom must be empty if decimals is zero")
+	}
+
+	if err := m.BankMetadata().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	return nil
+}
+
+// BankMetadata returns the bank metadata for the IBC voucher. The base denom unit is the
+// IBC denom (ibc/{hash}) with the base denomination of the trace as alias. If decimals
+// is non-zero, the display denom unit is added with decimals as exponent.
+func (m DenomMetadata) BankMetadata() banktypes.Metadata {
+	ibcDenom := m.Denom.IBCDenom()
+
+	denomUnits := []*banktypes.DenomUnit{
+		{
+			Denom:    ibcDenom,
+			Exponent: 0,
+			Aliases:  []string{m.Denom.Base},
+		},
+	}
+
+	display := ibcDenom
+	if m.Decimals > 0 {
+		display = m.Display
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    m.Display,
+			Exponent: m.Decimals,
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", m.Denom.Path()),
+		DenomUnits:  denomUnits,
+		Base:        ibcDenom,
+		Display:     display,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+		URI:         m.Uri,
+		URIHash:     m.UriHash,
+	}
+}
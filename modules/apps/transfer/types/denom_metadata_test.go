@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestDenomMetadataValidate(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))
+
+	testCases := []struct {
+		name     string
+		metadata types.DenomMetadata
+		expError error
+	}{
+		{
+			"success: metadata with decimals",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "atom", 6, "https://cosmos.network", ""),
+			nil,
+		},
+		{
+			"success: metadata without decimals",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "", 0, "", ""),
+			nil,
+		},
+		{
+			"failure: native denom",
+			types.NewDenomMetadata(types.NewDenom("uatom"), "Cosmos Hub Atom", "ATOM", "atom", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: invalid denom",
+			types.NewDenomMetadata(types.Denom{}, "Cosmos Hub Atom", "ATOM", "atom", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: empty name",
+			types.NewDenomMetadata(denom, "", "ATOM", "atom", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: empty symbol",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "", "atom", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: empty display with decimals",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: invalid display",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "0atom", 6, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"failure: display without decimals",
+			types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "atom", 0, "", ""),
+			types.ErrInvalidDenomForTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestDenomMetadataBankMetadata(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))
+	metadata := types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "atom", 6, "https://cosmos.network", "hash")
+
+	expMetadata := banktypes.Metadata{
+		Description: "IBC token from transfer/channel-0/uatom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom.IBCDenom(), Exponent: 0, Aliases: []string{"uatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    denom.IBCDenom(),
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		URI:     "https://cosmos.network",
+		URIHash: "hash",
+	}
+	require.Equal(t, expMetadata, metadata.BankMetadata())
+
+	// without decimals the IBC denom is displayed
+	metadata = types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "", 0, "", "")
+	require.Equal(t, denom.IBCDenom(), metadata.BankMetadata().Display)
+	require.Len(t, metadata.BankMetadata().DenomUnits, 1)
+}
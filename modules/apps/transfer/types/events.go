@@ -7,6 +7,12 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenom        = "denomination"
+	EventTypeReconcile    = "reconcile_escrow"
+
+	EventTypeScheduleTransfer          = "schedule_transfer"
+	EventTypeCancelScheduledTransfer   = "cancel_scheduled_transfer"
+	EventTypeDispatchScheduledTransfer = "dispatch_scheduled_transfer"
+	EventTypeReceiveHook               = "receive_hook"
 
 	AttributeKeySender         = "sender"
 	AttributeKeyReceiver       = "receiver"
@@ -19,4 +25,14 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyTrackedAmount  = "tracked_amount"
+	AttributeKeyEscrowedAmount = "escrowed_amount"
+	AttributeKeyScheduledID    = "scheduled_transfer_id"
+	AttributeKeyExecuteHeight  = "execute_height"
+	AttributeKeyExecuteTime    = "execute_timestamp"
+	AttributeKeySequence       = "packet_sequence"
+	AttributeKeySuccess        = "success"
+	AttributeKeyError          = "error"
+	AttributeKeyHandler        = "handler"
+	AttributeKeyIntermediate   = "intermediate_address"
 )
@@ -12,6 +12,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -44,6 +45,12 @@ type ChannelKeeper interface {
 	HasChannel(ctx sdk.Context, portID, channelID string) bool
 }
 
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	IterateClientStates(ctx sdk.Context, storePrefix []byte, cb func(clientID string, cs ibcexported.ClientState) bool)
+	GetClientStatus(ctx sdk.Context, clientID string) ibcexported.Status
+}
+
 // MessageRouter ADR 031 request type routing
 // https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-031-msg-service.md
 type MessageRouter interface {
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
@@ -19,10 +23,15 @@ func NewGenesisState(portID string, denoms Denoms, params Params, totalEscrowed
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:        PortID,
-		Denoms:        Denoms{},
-		Params:        DefaultParams(),
-		TotalEscrowed: sdk.Coins{},
+		PortId:                  PortID,
+		Denoms:                  Denoms{},
+		Params:                  DefaultParams(),
+		TotalEscrowed:           sdk.Coins{},
+		DenomTransferEnabled:    []DenomTransferEnabled{},
+		ChannelTransferEnabled:  []ChannelTransferEnabled{},
+		DenomMetadata:           []DenomMetadata{},
+		ScheduledTransfers:      []ScheduledTransfer{},
+		NextScheduledTransferId: 1,
 	}
 }
 
@@ -35,5 +44,65 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateTransferEnabled(gs.DenomTransferEnabled, gs.ChannelTransferEnabled); err != nil {
+		return err
+	}
+	if err := validateDenomMetadata(gs.DenomMetadata, gs.Denoms); err != nil {
+		return err
+	}
+	if err := validateScheduledTransfers(gs.ScheduledTransfers, gs.NextScheduledTransferId); err != nil {
+		return err
+	}
 	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
 }
+
+// validateDenomMetadata validates the registered denomination metadata and checks
+// that each denomination is unique and present in the provided denominations.
+func validateDenomMetadata(metadata []DenomMetadata, denoms Denoms) error {
+	knownDenoms := make(map[string]struct{})
+	for _, denom := range denoms {
+		knownDenoms[denom.Hash().String()] = struct{}{}
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for i, m := range metadata {
+		if err := m.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed to validate denom metadata index %d", i)
+		}
+
+		hash := m.Denom.Hash().String()
+		if _, ok := knownDenoms[hash]; !ok {
+			return errorsmod.Wrapf(ErrDenomNotFound, "denom metadata registered for unknown denom %s", m.Denom.Path())
+		}
+		if _, ok := seenDenoms[hash]; ok {
+			return fmt.Errorf("duplicate denom metadata for denom %s", m.Denom.Path())
+		}
+		seenDenoms[hash] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateScheduledTransfers validates the scheduled transfers and checks that each
+// identifier is unique and lower than the identifier of the next scheduled transfer.
+func validateScheduledTransfers(scheduledTransfers []ScheduledTransfer, nextID uint64) error {
+	seenIDs := make(map[uint64]struct{})
+	for i, st := range scheduledTransfers {
+		if err := st.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed to validate scheduled transfer index %d", i)
+		}
+
+		if st.Id >= nextID {
+			return errorsmod.Wrapf(ErrInvalidScheduledTransfer, "scheduled transfer identifier %d must be lower than the next identifier %d", st.Id, nextID)
+		}
+		if _, ok := seenIDs[st.Id]; ok {
+			return fmt.Errorf("duplicate scheduled transfer identifier %d", st.Id)
+		}
+		seenIDs[st.Id] = struct{}{}
+	}
+
+	return nil
+}
@@ -33,6 +33,16 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// denom_transfer_enabled contains the per denomination transfer enablement overrides
+	DenomTransferEnabled []DenomTransferEnabled `protobuf:"bytes,5,rep,name=denom_transfer_enabled,json=denomTransferEnabled,proto3" json:"denom_transfer_enabled"`
+	// channel_transfer_enabled contains the per channel or client transfer enablement overrides
+	ChannelTransferEnabled []ChannelTransferEnabled `protobuf:"bytes,6,rep,name=channel_transfer_enabled,json=channelTransferEnabled,proto3" json:"channel_transfer_enabled"`
+	// denom_metadata contains the registered IBC voucher denomination metadata
+	DenomMetadata []DenomMetadata `protobuf:"bytes,7,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// scheduled_transfers contains the scheduled transfers which have not been dispatched or cancelled
+	ScheduledTransfers []ScheduledTransfer `protobuf:"bytes,8,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers"`
+	// next_scheduled_transfer_id is the identifier assigned to the next scheduled transfer
+	NextScheduledTransferId uint64 `protobuf:"varint,9,opt,name=next_scheduled_transfer_id,json=nextScheduledTransferId,proto3" json:"next_scheduled_transfer_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +106,41 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetDenomTransferEnabled() []DenomTransferEnabled {
+	if m != nil {
+		return m.DenomTransferEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelTransferEnabled() []ChannelTransferEnabled {
+	if m != nil {
+		return m.ChannelTransferEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMetadata() []DenomMetadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledTransfers() []ScheduledTransfer {
+	if m != nil {
+		return m.ScheduledTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledTransferId() uint64 {
+	if m != nil {
+		return m.NextScheduledTransferId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -105,31 +150,40 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xe3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xca, 0x6a, 0xd3, 0xdd, 0x1e, 0xa2, 0x95, 0x36, 0x5b, 0xad, 0xd2, 0x0a,
-	0x38, 0x44, 0xa0, 0xda, 0x4d, 0xb9, 0x70, 0x0e, 0x20, 0x84, 0xb8, 0xa0, 0x70, 0xe3, 0x52, 0x39,
-	0x8e, 0x09, 0x56, 0x9b, 0x4c, 0x14, 0xbb, 0x41, 0xbc, 0x05, 0xcf, 0x81, 0x78, 0x90, 0x1e, 0x7b,
-	0xe4, 0x04, 0xa8, 0x7d, 0x11, 0x14, 0x27, 0x45, 0x95, 0x90, 0x72, 0xf2, 0x8c, 0xfd, 0xcd, 0x3f,
-	0xe3, 0x7f, 0xcc, 0x43, 0x1e, 0x52, 0x4c, 0xb2, 0x6c, 0xce, 0x29, 0x91, 0x1c, 0x52, 0x81, 0x65,
-	0x4e, 0x52, 0x71, 0xc7, 0x72, 0x5c, 0x78, 0x38, 0x66, 0x29, 0x13, 0x5c, 0xa0, 0x2c, 0x07, 0x09,
-	0xd6, 0x7f, 0x1e, 0x52, 0xb4, 0xcb, 0xa2, 0x2d, 0x8b, 0x0a, 0xaf, 0x7f, 0xd4, 0xa8, 0xf4, 0x45,
-	0x2a, 0xa9, 0xbe, 0xdb, 0x0c, 0xc3, 0x8c, 0xa5, 0x35, 0xe9, 0x50, 0x10, 0x09, 0x08, 0x1c, 0x12,
-	0xc1, 0x70, 0xe1, 0x85, 0x4c, 0x12, 0x0f, 0x53, 0xe0, 0xdb, 0xf7, 0x3f, 0x31, 0xc4, 0xa0, 0x42,
-	0x5c, 0x46, 0xd5, 0xed, 0xde, 0x4b, 0xcb, 0xfc, 0x75, 0x51, 0x0d, 0x7f, 0x23, 0x89, 0x64, 0xd6,
-	0x5f, 0xf3, 0x47, 0x06, 0xb9, 0x9c, 0xf2, 0xc8, 0xd6, 0x87, 0xba, 0xfb, 0x33, 0x30, 0xca, 0xf4,
-	0x32, 0xb2, 0xae, 0x4c, 0x23, 0x62, 0x29, 0x24, 0xc2, 0x6e, 0x0d, 0xdb, 0x6e, 0x77, 0xb2, 0x8f,
-	0x9a, 0x7e, 0x89, 0xce, 0x4a, 0xd6, 0xef, 0x2d, 0xdf, 0x06, 0xda, 0xf3, 0xfb, 0xc0, 0x50, 0xa9,
-	0x08, 0x6a, 0x09, 0xcb, 0x37, 0x8d, 0x8c, 0xe4, 0x24, 0x11, 0x76, 0x7b, 0xa8, 0xbb, 0xdd, 0xc9,
-	0x41, 0xb3, 0xd8, 0xb5, 0x62, 0xfd, 0x4e, 0xa9, 0x16, 0xd4, 0x95, 0x56, 0x6e, 0xf6, 0x24, 0x48,
-	0x32, 0x9f, 0x32, 0x41, 0x73, 0x78, 0x60, 0x91, 0xdd, 0x51, 0x83, 0xfd, 0x43, 0x95, 0x13, 0xa8,
-	0x74, 0x02, 0xd5, 0x4e, 0xa0, 0x53, 0xe0, 0xa9, 0x3f, 0xae, 0xc7, 0x71, 0x63, 0x2e, 0xef, 0x17,
-	0x21, 0xa2, 0x90, 0xe0, 0xda, 0xb6, 0xea, 0x18, 0x89, 0x68, 0x86, 0xe5, 0x63, 0xc6, 0x84, 0x2a,
-	0x10, 0xc1, 0x6f, 0xd5, 0xe2, 0xbc, 0xee, 0xe0, 0x07, 0xcb, 0xb5, 0xa3, 0xaf, 0xd6, 0x8e, 0xfe,
-	0xb1, 0x76, 0xf4, 0xa7, 0x8d, 0xa3, 0xad, 0x36, 0x8e, 0xf6, 0xba, 0x71, 0xb4, 0xdb, 0x93, 0xef,
-	0x92, 0x3c, 0xa4, 0xa3, 0x18, 0x70, 0xe1, 0x8d, 0x71, 0x02, 0xd1, 0x62, 0xce, 0x44, 0xb9, 0xc9,
-	0x9d, 0x0d, 0xaa, 0x46, 0xa1, 0xa1, 0x36, 0x71, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0x83, 0x55,
-	0x07, 0xb2, 0x62, 0x02, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x56, 0x32, 0xe6, 0xb1, 0x1e, 0xcc, 0xb4, 0x85, 0x0a, 0x65, 0x15, 0x70, 0x88,
+	0x98, 0x66, 0xaf, 0x05, 0x24, 0x24, 0x6e, 0x1d, 0x13, 0x9a, 0x10, 0x12, 0xca, 0x38, 0x20, 0x2e,
+	0x95, 0x63, 0x7b, 0xad, 0xb5, 0xc4, 0x8e, 0x62, 0xaf, 0xc0, 0x5b, 0xc0, 0x6b, 0xf0, 0x24, 0x3b,
+	0xee, 0xc8, 0x09, 0x50, 0xfb, 0x22, 0x53, 0x1c, 0x77, 0xaa, 0x96, 0x2a, 0xda, 0x29, 0xb1, 0xfd,
+	0xff, 0xfe, 0x3f, 0x7f, 0x7f, 0x7d, 0x06, 0x2f, 0x44, 0x42, 0x31, 0xc9, 0xf3, 0x54, 0x50, 0x62,
+	0x84, 0x92, 0x1a, 0x9b, 0x82, 0x48, 0x7d, 0xc6, 0x0b, 0x3c, 0xed, 0xe3, 0x31, 0x97, 0x5c, 0x0b,
+	0x8d, 0xf2, 0x42, 0x19, 0x05, 0x9f, 0x88, 0x84, 0xa2, 0x65, 0x2d, 0x5a, 0x68, 0xd1, 0xb4, 0xdf,
+	0xdd, 0x6f, 0x74, 0xba, 0x51, 0x5a, 0xab, 0x6e, 0xd4, 0x2c, 0x56, 0xe7, 0x5c, 0x3a, 0xe5, 0xeb,
+	0x46, 0xa5, 0xa6, 0x13, 0xce, 0x2e, 0x52, 0xce, 0x46, 0xb7, 0x00, 0x21, 0x55, 0x3a, 0x53, 0x1a,
+	0x27, 0x44, 0x73, 0x3c, 0xed, 0x27, 0xdc, 0x90, 0x3e, 0xa6, 0x4a, 0x2c, 0x6c, 0xb7, 0xc7, 0x6a,
+	0xac, 0xec, 0x2f, 0x2e, 0xff, 0xaa, 0xdd, 0xa7, 0xbf, 0x7c, 0xf0, 0xf0, 0x7d, 0xd5, 0xf3, 0xa9,
+	0x21, 0x86, 0xc3, 0x5d, 0xb0, 0x9e, 0xab, 0xc2, 0x8c, 0x04, 0x0b, 0xbc, 0x9e, 0x17, 0x6d, 0xc4,
+	0x7e, 0xb9, 0x3c, 0x61, 0xf0, 0x03, 0xf0, 0x19, 0x97, 0x2a, 0xd3, 0xc1, 0xbd, 0xde, 0x5a, 0xb4,
+	0x39, 0x78, 0x86, 0x9a, 0xc2, 0x41, 0xef, 0x4a, 0xed, 0xb0, 0x73, 0xf9, 0x77, 0xaf, 0xf5, 0xfb,
+	0xdf, 0x9e, 0x6f, 0x97, 0x3a, 0x76, 0x16, 0x70, 0x08, 0xfc, 0x9c, 0x14, 0x24, 0xd3, 0xc1, 0x5a,
+	0xcf, 0x8b, 0x36, 0x07, 0xcf, 0x9b, 0xcd, 0x3e, 0x59, 0xed, 0xb0, 0x5d, 0xba, 0xc5, 0xae, 0x12,
+	0x16, 0xa0, 0x63, 0x94, 0x21, 0xe9, 0x88, 0x6b, 0x5a, 0xa8, 0x6f, 0x9c, 0x05, 0x6d, 0x7b, 0xb1,
+	0xc7, 0xa8, 0x4a, 0x02, 0x95, 0x49, 0x20, 0x97, 0x04, 0x3a, 0x52, 0x42, 0x0e, 0x0f, 0xdd, 0x75,
+	0xa2, 0xb1, 0x30, 0x93, 0x8b, 0x04, 0x51, 0x95, 0x61, 0x17, 0x5b, 0xf5, 0x39, 0xd0, 0xec, 0x1c,
+	0x9b, 0x1f, 0x39, 0xd7, 0xb6, 0x40, 0xc7, 0x5b, 0x16, 0x71, 0xec, 0x08, 0x50, 0x82, 0x1d, 0xdb,
+	0xc1, 0x4d, 0xf8, 0x23, 0x2e, 0x49, 0x92, 0x72, 0x16, 0xdc, 0xb7, 0xec, 0xc1, 0x1d, 0x42, 0xf9,
+	0xec, 0x36, 0x8e, 0xab, 0x4a, 0xd7, 0xd5, 0x36, 0x5b, 0x71, 0x06, 0x0d, 0x08, 0xe8, 0x84, 0x48,
+	0xc9, 0xd3, 0x3a, 0xd1, 0xb7, 0xc4, 0x57, 0xcd, 0xc4, 0xa3, 0xaa, 0x7a, 0x35, 0x73, 0x87, 0xae,
+	0x3c, 0x85, 0x5f, 0x40, 0xa7, 0xea, 0x32, 0xe3, 0x86, 0x30, 0x62, 0x48, 0xb0, 0x6e, 0x59, 0xfb,
+	0x77, 0xe8, 0xee, 0xa3, 0x2b, 0x71, 0x88, 0x2d, 0xb6, 0xbc, 0x09, 0xcf, 0xc0, 0xa3, 0xfa, 0x00,
+	0xeb, 0xe0, 0x81, 0xb5, 0xc7, 0xcd, 0xf6, 0xa7, 0x8b, 0xc2, 0xc5, 0x75, 0x1d, 0x02, 0xea, 0xdb,
+	0x07, 0x1a, 0xbe, 0x05, 0x5d, 0xc9, 0xbf, 0x9b, 0x51, 0x1d, 0x56, 0x0e, 0xf6, 0x46, 0xcf, 0x8b,
+	0xda, 0xf1, 0x6e, 0xa9, 0xa8, 0x99, 0x9e, 0xb0, 0x61, 0x7c, 0x39, 0x0b, 0xbd, 0xab, 0x59, 0xe8,
+	0xfd, 0x9f, 0x85, 0xde, 0xcf, 0x79, 0xd8, 0xba, 0x9a, 0x87, 0xad, 0x3f, 0xf3, 0xb0, 0xf5, 0xf5,
+	0x4d, 0x7d, 0x6e, 0x44, 0x42, 0x0f, 0xc6, 0x0a, 0x4f, 0xfb, 0x87, 0x38, 0x53, 0xa5, 0x93, 0x2e,
+	0xdf, 0xee, 0xd2, 0x9b, 0xb5, 0xd3, 0x94, 0xf8, 0xf6, 0xb9, 0xbd, 0xbc, 0x1e, 0x00, 0xc7, 0x5c,
+	0xd9, 0x60, 0x7e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +206,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledTransferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledTransferId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for iNdEx := len(m.ScheduledTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChannelTransferEnabled) > 0 {
+		for iNdEx := len(m.ChannelTransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DenomTransferEnabled) > 0 {
+		for iNdEx := len(m.DenomTransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +350,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomTransferEnabled) > 0 {
+		for _, e := range m.DenomTransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelTransferEnabled) > 0 {
+		for _, e := range m.ChannelTransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for _, e := range m.DenomMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for _, e := range m.ScheduledTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledTransferId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledTransferId))
+	}
 	return n
 }
 
@@ -406,6 +548,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransferEnabled = append(m.DenomTransferEnabled, DenomTransferEnabled{})
+			if err := m.DenomTransferEnabled[len(m.DenomTransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTransferEnabled = append(m.ChannelTransferEnabled, ChannelTransferEnabled{})
+			if err := m.ChannelTransferEnabled[len(m.ChannelTransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadata = append(m.DenomMetadata, DenomMetadata{})
+			if err := m.DenomMetadata[len(m.DenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTransfers = append(m.ScheduledTransfers, ScheduledTransfer{})
+			if err := m.ScheduledTransfers[len(m.ScheduledTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTransferId", wireType)
+			}
+			m.NextScheduledTransferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledTransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
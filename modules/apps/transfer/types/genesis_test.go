@@ -1,12 +1,16 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -27,6 +31,61 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"valid genesis with transfer enabled overrides",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				DenomTransferEnabled:   []types.DenomTransferEnabled{types.NewDenomTransferEnabled("uatom", false, true)},
+				ChannelTransferEnabled: []types.ChannelTransferEnabled{types.NewChannelTransferEnabled("channel-0", true, false), types.NewChannelTransferEnabled("07-tendermint-0", false, false)},
+			},
+			nil,
+		},
+		{
+			"valid genesis with denom metadata",
+			&types.GenesisState{
+				PortId:        "portidone",
+				Denoms:        types.Denoms{types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))},
+				DenomMetadata: []types.DenomMetadata{types.NewDenomMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")), "Atom", "ATOM", "atom", 6, "", "")},
+			},
+			nil,
+		},
+		{
+			"invalid denom metadata registrar",
+			&types.GenesisState{
+				PortId: "portidone",
+				Params: types.Params{DenomMetadataRegistrar: "invalid"},
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid denom metadata",
+			&types.GenesisState{
+				PortId:        "portidone",
+				Denoms:        types.Denoms{types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))},
+				DenomMetadata: []types.DenomMetadata{types.NewDenomMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")), "", "ATOM", "atom", 6, "", "")},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"denom metadata for unknown denom",
+			&types.GenesisState{
+				PortId:        "portidone",
+				DenomMetadata: []types.DenomMetadata{types.NewDenomMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")), "Atom", "ATOM", "atom", 6, "", "")},
+			},
+			types.ErrDenomNotFound,
+		},
+		{
+			"duplicate denom metadata",
+			&types.GenesisState{
+				PortId: "portidone",
+				Denoms: types.Denoms{types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))},
+				DenomMetadata: []types.DenomMetadata{
+					types.NewDenomMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")), "Atom", "ATOM", "atom", 6, "", ""),
+					types.NewDenomMetadata(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")), "Atom", "ATOM", "", 0, "", ""),
+				},
+			},
+			errors.New("duplicate denom metadata"),
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -34,6 +93,77 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid denom transfer enabled denom",
+			&types.GenesisState{
+				PortId:               "portidone",
+				DenomTransferEnabled: []types.DenomTransferEnabled{types.NewDenomTransferEnabled("0atom", false, true)},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"duplicate denom transfer enabled denom",
+			&types.GenesisState{
+				PortId:               "portidone",
+				DenomTransferEnabled: []types.DenomTransferEnabled{types.NewDenomTransferEnabled("uatom", false, true), types.NewDenomTransferEnabled("uatom", true, false)},
+			},
+			errors.New("duplicate denom transfer enabled entry"),
+		},
+		{
+			"invalid channel transfer enabled channel",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				ChannelTransferEnabled: []types.ChannelTransferEnabled{types.NewChannelTransferEnabled("(INVALIDCHANNEL)", false, true)},
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate channel transfer enabled channel",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				ChannelTransferEnabled: []types.ChannelTransferEnabled{types.NewChannelTransferEnabled("channel-0", false, true), types.NewChannelTransferEnabled("channel-0", true, false)},
+			},
+			errors.New("duplicate channel transfer enabled entry"),
+		},
+		{
+			"valid scheduled transfer",
+			&types.GenesisState{
+				PortId:                  "portidone",
+				ScheduledTransfers:      []types.ScheduledTransfer{types.NewScheduledTransfer(1, scheduledMsgTransfer(), 0, 10)},
+				NextScheduledTransferId: 2,
+			},
+			nil,
+		},
+		{
+			"invalid scheduled transfer",
+			&types.GenesisState{
+				PortId:                  "portidone",
+				ScheduledTransfers:      []types.ScheduledTransfer{types.NewScheduledTransfer(1, scheduledMsgTransfer(), 0, 0)},
+				NextScheduledTransferId: 2,
+			},
+			types.ErrInvalidScheduledTransfer,
+		},
+		{
+			"scheduled transfer identifier not lower than next identifier",
+			&types.GenesisState{
+				PortId:                  "portidone",
+				ScheduledTransfers:      []types.ScheduledTransfer{types.NewScheduledTransfer(2, scheduledMsgTransfer(), 0, 10)},
+				NextScheduledTransferId: 2,
+			},
+			types.ErrInvalidScheduledTransfer,
+		},
+		{
+			"duplicate scheduled transfer identifier",
+			&types.GenesisState{
+				PortId: "portidone",
+				ScheduledTransfers: []types.ScheduledTransfer{
+					types.NewScheduledTransfer(1, scheduledMsgTransfer(), 0, 10),
+					types.NewScheduledTransfer(1, scheduledMsgTransfer(), 0, 20),
+				},
+				NextScheduledTransferId: 2,
+			},
+			errors.New("duplicate scheduled transfer identifier"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -41,7 +171,11 @@ func TestValidateGenesis(t *testing.T) {
 		if tc.expErr == nil {
 			require.NoError(t, err, tc.name)
 		} else {
-			require.ErrorIs(t, err, tc.expErr, tc.name)
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, tc.name)
 		}
 	}
 }
+
+func scheduledMsgTransfer() types.MsgTransfer {
+	return *types.NewMsgTransfer(ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TestCoin, ibctesting.TestAccAddress, ibctesting.TestAccAddress, clienttypes.ZeroHeight(), 100, "")
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -36,6 +38,9 @@ const (
 	// V1 defines first version of the IBC transfer module
 	V1 = "ics20-1"
 
+	// V2 defines the version of the IBC transfer module which transfers multiple tokens in a single packet
+	V2 = "ics20-2"
+
 	// escrowAddressVersion should remain as ics20-1 to avoid the address changing.
 	// this address has been reasoned about to avoid collisions with other addresses
 	// https://github.com/cosmos/cosmos-sdk/issues/7737#issuecomment-735671951
@@ -49,9 +54,27 @@ var (
 	DenomTraceKey = []byte{0x02}
 	// DenomKey defines the key to store the token denomination in store
 	DenomKey = []byte{0x03}
+	// DenomTransferEnabledKey defines the key prefix to store the per denomination transfer enablement overrides
+	DenomTransferEnabledKey = []byte{0x04}
+	// ChannelTransferEnabledKey defines the key prefix to store the per channel or client transfer enablement overrides
+	ChannelTransferEnabledKey = []byte{0x05}
+	// DenomMetadataKey defines the key prefix to store the registered IBC voucher denomination metadata
+	DenomMetadataKey = []byte{0x06}
+	// DenomByBaseDenomKey defines the key prefix of the index of denomination hashes by base denomination
+	DenomByBaseDenomKey = []byte{0x07}
+	// DenomByHopKey defines the key prefix of the index of denomination hashes by the hops of their trace
+	DenomByHopKey = []byte{0x08}
+	// ScheduledTransferKey defines the key prefix to store the scheduled transfers by identifier
+	ScheduledTransferKey = []byte{0x09}
+	// ScheduledTransferByHeightKey defines the key prefix of the queue of scheduled transfers by execution height
+	ScheduledTransferByHeightKey = []byte{0x0a}
+	// ScheduledTransferByTimestampKey defines the key prefix of the queue of scheduled transfers by execution timestamp
+	ScheduledTransferByTimestampKey = []byte{0x0b}
+	// NextScheduledTransferIDKey defines the key to store the identifier of the next scheduled transfer
+	NextScheduledTransferIDKey = []byte{0x0c}
 
 	// SupportedVersions defines all versions that are supported by the module
-	SupportedVersions = []string{V1}
+	SupportedVersions = []string{V1, V2}
 
 	// KeySendEnabled is store's key for SendEnabled Params
 	KeySendEnabled = []byte("SendEnabled")
@@ -80,3 +103,35 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 func TotalEscrowForDenomKey(denom string) []byte {
 	return fmt.Appendf(nil, "%s/%s", KeyTotalEscrowPrefix, denom)
 }
+
+// DenomByBaseDenomPrefix returns the key prefix under which the hashes of all
+// denominations with the given base denomination are indexed.
+func DenomByBaseDenomPrefix(baseDenom string) []byte {
+	return appendLengthPrefixed(slices.Clone(DenomByBaseDenomKey), baseDenom)
+}
+
+// DenomByHopPrefix returns the key prefix under which the hashes of all
+// denominations whose trace contains the given hop are indexed.
+func DenomByHopPrefix(portID, channelID string) []byte {
+	return appendLengthPrefixed(appendLengthPrefixed(slices.Clone(DenomByHopKey), portID), channelID)
+}
+
+// ScheduledTransferStoreKey returns the store key under which the scheduled transfer
+// with the given identifier is stored.
+func ScheduledTransferStoreKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(slices.Clone(ScheduledTransferKey), id)
+}
+
+// ScheduledTransferQueueKey returns the key of a scheduled transfer in the queue with the
+// given prefix, ordered by execution height or timestamp and then by identifier.
+func ScheduledTransferQueueKey(queuePrefix []byte, executeAt, id uint64) []byte {
+	key := binary.BigEndian.AppendUint64(slices.Clone(queuePrefix), executeAt)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// appendLengthPrefixed appends the length prefixed string to the key, so that
+// a variable length key component is never a prefix of another one.
+func appendLengthPrefixed(key []byte, s string) []byte {
+	key = binary.AppendUvarint(key, uint64(len(s)))
+	return append(key, s...)
+}
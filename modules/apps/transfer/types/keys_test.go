@@ -22,3 +22,10 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// Test that the denom index prefixes of different base denoms or hops never overlap
+func TestDenomIndexPrefixes(t *testing.T) {
+	require.NotEqual(t, types.DenomByBaseDenomPrefix("uatom"), types.DenomByBaseDenomPrefix("uatom2")[:len(types.DenomByBaseDenomPrefix("uatom"))])
+	require.NotEqual(t, types.DenomByHopPrefix("transfer", "channel"), types.DenomByHopPrefix("transfercha", "nnel"))
+	require.NotEqual(t, types.DenomByHopPrefix("transfer", "channel-1"), types.DenomByHopPrefix("transfer", "channel-10")[:len(types.DenomByHopPrefix("transfer", "channel-1"))])
+}
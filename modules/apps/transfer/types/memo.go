@@ -0,0 +1,5 @@
+package types
+
+// MemoKeyValidator validates the value of a top-level key of a memo which is a JSON
+// object. The value is decoded by encoding/json, as returned by GetCustomPacketData.
+type MemoKeyValidator func(value any) error
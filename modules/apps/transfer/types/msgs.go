@@ -16,13 +16,24 @@ import (
 const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgSetTransferEnabled)(nil)
+	_ sdk.Msg              = (*MsgSetDenomMetadata)(nil)
+	_ sdk.Msg              = (*MsgReconcileEscrow)(nil)
+	_ sdk.Msg              = (*MsgScheduleTransfer)(nil)
+	_ sdk.Msg              = (*MsgCancelScheduledTransfer)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetTransferEnabled)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetDenomMetadata)(nil)
+	_ sdk.HasValidateBasic = (*MsgReconcileEscrow)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelScheduledTransfer)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -40,6 +51,120 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	return msg.Params.Validate()
+}
+
+// NewMsgSetTransferEnabled creates a new MsgSetTransferEnabled instance
+func NewMsgSetTransferEnabled(signer string, denoms []DenomTransferEnabled, channels []ChannelTransferEnabled) *MsgSetTransferEnabled {
+	return &MsgSetTransferEnabled{
+		Signer:   signer,
+		Denoms:   denoms,
+		Channels: channels,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetTransferEnabled) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Denoms) == 0 && len(msg.Channels) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "at least one denom or channel override must be set")
+	}
+
+	if err := ValidateTransferEnabled(msg.Denoms, msg.Channels); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance
+func NewMsgSetDenomMetadata(signer string, metadata DenomMetadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Signer:   signer,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Metadata.Validate()
+}
+
+// NewMsgReconcileEscrow creates a new MsgReconcileEscrow instance
+func NewMsgReconcileEscrow(signer string, denoms []string) *MsgReconcileEscrow {
+	return &MsgReconcileEscrow{
+		Signer: signer,
+		Denoms: denoms,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgReconcileEscrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Denoms) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "at least one denom must be set")
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, denom := range msg.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := seenDenoms[denom]; ok {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewMsgScheduleTransfer creates a new MsgScheduleTransfer instance
+func NewMsgScheduleTransfer(transfer MsgTransfer, executeHeight, executeTimestamp uint64) *MsgScheduleTransfer {
+	return &MsgScheduleTransfer{
+		Transfer:         transfer,
+		ExecuteHeight:    executeHeight,
+		ExecuteTimestamp: executeTimestamp,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgScheduleTransfer) ValidateBasic() error {
+	return validateSchedule(msg.Transfer, msg.ExecuteHeight, msg.ExecuteTimestamp)
+}
+
+// NewMsgCancelScheduledTransfer creates a new MsgCancelScheduledTransfer instance
+func NewMsgCancelScheduledTransfer(sender string, id uint64) *MsgCancelScheduledTransfer {
+	return &MsgCancelScheduledTransfer{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgCancelScheduledTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if msg.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledTransfer, "identifier cannot be zero")
+	}
+
 	return nil
 }
 
@@ -106,6 +231,26 @@ func NewMsgTransferWithEncoding(
 	}
 }
 
+// NewMsgTransferWithTokens creates a new MsgTransfer instance
+// transferring multiple tokens atomically in a single packet
+func NewMsgTransferWithTokens(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
 // ValidateBasic performs a basic check of the MsgTransfer fields.
 // NOTE: If you are sending with V1 protocol, timeoutHeight or timeoutTimestamp must be non-zero,
 // if you are sending with V2 protocol, timeoutTimestamp must be non-zero and timeoutHeight must be zero
@@ -116,8 +261,8 @@ func (msg MsgTransfer) ValidateBasic() error {
 		return err
 	}
 
-	if !isValidIBCCoin(msg.Token) {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+	if err := msg.validateCoins(); err != nil {
+		return err
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -137,8 +282,62 @@ func (msg MsgTransfer) ValidateBasic() error {
 	return nil
 }
 
+// GetCoins returns the coins to be transferred. These are either the single token,
+// or the list of tokens transferred atomically in a single packet.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) > 0 {
+		return msg.Tokens
+	}
+
+	return sdk.Coins{msg.Token}
+}
+
+// validateCoins checks that either the single token or the list of tokens is set,
+// and that all coins to be transferred are valid and have unique denominations.
+func (msg MsgTransfer) validateCoins() error {
+	if len(msg.Tokens) > 0 && !isZeroCoin(msg.Token) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "cannot set both token and tokens")
+	}
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seen := make(map[string]struct{}, len(msg.Tokens))
+	for _, coin := range msg.GetCoins() {
+		if !isValidIBCCoin(coin) {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coin.String())
+		}
+		if msg.Unwind && !strings.HasPrefix(coin.Denom, DenomPrefix+"/") {
+			return errorsmod.Wrapf(ErrInvalidUnwind, "only IBC denominations can be unwound, got %s", coin.Denom)
+		}
+		if _, found := seen[coin.Denom]; found {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate denomination %s", coin.Denom)
+		}
+		seen[coin.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// isZeroCoin returns true if the coin has not been set.
+func isZeroCoin(coin sdk.Coin) bool {
+	return coin.Denom == "" && (coin.Amount.IsNil() || coin.Amount.IsZero())
+}
+
 // validateIdentifiers checks if the source port and channel identifiers are valid
 func (msg MsgTransfer) validateIdentifiers() error {
+	// when unwinding, the source port and channel are set from the denomination trace of the tokens
+	if msg.Unwind {
+		if msg.SourcePort != "" || msg.SourceChannel != "" {
+			return errorsmod.Wrap(ErrInvalidUnwind, "source port and channel must be empty when unwinding")
+		}
+		if msg.UseAliasing {
+			return errorsmod.Wrap(ErrInvalidUnwind, "cannot use aliasing when unwinding")
+		}
+
+		return nil
+	}
+
 	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
 		return errorsmod.Wrapf(err, "invalid source port ID %s", msg.SourcePort)
 	}
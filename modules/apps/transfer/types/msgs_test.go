@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,13 @@ var (
 )
 
 // TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+// newUnwindMsgTransfer returns an unwinding MsgTransfer with the given source port and channel.
+func newUnwindMsgTransfer(sourcePort, sourceChannel string, token sdk.Coin) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(sourcePort, sourceChannel, token, sender, receiver, clienttypes.ZeroHeight(), 100, "")
+	msg.Unwind = true
+	return msg
+}
+
 func TestMsgTransferValidation(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -80,6 +88,26 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidAddress},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
 		{"invalid aliased channel", types.NewMsgTransferAliased(validPort, eurekaClient, coin, sender, receiver, clienttypes.ZeroHeight(), 100, ""), host.ErrInvalidID},
+		{"valid msg with multiple tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), sender, receiver, clienttypes.ZeroHeight(), 100, ""), nil},
+		{"valid eureka msg with multiple tokens", types.NewMsgTransferWithTokens(validPort, eurekaClient, sdk.NewCoins(coin, ibcCoin), sender, receiver, clienttypes.ZeroHeight(), 100, ""), nil},
+		{"invalid ibc denom in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, invalidIBCCoin}, sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
+		{"zero coin in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, zeroCoin}, sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
+		{"duplicate denom in tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{coin, coin}, sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
+		{"too many tokens", types.NewMsgTransferWithTokens(validPort, validChannel, generateCoins(types.MaximumTokensLength+1), sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
+		{"both token and tokens set", func() *types.MsgTransfer {
+			msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "")
+			msg.Tokens = sdk.NewCoins(ibcCoin)
+			return msg
+		}(), ibcerrors.ErrInvalidCoins},
+		{"valid unwind msg", newUnwindMsgTransfer("", "", ibcCoin), nil},
+		{"unwind with source port", newUnwindMsgTransfer(validPort, "", ibcCoin), types.ErrInvalidUnwind},
+		{"unwind with source channel", newUnwindMsgTransfer("", validChannel, ibcCoin), types.ErrInvalidUnwind},
+		{"unwind with aliasing", func() *types.MsgTransfer {
+			msg := newUnwindMsgTransfer("", "", ibcCoin)
+			msg.UseAliasing = true
+			return msg
+		}(), types.ErrInvalidUnwind},
+		{"unwind with base denom", newUnwindMsgTransfer("", "", coin), types.ErrInvalidUnwind},
 	}
 
 	for _, tc := range testCases {
@@ -95,6 +123,24 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// TestMsgTransferGetCoins tests GetCoins for MsgTransfer
+func TestMsgTransferGetCoins(t *testing.T) {
+	msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	require.Equal(t, sdk.Coins{coin}, msg.GetCoins())
+
+	msg = types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), sender, receiver, timeoutHeight, 0, "")
+	require.Equal(t, sdk.NewCoins(coin, ibcCoin), msg.GetCoins())
+}
+
+// generateCoins returns the given number of valid coins with distinct denominations
+func generateCoins(n int) sdk.Coins {
+	coins := make(sdk.Coins, n)
+	for i := range coins {
+		coins[i] = sdk.NewCoin(fmt.Sprintf("denom%d", i), sdkmath.NewInt(100))
+	}
+	return coins
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -116,6 +162,73 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), nil},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
+		{"success: valid denom metadata registrar", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true, DenomMetadataRegistrar: sender}), nil},
+		{"failure: invalid denom metadata registrar", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true, DenomMetadataRegistrar: invalidAddress}), ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgSetTransferEnabledValidateBasic tests ValidateBasic for MsgSetTransferEnabled
+func TestMsgSetTransferEnabledValidateBasic(t *testing.T) {
+	denoms := []types.DenomTransferEnabled{types.NewDenomTransferEnabled(coin.Denom, false, true)}
+	channels := []types.ChannelTransferEnabled{types.NewChannelTransferEnabled(validChannel, true, false)}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetTransferEnabled
+		expError error
+	}{
+		{"success: valid denom and channel overrides", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, denoms, channels), nil},
+		{"success: valid denom override", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, denoms, nil), nil},
+		{"success: valid client override", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, nil, []types.ChannelTransferEnabled{types.NewChannelTransferEnabled(eurekaClient, false, false)}), nil},
+		{"failure: invalid signer", types.NewMsgSetTransferEnabled(invalidAddress, denoms, channels), ibcerrors.ErrInvalidAddress},
+		{"failure: empty signer", types.NewMsgSetTransferEnabled(emptyAddr, denoms, channels), ibcerrors.ErrInvalidAddress},
+		{"failure: no overrides", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, nil, nil), ibcerrors.ErrInvalidRequest},
+		{"failure: invalid denom", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, []types.DenomTransferEnabled{types.NewDenomTransferEnabled(invalidDenomCoin.Denom, false, true)}, nil), ibcerrors.ErrInvalidRequest},
+		{"failure: invalid channel", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, nil, []types.ChannelTransferEnabled{types.NewChannelTransferEnabled(invalidChannel, false, true)}), ibcerrors.ErrInvalidRequest},
+		{"failure: duplicate denom", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, append(denoms, denoms...), nil), ibcerrors.ErrInvalidRequest},
+		{"failure: duplicate channel", types.NewMsgSetTransferEnabled(ibctesting.TestAccAddress, nil, append(channels, channels...)), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgSetDenomMetadataValidateBasic tests ValidateBasic for MsgSetDenomMetadata
+func TestMsgSetDenomMetadataValidateBasic(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop(validPort, validChannel))
+	metadata := types.NewDenomMetadata(denom, "Cosmos Hub Atom", "ATOM", "atom", 6, "", "")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetDenomMetadata
+		expError error
+	}{
+		{"success: valid signer and metadata", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, metadata), nil},
+		{"failure: invalid signer", types.NewMsgSetDenomMetadata(invalidAddress, metadata), ibcerrors.ErrInvalidAddress},
+		{"failure: empty signer", types.NewMsgSetDenomMetadata(emptyAddr, metadata), ibcerrors.ErrInvalidAddress},
+		{"failure: native denom", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, types.NewDenomMetadata(types.NewDenom("uatom"), "Atom", "ATOM", "atom", 6, "", "")), types.ErrInvalidDenomForTransfer},
+		{"failure: invalid metadata", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, types.NewDenomMetadata(denom, "", "ATOM", "atom", 6, "", "")), types.ErrInvalidDenomForTransfer},
 	}
 
 	for _, tc := range testCases {
@@ -132,6 +245,104 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 }
 
 // TestMsgUpdateParamsGetSigners tests GetSigners for MsgUpdateParams
+// TestMsgReconcileEscrowValidateBasic tests ValidateBasic for MsgReconcileEscrow
+func TestMsgReconcileEscrowValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgReconcileEscrow
+		expError error
+	}{
+		{"success: valid signer and denoms", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, []string{coin.Denom, ibcCoin.Denom}), nil},
+		{"failure: invalid signer", types.NewMsgReconcileEscrow(invalidAddress, []string{coin.Denom}), ibcerrors.ErrInvalidAddress},
+		{"failure: empty signer", types.NewMsgReconcileEscrow(emptyAddr, []string{coin.Denom}), ibcerrors.ErrInvalidAddress},
+		{"failure: no denoms", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, nil), ibcerrors.ErrInvalidRequest},
+		{"failure: invalid denom", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, []string{invalidDenomCoin.Denom}), ibcerrors.ErrInvalidRequest},
+		{"failure: duplicate denom", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, []string{coin.Denom, coin.Denom}), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgScheduleTransferValidateBasic tests ValidateBasic for MsgScheduleTransfer
+func TestMsgScheduleTransferValidateBasic(t *testing.T) {
+	heightTransfer := *types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	timestampTransfer := *types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgScheduleTransfer
+		expError error
+	}{
+		{"success: scheduled by height", types.NewMsgScheduleTransfer(heightTransfer, 5, 0), nil},
+		{"success: scheduled by timestamp", types.NewMsgScheduleTransfer(timestampTransfer, 0, 10), nil},
+		{"failure: invalid transfer", types.NewMsgScheduleTransfer(*types.NewMsgTransfer(validPort, validChannel, zeroCoin, sender, receiver, timeoutHeight, 0, ""), 5, 0), ibcerrors.ErrInvalidCoins},
+		{"failure: neither height nor timestamp", types.NewMsgScheduleTransfer(heightTransfer, 0, 0), types.ErrInvalidScheduledTransfer},
+		{"failure: both height and timestamp", types.NewMsgScheduleTransfer(heightTransfer, 5, 5), types.ErrInvalidScheduledTransfer},
+		{"failure: timeout height at execute height", types.NewMsgScheduleTransfer(heightTransfer, 10, 0), types.ErrInvalidScheduledTransfer},
+		{"failure: timeout timestamp at execute timestamp", types.NewMsgScheduleTransfer(timestampTransfer, 0, 100), types.ErrInvalidScheduledTransfer},
+		{"failure: scheduled by height with timeout timestamp", types.NewMsgScheduleTransfer(timestampTransfer, 5, 0), types.ErrInvalidScheduledTransfer},
+		{"failure: scheduled by timestamp with timeout height", types.NewMsgScheduleTransfer(heightTransfer, 0, 10), types.ErrInvalidScheduledTransfer},
+		{"failure: scheduled by height with both timeouts", types.NewMsgScheduleTransfer(*types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 100, ""), 5, 0), types.ErrInvalidScheduledTransfer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgCancelScheduledTransferValidateBasic tests ValidateBasic for MsgCancelScheduledTransfer
+func TestMsgCancelScheduledTransferValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgCancelScheduledTransfer
+		expError error
+	}{
+		{"success: valid sender and identifier", types.NewMsgCancelScheduledTransfer(sender, 1), nil},
+		{"failure: invalid sender", types.NewMsgCancelScheduledTransfer(invalidAddress, 1), ibcerrors.ErrInvalidAddress},
+		{"failure: zero identifier", types.NewMsgCancelScheduledTransfer(sender, 0), types.ErrInvalidScheduledTransfer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgScheduleTransferGetSigners tests that the signer of MsgScheduleTransfer is the sender of the transfer
+func TestMsgScheduleTransferGetSigners(t *testing.T) {
+	msg := types.NewMsgScheduleTransfer(*types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, ""), 5, 0)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(transfer.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustAccAddressFromBech32(sender).Bytes(), signers[0])
+}
+
 func TestMsgUpdateParamsGetSigners(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -19,7 +19,7 @@ import (
 // InternalTransferRepresentation defines a struct used internally by the transfer application to represent a fungible token transfer
 type InternalTransferRepresentation struct {
 	// the tokens to be transferred
-	Token Token
+	Tokens Tokens
 	// the sender address
 	Sender string
 	// the recipient address on the destination chain
@@ -31,6 +31,8 @@ type InternalTransferRepresentation struct {
 var (
 	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketData         = (*FungibleTokenPacketDataV2)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketDataV2)(nil)
 )
 
 const (
@@ -120,14 +122,92 @@ func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) any {
 	return memoData
 }
 
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if err := Tokens(ftpd.Tokens).Validate(); err != nil {
+		return err
+	}
+	if len(ftpd.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising the packet to bytes.
+// ICS20-V2 packet data is protobuf encoded by default.
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	bz, err := proto.Marshal(&ftpd)
+	if err != nil {
+		panic(errors.New("cannot marshal FungibleTokenPacketDataV2 into bytes"))
+	}
+
+	return bz
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketDataV2) GetCustomPacketData(key string) any {
+	if len(ftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]any)
+	err := json.Unmarshal([]byte(ftpd.Memo), &jsonObject)
+	if err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
 // NewInternalTransferRepresentation constructs a new InternalTransferRepresentation instance
 func NewInternalTransferRepresentation(
-	token Token,
+	tokens Tokens,
 	sender, receiver string,
 	memo string,
 ) InternalTransferRepresentation {
 	return InternalTransferRepresentation{
-		Token:    token,
+		Tokens:   tokens,
 		Sender:   sender,
 		Receiver: receiver,
 		Memo:     memo,
@@ -146,7 +226,7 @@ func (ftpd InternalTransferRepresentation) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
 
-	if err := ftpd.Token.Validate(); err != nil {
+	if err := ftpd.Tokens.Validate(); err != nil {
 		return err
 	}
 
@@ -208,25 +288,47 @@ func MarshalPacketData(data FungibleTokenPacketData, ics20Version string, encodi
 	}
 }
 
+// MarshalPacketDataV2 attempts to marshal the provided FungibleTokenPacketDataV2 into bytes with the provided encoding.
+func MarshalPacketDataV2(data FungibleTokenPacketDataV2, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingJSON:
+		return json.Marshal(data)
+	case EncodingProtobuf:
+		return proto.Marshal(&data)
+	case EncodingABI:
+		return EncodeABIFungibleTokenPacketDataV2(&data)
+	default:
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
+	}
+}
+
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a InternalTransferRepresentation.
 func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (InternalTransferRepresentation, error) {
 	const failedUnmarshalingErrorMsg = "cannot unmarshal %s transfer packet data: %s"
 
 	// Depending on the ics20 version, we use a different default encoding (json for V1, proto for V2)
 	// and we have a different type to unmarshal the data into.
-	var data proto.Message
+	var (
+		data            proto.Message
+		errorMsgVersion string
+	)
 	switch ics20Version {
 	case V1:
 		if encoding == "" {
 			encoding = EncodingJSON
 		}
 		data = &FungibleTokenPacketData{}
+		errorMsgVersion = "ICS20-V1"
+	case V2:
+		if encoding == "" {
+			encoding = EncodingProtobuf
+		}
+		data = &FungibleTokenPacketDataV2{}
+		errorMsgVersion = "ICS20-V2"
 	default:
 		return InternalTransferRepresentation{}, errorsmod.Wrap(ErrInvalidVersion, ics20Version)
 	}
 
-	errorMsgVersion := "ICS20-V1"
-
 	// Here we perform the unmarshaling based on the specified encoding.
 	// The functions act on the generic "data" variable which is of type proto.Message (an interface).
 	switch encoding {
@@ -243,11 +345,12 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Inter
 			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
 	case EncodingABI:
-		if ics20Version != V1 {
-			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "encoding %s is only supported for ICS20-V1", EncodingABI)
-		}
 		var err error
-		data, err = DecodeABIFungibleTokenPacketData(bz)
+		if ics20Version == V2 {
+			data, err = DecodeABIFungibleTokenPacketDataV2(bz)
+		} else {
+			data, err = DecodeABIFungibleTokenPacketData(bz)
+		}
 		if err != nil {
 			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
@@ -256,15 +359,18 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Inter
 	}
 
 	// When the unmarshaling is done, we want to retrieve the underlying data type based on the value of ics20Version
-	// Since it has to be v1, we convert the data to FungibleTokenPacketData and then call the conversion function to construct
-	// the v2 type.
-	datav1, ok := data.(*FungibleTokenPacketData)
-	if !ok {
+	// and call the corresponding conversion function to construct the internal representation.
+	switch data := data.(type) {
+	case *FungibleTokenPacketData:
+		// The call to ValidateBasic for V1 is done inside PacketDataV1toV2.
+		return PacketDataV1ToV2(*data)
+	case *FungibleTokenPacketDataV2:
+		// The call to ValidateBasic for V2 is done inside PacketDataV2ToInternalTransferRepresentation.
+		return PacketDataV2ToInternalTransferRepresentation(*data)
+	default:
 		// We should never get here, as we manually constructed the type at the beginning of the file
-		return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot convert proto message into FungibleTokenPacketData")
+		return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot convert proto message %T into internal transfer representation", data)
 	}
-	// The call to ValidateBasic for V1 is done inside PacketDataV1toV2.
-	return PacketDataV1ToV2(*datav1)
 }
 
 // PacketDataV1ToV2 converts a v1 packet data to a v2 packet data. The packet data is validated
@@ -276,12 +382,29 @@ func PacketDataV1ToV2(packetData FungibleTokenPacketData) (InternalTransferRepre
 
 	denom := ExtractDenomFromPath(packetData.Denom)
 	return InternalTransferRepresentation{
-		Token: Token{
-			Denom:  denom,
-			Amount: packetData.Amount,
+		Tokens: Tokens{
+			{
+				Denom:  denom,
+				Amount: packetData.Amount,
+			},
 		},
 		Sender:   packetData.Sender,
 		Receiver: packetData.Receiver,
 		Memo:     packetData.Memo,
 	}, nil
 }
+
+// PacketDataV2ToInternalTransferRepresentation converts a v2 packet data to the internal transfer
+// representation. The packet data is validated before conversion.
+func PacketDataV2ToInternalTransferRepresentation(packetData FungibleTokenPacketDataV2) (InternalTransferRepresentation, error) {
+	if err := packetData.ValidateBasic(); err != nil {
+		return InternalTransferRepresentation{}, errorsmod.Wrapf(err, "invalid packet data")
+	}
+
+	return InternalTransferRepresentation{
+		Tokens:   packetData.Tokens,
+		Sender:   packetData.Sender,
+		Receiver: packetData.Receiver,
+		Memo:     packetData.Memo,
+	}, nil
+}
@@ -172,10 +172,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -185,10 +185,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet with memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"memo",
@@ -198,10 +198,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet with large amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetLargeAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"memo",
@@ -211,10 +211,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid denom",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -224,10 +224,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid empty amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: "",
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -237,10 +237,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid zero amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: "0",
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -250,10 +250,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid negative amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: "-100",
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -263,10 +263,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid large amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetInvalidLargeAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"memo",
@@ -276,10 +276,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: missing sender address",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				"",
 				receiver,
 				"memo",
@@ -289,10 +289,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: missing recipient address",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				"",
 				"",
@@ -302,10 +302,10 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: memo field too large",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetLargeAmount,
-				},
+				}},
 				sender,
 				receiver,
 				ibctesting.GenerateString(types.MaximumMemoLength+1),
@@ -336,10 +336,10 @@ func TestGetPacketSender(t *testing.T) {
 		{
 			"non-empty sender field",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -349,10 +349,10 @@ func TestGetPacketSender(t *testing.T) {
 		{
 			"empty sender field",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				"",
 				receiver,
 				"abc",
@@ -377,10 +377,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback key in memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, receiver),
@@ -393,10 +393,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback key in memo with additional fields",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "200000"}}`, receiver),
@@ -409,10 +409,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback has string value",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				`{"src_callback": "string"}`,
@@ -422,10 +422,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: src_callback key not found memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				fmt.Sprintf(`{"dest_callback": {"address": "%s", "min_gas": "200000"}}`, receiver),
@@ -435,10 +435,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: empty memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"",
@@ -448,10 +448,10 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: non-json memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom(packetDenom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: packetAmount,
-				},
+				}},
 				sender,
 				receiver,
 				"invalid",
@@ -542,7 +542,7 @@ func TestUnmarshalPacketData(t *testing.T) {
 
 		if tc.expError == nil {
 			require.NoError(t, err)
-			require.NotEmpty(t, packetData.Token)
+			require.NotEmpty(t, packetData.Tokens[0])
 			require.NotEmpty(t, packetData.Sender)
 			require.NotEmpty(t, packetData.Receiver)
 			require.IsType(t, types.InternalTransferRepresentation{}, packetData)
@@ -552,6 +552,44 @@ func TestUnmarshalPacketData(t *testing.T) {
 	}
 }
 
+func TestMarshalUnmarshalPacketDataV2(t *testing.T) {
+	packetData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{
+			{Denom: types.NewDenom("atom", types.NewHop("transfer", "channel-0")), Amount: "1000"},
+			{Denom: types.NewDenom("osmo"), Amount: "2000"},
+		},
+		sender, receiver, "memo",
+	)
+
+	expPacketData := types.InternalTransferRepresentation{
+		Tokens:   packetData.Tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     "memo",
+	}
+
+	for _, encoding := range []string{types.EncodingJSON, types.EncodingProtobuf, types.EncodingABI} {
+		t.Run(encoding, func(t *testing.T) {
+			bz, err := types.MarshalPacketDataV2(packetData, encoding)
+			require.NoError(t, err)
+
+			internalPacketData, err := types.UnmarshalPacketData(bz, types.V2, encoding)
+			require.NoError(t, err)
+			require.Equal(t, expPacketData, internalPacketData)
+		})
+	}
+
+	// the default encoding of V2 packet data is protobuf
+	internalPacketData, err := types.UnmarshalPacketData(packetData.GetBytes(), types.V2, "")
+	require.NoError(t, err)
+	require.Equal(t, expPacketData, internalPacketData)
+
+	// invalid V2 packet data fails to unmarshal
+	packetData.Tokens = append(packetData.Tokens, packetData.Tokens[0])
+	_, err = types.UnmarshalPacketData(packetData.GetBytes(), types.V2, "")
+	require.ErrorIs(t, err, types.ErrInvalidDenomForTransfer)
+}
+
 func TestPacketV1ToPacketV2(t *testing.T) {
 	const (
 		sender   = "sender"
@@ -568,70 +606,70 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success with empty trace",
 			types.NewFungibleTokenPacketData("atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom"),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success: base denom with '/'",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom/withslash", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom/withslash", types.NewHop("transfer", "channel-0")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success: base denom with '/' at the end",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom/", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom/", types.NewHop("transfer", "channel-0")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success: longer trace base denom with '/'",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/atom/pool", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success: longer trace with non transfer port",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/transfer-custom/channel-2/atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
 			"success: base denom with slash, trace with non transfer port",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/transfer-custom/channel-2/atom/pool", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
+				types.Tokens{types.Token{
 					Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
 					Amount: "1000",
-				}, sender, receiver, ""),
+				}}, sender, receiver, ""),
 			nil,
 		},
 		{
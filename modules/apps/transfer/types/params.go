@@ -1,5 +1,13 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
@@ -19,3 +27,14 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate performs a basic validation of the ibc-transfer parameters.
+func (p Params) Validate() error {
+	if p.DenomMetadataRegistrar != "" {
+		if _, err := sdk.AccAddressFromBech32(p.DenomMetadataRegistrar); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "denom metadata registrar could not be parsed as address: %v", err)
+		}
+	}
+
+	return nil
+}
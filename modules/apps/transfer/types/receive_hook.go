@@ -0,0 +1,124 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ReceiveHookKey is the top-level memo key naming the handler which is invoked with the tokens
+	// of a received transfer, e.g. {"receive_hook": {"handler": "name", "msg": {...}}}
+	ReceiveHookKey = "receive_hook"
+
+	receiveHookHandlerKey = "handler"
+	receiveHookMsgKey     = "msg"
+)
+
+// ReceiveHookHandler is an on-chain handler, e.g. a module or a contract, which is invoked with the
+// tokens of a received transfer whose memo names it. Handlers are registered in the transfer keeper
+// with RegisterReceiveHookHandler.
+type ReceiveHookHandler interface {
+	// OnTransferReceived is called once the tokens of the transfer have been minted or unescrowed to
+	// the intermediate account, which the handler is expected to move them out of. Coins left in the
+	// intermediate account are sent to the receiver once the handler returns. If an error is returned,
+	// the receive of the transfer is reverted and an error acknowledgement is written.
+	OnTransferReceived(ctx sdk.Context, transfer ReceivedTransfer) error
+}
+
+// ReceivedTransfer contains the details of a received transfer which are passed to a ReceiveHookHandler.
+type ReceivedTransfer struct {
+	// the port and channel (or client) of the transfer on the sending chain
+	SourcePort    string
+	SourceChannel string
+	// the port and channel (or client) of the transfer on this chain
+	DestinationPort    string
+	DestinationChannel string
+	// the sender of the transfer on the sending chain
+	Sender string
+	// the receiver of the transfer packet data
+	Receiver string
+	// the intermediate account holding the received coins
+	IntermediateAddress sdk.AccAddress
+	// the received coins, in their denominations on this chain
+	Coins sdk.Coins
+	// the value of the msg field of the receive hook memo as raw JSON, nil if it is not set
+	Msg json.RawMessage
+}
+
+// ReceiveHookMemo is the value of the receive hook memo key.
+type ReceiveHookMemo struct {
+	// the name under which the handler is registered
+	Handler string
+	// the value of the optional msg field as raw JSON
+	Msg json.RawMessage
+}
+
+// GetReceiveHookMemo returns the receive hook of the memo. It returns false if the memo is not a JSON
+// object or does not contain the receive hook key.
+func GetReceiveHookMemo(memo string) (ReceiveHookMemo, bool, error) {
+	if len(memo) == 0 {
+		return ReceiveHookMemo{}, false, nil
+	}
+
+	jsonObject := make(map[string]any)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return ReceiveHookMemo{}, false, nil
+	}
+
+	value, found := jsonObject[ReceiveHookKey]
+	if !found {
+		return ReceiveHookMemo{}, false, nil
+	}
+
+	hookMemo, err := parseReceiveHookMemo(value)
+	if err != nil {
+		return ReceiveHookMemo{}, true, err
+	}
+
+	return hookMemo, true, nil
+}
+
+// ValidateReceiveHookMemo validates the value of the receive hook memo key. It is registered as the
+// memo key validator of ReceiveHookKey by the transfer keeper.
+func ValidateReceiveHookMemo(value any) error {
+	_, err := parseReceiveHookMemo(value)
+	return err
+}
+
+// GetReceiveHookIntermediateAddress returns the address of the intermediate account to which the
+// tokens of a transfer with a receive hook are minted or unescrowed before the handler is invoked.
+// The address is derived from the destination channel (or client) and the sender of the transfer,
+// so that senders cannot use the intermediate accounts of other senders.
+func GetReceiveHookIntermediateAddress(destinationChannel, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(ReceiveHookKey), []byte(destinationChannel), []byte(sender))
+}
+
+func parseReceiveHookMemo(value any) (ReceiveHookMemo, error) {
+	hookData, ok := value.(map[string]any)
+	if !ok {
+		return ReceiveHookMemo{}, errorsmod.Wrapf(ErrInvalidReceiveHook, "key %s must be a JSON object", ReceiveHookKey)
+	}
+
+	handler, ok := hookData[receiveHookHandlerKey].(string)
+	if !ok || strings.TrimSpace(handler) == "" {
+		return ReceiveHookMemo{}, errorsmod.Wrapf(ErrInvalidReceiveHook, "key %s must be a non-empty string", receiveHookHandlerKey)
+	}
+
+	hookMemo := ReceiveHookMemo{Handler: handler}
+
+	if msg, found := hookData[receiveHookMsgKey]; found {
+		bz, err := json.Marshal(msg)
+		if err != nil {
+			return ReceiveHookMemo{}, errorsmod.Wrapf(ErrInvalidReceiveHook, "failed to marshal key %s: %s", receiveHookMsgKey, err)
+		}
+
+		hookMemo.Msg = bz
+	}
+
+	return hookMemo, nil
+}
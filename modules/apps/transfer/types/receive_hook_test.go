@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestGetReceiveHookMemo(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expHookMemo types.ReceiveHookMemo
+		expHasHook  bool
+		expError    error
+	}{
+		{
+			"success: empty memo",
+			"",
+			types.ReceiveHookMemo{},
+			false,
+			nil,
+		},
+		{
+			"success: memo is not a JSON object",
+			"memo",
+			types.ReceiveHookMemo{},
+			false,
+			nil,
+		},
+		{
+			"success: memo without receive hook",
+			`{"forward": {}}`,
+			types.ReceiveHookMemo{},
+			false,
+			nil,
+		},
+		{
+			"success: receive hook without msg",
+			`{"receive_hook": {"handler": "handler"}}`,
+			types.ReceiveHookMemo{Handler: "handler"},
+			true,
+			nil,
+		},
+		{
+			"success: receive hook with msg",
+			`{"receive_hook": {"handler": "handler", "msg": {"deposit": {"amount": "10"}}}}`,
+			types.ReceiveHookMemo{Handler: "handler", Msg: json.RawMessage(`{"deposit":{"amount":"10"}}`)},
+			true,
+			nil,
+		},
+		{
+			"failure: receive hook is not a JSON object",
+			`{"receive_hook": "handler"}`,
+			types.ReceiveHookMemo{},
+			true,
+			types.ErrInvalidReceiveHook,
+		},
+		{
+			"failure: missing handler",
+			`{"receive_hook": {"msg": {}}}`,
+			types.ReceiveHookMemo{},
+			true,
+			types.ErrInvalidReceiveHook,
+		},
+		{
+			"failure: empty handler",
+			`{"receive_hook": {"handler": " "}}`,
+			types.ReceiveHookMemo{},
+			true,
+			types.ErrInvalidReceiveHook,
+		},
+		{
+			"failure: handler is not a string",
+			`{"receive_hook": {"handler": 1}}`,
+			types.ReceiveHookMemo{},
+			true,
+			types.ErrInvalidReceiveHook,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hookMemo, hasHook, err := types.GetReceiveHookMemo(tc.memo)
+
+			require.Equal(t, tc.expHasHook, hasHook)
+			if tc.expError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expHookMemo, hookMemo)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// Test that the intermediate accounts of different channels or senders never overlap
+func TestGetReceiveHookIntermediateAddress(t *testing.T) {
+	require.NotEqual(t, types.GetReceiveHookIntermediateAddress("channel-0", "sender"), types.GetReceiveHookIntermediateAddress("channel-1", "sender"))
+	require.NotEqual(t, types.GetReceiveHookIntermediateAddress("channel-0", "sender"), types.GetReceiveHookIntermediateAddress("channel-0", "sender2"))
+	require.NotEqual(t, types.GetReceiveHookIntermediateAddress("channel", "-0sender"), types.GetReceiveHookIntermediateAddress("channel-0", "sender"))
+}
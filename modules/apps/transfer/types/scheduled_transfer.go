@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxScheduledTransfersPerBlock is the maximum number of scheduled transfers dispatched
+// at the end of a block. Due transfers exceeding the limit are dispatched in the following blocks.
+const MaxScheduledTransfersPerBlock = 100
+
+// NewScheduledTransfer creates a new ScheduledTransfer instance
+func NewScheduledTransfer(id uint64, transfer MsgTransfer, executeHeight, executeTimestamp uint64) ScheduledTransfer {
+	return ScheduledTransfer{
+		Id:               id,
+		Transfer:         transfer,
+		ExecuteHeight:    executeHeight,
+		ExecuteTimestamp: executeTimestamp,
+	}
+}
+
+// Validate performs a basic validation of the ScheduledTransfer fields.
+func (st ScheduledTransfer) Validate() error {
+	if st.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledTransfer, "identifier cannot be zero")
+	}
+
+	return validateSchedule(st.Transfer, st.ExecuteHeight, st.ExecuteTimestamp)
+}
+
+// QueueKey returns the key of the scheduled transfer in the queue of transfers
+// scheduled by height or by timestamp.
+func (st ScheduledTransfer) QueueKey() []byte {
+	if st.ExecuteHeight != 0 {
+		return ScheduledTransferQueueKey(ScheduledTransferByHeightKey, st.ExecuteHeight, st.Id)
+	}
+
+	return ScheduledTransferQueueKey(ScheduledTransferByTimestampKey, st.ExecuteTimestamp, st.Id)
+}
+
+// validateSchedule validates the transfer and checks that it is scheduled either by
+// height or by timestamp, and that it does not time out before it is dispatched. As a
+// timeout height cannot be compared with an execute timestamp, nor a timeout timestamp
+// with an execute height, the transfer may only time out by the unit it is scheduled by.
+func validateSchedule(transfer MsgTransfer, executeHeight, executeTimestamp uint64) error {
+	if err := transfer.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if (executeHeight == 0) == (executeTimestamp == 0) {
+		return errorsmod.Wrap(ErrInvalidScheduledTransfer, "exactly one of execute height and execute timestamp must be set")
+	}
+
+	if executeHeight != 0 {
+		if transfer.TimeoutTimestamp != 0 {
+			return errorsmod.Wrap(ErrInvalidScheduledTransfer, "transfer scheduled by height cannot time out by timestamp")
+		}
+
+		if transfer.TimeoutHeight.RevisionHeight <= executeHeight {
+			return errorsmod.Wrapf(ErrInvalidScheduledTransfer, "timeout height %s must be after execute height %d", transfer.TimeoutHeight, executeHeight)
+		}
+
+		return nil
+	}
+
+	if !transfer.TimeoutHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidScheduledTransfer, "transfer scheduled by timestamp cannot time out by height")
+	}
+
+	if transfer.TimeoutTimestamp <= executeTimestamp {
+		return errorsmod.Wrapf(ErrInvalidScheduledTransfer, "timeout timestamp %d must be after execute timestamp %d", transfer.TimeoutTimestamp, executeTimestamp)
+	}
+
+	return nil
+}
@@ -0,0 +1,441 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/transfer/v1/scheduled_transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledTransfer defines a transfer whose tokens are escrowed by the transfer module
+// and which is dispatched at the end of the first block reaching its execution height
+// or timestamp.
+type ScheduledTransfer struct {
+	// unique identifier of the scheduled transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the transfer to be dispatched
+	Transfer MsgTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer"`
+	// block height at which the transfer is dispatched, if scheduled by height
+	ExecuteHeight uint64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// block timestamp in absolute nanoseconds since unix epoch at which the transfer
+	// is dispatched, if scheduled by time
+	ExecuteTimestamp uint64 `protobuf:"varint,4,opt,name=execute_timestamp,json=executeTimestamp,proto3" json:"execute_timestamp,omitempty"`
+}
+
+func (m *ScheduledTransfer) Reset()         { *m = ScheduledTransfer{} }
+func (m *ScheduledTransfer) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTransfer) ProtoMessage()    {}
+func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23d3673cee21e507, []int{0}
+}
+func (m *ScheduledTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTransfer.Merge(m, src)
+}
+func (m *ScheduledTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTransfer proto.InternalMessageInfo
+
+func (m *ScheduledTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledTransfer) GetTransfer() MsgTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return MsgTransfer{}
+}
+
+func (m *ScheduledTransfer) GetExecuteHeight() uint64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *ScheduledTransfer) GetExecuteTimestamp() uint64 {
+	if m != nil {
+		return m.ExecuteTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ScheduledTransfer)(nil), "ibc.applications.transfer.v1.ScheduledTransfer")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/scheduled_transfer.proto", fileDescriptor_23d3673cee21e507)
+}
+
+var fileDescriptor_23d3673cee21e507 = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x00, 0x85, 0x9b, 0x5a, 0x44, 0x22, 0x0e, 0x57, 0x3c, 0x94, 0x21, 0x71, 0x08, 0x83, 0x89, 0x98,
+	0x58, 0x45, 0xf0, 0xbc, 0x93, 0x20, 0x5e, 0xea, 0x4e, 0x5e, 0x46, 0x9b, 0xc6, 0x34, 0xb0, 0x2e,
+	0xa5, 0x49, 0xcb, 0xfc, 0x17, 0xfe, 0x28, 0x0f, 0x3b, 0xee, 0xe8, 0x49, 0xa4, 0xfd, 0x23, 0xa3,
+	0x5d, 0x33, 0x76, 0xda, 0x2d, 0xbc, 0x7c, 0xef, 0xbd, 0xe4, 0xc1, 0x27, 0x11, 0x51, 0x12, 0x66,
+	0xd9, 0x5c, 0xd0, 0x50, 0x0b, 0xb9, 0x50, 0x44, 0xe7, 0xe1, 0x42, 0x7d, 0xb2, 0x9c, 0x94, 0x3e,
+	0x51, 0x34, 0x61, 0x71, 0x31, 0x67, 0xf1, 0xcc, 0xa8, 0x38, 0xcb, 0xa5, 0x96, 0xee, 0xa5, 0x88,
+	0x28, 0xde, 0xb7, 0xe1, 0x1d, 0x50, 0xfa, 0x83, 0x0b, 0x2e, 0xb9, 0x6c, 0x41, 0xd2, 0x9c, 0xb6,
+	0x9e, 0xc1, 0xe8, 0x60, 0x95, 0x5e, 0x6e, 0xb1, 0xeb, 0x1f, 0x00, 0xfb, 0xef, 0xa6, 0x77, 0xda,
+	0x11, 0x6e, 0x0f, 0xda, 0x22, 0xf6, 0xc0, 0x10, 0x8c, 0x9d, 0xc0, 0x16, 0xb1, 0xfb, 0x0a, 0x4f,
+	0x8c, 0xdb, 0xb3, 0x87, 0x60, 0x7c, 0xfa, 0x70, 0x83, 0x0f, 0xbd, 0x09, 0xbf, 0x29, 0x6e, 0xc2,
+	0x26, 0xce, 0xea, 0xef, 0xca, 0x0a, 0x76, 0x01, 0xee, 0x08, 0xf6, 0xd8, 0x92, 0xd1, 0x42, 0xb3,
+	0x59, 0xc2, 0x04, 0x4f, 0xb4, 0x77, 0xd4, 0x16, 0x9d, 0x75, 0xea, 0x4b, 0x2b, 0xba, 0xb7, 0xb0,
+	0x6f, 0x30, 0x2d, 0x52, 0xa6, 0x74, 0x98, 0x66, 0x9e, 0xd3, 0x92, 0xe7, 0xdd, 0xc5, 0xd4, 0xe8,
+	0x93, 0x60, 0x55, 0x21, 0xb0, 0xae, 0x10, 0xf8, 0xaf, 0x10, 0xf8, 0xae, 0x91, 0xb5, 0xae, 0x91,
+	0xf5, 0x5b, 0x23, 0xeb, 0xe3, 0x99, 0x0b, 0x9d, 0x14, 0x11, 0xa6, 0x32, 0x25, 0x54, 0xaa, 0x54,
+	0x2a, 0x22, 0x22, 0x7a, 0xc7, 0x25, 0x29, 0xfd, 0x7b, 0x92, 0xca, 0xe6, 0xe3, 0xaa, 0x19, 0x6a,
+	0x6f, 0x20, 0xfd, 0x95, 0x31, 0x15, 0x1d, 0xb7, 0x0b, 0x3d, 0x6e, 0x06, 0x00, 0x90, 0x99, 0x56,
+	0x18, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (m *ScheduledTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteTimestamp != 0 {
+		i = encodeVarintScheduledTransfer(dAtA, i, uint64(m.ExecuteTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintScheduledTransfer(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScheduledTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintScheduledTransfer(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduledTransfer(uint64(m.Id))
+	}
+	l = m.Transfer.Size()
+	n += 1 + l + sovScheduledTransfer(uint64(l))
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovScheduledTransfer(uint64(m.ExecuteHeight))
+	}
+	if m.ExecuteTimestamp != 0 {
+		n += 1 + sovScheduledTransfer(uint64(m.ExecuteTimestamp))
+	}
+	return n
+}
+
+func sovScheduledTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTransfer(x uint64) (n int) {
+	return sovScheduledTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTimestamp", wireType)
+			}
+			m.ExecuteTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -109,3 +109,170 @@ func EncodeABIFungibleTokenPacketData(data *FungibleTokenPacketData) ([]byte, er
 
 	return encodedData, nil
 }
+
+// abiFungibleTokenPacketDataV2 is the Go representation of the solidity ABI tuple of an ICS20-V2 packet data.
+// The field names must match the camel cased names of the ABI components.
+type abiFungibleTokenPacketDataV2 struct {
+	Tokens   []abiToken
+	Sender   string
+	Receiver string
+	Memo     string
+}
+
+// abiToken is the Go representation of the solidity ABI tuple of a Token.
+type abiToken struct {
+	Denom  abiDenom
+	Amount *big.Int
+}
+
+// abiDenom is the Go representation of the solidity ABI tuple of a Denom.
+type abiDenom struct {
+	Base  string
+	Trace []abiHop
+}
+
+// abiHop is the Go representation of the solidity ABI tuple of a Hop.
+type abiHop struct {
+	PortId    string //nolint:revive // the field name must match the ABI component name
+	ChannelId string //nolint:revive // the field name must match the ABI component name
+}
+
+// getICS20V2ABI returns an abi.Arguments slice describing the Solidity types of the ICS20-V2 packet data.
+func getICS20V2ABI() abi.Arguments {
+	// The Solidity types used are:
+	// - tuple(tuple(string base, tuple(string portId, string channelId)[] trace) denom, uint256 amount)[] for Tokens.
+	// - string for Sender, Receiver and Memo.
+	tupleType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "tokens",
+			Type: "tuple[]",
+			Components: []abi.ArgumentMarshaling{
+				{
+					Name: "denom",
+					Type: "tuple",
+					Components: []abi.ArgumentMarshaling{
+						{
+							Name: "base",
+							Type: "string",
+						},
+						{
+							Name: "trace",
+							Type: "tuple[]",
+							Components: []abi.ArgumentMarshaling{
+								{
+									Name: "portId",
+									Type: "string",
+								},
+								{
+									Name: "channelId",
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "amount",
+					Type: "uint256",
+				},
+			},
+		},
+		{
+			Name: "sender",
+			Type: "string",
+		},
+		{
+			Name: "receiver",
+			Type: "string",
+		},
+		{
+			Name: "memo",
+			Type: "string",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{
+		{
+			Type: tupleType,
+		},
+	}
+}
+
+// DecodeABIFungibleTokenPacketDataV2 decodes a solidity ABI encoded ICS20-V2 packet data
+// and converts it into an ibc-go FungibleTokenPacketDataV2.
+func DecodeABIFungibleTokenPacketDataV2(data []byte) (*FungibleTokenPacketDataV2, error) {
+	arguments := getICS20V2ABI()
+
+	packetDataI, err := arguments.Unpack(data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to unpack data: %s", err)
+	}
+
+	packetData, ok := abi.ConvertType(packetDataI[0], new(abiFungibleTokenPacketDataV2)).(*abiFungibleTokenPacketDataV2)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse packet data")
+	}
+
+	tokens := make([]Token, 0, len(packetData.Tokens))
+	for _, token := range packetData.Tokens {
+		if token.Amount == nil {
+			return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse token amount")
+		}
+
+		var trace []Hop
+		for _, hop := range token.Denom.Trace {
+			trace = append(trace, NewHop(hop.PortId, hop.ChannelId))
+		}
+
+		tokens = append(tokens, Token{
+			Denom:  NewDenom(token.Denom.Base, trace...),
+			Amount: token.Amount.String(),
+		})
+	}
+
+	return &FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   packetData.Sender,
+		Receiver: packetData.Receiver,
+		Memo:     packetData.Memo,
+	}, nil
+}
+
+// EncodeABIFungibleTokenPacketDataV2 encodes an ibc-go FungibleTokenPacketDataV2 with the solidity ABI encoding.
+func EncodeABIFungibleTokenPacketDataV2(data *FungibleTokenPacketDataV2) ([]byte, error) {
+	packetData := abiFungibleTokenPacketDataV2{
+		Tokens:   make([]abiToken, 0, len(data.Tokens)),
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+	}
+
+	for _, token := range data.Tokens {
+		amount, ok := new(big.Int).SetString(token.Amount, 10)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to parse amount: %s", token.Amount)
+		}
+
+		trace := make([]abiHop, 0, len(token.Denom.Trace))
+		for _, hop := range token.Denom.Trace {
+			trace = append(trace, abiHop{PortId: hop.PortId, ChannelId: hop.ChannelId})
+		}
+
+		packetData.Tokens = append(packetData.Tokens, abiToken{
+			Denom:  abiDenom{Base: token.Denom.Base, Trace: trace},
+			Amount: amount,
+		})
+	}
+
+	arguments := getICS20V2ABI()
+	// Pack the values in the order defined in the ABI.
+	encodedData, err := arguments.Pack(packetData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to pack data: %s", err)
+	}
+
+	return encodedData, nil
+}
@@ -21,3 +21,21 @@ func (s *TypesTestSuite) TestFTPD() {
 
 	s.Require().Equal(packetData, *decodedPacketData)
 }
+
+func (s *TypesTestSuite) TestFTPDV2() {
+	packetData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{
+			{Denom: types.NewDenom("uatom"), Amount: "1000000"},
+			{Denom: types.NewDenom("uosmo", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "07-tendermint-1")), Amount: "2000000"},
+		},
+		"sender", "receiver", "memo",
+	)
+
+	bz, err := types.EncodeABIFungibleTokenPacketDataV2(&packetData)
+	s.Require().NoError(err)
+
+	decodedPacketData, err := types.DecodeABIFungibleTokenPacketDataV2(bz)
+	s.Require().NoError(err)
+
+	s.Require().Equal(packetData, *decodedPacketData)
+}
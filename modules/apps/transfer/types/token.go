@@ -45,6 +45,49 @@ func (t Token) ToCoin() (sdk.Coin, error) {
 	return coin, nil
 }
 
+// Tokens is a slice of Tokens transferred atomically in a single packet.
+type Tokens []Token
+
+// Validate performs a basic validation of the tokens. At least one token must be provided,
+// the number of tokens must not exceed MaximumTokensLength and the denominations must be unique.
+func (t Tokens) Validate() error {
+	if len(t) == 0 {
+		return errorsmod.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+	if len(t) > MaximumTokensLength {
+		return errorsmod.Wrapf(ErrInvalidAmount, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, token := range t {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		denomPath := token.Denom.Path()
+		if seenDenoms[denomPath] {
+			return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination %s", denomPath)
+		}
+		seenDenoms[denomPath] = true
+	}
+
+	return nil
+}
+
+// ToCoins converts the tokens to sorted sdk.Coins.
+func (t Tokens) ToCoins() (sdk.Coins, error) {
+	coins := make(sdk.Coins, 0, len(t))
+	for _, token := range t {
+		coin, err := token.ToCoin()
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	return coins.Sort(), nil
+}
+
 // UnboundedSpendLimit returns the sentinel value that can be used
 // as the amount for a denomination's spend limit for which spend limit updating
 // should be disabled. Please note that using this sentinel value means that a grantee
@@ -185,10 +185,112 @@ func (m *Hop) GetChannelId() string {
 	return ""
 }
 
+// DenomMetadata defines the registered bank metadata of an IBC voucher denomination.
+type DenomMetadata struct {
+	// denom is the denomination trace of the IBC voucher
+	Denom Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
+	// name defines the name of the token (eg: Cosmos Atom)
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the token symbol usually shown on exchanges (eg: ATOM)
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// display indicates the suggested denom unit that should be displayed in clients (eg: atom).
+	// It must be empty if decimals is zero, in which case the IBC denom is displayed.
+	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
+	// decimals is the exponent of the display denom unit
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// uri to a document (on or off-chain) that contains additional information.
+	Uri string `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is a hash of the document pointed by the uri.
+	UriHash string `protobuf:"bytes,7,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+}
+
+func (m *DenomMetadata) Reset()         { *m = DenomMetadata{} }
+func (m *DenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomMetadata) ProtoMessage()    {}
+func (*DenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa36f5082d5cd501, []int{3}
+}
+func (m *DenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMetadata.Merge(m, src)
+}
+func (m *DenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMetadata proto.InternalMessageInfo
+
+func (m *DenomMetadata) GetDenom() Denom {
+	if m != nil {
+		return m.Denom
+	}
+	return Denom{}
+}
+
+func (m *DenomMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *DenomMetadata) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetUriHash() string {
+	if m != nil {
+		return m.UriHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "ibc.applications.transfer.v1.Token")
 	proto.RegisterType((*Denom)(nil), "ibc.applications.transfer.v1.Denom")
 	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
+	proto.RegisterType((*DenomMetadata)(nil), "ibc.applications.transfer.v1.DenomMetadata")
 }
 
 func init() {
@@ -196,27 +298,33 @@ func init() {
 }
 
 var fileDescriptor_aa36f5082d5cd501 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x1c, 0xc5, 0xef, 0x84, 0xc3, 0x50, 0xb6, 0xc6, 0x28, 0x31, 0x7a, 0x20, 0x2e, 0xb7, 0xd8, 0x0a,
-	0x2e, 0xc6, 0xc4, 0x98, 0xa0, 0x03, 0xac, 0x17, 0x27, 0x16, 0xed, 0xf5, 0xea, 0xd1, 0xc8, 0xf5,
-	0xdf, 0x5c, 0x0b, 0x89, 0xdf, 0xc2, 0xd1, 0xd1, 0x8f, 0xc3, 0xc8, 0xe8, 0x64, 0x0c, 0x7c, 0x11,
-	0x73, 0x05, 0x12, 0x26, 0xb6, 0xff, 0xbf, 0xfd, 0xbd, 0xf7, 0x9a, 0x3e, 0x14, 0xc9, 0x84, 0x53,
-	0xa6, 0xf5, 0x44, 0x72, 0x66, 0x25, 0x28, 0x43, 0x6d, 0xc1, 0x94, 0x79, 0x13, 0x05, 0x9d, 0x75,
-	0xa9, 0x85, 0x77, 0xa1, 0x88, 0x2e, 0xc0, 0x02, 0x3e, 0x93, 0x09, 0x27, 0xbb, 0x24, 0xd9, 0x92,
-	0x64, 0xd6, 0x3d, 0x3d, 0xca, 0x20, 0x03, 0x07, 0xd2, 0x72, 0x5a, 0x6b, 0x3a, 0xaf, 0x28, 0x78,
-	0x2e, 0x2d, 0xf0, 0x03, 0x0a, 0x52, 0xa1, 0x20, 0x6f, 0xfa, 0x6d, 0x3f, 0x6a, 0xf4, 0x2e, 0xc9,
-	0x3e, 0x33, 0xf2, 0x54, 0xa2, 0xfd, 0xea, 0xfc, 0xb7, 0xe5, 0xc5, 0x6b, 0x1d, 0x3e, 0x46, 0x35,
-	0x96, 0xc3, 0x54, 0xd9, 0xe6, 0x41, 0xdb, 0x8f, 0xea, 0xf1, 0x66, 0xeb, 0x8c, 0x50, 0xe0, 0x68,
-	0x8c, 0x51, 0x35, 0x61, 0x46, 0xb8, 0x80, 0x7a, 0xec, 0x66, 0x7c, 0x8f, 0x02, 0x5b, 0x30, 0x2e,
-	0x9a, 0x95, 0x76, 0x25, 0x6a, 0xf4, 0x2e, 0xf6, 0xa7, 0x0e, 0x40, 0x6f, 0x33, 0x9d, 0xaa, 0xf3,
-	0x88, 0x2a, 0x03, 0xd0, 0xf8, 0x04, 0x1d, 0x6a, 0x28, 0xec, 0x8b, 0x4c, 0x37, 0xe6, 0xb5, 0x72,
-	0x1d, 0xa6, 0xf8, 0x1c, 0x21, 0x3e, 0x66, 0x4a, 0x89, 0x49, 0x79, 0xb7, 0x7e, 0x57, 0x7d, 0x73,
-	0x32, 0x4c, 0xef, 0xaa, 0x5f, 0xdf, 0x2d, 0xaf, 0x1f, 0xcf, 0x97, 0xa1, 0xbf, 0x58, 0x86, 0xfe,
-	0xdf, 0x32, 0xf4, 0x3f, 0x57, 0xa1, 0xb7, 0x58, 0x85, 0xde, 0xcf, 0x2a, 0xf4, 0x46, 0xb7, 0x99,
-	0xb4, 0xe3, 0x69, 0x42, 0x38, 0xe4, 0x94, 0x83, 0xc9, 0xc1, 0x50, 0x99, 0xf0, 0xab, 0x0c, 0xe8,
-	0xac, 0x7b, 0x4d, 0x73, 0x48, 0xa7, 0x13, 0x61, 0xca, 0x6e, 0x76, 0x3a, 0xb1, 0x1f, 0x5a, 0x98,
-	0xa4, 0xe6, 0x7e, 0xf7, 0xe6, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x38, 0x59, 0x27, 0xbd, 0x01,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0xb1, 0x9d, 0x34, 0xaf, 0xaa, 0x84, 0x4e, 0x08, 0x8e, 0x0a, 0xdc, 0x10, 0x16, 0x2f,
+	0xf8, 0x48, 0x59, 0x10, 0x12, 0x42, 0x2a, 0x0c, 0xe9, 0xc0, 0x62, 0x31, 0x75, 0x29, 0xe7, 0xf3,
+	0x11, 0x9f, 0xb0, 0xfd, 0x2c, 0xdf, 0x39, 0x52, 0xbe, 0x05, 0x23, 0x23, 0x1f, 0xa7, 0x63, 0x47,
+	0x26, 0x40, 0xc9, 0x17, 0x41, 0x77, 0x4e, 0x50, 0xa7, 0x2c, 0x6c, 0xbf, 0xdf, 0xdd, 0xef, 0xcf,
+	0xd3, 0xd3, 0x83, 0x44, 0xe5, 0x82, 0xf1, 0xb6, 0xad, 0x94, 0xe0, 0x46, 0x61, 0xa3, 0x99, 0xe9,
+	0x78, 0xa3, 0xbf, 0xc8, 0x8e, 0xad, 0xe6, 0xcc, 0xe0, 0x57, 0xd9, 0xa4, 0x6d, 0x87, 0x06, 0xc9,
+	0x13, 0x95, 0x8b, 0xf4, 0xae, 0x32, 0xdd, 0x2b, 0xd3, 0xd5, 0xfc, 0xf4, 0xc1, 0x12, 0x97, 0xe8,
+	0x84, 0xcc, 0xa2, 0xc1, 0x33, 0xfb, 0x0c, 0xd1, 0x27, 0x1b, 0x41, 0xde, 0x41, 0x54, 0xc8, 0x06,
+	0x6b, 0xea, 0x4f, 0xfd, 0xe4, 0xf8, 0xfc, 0x79, 0x7a, 0x28, 0x2c, 0xfd, 0x60, 0xa5, 0x17, 0xe1,
+	0xcd, 0xaf, 0x33, 0x2f, 0x1b, 0x7c, 0xe4, 0x21, 0x8c, 0x78, 0x8d, 0x7d, 0x63, 0xe8, 0xbd, 0xa9,
+	0x9f, 0x4c, 0xb2, 0x1d, 0x9b, 0x5d, 0x41, 0xe4, 0xd4, 0x84, 0x40, 0x98, 0x73, 0x2d, 0x5d, 0xc1,
+	0x24, 0x73, 0x98, 0xbc, 0x85, 0xc8, 0x74, 0x5c, 0x48, 0x1a, 0x4c, 0x83, 0xe4, 0xf8, 0xfc, 0xd9,
+	0xe1, 0xd6, 0x05, 0xb6, 0xfb, 0x4e, 0xe7, 0x9a, 0xbd, 0x87, 0x60, 0x81, 0x2d, 0x79, 0x04, 0xe3,
+	0x16, 0x3b, 0x73, 0xad, 0x8a, 0x5d, 0xf8, 0xc8, 0xd2, 0xcb, 0x82, 0x3c, 0x05, 0x10, 0x25, 0x6f,
+	0x1a, 0x59, 0xd9, 0xbf, 0x61, 0xae, 0xc9, 0xee, 0xe5, 0xb2, 0x78, 0x13, 0x7e, 0xff, 0x71, 0xe6,
+	0xcd, 0x7e, 0xfb, 0x70, 0xe2, 0x26, 0xfc, 0x28, 0x0d, 0x2f, 0xb8, 0xe1, 0xff, 0xbf, 0x0b, 0x02,
+	0x61, 0xc3, 0x6b, 0xb9, 0x6b, 0x74, 0xd8, 0xee, 0x47, 0xaf, 0xeb, 0x1c, 0x2b, 0x1a, 0x0c, 0x33,
+	0x0e, 0x8c, 0x50, 0x18, 0x17, 0x4a, 0xb7, 0x15, 0x5f, 0xd3, 0xd0, 0x7d, 0xec, 0x29, 0x39, 0x85,
+	0xa3, 0x42, 0x0a, 0x55, 0xf3, 0x4a, 0xd3, 0x68, 0xea, 0x27, 0x27, 0xd9, 0x3f, 0x4e, 0xee, 0x43,
+	0xd0, 0x77, 0x8a, 0x8e, 0x9c, 0xc3, 0x42, 0xf2, 0x18, 0x8e, 0xfa, 0x4e, 0x5d, 0x97, 0x5c, 0x97,
+	0x74, 0x3c, 0x04, 0xf5, 0x9d, 0x5a, 0x70, 0x5d, 0x5e, 0x64, 0x37, 0x9b, 0xd8, 0xbf, 0xdd, 0xc4,
+	0xfe, 0x9f, 0x4d, 0xec, 0x7f, 0xdb, 0xc6, 0xde, 0xed, 0x36, 0xf6, 0x7e, 0x6e, 0x63, 0xef, 0xea,
+	0xf5, 0x52, 0x99, 0xb2, 0xcf, 0x53, 0x81, 0x35, 0x13, 0xa8, 0x6b, 0xd4, 0x4c, 0xe5, 0xe2, 0xc5,
+	0x12, 0xd9, 0x6a, 0xfe, 0x92, 0xd5, 0x58, 0xf4, 0x95, 0xd4, 0xf6, 0xfa, 0xee, 0x5c, 0x9d, 0x59,
+	0xb7, 0x52, 0xe7, 0x23, 0x77, 0x3f, 0xaf, 0xfe, 0x0e, 0x00, 0xcd, 0xda, 0x9f, 0x3d, 0x9f, 0x02,
 	0x00, 0x00,
 }
 
@@ -341,6 +449,79 @@ func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -403,6 +584,40 @@ func (m *Hop) Size() (n int) {
 	return n
 }
 
+func (m *DenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Denom.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovToken(uint64(m.Decimals))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
 func sovToken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -754,6 +969,268 @@ func (m *Hop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipToken(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -202,3 +202,50 @@ func TestToCoin(t *testing.T) {
 		})
 	}
 }
+
+func TestTokensValidate(t *testing.T) {
+	token := types.Token{Denom: types.NewDenom("atom"), Amount: tokenAmount}
+
+	testCases := []struct {
+		name     string
+		tokens   types.Tokens
+		expError error
+	}{
+		{
+			"success: multiple tokens",
+			types.Tokens{token, {Denom: types.NewDenom("atom", types.NewHop("transfer", "channel-0")), Amount: tokenAmount}},
+			nil,
+		},
+		{
+			"failure: empty tokens",
+			types.Tokens{},
+			types.ErrInvalidAmount,
+		},
+		{
+			"failure: too many tokens",
+			make(types.Tokens, types.MaximumTokensLength+1),
+			types.ErrInvalidAmount,
+		},
+		{
+			"failure: invalid token",
+			types.Tokens{token, {Denom: types.NewDenom("uosmo"), Amount: "0"}},
+			types.ErrInvalidAmount,
+		},
+		{
+			"failure: duplicate denomination",
+			types.Tokens{token, token},
+			types.ErrInvalidDenomForTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.tokens.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				require.ErrorIs(t, err, tc.expError, tc.name)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,9 +26,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of IBC transfer parameters.
-// NOTE: To prevent a single token from being transferred, set the
-// TransfersEnabled parameter to true and then set the bank module's SendEnabled
-// parameter for the denomination to false.
+// NOTE: To prevent a single token or a single channel from being used for
+// transfers without halting all transfers, set a DenomTransferEnabled or
+// ChannelTransferEnabled override using MsgSetTransferEnabled.
 type Params struct {
 	// send_enabled enables or disables all cross-chain token transfers from this
 	// chain.
@@ -33,6 +36,12 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// denom_metadata_registrar is an optional address which, in addition to the
+	// module authority, is allowed to register IBC voucher denomination metadata.
+	DenomMetadataRegistrar string `protobuf:"bytes,3,opt,name=denom_metadata_registrar,json=denomMetadataRegistrar,proto3" json:"denom_metadata_registrar,omitempty"`
+	// strict_memo_keys rejects transfers whose memo is a JSON object containing a
+	// top-level key for which no memo key validator is registered.
+	StrictMemoKeys bool `protobuf:"varint,4,opt,name=strict_memo_keys,json=strictMemoKeys,proto3" json:"strict_memo_keys,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,8 +91,280 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomMetadataRegistrar() string {
+	if m != nil {
+		return m.DenomMetadataRegistrar
+	}
+	return ""
+}
+
+func (m *Params) GetStrictMemoKeys() bool {
+	if m != nil {
+		return m.StrictMemoKeys
+	}
+	return false
+}
+
+// DenomTransferEnabled overrides whether a single denomination can be sent or
+// received over IBC. An override can only further restrict transfers: the
+// global send_enabled and receive_enabled parameters always take precedence.
+type DenomTransferEnabled struct {
+	// the denomination on this chain, i.e. the native denom or the ibc/{hash} voucher denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// send_enabled enables or disables cross-chain transfers of the denomination from this chain.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables cross-chain transfers of the denomination to this chain.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *DenomTransferEnabled) Reset()         { *m = DenomTransferEnabled{} }
+func (m *DenomTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferEnabled) ProtoMessage()    {}
+func (*DenomTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{1}
+}
+func (m *DenomTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferEnabled.Merge(m, src)
+}
+func (m *DenomTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferEnabled proto.InternalMessageInfo
+
+func (m *DenomTransferEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTransferEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *DenomTransferEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// ChannelTransferEnabled overrides whether tokens can be sent or received over
+// a single channel (IBC v1) or client (IBC v2). An override can only further
+// restrict transfers: the global send_enabled and receive_enabled parameters
+// always take precedence.
+type ChannelTransferEnabled struct {
+	// the channel identifier (IBC v1) or client identifier (IBC v2) on this chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send_enabled enables or disables cross-chain transfers from this chain over the channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables cross-chain transfers to this chain over the channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *ChannelTransferEnabled) Reset()         { *m = ChannelTransferEnabled{} }
+func (m *ChannelTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferEnabled) ProtoMessage()    {}
+func (*ChannelTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *ChannelTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferEnabled.Merge(m, src)
+}
+func (m *ChannelTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransferEnabled proto.InternalMessageInfo
+
+func (m *ChannelTransferEnabled) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelTransferEnabled) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelTransferEnabled) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// EscrowAccount defines the balances of the escrow account of a transfer channel
+// (IBC v1) or client (IBC v2).
+type EscrowAccount struct {
+	// the channel identifier (IBC v1) or client identifier (IBC v2) of the escrow account
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the escrow account address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the balances of the escrow account
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *EscrowAccount) Reset()         { *m = EscrowAccount{} }
+func (m *EscrowAccount) String() string { return proto.CompactTextString(m) }
+func (*EscrowAccount) ProtoMessage()    {}
+func (*EscrowAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *EscrowAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowAccount.Merge(m, src)
+}
+func (m *EscrowAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowAccount proto.InternalMessageInfo
+
+func (m *EscrowAccount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *EscrowAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EscrowAccount) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// EscrowDiscrepancy defines a denomination for which the total escrow tracked by
+// the transfer module differs from the sum of the balances of all escrow accounts.
+type EscrowDiscrepancy struct {
+	// tracked is the total escrow tracked by the transfer module
+	Tracked types.Coin `protobuf:"bytes,1,opt,name=tracked,proto3" json:"tracked"`
+	// escrowed is the sum of the balances of all escrow accounts
+	Escrowed types.Coin `protobuf:"bytes,2,opt,name=escrowed,proto3" json:"escrowed"`
+}
+
+func (m *EscrowDiscrepancy) Reset()         { *m = EscrowDiscrepancy{} }
+func (m *EscrowDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*EscrowDiscrepancy) ProtoMessage()    {}
+func (*EscrowDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *EscrowDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowDiscrepancy.Merge(m, src)
+}
+func (m *EscrowDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowDiscrepancy proto.InternalMessageInfo
+
+func (m *EscrowDiscrepancy) GetTracked() types.Coin {
+	if m != nil {
+		return m.Tracked
+	}
+	return types.Coin{}
+}
+
+func (m *EscrowDiscrepancy) GetEscrowed() types.Coin {
+	if m != nil {
+		return m.Escrowed
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*DenomTransferEnabled)(nil), "ibc.applications.transfer.v1.DenomTransferEnabled")
+	proto.RegisterType((*ChannelTransferEnabled)(nil), "ibc.applications.transfer.v1.ChannelTransferEnabled")
+	proto.RegisterType((*EscrowAccount)(nil), "ibc.applications.transfer.v1.EscrowAccount")
+	proto.RegisterType((*EscrowDiscrepancy)(nil), "ibc.applications.transfer.v1.EscrowDiscrepancy")
 }
 
 func init() {
@@ -91,21 +372,39 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x29, 0x4a,
-	0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x2f, 0x33, 0x84, 0xb3, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x64, 0x32, 0x93, 0x92, 0xf5, 0x90, 0x15, 0xeb, 0xc1, 0x15, 0x94, 0x19, 0x2a, 0x85, 0x70,
-	0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x29, 0x72, 0xf1, 0x14, 0xa7, 0xe6, 0xa5, 0xc4,
-	0xa7, 0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x04, 0x71, 0x83,
-	0xc4, 0x5c, 0x21, 0x42, 0x42, 0xea, 0x5c, 0xfc, 0x45, 0xa9, 0xc9, 0xa9, 0x99, 0x65, 0xa9, 0x70,
-	0x55, 0x4c, 0x60, 0x55, 0x7c, 0x50, 0x61, 0xa8, 0x42, 0xa7, 0xa0, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d, 0xcf, 0xd7, 0x2f,
-	0x33, 0x34, 0xd0, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0xf9, 0x0d, 0xc9, 0x4f, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xef, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0xe2, 0xb2, 0xbf, 0xfd, 0x00, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3d, 0x6f, 0x13, 0x41,
+	0x10, 0xf5, 0xc5, 0x21, 0x89, 0x37, 0x7c, 0x9e, 0xac, 0xe8, 0x88, 0xe0, 0x62, 0xdc, 0x60, 0x09,
+	0xe5, 0x36, 0x86, 0x26, 0x88, 0x8a, 0x7c, 0x14, 0x08, 0x45, 0x42, 0x27, 0x2a, 0x9a, 0xd3, 0xde,
+	0xee, 0x70, 0x59, 0xd9, 0xb7, 0x6b, 0xed, 0xac, 0x0d, 0xae, 0x29, 0x69, 0xf8, 0x1d, 0x74, 0x74,
+	0xfc, 0x84, 0x94, 0x29, 0xa9, 0x00, 0xd9, 0x7f, 0x04, 0xdd, 0xee, 0xd9, 0x8a, 0x08, 0x82, 0x14,
+	0x54, 0xbb, 0x3b, 0xf3, 0x66, 0xde, 0xbc, 0xd5, 0x1b, 0xf2, 0x48, 0xe6, 0x9c, 0xb2, 0xd1, 0x68,
+	0x28, 0x39, 0xb3, 0x52, 0x2b, 0xa4, 0xd6, 0x30, 0x85, 0x6f, 0xc1, 0xd0, 0x49, 0x7f, 0x79, 0x4f,
+	0x46, 0x46, 0x5b, 0x1d, 0xde, 0x93, 0x39, 0x4f, 0x2e, 0x82, 0x93, 0x25, 0x60, 0xd2, 0xdf, 0x6e,
+	0x17, 0xba, 0xd0, 0x0e, 0x48, 0xab, 0x9b, 0xaf, 0xd9, 0x8e, 0xb9, 0xc6, 0x52, 0x23, 0xcd, 0x19,
+	0x02, 0x9d, 0xf4, 0x73, 0xb0, 0xac, 0x4f, 0xb9, 0x96, 0xca, 0xe7, 0xbb, 0x5f, 0x03, 0xb2, 0xf6,
+	0x8a, 0x19, 0x56, 0x62, 0xf8, 0x80, 0x5c, 0x47, 0x50, 0x22, 0x03, 0xc5, 0xf2, 0x21, 0x88, 0x28,
+	0xe8, 0x04, 0xbd, 0x8d, 0x74, 0xb3, 0x8a, 0x1d, 0xfb, 0x50, 0xf8, 0x90, 0xdc, 0x32, 0xc0, 0x41,
+	0x4e, 0x60, 0x89, 0x5a, 0x71, 0xa8, 0x9b, 0x75, 0x78, 0x01, 0xdc, 0x27, 0x91, 0x00, 0xa5, 0xcb,
+	0xac, 0x04, 0xcb, 0x04, 0xb3, 0x2c, 0x33, 0x50, 0x48, 0xb4, 0x86, 0x99, 0xa8, 0xd9, 0x09, 0x7a,
+	0xad, 0x74, 0xcb, 0xe5, 0x4f, 0xea, 0x74, 0xba, 0xc8, 0x86, 0x3d, 0x72, 0x1b, 0xad, 0x91, 0xdc,
+	0x66, 0x25, 0x94, 0x3a, 0x1b, 0xc0, 0x14, 0xa3, 0x55, 0xcf, 0xe1, 0xe3, 0x27, 0x50, 0xea, 0x97,
+	0x30, 0xc5, 0xee, 0x7b, 0xd2, 0x3e, 0xaa, 0x7a, 0xbc, 0xae, 0x3f, 0x61, 0xc1, 0xdd, 0x26, 0xd7,
+	0x5c, 0x6f, 0x27, 0xa0, 0x95, 0xfa, 0xc7, 0x25, 0x75, 0x2b, 0x57, 0x52, 0xd7, 0xfc, 0x93, 0xba,
+	0xee, 0x87, 0x80, 0x6c, 0x1d, 0x9e, 0x32, 0xa5, 0x60, 0xf8, 0x3b, 0xf9, 0x7d, 0x42, 0xb8, 0xcf,
+	0x64, 0x52, 0xd4, 0x13, 0xb4, 0xea, 0xc8, 0x0b, 0xf1, 0x5f, 0xa7, 0xf8, 0x12, 0x90, 0x1b, 0xc7,
+	0xc8, 0x8d, 0x7e, 0xf7, 0x9c, 0x73, 0x3d, 0x56, 0xf6, 0x5f, 0xe4, 0x11, 0x59, 0x67, 0x42, 0x18,
+	0x40, 0x74, 0xbc, 0xad, 0x74, 0xf1, 0x0c, 0x0b, 0xb2, 0x91, 0xb3, 0x21, 0x53, 0x1c, 0x30, 0x6a,
+	0x76, 0x9a, 0xbd, 0xcd, 0xc7, 0x77, 0x13, 0x6f, 0x9c, 0xa4, 0x32, 0x4e, 0x52, 0x1b, 0x27, 0x39,
+	0xd4, 0x52, 0x1d, 0xec, 0x9d, 0x7d, 0xdf, 0x69, 0x7c, 0xfe, 0xb1, 0xd3, 0x2b, 0xa4, 0x3d, 0x1d,
+	0xe7, 0x09, 0xd7, 0x25, 0xad, 0x5d, 0xe6, 0x8f, 0x5d, 0x14, 0x03, 0x6a, 0xa7, 0x23, 0x40, 0x57,
+	0x80, 0xe9, 0xb2, 0x79, 0xf7, 0x63, 0x40, 0xee, 0xf8, 0x99, 0x8f, 0x24, 0x72, 0x03, 0x23, 0xa6,
+	0xf8, 0x34, 0x7c, 0x4a, 0xd6, 0xad, 0x61, 0x7c, 0x50, 0x9b, 0xee, 0xaf, 0xec, 0xab, 0x15, 0x7b,
+	0xba, 0xc0, 0x87, 0xcf, 0xc8, 0x06, 0xb8, 0x7e, 0xf5, 0x67, 0x5e, 0xa1, 0x76, 0x59, 0x70, 0x90,
+	0x9e, 0xcd, 0xe2, 0xe0, 0x7c, 0x16, 0x07, 0x3f, 0x67, 0x71, 0xf0, 0x69, 0x1e, 0x37, 0xce, 0xe7,
+	0x71, 0xe3, 0xdb, 0x3c, 0x6e, 0xbc, 0xd9, 0xbf, 0xac, 0x4d, 0xe6, 0x7c, 0xb7, 0xd0, 0x74, 0xd2,
+	0xdf, 0xa3, 0xa5, 0x16, 0xe3, 0x21, 0x60, 0xb5, 0xb8, 0x17, 0x16, 0xd6, 0x29, 0xce, 0xd7, 0xdc,
+	0x5e, 0x3d, 0xf9, 0x35, 0x00, 0x5a, 0x4d, 0x74, 0x9e, 0xda, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +427,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrictMemoKeys {
+		i--
+		if m.StrictMemoKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomMetadataRegistrar) > 0 {
+		i -= len(m.DenomMetadataRegistrar)
+		copy(dAtA[i:], m.DenomMetadataRegistrar)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.DenomMetadataRegistrar)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -151,39 +467,578 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DenomTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SendEnabled {
-		n += 2
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.ReceiveEnabled {
-		n += 2
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrowed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tracked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	l = len(m.DenomMetadataRegistrar)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.StrictMemoKeys {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EscrowAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tracked.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.Escrowed.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadataRegistrar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadataRegistrar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictMemoKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictMemoKeys = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ChannelTransferEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -206,13 +1061,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelTransferEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
@@ -232,7 +1119,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SendEnabled = bool(v != 0)
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
 			}
@@ -273,6 +1160,270 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EscrowAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowDiscrepancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowDiscrepancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tracked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransfer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -42,31 +43,36 @@ func (a *TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidType, "type mismatch")
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	index := getAllocationIndex(*msgTransfer, a.Allocations)
 	if index == allocationNotFound {
-		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrNotFound, "requested port and channel allocation does not exist")
-	}
-
-	ctx := sdk.UnwrapSDKContext(goCtx)
+		periodicIndex := getPeriodicAllocationIndex(*msgTransfer, a.PeriodicAllocations)
+		if periodicIndex == allocationNotFound {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrNotFound, "requested port and channel allocation does not exist")
+		}
 
-	if !isAllowedAddress(ctx, msgTransfer.Receiver, a.Allocations[index].AllowList) {
-		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
+		return a.acceptPeriodic(ctx, *msgTransfer, periodicIndex)
 	}
 
-	if err := validateMemo(ctx, msgTransfer.Memo, a.Allocations[index].AllowedPacketData); err != nil {
+	if err := validateAllocationRestrictions(ctx, *msgTransfer, a.Allocations[index]); err != nil {
 		return authz.AcceptResponse{}, err
 	}
 
 	// bool flag to see if we have updated any of the allocations
 	allocationModified := false
 
-	// update spend limit the token token in the MsgTransfer
+	// update spend limit for each token in the MsgTransfer
 	// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
 	// if there is no unlimited spend, then we need to subtract the amount from the spend limit to get the limit left
-	if !a.Allocations[index].SpendLimit.AmountOf(msgTransfer.Token.Denom).Equal(UnboundedSpendLimit()) {
-		limitLeft, isNegative := a.Allocations[index].SpendLimit.SafeSub(msgTransfer.Token)
+	for _, coin := range msgTransfer.GetCoins() {
+		if a.Allocations[index].SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+			continue
+		}
+
+		limitLeft, isNegative := a.Allocations[index].SpendLimit.SafeSub(coin)
 		if isNegative {
-			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", msgTransfer.Token.Denom)
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", coin.Denom)
 		}
 
 		allocationModified = true
@@ -82,7 +88,7 @@ func (a *TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		a.Allocations = slices.Delete(a.Allocations, index, index+1)
 	}
 
-	if len(a.Allocations) == 0 {
+	if len(a.Allocations) == 0 && len(a.PeriodicAllocations) == 0 {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
@@ -91,53 +97,175 @@ func (a *TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 	}
 
 	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &TransferAuthorization{
-		Allocations: a.Allocations,
+		Allocations:         a.Allocations,
+		PeriodicAllocations: a.PeriodicAllocations,
+	}}, nil
+}
+
+// acceptPeriodic accepts the MsgTransfer for the periodic allocation at the given index. The tokens
+// are subtracted from both the amount left to be spent in the current period and the total spend
+// limit. The period is reset first if it has ended.
+func (a *TransferAuthorization) acceptPeriodic(ctx sdk.Context, msgTransfer MsgTransfer, index int) (authz.AcceptResponse, error) {
+	periodicAllocation := &a.PeriodicAllocations[index]
+
+	if err := validateAllocationRestrictions(ctx, msgTransfer, periodicAllocation.Allocation); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	periodicAllocation.tryResetPeriod(ctx.BlockTime())
+
+	for _, coin := range msgTransfer.GetCoins() {
+		periodCanSpend, isNegative := periodicAllocation.PeriodCanSpend.SafeSub(coin)
+		if isNegative {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than period spend limit", coin.Denom)
+		}
+
+		periodicAllocation.PeriodCanSpend = periodCanSpend
+
+		// the total spend limit is not updated if it is set to the MaxUint256 sentinel value
+		if periodicAllocation.Allocation.SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+			continue
+		}
+
+		limitLeft, isNegative := periodicAllocation.Allocation.SpendLimit.SafeSub(coin)
+		if isNegative {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", coin.Denom)
+		}
+
+		periodicAllocation.Allocation.SpendLimit = limitLeft
+	}
+
+	// the periodic allocation is deleted once its total spend limit is exhausted
+	if periodicAllocation.Allocation.SpendLimit.IsZero() {
+		a.PeriodicAllocations = slices.Delete(a.PeriodicAllocations, index, index+1)
+	}
+
+	if len(a.Allocations) == 0 && len(a.PeriodicAllocations) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &TransferAuthorization{
+		Allocations:         a.Allocations,
+		PeriodicAllocations: a.PeriodicAllocations,
 	}}, nil
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
 func (a *TransferAuthorization) ValidateBasic() error {
-	if len(a.Allocations) == 0 {
+	if len(a.Allocations) == 0 && len(a.PeriodicAllocations) == 0 {
 		return errorsmod.Wrap(ErrInvalidAuthorization, "allocations cannot be empty")
 	}
 
 	foundChannels := make(map[string]bool, 0)
 
 	for _, allocation := range a.Allocations {
-		if _, found := foundChannels[allocation.SourceChannel]; found {
-			return errorsmod.Wrapf(channeltypes.ErrInvalidChannel, "duplicate source channel ID: %s", allocation.SourceChannel)
+		if err := allocation.validate(foundChannels); err != nil {
+			return err
 		}
+	}
 
-		foundChannels[allocation.SourceChannel] = true
-
-		if allocation.SpendLimit == nil {
-			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	for _, periodicAllocation := range a.PeriodicAllocations {
+		if err := periodicAllocation.validate(foundChannels); err != nil {
+			return err
 		}
+	}
 
-		if err := allocation.SpendLimit.Validate(); err != nil {
-			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid spend limit: %s", err.Error())
-		}
+	return nil
+}
 
-		if err := host.PortIdentifierValidator(allocation.SourcePort); err != nil {
-			return errorsmod.Wrap(err, "invalid source port ID")
-		}
+// validate performs a basic validation of the allocation. The source channel of the allocation
+// must not be in foundChannels, and it is added to it.
+func (a Allocation) validate(foundChannels map[string]bool) error {
+	if _, found := foundChannels[a.SourceChannel]; found {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannel, "duplicate source channel ID: %s", a.SourceChannel)
+	}
 
-		if err := host.ChannelIdentifierValidator(allocation.SourceChannel); err != nil {
-			return errorsmod.Wrap(err, "invalid source channel ID")
-		}
+	foundChannels[a.SourceChannel] = true
 
-		found := make(map[string]bool, 0)
-		for i := range allocation.AllowList {
-			if found[allocation.AllowList[i]] {
-				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in allow list %s", allocation.AllowList[i])
-			}
-			found[allocation.AllowList[i]] = true
+	if a.SpendLimit == nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid spend limit: %s", err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(a.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(a.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+
+	found := make(map[string]bool, 0)
+	for i := range a.AllowList {
+		if found[a.AllowList[i]] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in allow list %s", a.AllowList[i])
 		}
+		found[a.AllowList[i]] = true
+	}
+
+	return nil
+}
+
+// validate performs a basic validation of the periodic allocation. The source channel of the
+// allocation must not be in foundChannels, and it is added to it.
+func (a PeriodicAllocation) validate(foundChannels map[string]bool) error {
+	if err := a.Allocation.validate(foundChannels); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "period must be positive")
+	}
+
+	if a.PeriodSpendLimit.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit cannot be empty")
+	}
+
+	if err := a.PeriodSpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period spend limit: %s", err.Error())
+	}
+
+	if !a.PeriodSpendLimit.DenomsSubsetOf(a.Allocation.SpendLimit) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit has denominations which are not in the spend limit")
+	}
+
+	if err := a.PeriodCanSpend.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period can spend: %s", err.Error())
 	}
 
 	return nil
 }
 
+// tryResetPeriod starts a new period if the current one has ended at the given block time. The
+// amount left to be spent is reset to the period spend limit, capped by the total spend limit.
+func (a *PeriodicAllocation) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit.Min(a.Allocation.SpendLimit)
+
+	// if the allocation has not been used for longer than a period, the new period
+	// begins at the given block time rather than at the end of the previous period
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// validateAllocationRestrictions returns an error if the receiver or memo of the MsgTransfer are not
+// allowed by the allocation.
+func validateAllocationRestrictions(ctx sdk.Context, msgTransfer MsgTransfer, allocation Allocation) error {
+	if !isAllowedAddress(ctx, msgTransfer.Receiver, allocation.AllowList) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
+	}
+
+	return validateMemo(ctx, msgTransfer.Memo, allocation.AllowedPacketData)
+}
+
 // isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
 // gasCostPerIteration gas is consumed for each iteration.
 func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
@@ -190,9 +318,40 @@ func validateMemo(ctx sdk.Context, memo string, allowedMemos []string) error {
 // getAllocationIndex ranges through a set of allocations, and returns the index of the allocation if found. If not, returns -1.
 func getAllocationIndex(msg MsgTransfer, allocations []Allocation) int {
 	for index, allocation := range allocations {
-		if allocation.SourceChannel == msg.SourceChannel && allocation.SourcePort == msg.SourcePort {
+		if allocation.matches(msg) {
+			return index
+		}
+	}
+	return allocationNotFound
+}
+
+// getPeriodicAllocationIndex ranges through a set of periodic allocations, and returns the index of the periodic allocation if found. If not, returns -1.
+func getPeriodicAllocationIndex(msg MsgTransfer, periodicAllocations []PeriodicAllocation) int {
+	for index, periodicAllocation := range periodicAllocations {
+		if periodicAllocation.Allocation.matches(msg) {
 			return index
 		}
 	}
 	return allocationNotFound
 }
+
+// matches returns true if the allocation applies to the source port and channel of the MsgTransfer.
+// IBC v2 transfers are always sent from the transfer port, so they are matched against allocations
+// for the transfer port regardless of the source port of the MsgTransfer.
+func (a Allocation) matches(msg MsgTransfer) bool {
+	if a.SourceChannel != msg.SourceChannel {
+		return false
+	}
+
+	if isIBCV2Transfer(msg) {
+		return a.SourcePort == PortID
+	}
+
+	return a.SourcePort == msg.SourcePort
+}
+
+// isIBCV2Transfer returns true if the MsgTransfer is sent over IBC v2, i.e. it uses channel aliasing
+// or its source channel is an IBC v2 client identifier rather than a channel identifier.
+func isIBCV2Transfer(msg MsgTransfer) bool {
+	return msg.UseAliasing || !channeltypes.IsValidChannelID(msg.SourceChannel)
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -27,6 +28,20 @@ func (s *TypesTestSuite) TestTransferAuthorizationAccept() {
 		transferAuthz types.TransferAuthorization
 	)
 
+	// setPeriodicAllocation replaces the allocation with a periodic allocation with a period of one hour
+	setPeriodicAllocation := func(periodSpendLimit, periodCanSpend sdk.Coins, periodReset time.Time) {
+		transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{
+			{
+				Allocation:       transferAuthz.Allocations[0],
+				Period:           time.Hour,
+				PeriodSpendLimit: periodSpendLimit,
+				PeriodCanSpend:   periodCanSpend,
+				PeriodReset:      periodReset,
+			},
+		}
+		transferAuthz.Allocations = nil
+	}
+
 	testCases := []struct {
 		name         string
 		malleate     func()
@@ -87,6 +102,57 @@ func (s *TypesTestSuite) TestTransferAuthorizationAccept() {
 				s.Require().Nil(res.Updated)
 			},
 		},
+		{
+			"success: with multiple tokens and spend limit updated",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = transferAuthz.Allocations[0].SpendLimit.Add(sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{ibctesting.TestCoin, sdk.NewCoin("test-denom", sdkmath.NewInt(40))}
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				s.Require().True(ok)
+
+				isEqual := updatedAuthz.Allocations[0].SpendLimit.Equal(sdk.NewCoins(sdk.NewCoin("test-denom", sdkmath.NewInt(60))))
+				s.Require().True(isEqual)
+			},
+		},
+		{
+			"success: with multiple tokens and unlimited spend limit for one denom",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()), sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{ibctesting.TestCoin, sdk.NewCoin("test-denom", sdkmath.NewInt(40))}
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				s.Require().True(ok)
+
+				isEqual := updatedAuthz.Allocations[0].SpendLimit.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()), sdk.NewCoin("test-denom", sdkmath.NewInt(60))))
+				s.Require().True(isEqual)
+			},
+		},
+		{
+			"failure: with multiple tokens exceeding the spend limit of one denom",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = transferAuthz.Allocations[0].SpendLimit.Add(sdk.NewCoin("test-denom", sdkmath.NewInt(10)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{ibctesting.TestCoin, sdk.NewCoin("test-denom", sdkmath.NewInt(40))}
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
 		{
 			"success: empty AllowedPacketData and empty memo",
 			func() {
@@ -209,6 +275,159 @@ func (s *TypesTestSuite) TestTransferAuthorizationAccept() {
 				s.Require().Error(err)
 			},
 		},
+		{
+			"success: aliased IBC v2 transfer matches allocation of the channel",
+			func() {
+				msgTransfer.UseAliasing = true
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().True(res.Delete)
+			},
+		},
+		{
+			"success: IBC v2 transfer matches allocation of the transfer port regardless of source port",
+			func() {
+				transferAuthz.Allocations[0].SourceChannel = ibctesting.FirstClientID
+				msgTransfer.SourcePort = ibctesting.MockPort
+				msgTransfer.SourceChannel = ibctesting.FirstClientID
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().True(res.Delete)
+			},
+		},
+		{
+			"failure: IBC v2 transfer does not match allocation of another port",
+			func() {
+				transferAuthz.Allocations[0].SourcePort = ibctesting.MockPort
+				transferAuthz.Allocations[0].SourceChannel = ibctesting.FirstClientID
+				msgTransfer.SourcePort = ibctesting.MockPort
+				msgTransfer.SourceChannel = ibctesting.FirstClientID
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+			},
+		},
+		{
+			"success: periodic allocation within period spend limit",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))), nil, time.Time{})
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				s.Require().True(ok)
+				s.Require().Len(updatedAuthz.PeriodicAllocations, 1)
+
+				periodicAllocation := updatedAuthz.PeriodicAllocations[0]
+				s.Require().True(periodicAllocation.PeriodCanSpend.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10)))))
+				s.Require().True(periodicAllocation.Allocation.SpendLimit.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))))
+				s.Require().Equal(s.chainA.GetContext().BlockTime().Add(time.Hour), periodicAllocation.PeriodReset)
+			},
+		},
+		{
+			"success: period is reset once it has ended",
+			func() {
+				periodReset := s.chainA.GetContext().BlockTime().Add(-time.Minute)
+				setPeriodicAllocation(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))), sdk.NewCoins(), periodReset)
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				s.Require().True(ok)
+
+				// the new period begins at the end of the previous one
+				periodicAllocation := updatedAuthz.PeriodicAllocations[0]
+				s.Require().True(periodicAllocation.PeriodCanSpend.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10)))))
+				s.Require().Equal(s.chainA.GetContext().BlockTime().Add(time.Hour-time.Minute), periodicAllocation.PeriodReset)
+			},
+		},
+		{
+			"success: periodic allocation is deleted once its spend limit is exhausted",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(ibctesting.TestCoin), nil, time.Time{})
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				s.Require().True(res.Accept)
+				s.Require().True(res.Delete)
+				s.Require().Nil(res.Updated)
+			},
+		},
+		{
+			"success: period can spend is capped by the spend limit",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))), nil, time.Time{})
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    ibctesting.MockPort,
+					SourceChannel: "channel-9",
+					SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+				})
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().NoError(err)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				s.Require().True(ok)
+				s.Require().Len(updatedAuthz.Allocations, 1)
+
+				periodicAllocation := updatedAuthz.PeriodicAllocations[0]
+				s.Require().True(periodicAllocation.PeriodCanSpend.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40)))))
+			},
+		},
+		{
+			"failure: requested transfer amount is more than the period spend limit",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), nil, time.Time{})
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"failure: period can spend is exhausted before the period has ended",
+			func() {
+				periodReset := s.chainA.GetContext().BlockTime().Add(time.Minute)
+				setPeriodicAllocation(sdk.NewCoins(ibctesting.TestCoin), sdk.NewCoins(), periodReset)
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"failure: receiver address not permitted via allow list of periodic allocation",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(ibctesting.TestCoin), nil, time.Time{})
+				msgTransfer.Receiver = s.chainB.SenderAccount.GetAddress().String()
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+			},
+		},
+		{
+			"failure: memo not permitted by periodic allocation",
+			func() {
+				setPeriodicAllocation(sdk.NewCoins(ibctesting.TestCoin), nil, time.Time{})
+				msgTransfer.Memo = testMemo1
+			},
+			func(res authz.AcceptResponse, err error) {
+				s.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -254,7 +473,10 @@ func (s *TypesTestSuite) TestTransferAuthorizationMsgTypeURL() {
 }
 
 func (s *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
-	var transferAuthz types.TransferAuthorization
+	var (
+		transferAuthz      types.TransferAuthorization
+		periodicAllocation types.PeriodicAllocation
+	)
 
 	testCases := []struct {
 		name     string
@@ -350,6 +572,76 @@ func (s *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"success: with periodic allocation",
+			func() {
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			nil,
+		},
+		{
+			"success: with periodic allocation only",
+			func() {
+				transferAuthz.Allocations = nil
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			nil,
+		},
+		{
+			"success: with IBC v2 client identifier",
+			func() {
+				transferAuthz.Allocations[0].SourceChannel = ibctesting.FirstClientID
+			},
+			nil,
+		},
+		{
+			"periodic allocation with invalid allocation",
+			func() {
+				periodicAllocation.Allocation.SpendLimit = nil
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"periodic allocation with zero period",
+			func() {
+				periodicAllocation.Period = 0
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"periodic allocation with empty period spend limit",
+			func() {
+				periodicAllocation.PeriodSpendLimit = nil
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"periodic allocation with period spend limit denom not in spend limit",
+			func() {
+				periodicAllocation.PeriodSpendLimit = sdk.NewCoins(sdk.NewCoin("test-denom", sdkmath.NewInt(10)))
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"periodic allocation with invalid period can spend",
+			func() {
+				periodicAllocation.PeriodCanSpend = sdk.Coins{sdk.Coin{Denom: ""}}
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"duplicate channel ID in periodic allocation",
+			func() {
+				periodicAllocation.Allocation.SourceChannel = transferAuthz.Allocations[0].SourceChannel
+				transferAuthz.PeriodicAllocations = []types.PeriodicAllocation{periodicAllocation}
+			},
+			channeltypes.ErrInvalidChannel,
+		},
 		{
 			"duplicate channel ID",
 			func() {
@@ -379,6 +671,16 @@ func (s *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 				},
 			}
 
+			periodicAllocation = types.PeriodicAllocation{
+				Allocation: types.Allocation{
+					SourcePort:    mock.PortID,
+					SourceChannel: "channel-1",
+					SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+			}
+
 			tc.malleate()
 
 			err := transferAuthz.ValidateBasic()
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewDenomTransferEnabled creates a new DenomTransferEnabled instance
+func NewDenomTransferEnabled(denom string, sendEnabled, receiveEnabled bool) DenomTransferEnabled {
+	return DenomTransferEnabled{
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the DenomTransferEnabled fields.
+func (d DenomTransferEnabled) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	return nil
+}
+
+// IsDefault returns true if the override neither disables sending nor
+// receiving, in which case it has no effect and is not stored.
+func (d DenomTransferEnabled) IsDefault() bool {
+	return d.SendEnabled && d.ReceiveEnabled
+}
+
+// NewChannelTransferEnabled creates a new ChannelTransferEnabled instance
+func NewChannelTransferEnabled(channelID string, sendEnabled, receiveEnabled bool) ChannelTransferEnabled {
+	return ChannelTransferEnabled{
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the ChannelTransferEnabled fields.
+// The identifier may be either a channel identifier or a client identifier.
+func (c ChannelTransferEnabled) Validate() error {
+	return host.ChannelIdentifierValidator(c.ChannelId)
+}
+
+// IsDefault returns true if the override neither disables sending nor
+// receiving, in which case it has no effect and is not stored.
+func (c ChannelTransferEnabled) IsDefault() bool {
+	return c.SendEnabled && c.ReceiveEnabled
+}
+
+// ValidateTransferEnabled validates the provided per denomination and per
+// channel overrides and checks that no denomination or channel is repeated.
+func ValidateTransferEnabled(denoms []DenomTransferEnabled, channels []ChannelTransferEnabled) error {
+	seenDenoms := make(map[string]struct{})
+	for i, d := range denoms {
+		if err := d.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed to validate denom transfer enabled index %d", i)
+		}
+		if _, ok := seenDenoms[d.Denom]; ok {
+			return fmt.Errorf("duplicate denom transfer enabled entry for denom %s", d.Denom)
+		}
+		seenDenoms[d.Denom] = struct{}{}
+	}
+
+	seenChannels := make(map[string]struct{})
+	for i, c := range channels {
+		if err := c.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed to validate channel transfer enabled index %d", i)
+		}
+		if _, ok := seenChannels[c.ChannelId]; ok {
+			return fmt.Errorf("duplicate channel transfer enabled entry for channel %s", c.ChannelId)
+		}
+		seenChannels[c.ChannelId] = struct{}{}
+	}
+
+	return nil
+}
@@ -40,7 +40,7 @@ type MsgTransfer struct {
 	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
 	// the channel by which the packet will be sent
 	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
-	// token to be transferred
+	// token to be transferred, if a single token is transferred
 	Token types.Coin `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
 	// the sender address
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -65,6 +65,13 @@ type MsgTransfer struct {
 	// This only needs to be set if the channel IDs
 	// are V1 channel identifiers.
 	UseAliasing bool `protobuf:"varint,10,opt,name=use_aliasing,json=useAliasing,proto3" json:"use_aliasing,omitempty"`
+	// tokens to be transferred atomically in a single packet.
+	// Either token or tokens must be set, but not both.
+	Tokens []types.Coin `protobuf:"bytes,11,rep,name=tokens,proto3" json:"tokens"`
+	// if set, the tokens are first sent back along the hops of their denomination trace to their
+	// origin chain, using packet forward middleware memos, before being delivered to the receiver.
+	// The source port and channel must be left empty as they are set from the denomination trace.
+	Unwind bool `protobuf:"varint,12,opt,name=unwind,proto3" json:"unwind,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -220,11 +227,448 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetTransferEnabled is the Msg/SetTransferEnabled request type.
+// It sets or removes per denomination and per channel (or client) transfer
+// enablement overrides. Setting both send_enabled and receive_enabled to true
+// removes the override.
+type MsgSetTransferEnabled struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// denoms defines the per denomination overrides to set.
+	Denoms []DenomTransferEnabled `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms"`
+	// channels defines the per channel or client overrides to set.
+	Channels []ChannelTransferEnabled `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *MsgSetTransferEnabled) Reset()         { *m = MsgSetTransferEnabled{} }
+func (m *MsgSetTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferEnabled) ProtoMessage()    {}
+func (*MsgSetTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
+}
+func (m *MsgSetTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferEnabled.Merge(m, src)
+}
+func (m *MsgSetTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferEnabled proto.InternalMessageInfo
+
+// MsgSetTransferEnabledResponse defines the response structure for executing a
+// MsgSetTransferEnabled message.
+type MsgSetTransferEnabledResponse struct {
+}
+
+func (m *MsgSetTransferEnabledResponse) Reset()         { *m = MsgSetTransferEnabledResponse{} }
+func (m *MsgSetTransferEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferEnabledResponse) ProtoMessage()    {}
+func (*MsgSetTransferEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetTransferEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferEnabledResponse proto.InternalMessageInfo
+
+// MsgSetDenomMetadata is the Msg/SetDenomMetadata request type.
+// It registers the bank metadata of an IBC voucher denomination received by this chain.
+type MsgSetDenomMetadata struct {
+	// signer address, either the module authority or the denom metadata registrar
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// metadata defines the denomination metadata to register.
+	Metadata DenomMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgSetDenomMetadata) Reset()         { *m = MsgSetDenomMetadata{} }
+func (m *MsgSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadata) ProtoMessage()    {}
+func (*MsgSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadata.Merge(m, src)
+}
+func (m *MsgSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadata proto.InternalMessageInfo
+
+// MsgSetDenomMetadataResponse defines the response structure for executing a
+// MsgSetDenomMetadata message.
+type MsgSetDenomMetadataResponse struct {
+}
+
+func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadataResponse{} }
+func (m *MsgSetDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgSetDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgReconcileEscrow is the Msg/ReconcileEscrow request type.
+// It sets the total escrow tracked for each of the given denominations to the
+// sum of the balances of the escrow accounts of all transfer channels and IBC v2 clients.
+type MsgReconcileEscrow struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// denoms defines the denominations whose total escrow is reconciled.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgReconcileEscrow) Reset()         { *m = MsgReconcileEscrow{} }
+func (m *MsgReconcileEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileEscrow) ProtoMessage()    {}
+func (*MsgReconcileEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{8}
+}
+func (m *MsgReconcileEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileEscrow.Merge(m, src)
+}
+func (m *MsgReconcileEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileEscrow proto.InternalMessageInfo
+
+// MsgReconcileEscrowResponse defines the response structure for executing a
+// MsgReconcileEscrow message.
+type MsgReconcileEscrowResponse struct {
+	// reconciled contains the denominations whose total escrow was changed,
+	// with the previously tracked amount.
+	Reconciled []EscrowDiscrepancy `protobuf:"bytes,1,rep,name=reconciled,proto3" json:"reconciled"`
+}
+
+func (m *MsgReconcileEscrowResponse) Reset()         { *m = MsgReconcileEscrowResponse{} }
+func (m *MsgReconcileEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileEscrowResponse) ProtoMessage()    {}
+func (*MsgReconcileEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{9}
+}
+func (m *MsgReconcileEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileEscrowResponse.Merge(m, src)
+}
+func (m *MsgReconcileEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgReconcileEscrowResponse) GetReconciled() []EscrowDiscrepancy {
+	if m != nil {
+		return m.Reconciled
+	}
+	return nil
+}
+
+// MsgScheduleTransfer defines a msg to escrow the tokens of a transfer and dispatch
+// the transfer at a future block height or timestamp. Exactly one of execute_height
+// and execute_timestamp must be set.
+type MsgScheduleTransfer struct {
+	// the transfer to be dispatched, signed by its sender
+	Transfer MsgTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+	// block height at which the transfer is dispatched
+	ExecuteHeight uint64 `protobuf:"varint,2,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// block timestamp in absolute nanoseconds since unix epoch at which the transfer is dispatched
+	ExecuteTimestamp uint64 `protobuf:"varint,3,opt,name=execute_timestamp,json=executeTimestamp,proto3" json:"execute_timestamp,omitempty"`
+}
+
+func (m *MsgScheduleTransfer) Reset()         { *m = MsgScheduleTransfer{} }
+func (m *MsgScheduleTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTransfer) ProtoMessage()    {}
+func (*MsgScheduleTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{10}
+}
+func (m *MsgScheduleTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTransfer.Merge(m, src)
+}
+func (m *MsgScheduleTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTransfer proto.InternalMessageInfo
+
+// MsgScheduleTransferResponse defines the response structure for executing a
+// MsgScheduleTransfer message.
+type MsgScheduleTransferResponse struct {
+	// the identifier of the scheduled transfer
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleTransferResponse) Reset()         { *m = MsgScheduleTransferResponse{} }
+func (m *MsgScheduleTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTransferResponse) ProtoMessage()    {}
+func (*MsgScheduleTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{11}
+}
+func (m *MsgScheduleTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTransferResponse.Merge(m, src)
+}
+func (m *MsgScheduleTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTransferResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleTransferResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledTransfer defines a msg to cancel a scheduled transfer and
+// refund its escrowed tokens to the sender.
+type MsgCancelScheduledTransfer struct {
+	// the sender of the scheduled transfer
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the identifier of the scheduled transfer
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledTransfer) Reset()         { *m = MsgCancelScheduledTransfer{} }
+func (m *MsgCancelScheduledTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTransfer) ProtoMessage()    {}
+func (*MsgCancelScheduledTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{12}
+}
+func (m *MsgCancelScheduledTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTransfer.Merge(m, src)
+}
+func (m *MsgCancelScheduledTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTransfer proto.InternalMessageInfo
+
+// MsgCancelScheduledTransferResponse defines the response structure for executing a
+// MsgCancelScheduledTransfer message.
+type MsgCancelScheduledTransferResponse struct {
+}
+
+func (m *MsgCancelScheduledTransferResponse) Reset()         { *m = MsgCancelScheduledTransferResponse{} }
+func (m *MsgCancelScheduledTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTransferResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{13}
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTransferResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTransferEnabled)(nil), "ibc.applications.transfer.v1.MsgSetTransferEnabled")
+	proto.RegisterType((*MsgSetTransferEnabledResponse)(nil), "ibc.applications.transfer.v1.MsgSetTransferEnabledResponse")
+	proto.RegisterType((*MsgSetDenomMetadata)(nil), "ibc.applications.transfer.v1.MsgSetDenomMetadata")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "ibc.applications.transfer.v1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgReconcileEscrow)(nil), "ibc.applications.transfer.v1.MsgReconcileEscrow")
+	proto.RegisterType((*MsgReconcileEscrowResponse)(nil), "ibc.applications.transfer.v1.MsgReconcileEscrowResponse")
+	proto.RegisterType((*MsgScheduleTransfer)(nil), "ibc.applications.transfer.v1.MsgScheduleTransfer")
+	proto.RegisterType((*MsgScheduleTransferResponse)(nil), "ibc.applications.transfer.v1.MsgScheduleTransferResponse")
+	proto.RegisterType((*MsgCancelScheduledTransfer)(nil), "ibc.applications.transfer.v1.MsgCancelScheduledTransfer")
+	proto.RegisterType((*MsgCancelScheduledTransferResponse)(nil), "ibc.applications.transfer.v1.MsgCancelScheduledTransferResponse")
 }
 
 func init() {
@@ -232,48 +676,74 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6e, 0x13, 0x41,
-	0x10, 0xf6, 0x11, 0xdb, 0x38, 0xeb, 0xfc, 0x90, 0x03, 0x25, 0x17, 0x0b, 0xd9, 0x96, 0x45, 0x24,
-	0xe3, 0x28, 0xbb, 0x38, 0x08, 0x81, 0xdc, 0xe1, 0x34, 0x14, 0x44, 0x8a, 0xac, 0xd0, 0xd0, 0x44,
-	0x77, 0xeb, 0xe1, 0xbc, 0x8a, 0x6f, 0xf7, 0xb8, 0xdd, 0xb3, 0xa0, 0x41, 0x88, 0x0a, 0x51, 0xf1,
-	0x08, 0x94, 0x94, 0x79, 0x0a, 0x94, 0x32, 0x25, 0x15, 0x42, 0x49, 0x91, 0x86, 0x87, 0x40, 0xbb,
-	0xb7, 0x67, 0x0e, 0x8a, 0x00, 0x8d, 0xbd, 0x33, 0xf3, 0xcd, 0xcc, 0x37, 0xdf, 0x78, 0x8c, 0xb6,
-	0x58, 0x40, 0x89, 0x1f, 0xc7, 0x53, 0x46, 0x7d, 0xc5, 0x04, 0x97, 0x44, 0x25, 0x3e, 0x97, 0x2f,
-	0x20, 0x21, 0xb3, 0x3e, 0x51, 0xaf, 0x70, 0x9c, 0x08, 0x25, 0xdc, 0xdb, 0x2c, 0xa0, 0xb8, 0x08,
-	0xc3, 0x39, 0x0c, 0xcf, 0xfa, 0x8d, 0x35, 0x3f, 0x62, 0x5c, 0x10, 0xf3, 0x99, 0x25, 0x34, 0x6e,
-	0x85, 0x22, 0x14, 0xe6, 0x49, 0xf4, 0xcb, 0x7a, 0x37, 0xa8, 0x90, 0x91, 0x90, 0x24, 0x92, 0xa1,
-	0x2e, 0x1f, 0xc9, 0xd0, 0x06, 0x9a, 0x36, 0x10, 0xf8, 0x12, 0xc8, 0xac, 0x1f, 0x80, 0xf2, 0xfb,
-	0x84, 0x0a, 0xc6, 0x6d, 0xbc, 0xa5, 0x69, 0x52, 0x91, 0x00, 0xa1, 0x53, 0x06, 0x5c, 0xe9, 0xec,
-	0xec, 0x65, 0x01, 0xdb, 0x57, 0xcf, 0x91, 0x93, 0x35, 0xe0, 0xce, 0x97, 0x05, 0x54, 0xdf, 0x97,
-	0xe1, 0xa1, 0xf5, 0xba, 0x2d, 0x54, 0x97, 0x22, 0x4d, 0x28, 0x1c, 0xc5, 0x22, 0x51, 0x9e, 0xd3,
-	0x76, 0xba, 0x8b, 0x23, 0x94, 0xb9, 0x0e, 0x44, 0xa2, 0xdc, 0x2d, 0xb4, 0x62, 0x01, 0x74, 0xe2,
-	0x73, 0x0e, 0x53, 0xef, 0x9a, 0xc1, 0x2c, 0x67, 0xde, 0xbd, 0xcc, 0xe9, 0x0e, 0x50, 0x45, 0x89,
-	0x63, 0xe0, 0xde, 0x42, 0xdb, 0xe9, 0xd6, 0x77, 0x37, 0x71, 0x36, 0x15, 0xd6, 0x53, 0x61, 0x3b,
-	0x15, 0xde, 0x13, 0x8c, 0x0f, 0x17, 0x4f, 0xbf, 0xb5, 0x4a, 0x9f, 0x2f, 0x4f, 0x7a, 0xce, 0x28,
-	0x4b, 0x71, 0xd7, 0x51, 0x55, 0x02, 0x1f, 0x43, 0xe2, 0x95, 0x4d, 0x69, 0x6b, 0xb9, 0x0d, 0x54,
-	0x4b, 0x80, 0x02, 0x9b, 0x41, 0xe2, 0x55, 0x4c, 0x64, 0x6e, 0xbb, 0x4f, 0xd1, 0x8a, 0x62, 0x11,
-	0x88, 0x54, 0x1d, 0x4d, 0x80, 0x85, 0x13, 0xe5, 0x55, 0x4d, 0xe3, 0x06, 0xd6, 0xeb, 0xd2, 0x72,
-	0x61, 0x2b, 0xd2, 0xac, 0x8f, 0x9f, 0x18, 0x44, 0xb1, 0xf3, 0xb2, 0x4d, 0xce, 0x22, 0xee, 0x36,
-	0x5a, 0xcb, 0xab, 0xe9, 0x6f, 0xa9, 0xfc, 0x28, 0xf6, 0xae, 0xb7, 0x9d, 0x6e, 0x79, 0x74, 0xc3,
-	0x06, 0x0e, 0x73, 0xbf, 0xeb, 0xa2, 0x72, 0x04, 0x91, 0xf0, 0x6a, 0x86, 0x92, 0x79, 0x6b, 0xaa,
-	0xc0, 0xa9, 0x18, 0x33, 0x1e, 0x7a, 0x8b, 0x19, 0xd5, 0xdc, 0x76, 0xbb, 0x68, 0x29, 0x95, 0x70,
-	0xe4, 0x4f, 0x99, 0x2f, 0x75, 0x1c, 0xb5, 0x9d, 0x6e, 0x6d, 0x58, 0xc9, 0x88, 0xd4, 0x53, 0x09,
-	0x8f, 0x6d, 0x64, 0xd0, 0x7b, 0xff, 0xa9, 0x55, 0x7a, 0x77, 0x79, 0xd2, 0xb3, 0x0a, 0x7c, 0xb8,
-	0x3c, 0xe9, 0xad, 0x67, 0x42, 0xee, 0xc8, 0xf1, 0x31, 0x29, 0x2c, 0xae, 0xf3, 0x10, 0xdd, 0x2c,
-	0x98, 0x23, 0x90, 0xb1, 0xe0, 0x12, 0x34, 0x11, 0x09, 0x2f, 0x53, 0xe0, 0x14, 0xcc, 0x32, 0xcb,
-	0xa3, 0xb9, 0x3d, 0x28, 0xeb, 0xf2, 0x9d, 0x37, 0x68, 0x75, 0x5f, 0x86, 0xcf, 0xe2, 0xb1, 0xaf,
-	0xe0, 0xc0, 0x4f, 0xfc, 0x48, 0x9a, 0x05, 0xb0, 0x90, 0x43, 0x62, 0xf7, 0x6f, 0x2d, 0x77, 0x88,
-	0xaa, 0xb1, 0x41, 0x98, 0x9d, 0xd7, 0x77, 0xef, 0xe0, 0xab, 0x6e, 0x01, 0x67, 0xd5, 0x86, 0x65,
-	0x2d, 0xf3, 0xc8, 0x66, 0x0e, 0x56, 0x7f, 0xcd, 0x64, 0x8a, 0x76, 0x36, 0xd1, 0xc6, 0x1f, 0xfd,
-	0x73, 0xf2, 0xbb, 0x3f, 0x1c, 0xb4, 0xb0, 0x2f, 0x43, 0x77, 0x82, 0x6a, 0xf3, 0x1f, 0xe8, 0xdd,
-	0xab, 0x7b, 0x16, 0x34, 0x68, 0xf4, 0xff, 0x19, 0x3a, 0x97, 0x4b, 0xa1, 0xa5, 0xdf, 0x94, 0xd8,
-	0xf9, 0x6b, 0x89, 0x22, 0xbc, 0xf1, 0xe0, 0xbf, 0xe0, 0x79, 0xd7, 0x46, 0xe5, 0xad, 0xde, 0xfd,
-	0x70, 0x74, 0x7a, 0xde, 0x74, 0xce, 0xce, 0x9b, 0xce, 0xf7, 0xf3, 0xa6, 0xf3, 0xf1, 0xa2, 0x59,
-	0x3a, 0xbb, 0x68, 0x96, 0xbe, 0x5e, 0x34, 0x4b, 0xcf, 0x1f, 0x85, 0x4c, 0x4d, 0xd2, 0x00, 0x53,
-	0x11, 0x11, 0xfb, 0xf7, 0xc0, 0x02, 0xba, 0x13, 0x0a, 0x32, 0xeb, 0xdf, 0x23, 0x91, 0x18, 0xa7,
-	0x53, 0x90, 0xfa, 0xe6, 0x0b, 0xb7, 0xae, 0x5e, 0xc7, 0x20, 0x83, 0xaa, 0x39, 0xf3, 0xfb, 0x3f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xc3, 0xca, 0xd9, 0xdd, 0x04, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x8e, 0xeb, 0x8c, 0xf3, 0xa3, 0x59, 0x20, 0xd9, 0x6e, 0xa9, 0x13, 0x59, 0xad,
+	0x64, 0x12, 0x65, 0xb7, 0x4e, 0x41, 0xb4, 0x81, 0x03, 0x24, 0xad, 0xc4, 0x01, 0x4b, 0x91, 0xdb,
+	0x70, 0xe0, 0x12, 0xad, 0x67, 0x1f, 0xeb, 0x51, 0xbd, 0x33, 0x66, 0x67, 0xec, 0xb6, 0x07, 0x50,
+	0xc5, 0x01, 0x21, 0x24, 0x24, 0x84, 0xc4, 0x8d, 0x03, 0x47, 0x8e, 0xf9, 0x33, 0x7a, 0x42, 0x3d,
+	0x72, 0x42, 0x28, 0x39, 0x44, 0xe2, 0xaf, 0xa8, 0x66, 0x76, 0x66, 0xb3, 0xb1, 0x63, 0xc7, 0xe9,
+	0x25, 0xd9, 0x99, 0xf7, 0xbe, 0xef, 0x7d, 0xef, 0x9b, 0x9d, 0xe7, 0x45, 0x77, 0x48, 0x0b, 0xfb,
+	0x41, 0xb7, 0xdb, 0x21, 0x38, 0x10, 0x84, 0x51, 0xee, 0x8b, 0x24, 0xa0, 0xfc, 0x1b, 0x48, 0xfc,
+	0x7e, 0xdd, 0x17, 0xcf, 0xbd, 0x6e, 0xc2, 0x04, 0xb3, 0xdf, 0x27, 0x2d, 0xec, 0xe5, 0xd3, 0x3c,
+	0x93, 0xe6, 0xf5, 0xeb, 0xee, 0x72, 0x10, 0x13, 0xca, 0x7c, 0xf5, 0x37, 0x05, 0xb8, 0xef, 0x46,
+	0x2c, 0x62, 0xea, 0xd1, 0x97, 0x4f, 0x7a, 0x77, 0x15, 0x33, 0x1e, 0x33, 0xee, 0xc7, 0x3c, 0x92,
+	0xf4, 0x31, 0x8f, 0x74, 0xa0, 0xa2, 0x03, 0xad, 0x80, 0x83, 0xdf, 0xaf, 0xb7, 0x40, 0x04, 0x75,
+	0x1f, 0x33, 0x42, 0x75, 0x7c, 0x4d, 0xca, 0xc4, 0x2c, 0x01, 0x1f, 0x77, 0x08, 0x50, 0x21, 0xd1,
+	0xe9, 0x93, 0x4e, 0xd8, 0x1c, 0xdf, 0x87, 0x11, 0x9b, 0x26, 0xd7, 0xc6, 0x27, 0xb3, 0xa7, 0xa0,
+	0xeb, 0x56, 0xff, 0x28, 0xa0, 0x72, 0x83, 0x47, 0x4f, 0x74, 0xd8, 0x5e, 0x43, 0x65, 0xce, 0x7a,
+	0x09, 0x86, 0xc3, 0x2e, 0x4b, 0x84, 0x63, 0xad, 0x5b, 0xb5, 0xb9, 0x26, 0x4a, 0xb7, 0xf6, 0x59,
+	0x22, 0xec, 0x3b, 0x68, 0x51, 0x27, 0xe0, 0x76, 0x40, 0x29, 0x74, 0x9c, 0x69, 0x95, 0xb3, 0x90,
+	0xee, 0xee, 0xa5, 0x9b, 0xf6, 0x0e, 0x9a, 0x55, 0x65, 0x9c, 0x99, 0x75, 0xab, 0x56, 0xde, 0xbe,
+	0xe1, 0xa5, 0xfd, 0x7b, 0xb2, 0x7f, 0x4f, 0xf7, 0xef, 0xed, 0x31, 0x42, 0x77, 0xe7, 0x5e, 0xfd,
+	0xbb, 0x36, 0xf5, 0xd7, 0xe9, 0xd1, 0x86, 0xd5, 0x4c, 0x21, 0xf6, 0x0a, 0x2a, 0x72, 0xa0, 0x21,
+	0x24, 0x4e, 0x41, 0x51, 0xeb, 0x95, 0xed, 0xa2, 0x52, 0x02, 0x18, 0x48, 0x1f, 0x12, 0x67, 0x56,
+	0x45, 0xb2, 0xb5, 0xfd, 0x25, 0x5a, 0x14, 0x24, 0x06, 0xd6, 0x13, 0x87, 0x6d, 0x20, 0x51, 0x5b,
+	0x38, 0x45, 0x55, 0xd8, 0xf5, 0xe4, 0xc1, 0x4a, 0x63, 0x3d, 0x6d, 0x67, 0xbf, 0xee, 0x7d, 0xa1,
+	0x32, 0xf2, 0x95, 0x17, 0x34, 0x38, 0x8d, 0xd8, 0x9b, 0x68, 0xd9, 0xb0, 0xc9, 0xff, 0x5c, 0x04,
+	0x71, 0xd7, 0xb9, 0xb6, 0x6e, 0xd5, 0x0a, 0xcd, 0xeb, 0x3a, 0xf0, 0xc4, 0xec, 0xdb, 0x36, 0x2a,
+	0xc4, 0x10, 0x33, 0xa7, 0xa4, 0x24, 0xa9, 0x67, 0x29, 0x15, 0x28, 0x66, 0x21, 0xa1, 0x91, 0x33,
+	0x97, 0x4a, 0x35, 0x6b, 0xbb, 0x86, 0xe6, 0x7b, 0x1c, 0x0e, 0x83, 0x0e, 0x09, 0xb8, 0x8c, 0xa3,
+	0x75, 0xab, 0x56, 0xda, 0x9d, 0x4d, 0x85, 0x94, 0x7b, 0x1c, 0x3e, 0xd7, 0x11, 0xfb, 0x53, 0x54,
+	0x54, 0x8e, 0x70, 0xa7, 0xbc, 0x3e, 0x33, 0xb1, 0x8b, 0x1a, 0x23, 0x6d, 0xec, 0xd1, 0x67, 0x84,
+	0x86, 0xce, 0xbc, 0xac, 0xd0, 0xd4, 0xab, 0x9d, 0x8d, 0x9f, 0xfe, 0x5c, 0x9b, 0xfa, 0xe1, 0xf4,
+	0x68, 0x43, 0xfb, 0xfa, 0xf3, 0xe9, 0xd1, 0xc6, 0x4a, 0x4a, 0xbc, 0xc5, 0xc3, 0xa7, 0x7e, 0xee,
+	0x75, 0xa8, 0x7e, 0x8c, 0xde, 0xc9, 0x2d, 0x9b, 0xc0, 0xbb, 0x8c, 0x72, 0x90, 0xed, 0x71, 0xf8,
+	0xb6, 0x07, 0x14, 0x83, 0x7a, 0x45, 0x0a, 0xcd, 0x6c, 0xbd, 0x53, 0x90, 0xf4, 0xd5, 0xef, 0xd1,
+	0x52, 0x83, 0x47, 0x07, 0xdd, 0x30, 0x10, 0xb0, 0x1f, 0x24, 0x41, 0xac, 0xf4, 0x70, 0x12, 0x51,
+	0x48, 0xf4, 0x5b, 0xa5, 0x57, 0xf6, 0x2e, 0x2a, 0x76, 0x55, 0x86, 0x7a, 0x93, 0xca, 0xdb, 0xb7,
+	0xbd, 0x71, 0x77, 0xd1, 0x4b, 0xd9, 0x76, 0x0b, 0xb2, 0xe1, 0xa6, 0x46, 0xee, 0x2c, 0x9d, 0xf5,
+	0xa4, 0x48, 0xab, 0x37, 0xd0, 0xea, 0x40, 0x7d, 0x23, 0xbe, 0xfa, 0xbf, 0x85, 0xde, 0x6b, 0xf0,
+	0xe8, 0x31, 0x08, 0xd3, 0xd7, 0x23, 0x1a, 0xb4, 0x3a, 0x10, 0x8e, 0x54, 0xb8, 0x8f, 0x8a, 0x21,
+	0x50, 0xa6, 0x14, 0xca, 0x73, 0xd8, 0x1e, 0xaf, 0xf0, 0xa1, 0xcc, 0x1d, 0xe0, 0x36, 0x7a, 0x53,
+	0x1e, 0xfb, 0x2b, 0x54, 0xd2, 0xd7, 0x87, 0x3b, 0x33, 0x8a, 0xf3, 0xc3, 0xf1, 0x9c, 0xfa, 0x5e,
+	0x5d, 0xcc, 0x9a, 0x71, 0x0d, 0xfb, 0xb0, 0x86, 0x6e, 0x5d, 0xd8, 0x6b, 0xe6, 0xc6, 0x2f, 0x96,
+	0x3a, 0xe2, 0xc7, 0x20, 0x94, 0xec, 0x06, 0x88, 0x20, 0x0c, 0x44, 0x30, 0xd2, 0x8b, 0x06, 0x2a,
+	0xc5, 0x3a, 0x47, 0x9f, 0xd7, 0xe6, 0x04, 0x6e, 0x18, 0x5a, 0x23, 0xd8, 0x50, 0x0c, 0x0b, 0xbe,
+	0x85, 0x6e, 0x5e, 0x20, 0x27, 0x93, 0x7b, 0x80, 0xec, 0x06, 0x8f, 0x9a, 0x80, 0x19, 0xc5, 0xa4,
+	0x03, 0x8f, 0x38, 0x4e, 0xd8, 0xb3, 0x91, 0x62, 0x57, 0xce, 0x1d, 0xdc, 0x9c, 0xb1, 0x7f, 0xb8,
+	0x2a, 0x47, 0xee, 0x30, 0x6d, 0xf6, 0xba, 0x1f, 0x20, 0x94, 0x98, 0x50, 0xe8, 0x58, 0xea, 0xbc,
+	0xfc, 0xf1, 0x5d, 0xa7, 0x0c, 0x0f, 0x09, 0xc7, 0x09, 0x74, 0x03, 0x8a, 0x5f, 0xe8, 0xce, 0x73,
+	0x44, 0xd5, 0xbf, 0xb5, 0xf5, 0xb8, 0x0d, 0x61, 0xaf, 0x03, 0xd9, 0x0c, 0xde, 0x47, 0x25, 0x43,
+	0xa5, 0xfa, 0x29, 0x6f, 0x7f, 0x30, 0xbe, 0x58, 0xee, 0x8a, 0xe6, 0x07, 0x41, 0xc6, 0x22, 0x87,
+	0x36, 0x3c, 0x07, 0xdc, 0x13, 0x60, 0xa6, 0xe3, 0xb4, 0xba, 0xb5, 0x0b, 0x7a, 0xf7, 0x6c, 0xec,
+	0x99, 0xb4, 0xb3, 0xb1, 0x37, 0x93, 0x8e, 0x3d, 0x1d, 0xc8, 0xc6, 0xde, 0xce, 0xb2, 0xf1, 0x30,
+	0x2b, 0x53, 0xdd, 0x42, 0x37, 0x2f, 0xe8, 0x27, 0xb3, 0x71, 0x11, 0x4d, 0x93, 0x50, 0xcf, 0x8b,
+	0x69, 0x12, 0x56, 0x0f, 0x94, 0xe9, 0x7b, 0x01, 0xc5, 0xd0, 0x31, 0xa0, 0x30, 0x73, 0xe1, 0xec,
+	0x57, 0xc0, 0x3a, 0xf7, 0x2b, 0x90, 0xb2, 0x4c, 0x1b, 0x96, 0xfc, 0x59, 0xaa, 0x84, 0xea, 0x6d,
+	0x54, 0x1d, 0x4d, 0x6b, 0xc4, 0x6c, 0xff, 0x76, 0x0d, 0xcd, 0x34, 0x78, 0x64, 0xb7, 0x51, 0x29,
+	0x2b, 0x39, 0xb9, 0xcd, 0x6e, 0x7d, 0xe2, 0xd4, 0xac, 0x7d, 0x81, 0xe6, 0xcf, 0xcd, 0xc3, 0xad,
+	0x4b, 0x29, 0xf2, 0xe9, 0xee, 0x47, 0x57, 0x4a, 0xcf, 0xaa, 0xfe, 0x68, 0x21, 0xfb, 0x82, 0x51,
+	0x77, 0xef, 0x52, 0xb6, 0x61, 0x90, 0xfb, 0xc9, 0x5b, 0x80, 0x32, 0x21, 0x2f, 0x2d, 0x74, 0x7d,
+	0x68, 0xca, 0xd4, 0x27, 0x61, 0x3c, 0x07, 0x71, 0x1f, 0x5c, 0x19, 0x92, 0x49, 0xf8, 0x0e, 0x2d,
+	0x0d, 0x4e, 0x8e, 0xbb, 0x97, 0xb2, 0x0d, 0x20, 0xdc, 0xfb, 0x57, 0x45, 0x9c, 0x77, 0x60, 0xf0,
+	0xb2, 0x4f, 0xe0, 0xc0, 0x00, 0xc4, 0x7d, 0x70, 0x65, 0x48, 0x26, 0xe1, 0x77, 0x0b, 0xad, 0x8e,
+	0xba, 0x70, 0x97, 0x37, 0x36, 0x02, 0xe9, 0x7e, 0xf6, 0xb6, 0x48, 0xa3, 0xcb, 0x9d, 0x7d, 0x29,
+	0x27, 0xd6, 0x6e, 0xf3, 0xd5, 0x71, 0xc5, 0x7a, 0x7d, 0x5c, 0xb1, 0xfe, 0x3b, 0xae, 0x58, 0xbf,
+	0x9e, 0x54, 0xa6, 0x5e, 0x9f, 0x54, 0xa6, 0xfe, 0x39, 0xa9, 0x4c, 0x7d, 0x7d, 0x3f, 0x22, 0xa2,
+	0xdd, 0x6b, 0x79, 0x98, 0xc5, 0xbe, 0xfe, 0x94, 0x26, 0x2d, 0xbc, 0x15, 0x31, 0xbf, 0x5f, 0xbf,
+	0xeb, 0xc7, 0x4c, 0xd2, 0x72, 0xf9, 0xc9, 0x9b, 0xfb, 0xd4, 0x15, 0x2f, 0xba, 0xc0, 0x5b, 0x45,
+	0xf5, 0xa1, 0x7b, 0xef, 0xcd, 0x00, 0x78, 0x0d, 0x3b, 0x2b, 0x09, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -292,6 +762,16 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetTransferEnabled defines a rpc handler for MsgSetTransferEnabled.
+	SetTransferEnabled(ctx context.Context, in *MsgSetTransferEnabled, opts ...grpc.CallOption) (*MsgSetTransferEnabledResponse, error)
+	// SetDenomMetadata defines a rpc handler for MsgSetDenomMetadata.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// ReconcileEscrow defines a rpc handler for MsgReconcileEscrow.
+	ReconcileEscrow(ctx context.Context, in *MsgReconcileEscrow, opts ...grpc.CallOption) (*MsgReconcileEscrowResponse, error)
+	// ScheduleTransfer defines a rpc handler for MsgScheduleTransfer.
+	ScheduleTransfer(ctx context.Context, in *MsgScheduleTransfer, opts ...grpc.CallOption) (*MsgScheduleTransferResponse, error)
+	// CancelScheduledTransfer defines a rpc handler for MsgCancelScheduledTransfer.
+	CancelScheduledTransfer(ctx context.Context, in *MsgCancelScheduledTransfer, opts ...grpc.CallOption) (*MsgCancelScheduledTransferResponse, error)
 }
 
 type msgClient struct {
@@ -320,12 +800,67 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetTransferEnabled(ctx context.Context, in *MsgSetTransferEnabled, opts ...grpc.CallOption) (*MsgSetTransferEnabledResponse, error) {
+	out := new(MsgSetTransferEnabledResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetTransferEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReconcileEscrow(ctx context.Context, in *MsgReconcileEscrow, opts ...grpc.CallOption) (*MsgReconcileEscrowResponse, error) {
+	out := new(MsgReconcileEscrowResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/ReconcileEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleTransfer(ctx context.Context, in *MsgScheduleTransfer, opts ...grpc.CallOption) (*MsgScheduleTransferResponse, error) {
+	out := new(MsgScheduleTransferResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/ScheduleTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledTransfer(ctx context.Context, in *MsgCancelScheduledTransfer, opts ...grpc.CallOption) (*MsgCancelScheduledTransferResponse, error) {
+	out := new(MsgCancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/CancelScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetTransferEnabled defines a rpc handler for MsgSetTransferEnabled.
+	SetTransferEnabled(context.Context, *MsgSetTransferEnabled) (*MsgSetTransferEnabledResponse, error)
+	// SetDenomMetadata defines a rpc handler for MsgSetDenomMetadata.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// ReconcileEscrow defines a rpc handler for MsgReconcileEscrow.
+	ReconcileEscrow(context.Context, *MsgReconcileEscrow) (*MsgReconcileEscrowResponse, error)
+	// ScheduleTransfer defines a rpc handler for MsgScheduleTransfer.
+	ScheduleTransfer(context.Context, *MsgScheduleTransfer) (*MsgScheduleTransferResponse, error)
+	// CancelScheduledTransfer defines a rpc handler for MsgCancelScheduledTransfer.
+	CancelScheduledTransfer(context.Context, *MsgCancelScheduledTransfer) (*MsgCancelScheduledTransferResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -338,11 +873,26 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (*UnimplementedMsgServer) SetTransferEnabled(ctx context.Context, req *MsgSetTransferEnabled) (*MsgSetTransferEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferEnabled not implemented")
 }
-
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ReconcileEscrow(ctx context.Context, req *MsgReconcileEscrow) (*MsgReconcileEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleTransfer(ctx context.Context, req *MsgScheduleTransfer) (*MsgScheduleTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledTransfer(ctx context.Context, req *MsgCancelScheduledTransfer) (*MsgCancelScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
 func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgTransfer)
 	if err := dec(in); err != nil {
@@ -379,6 +929,96 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTransferEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetTransferEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferEnabled(ctx, req.(*MsgSetTransferEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReconcileEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/ReconcileEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileEscrow(ctx, req.(*MsgReconcileEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/ScheduleTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleTransfer(ctx, req.(*MsgScheduleTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/CancelScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledTransfer(ctx, req.(*MsgCancelScheduledTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
@@ -392,6 +1032,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetTransferEnabled",
+			Handler:    _Msg_SetTransferEnabled_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "ReconcileEscrow",
+			Handler:    _Msg_ReconcileEscrow_Handler,
+		},
+		{
+			MethodName: "ScheduleTransfer",
+			Handler:    _Msg_ScheduleTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _Msg_CancelScheduledTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -417,6 +1077,30 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Unwind {
+		i--
+		if m.Unwind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.UseAliasing {
 		i--
 		if m.UseAliasing {
@@ -588,103 +1272,1242 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.Token.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Encoding)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.UseAliasing {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReconcileEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reconciled) > 0 {
+		for iNdEx := len(m.Reconciled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reconciled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UseAliasing {
+		n += 2
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Unwind {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTransferEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReconcileEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReconcileEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reconciled) > 0 {
+		for _, e := range m.Reconciled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteHeight))
+	}
+	if m.ExecuteTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteTimestamp))
+	}
+	return n
+}
+
+func (m *MsgScheduleTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseAliasing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseAliasing = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unwind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unwind = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -707,15 +2530,15 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,11 +2566,179 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomTransferEnabled{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelTransferEnabled{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTransferEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -775,11 +2766,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -796,23 +2787,123 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -840,11 +2931,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,11 +2963,61 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -903,34 +3044,66 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reconciled = append(m.Reconciled, EscrowDiscrepancy{})
+			if err := m.Reconciled[len(m.Reconciled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -940,29 +3113,30 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExecuteHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -972,29 +3146,16 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecuteHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Encoding = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseAliasing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTimestamp", wireType)
 			}
-			var v int
+			m.ExecuteTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1004,12 +3165,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExecuteTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseAliasing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1031,7 +3191,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,17 +3214,17 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Sequence = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1074,7 +3234,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1100,7 +3260,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,15 +3283,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1159,13 +3319,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1175,25 +3335,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1215,7 +3361,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1238,10 +3384,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
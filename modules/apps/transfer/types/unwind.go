@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// UnwindIntermediateReceiver is the receiver of the packets sent to the intermediate chains of an
+// unwind. It is never used as the packet forward middleware of these chains overrides the receiver.
+const UnwindIntermediateReceiver = "pfm"
+
+// keys of the memo of the packet forward middleware
+const (
+	forwardKey         = "forward"
+	forwardReceiverKey = "receiver"
+	forwardPortKey     = "port"
+	forwardChannelKey  = "channel"
+	forwardNextKey     = "next"
+)
+
+// NewUnwindMemo returns the packet forward middleware memo which forwards tokens along the given
+// hops, the last of which delivers them to the receiver. The memo of the unwinding transfer may only
+// contain forward metadata, in which case it is forwarded further once the hops are unwound.
+func NewUnwindMemo(hops []Hop, receiver, memo string) (string, error) {
+	var next map[string]any
+	if len(memo) != 0 {
+		if err := json.Unmarshal([]byte(memo), &next); err != nil || len(next) != 1 || next[forwardKey] == nil {
+			return "", errorsmod.Wrap(ErrInvalidUnwind, "memo must only contain forward metadata when unwinding over multiple hops")
+		}
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hopReceiver := UnwindIntermediateReceiver
+		if i == len(hops)-1 {
+			hopReceiver = receiver
+		}
+
+		forward := map[string]any{
+			forwardReceiverKey: hopReceiver,
+			forwardPortKey:     hops[i].PortId,
+			forwardChannelKey:  hops[i].ChannelId,
+		}
+		if next != nil {
+			forward[forwardNextKey] = next
+		}
+
+		next = map[string]any{forwardKey: forward}
+	}
+
+	bz, err := json.Marshal(next)
+	if err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidUnwind, "failed to marshal unwind memo: %s", err)
+	}
+
+	if len(bz) > MaximumMemoLength {
+		return "", errorsmod.Wrapf(ErrInvalidMemo, "unwind memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestNewUnwindMemo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		hops     []types.Hop
+		memo     string
+		expMemo  string
+		expError error
+	}{
+		{
+			"success: single hop",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			"",
+			`{"forward":{"channel":"channel-1","port":"transfer","receiver":"cosmos1receiver"}}`,
+			nil,
+		},
+		{
+			"success: multiple hops",
+			[]types.Hop{types.NewHop("transfer", "channel-1"), types.NewHop("transfer", "channel-2")},
+			"",
+			`{"forward":{"channel":"channel-1","next":{"forward":{"channel":"channel-2","port":"transfer","receiver":"cosmos1receiver"}},"port":"transfer","receiver":"pfm"}}`,
+			nil,
+		},
+		{
+			"success: memo with forward metadata",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			`{"forward":{"channel":"channel-3","port":"transfer","receiver":"cosmos1other"}}`,
+			`{"forward":{"channel":"channel-1","next":{"forward":{"channel":"channel-3","port":"transfer","receiver":"cosmos1other"}},"port":"transfer","receiver":"cosmos1receiver"}}`,
+			nil,
+		},
+		{
+			"failure: memo is not a JSON object",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			"memo",
+			"",
+			types.ErrInvalidUnwind,
+		},
+		{
+			"failure: memo with keys other than forward",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			`{"forward":{},"wasm":{}}`,
+			"",
+			types.ErrInvalidUnwind,
+		},
+		{
+			"failure: memo without forward metadata",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			`{"wasm":{}}`,
+			"",
+			types.ErrInvalidUnwind,
+		},
+		{
+			"failure: unwind memo is too long",
+			[]types.Hop{types.NewHop("transfer", "channel-1")},
+			`{"forward":{"receiver":"` + ibctesting.GenerateString(types.MaximumMemoLength) + `"}}`,
+			"",
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := types.NewUnwindMemo(tc.hops, "cosmos1receiver", tc.memo)
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMemo, memo)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -68,17 +68,19 @@ func (im IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinat
 	// This prevents such denominations from being sent with IBCV v2 packets, however we can still support them in IBC v1 packets
 	// If we enforce that IBC v2 packets are sent with ICS20 v2 and above versions that separate the trace from the base denomination
 	// in the packet data, then we can remove this restriction.
-	if strings.Contains(data.Token.Denom.Base, "/") {
-		return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet", data.Token.Denom.Base)
+	for _, token := range data.Tokens {
+		if strings.Contains(token.Denom.Base, "/") {
+			return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet", token.Denom.Base)
+		}
 	}
 
-	if err := im.keeper.SendTransfer(ctx, payload.SourcePort, sourceChannel, data.Token, signer); err != nil {
+	if err := im.keeper.SendTransfer(ctx, payload.SourcePort, sourceChannel, data.Tokens, signer); err != nil {
 		return err
 	}
 
-	events.EmitTransferEvent(ctx, sender.String(), data.Receiver, data.Token, data.Memo)
+	events.EmitTransferEvent(ctx, sender.String(), data.Receiver, data.Tokens, data.Memo)
 
-	telemetry.ReportTransfer(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Token)
+	telemetry.ReportTransfer(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Tokens)
 
 	return nil
 }
@@ -141,7 +143,7 @@ func (im IBCModule) OnRecvPacket(ctx sdk.Context, sourceChannel string, destinat
 
 	im.keeper.Logger(ctx).Info("successfully handled ICS-20 packet", "sequence", sequence)
 
-	telemetry.ReportOnRecvPacket(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Token)
+	telemetry.ReportOnRecvPacket(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Tokens)
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return recvResult
@@ -8,7 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	"github.com/cosmos/ibc-go/v10/testing/mock"
 	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
 )
@@ -56,6 +58,42 @@ func (s *TransferTestSuite) TestTransferV2Flow() {
 	s.Require().Equal(coinSentFromAToB, chainBBalance)
 }
 
+func (s *TransferTestSuite) TestTransferV2FlowWithMultipleTokens() {
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainB.SenderAccount.GetAddress()
+	coins := sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+	originalBalances := s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender)
+
+	// Set a timeout of 1 hour from the current block time on receiver chain
+	timeout := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	msg := types.NewMsgTransferWithTokens(types.PortID, s.pathAToB.EndpointA.ClientID, coins, sender.String(), receiver.String(), clienttypes.Height{}, timeout, "")
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParseV2PacketFromEvents(res.Events)
+	s.Require().NoError(err)
+	s.Require().Len(packet.Payloads, 1)
+	s.Require().Equal(types.V2, packet.Payloads[0].Version)
+
+	err = s.pathAToB.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+
+	err = s.pathAToB.EndpointA.RelayPacket(packet)
+	s.Require().NoError(err)
+
+	// check that all tokens have been escrowed on chainA and vouchers exist on chainB
+	escrowAddress := types.GetEscrowAddress(types.PortID, s.pathAToB.EndpointA.ClientID)
+	s.Require().Equal(originalBalances.Sub(coins...), s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), sender))
+	s.Require().Equal(coins, s.chainA.GetSimApp().BankKeeper.GetAllBalances(s.chainA.GetContext(), escrowAddress))
+
+	traceAToB := types.NewHop(types.PortID, s.pathAToB.EndpointB.ClientID)
+	for _, coin := range coins {
+		voucherDenom := types.NewDenom(coin.Denom, traceAToB).IBCDenom()
+		s.Require().Equal(sdk.NewCoin(voucherDenom, coin.Amount), s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), receiver, voucherDenom))
+	}
+}
+
 func (s *TransferTestSuite) TestMultiPayloadTransferV2Flow() {
 	mockPayload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
 	mockErrPayload := mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
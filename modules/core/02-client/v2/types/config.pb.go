@@ -29,6 +29,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Config struct {
 	// allowed_relayers defines the set of allowed relayers for IBC V2 protocol for the given client
 	AllowedRelayers []string `protobuf:"bytes,1,rep,name=allowed_relayers,json=allowedRelayers,proto3" json:"allowed_relayers,omitempty"`
+	// ordered defines whether packets sent and received over the client must be delivered in order of their
+	// sequences. It must be set on the configurations of both clients of a client pair, and packets are only sent
+	// and received over the client once the ordering of its counterparty has been verified.
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// pruning_delay defines the number of seconds after the timeout of a packet received over the client after which
+	// its receipt and acknowledgement can be pruned, once the counterparty is proven to have deleted its packet
+	// commitment. Pruning is disabled if it is zero.
+	PruningDelay uint64 `protobuf:"varint,3,opt,name=pruning_delay,json=pruningDelay,proto3" json:"pruning_delay,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -71,6 +79,20 @@ func (m *Config) GetAllowedRelayers() []string {
 	return nil
 }
 
+func (m *Config) GetOrdered() bool {
+	if m != nil {
+		return m.Ordered
+	}
+	return false
+}
+
+func (m *Config) GetPruningDelay() uint64 {
+	if m != nil {
+		return m.PruningDelay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "ibc.core.client.v2.Config")
 }
@@ -78,20 +100,22 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v2/config.proto", fileDescriptor_e89b8f1b1dcb51cb) }
 
 var fileDescriptor_e89b8f1b1dcb51cb = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0x33, 0xd2, 0x4f,
-	0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x4c, 0x4a,
-	0xd6, 0x03, 0x29, 0xd0, 0x83, 0x28, 0xd0, 0x2b, 0x33, 0x52, 0x32, 0xe6, 0x62, 0x73, 0x06, 0xab,
-	0x11, 0xd2, 0xe4, 0x12, 0x48, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0x4d, 0x89, 0x2f, 0x4a, 0xcd, 0x49,
-	0xac, 0x4c, 0x2d, 0x2a, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0xe2, 0x87, 0x8a, 0x07, 0x41,
-	0x85, 0x9d, 0xc2, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x26, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58,
-	0x3f, 0x33, 0x29, 0x59, 0x37, 0x3d, 0x5f, 0xbf, 0xcc, 0xd0, 0x40, 0x3f, 0x37, 0x3f, 0xa5, 0x34,
-	0x27, 0xb5, 0x18, 0xe2, 0x48, 0x03, 0x23, 0x5d, 0x84, 0x3b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0xee, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x51, 0xba, 0xd2, 0xca, 0x00,
-	0x00, 0x00,
+	// 235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xcf, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0xf1, 0x98, 0xa2, 0x02, 0x16, 0x08, 0xe4, 0x29, 0x93, 0x89, 0x60, 0x09, 0x43, 0xed,
+	0x12, 0x56, 0x26, 0xe0, 0x04, 0x19, 0x18, 0x58, 0xaa, 0xda, 0x7e, 0x04, 0x4b, 0x4e, 0x5e, 0x64,
+	0x27, 0x41, 0xbd, 0x05, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0x92, 0x8b, 0xa0, 0xb4, 0x41, 0x1d, 0xbf,
+	0xbf, 0x7e, 0xcb, 0x47, 0xaf, 0xad, 0xd2, 0x52, 0xa3, 0x07, 0xa9, 0x9d, 0x85, 0xaa, 0x91, 0x5d,
+	0x26, 0x35, 0x56, 0xef, 0xb6, 0x10, 0xb5, 0xc7, 0x06, 0x19, 0xb3, 0x4a, 0x8b, 0x11, 0x88, 0x3d,
+	0x10, 0x5d, 0x76, 0xe3, 0xe9, 0xfc, 0x79, 0x67, 0xd8, 0x1d, 0xbd, 0x5a, 0x3b, 0x87, 0x9f, 0x60,
+	0x56, 0x1e, 0xdc, 0x7a, 0x03, 0x3e, 0xc4, 0x24, 0x99, 0xa5, 0x67, 0xf9, 0xe5, 0xd4, 0xf3, 0x29,
+	0xb3, 0x98, 0x9e, 0xa0, 0x37, 0xe0, 0xc1, 0xc4, 0x47, 0x09, 0x49, 0x4f, 0xf3, 0xff, 0xc9, 0x6e,
+	0xe9, 0x45, 0xed, 0xdb, 0xca, 0x56, 0xc5, 0xca, 0x8c, 0x3a, 0x9e, 0x25, 0x24, 0x3d, 0xce, 0xcf,
+	0xa7, 0xf8, 0x32, 0xb6, 0xa7, 0xd7, 0xef, 0x9e, 0x93, 0x6d, 0xcf, 0xc9, 0x6f, 0xcf, 0xc9, 0xd7,
+	0xc0, 0xa3, 0xed, 0xc0, 0xa3, 0x9f, 0x81, 0x47, 0x6f, 0x8f, 0x85, 0x6d, 0x3e, 0x5a, 0x25, 0x34,
+	0x96, 0x52, 0x63, 0x28, 0x31, 0x48, 0xab, 0xf4, 0xa2, 0x40, 0xd9, 0xdd, 0x2f, 0x65, 0x89, 0xa6,
+	0x75, 0x10, 0xf6, 0x1f, 0x97, 0xd9, 0xe2, 0x70, 0xb3, 0xd9, 0xd4, 0x10, 0xd4, 0x7c, 0x77, 0xf3,
+	0xe1, 0x6f, 0x00, 0xee, 0xa2, 0x75, 0xdd, 0x09, 0x01, 0x00, 0x00,
 }
 
 func (m *Config) Marshal() (dAtA []byte, err error) {
@@ -114,6 +138,21 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PruningDelay != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.PruningDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ordered {
+		i--
+		if m.Ordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedRelayers) > 0 {
 		for iNdEx := len(m.AllowedRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedRelayers[iNdEx])
@@ -149,6 +188,12 @@ func (m *Config) Size() (n int) {
 			n += 1 + l + sovConfig(uint64(l))
 		}
 	}
+	if m.Ordered {
+		n += 2
+	}
+	if m.PruningDelay != 0 {
+		n += 1 + sovConfig(uint64(m.PruningDelay))
+	}
 	return n
 }
 
@@ -219,6 +264,45 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedRelayers = append(m.AllowedRelayers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ordered = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningDelay", wireType)
+			}
+			m.PruningDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
@@ -88,6 +89,12 @@ func (s *KeeperTestSuite) TestSendPacket() {
 
 			path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
 		}, types.ErrInvalidChannelState},
+		{"circuit breaker tripped", func() {
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+
+			s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainA.GetContext(), channeltypesv2.NewCircuitBreaker(sourceChannel, true, false))
+		}, channeltypesv2.ErrCircuitBreakerTripped},
 		{"channel is in INIT state", func() {
 			path.Setup()
 			sourceChannel = path.EndpointA.ChannelID
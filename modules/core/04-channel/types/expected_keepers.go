@@ -76,4 +76,5 @@ type ClientKeeperV2 interface {
 
 type ChannelKeeperV2 interface {
 	SetClientForAlias(ctx sdk.Context, channelID, clientID string)
+	IsSendPaused(ctx sdk.Context, id string) bool
 }
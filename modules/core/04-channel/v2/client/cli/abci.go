@@ -29,6 +29,23 @@ func queryNextSequenceSendABCI(clientCtx client.Context, channelID string) (*typ
 	return types.NewQueryNextSequenceSendResponse(sequence, proofBz, proofHeight), nil
 }
 
+func queryNextSequenceReceiveABCI(clientCtx client.Context, channelID string) (*types.QueryNextSequenceReceiveResponse, error) {
+	key := hostv2.NextSequenceRecvKey(channelID)
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if next sequence receive exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "channelID (%s)", channelID)
+	}
+
+	sequence := binary.BigEndian.Uint64(value)
+
+	return types.NewQueryNextSequenceReceiveResponse(sequence, proofBz, proofHeight), nil
+}
+
 func queryPacketCommitmentABCI(clientCtx client.Context, channelID string, sequence uint64) (*types.QueryPacketCommitmentResponse, error) {
 	key := hostv2.PacketCommitmentKey(channelID, sequence)
 	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
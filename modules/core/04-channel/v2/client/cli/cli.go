@@ -20,12 +20,16 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		getCmdQueryNextSequenceSend(),
+		getCmdQueryNextSequenceReceive(),
 		getCmdQueryPacketCommitment(),
 		getCmdQueryPacketCommitments(),
 		getCmdQueryPacketAcknowledgement(),
 		getCmdQueryPacketReceipt(),
 		getCmdQueryUnreceivedPackets(),
 		getCmdQueryUnreceivedAcks(),
+		getCmdQueryCircuitBreaker(),
+		getCmdQueryCircuitBreakerGuardians(),
+		getCmdQueryPruningState(),
 	)
 
 	return queryCmd
@@ -42,7 +46,9 @@ func NewTxCmd() *cobra.Command {
 	}
 
 	// TODO: Add v2 packet commands: https://github.com/cosmos/ibc-go/issues/7853
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		newUpdateCircuitBreakerCmd(),
+	)
 
 	return txCmd
 }
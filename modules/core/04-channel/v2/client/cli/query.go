@@ -64,6 +64,52 @@ func getCmdQueryNextSequenceSend() *cobra.Command {
 	return cmd
 }
 
+// getCmdQueryNextSequenceReceive defines the command to query a next receive sequence for a given ordered client
+func getCmdQueryNextSequenceReceive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-sequence-receive [client-id]",
+		Short: "Query a next receive sequence",
+		Long:  "Query the next sequence receive for a given ordered client",
+		Example: fmt.Sprintf(
+			"%s query %s %s next-sequence-receive [client-id]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+			prove, err := cmd.Flags().GetBool(flags.FlagProve)
+			if err != nil {
+				return err
+			}
+
+			if prove {
+				res, err := queryNextSequenceReceiveABCI(clientCtx, clientID)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NextSequenceReceive(cmd.Context(), types.NewQueryNextSequenceReceiveRequest(clientID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "packet-commitment [client-id] [sequence]",
@@ -348,3 +394,90 @@ The return value represents:
 
 	return cmd
 }
+
+// getCmdQueryCircuitBreaker defines the command to query the circuit breaker of a channel or client
+func getCmdQueryCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "circuit-breaker [channel-or-client-id]",
+		Short:   "Query the circuit breaker of a channel or client",
+		Long:    "Query the paused packet flows of a v1 channel or v2 client",
+		Example: fmt.Sprintf("%s query %s %s circuit-breaker [channel-or-client-id]", version.AppName, exported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CircuitBreaker(cmd.Context(), &types.QueryCircuitBreakerRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryCircuitBreakerGuardians defines the command to query the circuit breaker guardians
+func getCmdQueryCircuitBreakerGuardians() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "circuit-breaker-guardians",
+		Short:   "Query the circuit breaker guardians",
+		Long:    "Query the addresses allowed to update circuit breakers in addition to the authority",
+		Example: fmt.Sprintf("%s query %s %s circuit-breaker-guardians", version.AppName, exported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CircuitBreakerGuardians(cmd.Context(), &types.QueryCircuitBreakerGuardiansRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryPruningState defines the command to query the pruning state of a client
+func getCmdQueryPruningState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pruning-state [client-id]",
+		Short:   "Query the pruning state of a client",
+		Long:    "Query the progress of pruning the receipts and acknowledgements of packets received over a client",
+		Example: fmt.Sprintf("%s query %s %s pruning-state [client-id]", version.AppName, exported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PruningState(cmd.Context(), types.NewQueryPruningStateRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
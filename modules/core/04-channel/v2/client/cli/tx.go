@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+const (
+	flagPauseSend = "pause-send"
+	flagPauseRecv = "pause-recv"
+)
+
+// newUpdateCircuitBreakerCmd defines the command to pause or resume the packet flows of a channel or client.
+func newUpdateCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-circuit-breaker [channel-or-client-id]",
+		Short: "pause or resume the sending and receiving of packets on a channel or client",
+		Long: `pause or resume the sending and receiving of packets on a v1 channel or v2 client (replaces the existing circuit breaker,
+and omitting both flags resumes all packet flows). The signer must be the authority or a circuit breaker guardian.`,
+		Example: fmt.Sprintf("%s tx %s %s update-circuit-breaker 07-tendermint-0 --pause-send --pause-recv", version.AppName, exported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pauseSend, err := cmd.Flags().GetBool(flagPauseSend)
+			if err != nil {
+				return err
+			}
+
+			pauseRecv, err := cmd.Flags().GetBool(flagPauseRecv)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateCircuitBreaker(args[0], pauseSend, pauseRecv, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagPauseSend, false, "pause the sending of packets")
+	cmd.Flags().Bool(flagPauseRecv, false, "pause the receiving of packets")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
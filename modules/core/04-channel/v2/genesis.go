@@ -35,20 +35,69 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		k.SetAsyncPacket(ctx, gs.ClientId, gs.Sequence, packet)
 	}
 
+	// set pending acknowledgements of async packets
+	for _, gs := range gs.AsyncAcknowledgements {
+		var ack types.AsyncAcknowledgement
+		err := proto.Unmarshal(gs.Data, &ack)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAsyncAcknowledgement(ctx, gs.ClientId, gs.Sequence, ack)
+	}
+
 	// set send sequences
 	for _, seq := range gs.SendSequences {
 		k.SetNextSequenceSend(ctx, seq.ClientId, seq.Sequence)
 	}
+
+	// set recv sequences
+	for _, seq := range gs.RecvSequences {
+		k.SetNextSequenceRecv(ctx, seq.ClientId, seq.Sequence)
+	}
+
+	// set packets scheduled for pruning
+	for _, gs := range gs.PruningQueue {
+		k.SetPrunablePacket(ctx, gs.ClientId, gs.Sequence, sdk.BigEndianToUint64(gs.Data))
+	}
+
+	// set pruning states
+	for _, pruningState := range gs.PruningStates {
+		k.SetPruningState(ctx, pruningState)
+	}
+
+	// set the ordered clients whose counterparty has been verified to be ordered
+	for _, clientID := range gs.CounterpartyOrderedClients {
+		k.SetCounterpartyOrdered(ctx, clientID)
+	}
+
+	// set the ordered clients which are closed for sending packets
+	for _, clientID := range gs.ClosedOrderedClients {
+		k.SetOrderedClientClosed(ctx, clientID)
+	}
+
+	// set circuit breakers
+	for _, cb := range gs.CircuitBreakers {
+		k.SetCircuitBreaker(ctx, cb)
+	}
+
+	k.SetCircuitBreakerGuardians(ctx, types.CircuitBreakerGuardians{Guardians: gs.CircuitBreakerGuardians})
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 	clientStates := k.ClientKeeper.GetAllGenesisClients(ctx)
 	gs := types.GenesisState{
-		Acknowledgements: make([]types.PacketState, 0),
-		Commitments:      make([]types.PacketState, 0),
-		Receipts:         make([]types.PacketState, 0),
-		AsyncPackets:     make([]types.PacketState, 0),
-		SendSequences:    make([]types.PacketSequence, 0),
+		Acknowledgements:           make([]types.PacketState, 0),
+		Commitments:                make([]types.PacketState, 0),
+		Receipts:                   make([]types.PacketState, 0),
+		AsyncPackets:               make([]types.PacketState, 0),
+		SendSequences:              make([]types.PacketSequence, 0),
+		CircuitBreakers:            make([]types.CircuitBreaker, 0),
+		AsyncAcknowledgements:      make([]types.PacketState, 0),
+		RecvSequences:              make([]types.PacketSequence, 0),
+		PruningQueue:               make([]types.PacketState, 0),
+		PruningStates:              make([]types.PruningState, 0),
+		CounterpartyOrderedClients: make([]string, 0),
+		ClosedOrderedClients:       make([]string, 0),
 	}
 	for _, clientState := range clientStates {
 		acks := k.GetAllPacketAcknowledgementsForClient(ctx, clientState.ClientId)
@@ -63,11 +112,38 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		asyncPackets := k.GetAllAsyncPacketsForClient(ctx, clientState.ClientId)
 		gs.AsyncPackets = append(gs.AsyncPackets, asyncPackets...)
 
+		asyncAcks := k.GetAllAsyncAcknowledgementsForClient(ctx, clientState.ClientId)
+		gs.AsyncAcknowledgements = append(gs.AsyncAcknowledgements, asyncAcks...)
+
 		seq, ok := k.GetNextSequenceSend(ctx, clientState.ClientId)
 		if ok {
 			gs.SendSequences = append(gs.SendSequences, types.NewPacketSequence(clientState.ClientId, seq))
 		}
+
+		seq, ok = k.GetNextSequenceRecv(ctx, clientState.ClientId)
+		if ok {
+			gs.RecvSequences = append(gs.RecvSequences, types.NewPacketSequence(clientState.ClientId, seq))
+		}
+
+		if k.IsCounterpartyOrdered(ctx, clientState.ClientId) {
+			gs.CounterpartyOrderedClients = append(gs.CounterpartyOrderedClients, clientState.ClientId)
+		}
+
+		if k.IsOrderedClientClosed(ctx, clientState.ClientId) {
+			gs.ClosedOrderedClients = append(gs.ClosedOrderedClients, clientState.ClientId)
+		}
+
+		pruningQueue := k.GetAllPrunablePacketsForClient(ctx, clientState.ClientId)
+		gs.PruningQueue = append(gs.PruningQueue, pruningQueue...)
+
+		pruningState := k.GetPruningState(ctx, clientState.ClientId)
+		if pruningState.TotalPruned != 0 || pruningState.TotalRemaining != 0 {
+			gs.PruningStates = append(gs.PruningStates, pruningState)
+		}
 	}
 
+	gs.CircuitBreakers = append(gs.CircuitBreakers, k.GetAllCircuitBreakers(ctx)...)
+	gs.CircuitBreakerGuardians = k.GetCircuitBreakerGuardians(ctx).Guardians
+
 	return gs
 }
@@ -3,6 +3,8 @@ package channelv2_test
 import (
 	"github.com/cosmos/gogoproto/proto"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	channelv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2"
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
@@ -41,11 +43,27 @@ func (s *ModuleTestSuite) TestInitExportGenesis() {
 		s.Require().NoError(err)
 		asyncPacket := types.NewPacketState(clientState.ClientId, uint64(i+1), bz)
 
+		bz, err = proto.Marshal(&types.AsyncAcknowledgement{
+			AppAcknowledgements:   [][]byte{[]byte("ack"), {}},
+			PendingPayloadIndices: []uint32{1},
+		})
+		s.Require().NoError(err)
+		asyncAck := types.NewPacketState(clientState.ClientId, uint64(i+1), bz)
+
+		prunablePacket := types.NewPacketState(clientState.ClientId, uint64(i+1), sdk.Uint64ToBigEndian(uint64(i+100)))
+		pruningState := types.PruningState{ClientId: clientState.ClientId, TotalPruned: uint64(i), TotalRemaining: 1}
+
 		validGs.Acknowledgements = append(validGs.Acknowledgements, ack)
 		validGs.Receipts = append(validGs.Receipts, receipt)
 		validGs.Commitments = append(validGs.Commitments, commitment)
 		validGs.SendSequences = append(validGs.SendSequences, seq)
 		validGs.AsyncPackets = append(validGs.AsyncPackets, asyncPacket)
+		validGs.AsyncAcknowledgements = append(validGs.AsyncAcknowledgements, asyncAck)
+		validGs.RecvSequences = append(validGs.RecvSequences, seq)
+		validGs.PruningQueue = append(validGs.PruningQueue, prunablePacket)
+		validGs.PruningStates = append(validGs.PruningStates, pruningState)
+		validGs.CounterpartyOrderedClients = append(validGs.CounterpartyOrderedClients, clientState.ClientId)
+		validGs.ClosedOrderedClients = append(validGs.ClosedOrderedClients, clientState.ClientId)
 		emptyGenesis.SendSequences = append(emptyGenesis.SendSequences, seq)
 	}
 
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+)
+
+// circuitBreakerAnomaly is an anomaly reported by an application callback, which is applied once the
+// callback has been executed.
+type circuitBreakerAnomaly struct {
+	id        string
+	epoch     uint64
+	threshold uint64
+}
+
+// circuitBreakerAnomaliesKey is the context key under which the anomalies reported by application callbacks are recorded.
+type circuitBreakerAnomaliesKey struct{}
+
+// SetCircuitBreaker stores the circuit breaker of a v1 channel or v2 client.
+// The circuit breaker is deleted if neither sending nor receiving is paused.
+func (k *Keeper) SetCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	store := k.storeService.OpenKVStore(ctx)
+	if !circuitBreaker.IsTripped() {
+		if err := store.Delete(types.CircuitBreakerKey(circuitBreaker.Id)); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&circuitBreaker)
+	if err := store.Set(types.CircuitBreakerKey(circuitBreaker.Id), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetCircuitBreaker returns the circuit breaker of a v1 channel or v2 client.
+// If the circuit breaker was never tripped, a circuit breaker without any paused packet flows is returned.
+func (k *Keeper) GetCircuitBreaker(ctx sdk.Context, id string) types.CircuitBreaker {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CircuitBreakerKey(id))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.NewCircuitBreaker(id, false, false)
+	}
+
+	var circuitBreaker types.CircuitBreaker
+	k.cdc.MustUnmarshal(bz, &circuitBreaker)
+	return circuitBreaker
+}
+
+// GetAllCircuitBreakers returns the circuit breakers of all channels and clients with paused packet flows.
+func (k *Keeper) GetAllCircuitBreakers(ctx sdk.Context) []types.CircuitBreaker {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.CircuitBreakerPrefixKey())
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var circuitBreakers []types.CircuitBreaker
+	for ; iterator.Valid(); iterator.Next() {
+		var circuitBreaker types.CircuitBreaker
+		k.cdc.MustUnmarshal(iterator.Value(), &circuitBreaker)
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+	}
+	return circuitBreakers
+}
+
+// IsSendPaused returns true if the sending of packets is paused on the given v1 channel or v2 client.
+func (k *Keeper) IsSendPaused(ctx sdk.Context, id string) bool {
+	return k.GetCircuitBreaker(ctx, id).SendPaused
+}
+
+// IsRecvPaused returns true if the receiving of packets is paused on the given v1 channel or v2 client.
+func (k *Keeper) IsRecvPaused(ctx sdk.Context, id string) bool {
+	return k.GetCircuitBreaker(ctx, id).RecvPaused
+}
+
+// updateCircuitBreaker sets the paused packet flows of a v1 channel or v2 client and emits an event.
+func (k *Keeper) updateCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	k.SetCircuitBreaker(ctx, circuitBreaker)
+	emitUpdateCircuitBreakerEvent(ctx, circuitBreaker)
+
+	k.Logger(ctx).Info("circuit breaker updated", "id", circuitBreaker.Id, "send-paused", circuitBreaker.SendPaused, "recv-paused", circuitBreaker.RecvPaused)
+}
+
+// SetCircuitBreakerGuardians stores the addresses allowed to update circuit breakers in addition to the authority.
+// The guardians are deleted if the list is empty, as empty values cannot be proven.
+func (k *Keeper) SetCircuitBreakerGuardians(ctx sdk.Context, guardians types.CircuitBreakerGuardians) {
+	store := k.storeService.OpenKVStore(ctx)
+	if len(guardians.Guardians) == 0 {
+		if err := store.Delete(types.CircuitBreakerGuardiansKey()); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&guardians)
+	if err := store.Set(types.CircuitBreakerGuardiansKey(), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetCircuitBreakerGuardians returns the addresses allowed to update circuit breakers in addition to the authority.
+func (k *Keeper) GetCircuitBreakerGuardians(ctx sdk.Context) types.CircuitBreakerGuardians {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CircuitBreakerGuardiansKey())
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.CircuitBreakerGuardians{}
+	}
+
+	var guardians types.CircuitBreakerGuardians
+	k.cdc.MustUnmarshal(bz, &guardians)
+	return guardians
+}
+
+// setCircuitBreakerAnomalies stores the anomalies reported for a v1 channel or v2 client.
+func (k *Keeper) setCircuitBreakerAnomalies(ctx sdk.Context, id string, anomalies types.CircuitBreakerAnomalies) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&anomalies)
+	if err := store.Set(types.CircuitBreakerAnomaliesKey(id), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetCircuitBreakerAnomalies returns the anomalies reported for a v1 channel or v2 client.
+func (k *Keeper) GetCircuitBreakerAnomalies(ctx sdk.Context, id string) types.CircuitBreakerAnomalies {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CircuitBreakerAnomaliesKey(id))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.CircuitBreakerAnomalies{}
+	}
+
+	var anomalies types.CircuitBreakerAnomalies
+	k.cdc.MustUnmarshal(bz, &anomalies)
+	return anomalies
+}
+
+// ReportCircuitBreakerAnomaly reports an anomaly, such as an exceeded rate limit, for a v1 channel or v2 client.
+// The anomalies are counted per epoch, which is defined by the caller. Once the number of anomalies reported
+// during an epoch reaches the threshold, the circuit breaker is tripped and both the sending and receiving of
+// packets are paused. A threshold of zero only counts the anomalies.
+//
+// Anomalies reported while receiving a packet are kept even if the acknowledgement is unsuccessful and the
+// state changes of the application callbacks are discarded.
+func (k *Keeper) ReportCircuitBreakerAnomaly(ctx sdk.Context, id string, epoch, threshold uint64) {
+	anomaly := circuitBreakerAnomaly{id: id, epoch: epoch, threshold: threshold}
+	if recorded, ok := ctx.Value(circuitBreakerAnomaliesKey{}).(*[]circuitBreakerAnomaly); ok {
+		*recorded = append(*recorded, anomaly)
+		return
+	}
+
+	k.applyCircuitBreakerAnomaly(ctx, anomaly)
+}
+
+// RecordCircuitBreakerAnomalies returns a context which records the anomalies reported during the execution of
+// application callbacks, instead of writing them to the store. The returned function writes the recorded
+// anomalies to the store of the given context, which must not be discarded along with the callback state.
+func (k *Keeper) RecordCircuitBreakerAnomalies(callbackCtx sdk.Context) (sdk.Context, func(ctx sdk.Context)) {
+	var recorded []circuitBreakerAnomaly
+	callbackCtx = callbackCtx.WithValue(circuitBreakerAnomaliesKey{}, &recorded)
+
+	return callbackCtx, func(ctx sdk.Context) {
+		for _, anomaly := range recorded {
+			k.applyCircuitBreakerAnomaly(ctx, anomaly)
+		}
+	}
+}
+
+// applyCircuitBreakerAnomaly increments the anomalies of the current epoch, and trips the circuit breaker
+// once the threshold is reached.
+func (k *Keeper) applyCircuitBreakerAnomaly(ctx sdk.Context, anomaly circuitBreakerAnomaly) {
+	anomalies := k.GetCircuitBreakerAnomalies(ctx, anomaly.id)
+	if anomalies.Epoch != anomaly.epoch {
+		anomalies = types.CircuitBreakerAnomalies{Epoch: anomaly.epoch}
+	}
+	anomalies.Count++
+	k.setCircuitBreakerAnomalies(ctx, anomaly.id, anomalies)
+
+	if anomaly.threshold == 0 || anomalies.Count < anomaly.threshold {
+		return
+	}
+
+	circuitBreaker := k.GetCircuitBreaker(ctx, anomaly.id)
+	if circuitBreaker.SendPaused && circuitBreaker.RecvPaused {
+		return
+	}
+
+	k.updateCircuitBreaker(ctx, types.NewCircuitBreaker(anomaly.id, true, true))
+}
+
+// isCircuitBreakerAuthorized returns true if the signer is the authority or a circuit breaker guardian.
+func (k *Keeper) isCircuitBreakerAuthorized(ctx sdk.Context, signer string) bool {
+	return signer == k.authority || k.GetCircuitBreakerGuardians(ctx).IsGuardian(signer)
+}
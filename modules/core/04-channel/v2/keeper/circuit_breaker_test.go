@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (s *KeeperTestSuite) TestReportCircuitBreakerAnomaly() {
+	ck := s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2
+	ctx := s.chainA.GetContext()
+	id := ibctesting.FirstClientID
+
+	// anomalies are counted without tripping the circuit breaker below the threshold
+	ck.ReportCircuitBreakerAnomaly(ctx, id, 1, 3)
+	ck.ReportCircuitBreakerAnomaly(ctx, id, 1, 3)
+	s.Require().Equal(types.CircuitBreakerAnomalies{Epoch: 1, Count: 2}, ck.GetCircuitBreakerAnomalies(ctx, id))
+	s.Require().False(ck.GetCircuitBreaker(ctx, id).IsTripped())
+
+	// the count is reset once an anomaly is reported for a new epoch
+	ck.ReportCircuitBreakerAnomaly(ctx, id, 2, 3)
+	ck.ReportCircuitBreakerAnomaly(ctx, id, 2, 3)
+	s.Require().Equal(types.CircuitBreakerAnomalies{Epoch: 2, Count: 2}, ck.GetCircuitBreakerAnomalies(ctx, id))
+	s.Require().False(ck.GetCircuitBreaker(ctx, id).IsTripped())
+
+	// the circuit breaker is tripped in both directions once the threshold is reached
+	ck.ReportCircuitBreakerAnomaly(ctx, id, 2, 3)
+	s.Require().Equal(types.NewCircuitBreaker(id, true, true), ck.GetCircuitBreaker(ctx, id))
+	s.Require().Equal([]types.CircuitBreaker{types.NewCircuitBreaker(id, true, true)}, ck.GetAllCircuitBreakers(ctx))
+
+	// a threshold of zero never trips the circuit breaker
+	ck.ReportCircuitBreakerAnomaly(ctx, ibctesting.SecondClientID, 1, 0)
+	s.Require().Equal(types.CircuitBreakerAnomalies{Epoch: 1, Count: 1}, ck.GetCircuitBreakerAnomalies(ctx, ibctesting.SecondClientID))
+	s.Require().False(ck.GetCircuitBreaker(ctx, ibctesting.SecondClientID).IsTripped())
+}
+
+func (s *KeeperTestSuite) TestRecordCircuitBreakerAnomalies() {
+	ck := s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2
+	ctx := s.chainA.GetContext()
+	id := ibctesting.FirstClientID
+
+	// anomalies reported in a discarded callback context are written once the callback completes
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx, writeAnomaliesFn := ck.RecordCircuitBreakerAnomalies(cacheCtx)
+	ck.ReportCircuitBreakerAnomaly(cacheCtx, id, 1, 1)
+	s.Require().Equal(types.CircuitBreakerAnomalies{}, ck.GetCircuitBreakerAnomalies(ctx, id))
+	s.Require().False(ck.GetCircuitBreaker(cacheCtx, id).IsTripped())
+
+	writeAnomaliesFn(ctx)
+	s.Require().Equal(types.CircuitBreakerAnomalies{Epoch: 1, Count: 1}, ck.GetCircuitBreakerAnomalies(ctx, id))
+	s.Require().Equal(types.NewCircuitBreaker(id, true, true), ck.GetCircuitBreaker(ctx, id))
+}
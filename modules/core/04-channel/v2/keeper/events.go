@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -131,3 +132,63 @@ func emitTimeoutPacketEvents(ctx sdk.Context, packet types.Packet) {
 		),
 	})
 }
+
+// emitUpdateCircuitBreakerEvent emits an event when the paused packet flows of a channel or client are updated.
+func emitUpdateCircuitBreakerEvent(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerID, circuitBreaker.Id),
+			sdk.NewAttribute(types.AttributeKeySendPaused, strconv.FormatBool(circuitBreaker.SendPaused)),
+			sdk.NewAttribute(types.AttributeKeyRecvPaused, strconv.FormatBool(circuitBreaker.RecvPaused)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitPrunePacketsEvent emits an event when the receipts and acknowledgements of packets received over a client are pruned.
+func emitPrunePacketsEvent(ctx sdk.Context, pruningState types.PruningState, totalPruned uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePrunePackets,
+			sdk.NewAttribute(types.AttributeKeyClientID, pruningState.ClientId),
+			sdk.NewAttribute(types.AttributeKeyTotalPruned, strconv.FormatUint(totalPruned, 10)),
+			sdk.NewAttribute(types.AttributeKeyTotalRemaining, strconv.FormatUint(pruningState.TotalRemaining, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitVerifyCounterpartyOrderingEvent emits an event when the counterparty of an ordered client is verified to be ordered.
+func emitVerifyCounterpartyOrderingEvent(ctx sdk.Context, clientID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVerifyCounterpartyOrdering,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitCloseOrderedClientEvent emits an event when an ordered client is closed after one of its packets timed out.
+func emitCloseOrderedClientEvent(ctx sdk.Context, clientID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCloseOrderedClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -22,6 +22,7 @@ func (k *Keeper) SendPacketTest(
 		sourceChannel,
 		timeoutTimestamp,
 		payloads,
+		false,
 	)
 }
 
@@ -60,11 +61,13 @@ func (k *Keeper) TimeoutPacketTest(
 	packet types.Packet,
 	proof []byte,
 	proofHeight exported.Height,
+	nextSequenceRecv uint64,
 ) error {
 	return k.timeoutPacket(
 		ctx,
 		packet,
 		proof,
 		proofHeight,
+		nextSequenceRecv,
 	)
 }
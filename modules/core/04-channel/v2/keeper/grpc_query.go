@@ -56,6 +56,28 @@ func (q *queryServer) NextSequenceSend(goCtx context.Context, req *types.QueryNe
 	return types.NewQueryNextSequenceSendResponse(sequence, nil, clienttypes.GetSelfHeight(ctx)), nil
 }
 
+// NextSequenceReceive implements the Query/NextSequenceReceive gRPC method
+func (q *queryServer) NextSequenceReceive(goCtx context.Context, req *types.QueryNextSequenceReceiveRequest) (*types.QueryNextSequenceReceiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sequence, found := q.GetNextSequenceRecv(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "client-id %s", req.ClientId).Error(),
+		)
+	}
+	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, clienttypes.GetSelfHeight(ctx)), nil
+}
+
 // PacketCommitment implements the Query/PacketCommitment gRPC method.
 func (q *queryServer) PacketCommitment(goCtx context.Context, req *types.QueryPacketCommitmentRequest) (*types.QueryPacketCommitmentResponse, error) {
 	if req == nil {
@@ -319,3 +341,44 @@ func (q *queryServer) UnreceivedAcks(goCtx context.Context, req *types.QueryUnre
 		Height:    selfHeight,
 	}, nil
 }
+
+// CircuitBreaker implements the Query/CircuitBreaker gRPC method
+func (q *queryServer) CircuitBreaker(goCtx context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCircuitBreakerResponse{CircuitBreaker: q.GetCircuitBreaker(ctx, req.Id)}, nil
+}
+
+// CircuitBreakerGuardians implements the Query/CircuitBreakerGuardians gRPC method
+func (q *queryServer) CircuitBreakerGuardians(goCtx context.Context, req *types.QueryCircuitBreakerGuardiansRequest) (*types.QueryCircuitBreakerGuardiansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCircuitBreakerGuardiansResponse{Guardians: q.GetCircuitBreakerGuardians(ctx).Guardians}, nil
+}
+
+// PruningState implements the Query/PruningState gRPC method
+func (q *queryServer) PruningState(goCtx context.Context, req *types.QueryPruningStateRequest) (*types.QueryPruningStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPruningStateResponse{PruningState: q.GetPruningState(ctx, req.ClientId)}, nil
+}
@@ -564,6 +564,76 @@ func (s *KeeperTestSuite) TestQueryNextSequenceSend() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryNextSequenceReceive() {
+	var (
+		req    *types.QueryNextSequenceReceiveRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(s.chainA, s.chainB)
+				path.SetupV2()
+
+				expSeq = 42
+				seq := uint64(42)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(s.chainA.GetContext(), path.EndpointA.ClientID, seq)
+				req = types.NewQueryNextSequenceReceiveRequest(path.EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryNextSequenceReceiveRequest("")
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"sequence receive not found",
+			func() {
+				req = types.NewQueryNextSequenceReceiveRequest(ibctesting.FirstClientID)
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("client-id %s: sequence receive not found", ibctesting.FirstClientID)),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(s.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+			res, err := queryServer.NextSequenceReceive(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expSeq, res.NextSequenceReceive)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	var (
 		expSeq []uint64
@@ -813,3 +883,73 @@ func (s *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryPruningState() {
+	var (
+		req             *types.QueryPruningStateRequest
+		expPruningState types.PruningState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(s.chainA, s.chainB)
+				path.SetupV2()
+
+				expPruningState = types.PruningState{ClientId: path.EndpointA.ClientID, TotalPruned: 3, TotalRemaining: 2}
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPruningState(s.chainA.GetContext(), expPruningState)
+				req = types.NewQueryPruningStateRequest(path.EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"success: nothing pruned",
+			func() {
+				expPruningState = types.PruningState{ClientId: ibctesting.FirstClientID}
+				req = types.NewQueryPruningStateRequest(ibctesting.FirstClientID)
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryPruningStateRequest("")
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+
+			tc.malleate()
+			ctx := s.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(s.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+			res, err := queryServer.PruningState(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expPruningState, res.PruningState)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -32,6 +32,9 @@ type Keeper struct {
 	// Router is used to route messages to the appropriate module callbacks
 	// NOTE: it must be explicitly set before usage.
 	Router *api.Router
+
+	// authority is allowed to update circuit breakers and their guardians
+	authority string
 }
 
 // NewKeeper creates a new channel v2 keeper
@@ -41,6 +44,7 @@ func NewKeeper(
 	clientKeeper types.ClientKeeper,
 	clientV2Keeper *clientv2keeper.Keeper,
 	connectionKeeper *connectionkeeper.Keeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
 		storeService:     storeService,
@@ -48,6 +52,7 @@ func NewKeeper(
 		clientV2Keeper:   clientV2Keeper,
 		connectionKeeper: connectionKeeper,
 		ClientKeeper:     clientKeeper,
+		authority:        authority,
 	}
 }
 
@@ -89,6 +94,14 @@ func (k *Keeper) SetPacketReceipt(ctx sdk.Context, clientID string, sequence uin
 	}
 }
 
+// deletePacketReceipt deletes the packet receipt under the receipt path once it has been pruned.
+func (k *Keeper) deletePacketReceipt(ctx sdk.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(hostv2.PacketReceiptKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // GetPacketAcknowledgement fetches the packet acknowledgement from the store.
 func (k *Keeper) GetPacketAcknowledgement(ctx sdk.Context, clientID string, sequence uint64) []byte {
 	store := k.storeService.OpenKVStore(ctx)
@@ -108,6 +121,14 @@ func (k *Keeper) SetPacketAcknowledgement(ctx sdk.Context, clientID string, sequ
 	}
 }
 
+// deletePacketAcknowledgement deletes the acknowledgement hash under the acknowledgement path once it has been pruned.
+func (k *Keeper) deletePacketAcknowledgement(ctx sdk.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(hostv2.PacketAcknowledgementKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // HasPacketAcknowledgement checks if the packet ack hash is already on the store.
 func (k *Keeper) HasPacketAcknowledgement(ctx sdk.Context, clientID string, sequence uint64) bool {
 	return len(k.GetPacketAcknowledgement(ctx, clientID, sequence)) > 0
@@ -164,6 +185,53 @@ func (k *Keeper) SetNextSequenceSend(ctx sdk.Context, clientID string, sequence
 	}
 }
 
+// GetNextSequenceRecv returns the next receive sequence of an ordered client from the sequence path
+func (k *Keeper) GetNextSequenceRecv(ctx sdk.Context, clientID string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(hostv2.NextSequenceRecvKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetNextSequenceRecv writes the next receive sequence of an ordered client under the sequence path
+func (k *Keeper) SetNextSequenceRecv(ctx sdk.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bigEndianBz := sdk.Uint64ToBigEndian(sequence)
+	if err := store.Set(hostv2.NextSequenceRecvKey(clientID), bigEndianBz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteNextSequenceRecv deletes the next receive sequence of a client which is no longer ordered
+func (k *Keeper) DeleteNextSequenceRecv(ctx sdk.Context, clientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(hostv2.NextSequenceRecvKey(clientID)); err != nil {
+		panic(err)
+	}
+}
+
+// HasPacketFlow returns true if any packets have been sent or received over the given client.
+func (k *Keeper) HasPacketFlow(ctx sdk.Context, clientID string) bool {
+	if sequence, found := k.GetNextSequenceSend(ctx, clientID); found && sequence > 1 {
+		return true
+	}
+
+	if sequence, found := k.GetNextSequenceRecv(ctx, clientID); found && sequence > 1 {
+		return true
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, hostv2.PacketReceiptPrefixKey(clientID))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 // SetAsyncPacket writes the packet under the async path
 func (k *Keeper) SetAsyncPacket(ctx sdk.Context, clientID string, sequence uint64, packet types.Packet) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -196,6 +264,38 @@ func (k *Keeper) DeleteAsyncPacket(ctx sdk.Context, clientID string, sequence ui
 	}
 }
 
+// SetAsyncAcknowledgement writes the pending acknowledgement of an async packet under the async acknowledgement path
+func (k *Keeper) SetAsyncAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack types.AsyncAcknowledgement) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&ack)
+	if err := store.Set(types.AsyncAcknowledgementKey(clientID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAsyncAcknowledgement fetches the pending acknowledgement of an async packet from the async acknowledgement path
+func (k *Keeper) GetAsyncAcknowledgement(ctx sdk.Context, clientID string, sequence uint64) (types.AsyncAcknowledgement, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.AsyncAcknowledgementKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.AsyncAcknowledgement{}, false
+	}
+	var ack types.AsyncAcknowledgement
+	k.cdc.MustUnmarshal(bz, &ack)
+	return ack, true
+}
+
+// DeleteAsyncAcknowledgement deletes the pending acknowledgement of an async packet from the async acknowledgement path
+func (k *Keeper) DeleteAsyncAcknowledgement(ctx sdk.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.AsyncAcknowledgementKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // extractSequenceFromKey takes the full store key as well as a packet store prefix and extracts
 // the encoded sequence number from the key.
 //
@@ -233,6 +333,12 @@ func (k *Keeper) GetAllAsyncPacketsForClient(ctx sdk.Context, clientID string) [
 	return k.getAllPacketStateForClient(ctx, clientID, types.AsyncPacketPrefixKey)
 }
 
+// GetAllAsyncAcknowledgementsForClient returns all stored pending acknowledgements of async packets
+// for a specified client ID.
+func (k *Keeper) GetAllAsyncAcknowledgementsForClient(ctx sdk.Context, clientID string) []types.PacketState {
+	return k.getAllPacketStateForClient(ctx, clientID, types.AsyncAcknowledgementPrefixKey)
+}
+
 // prefixKeyConstructor is a function that constructs a store key for a specific packet store using the provided
 // clientID.
 type prefixKeyConstructor func(clientID string) []byte
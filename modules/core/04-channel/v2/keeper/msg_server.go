@@ -27,7 +27,7 @@ func (k *Keeper) SendPacket(goCtx context.Context, msg *types.MsgSendPacket) (*t
 		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
 	}
 
-	sequence, destChannel, err := k.sendPacket(ctx, msg.SourceClient, msg.TimeoutTimestamp, msg.Payloads)
+	sequence, destChannel, err := k.sendPacket(ctx, msg.SourceClient, msg.TimeoutTimestamp, msg.Payloads, msg.NonAtomic)
 	if err != nil {
 		ctx.Logger().Error("send packet failed", "source-client", msg.SourceClient, "error", errorsmod.Wrap(err, "send packet failed"))
 		return nil, errorsmod.Wrapf(err, "send packet failed for source id: %s", msg.SourceClient)
@@ -69,6 +69,12 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 
 	switch {
 	case err == nil:
+		// if receiving packets is paused, the packet is not received: neither its receipt nor an acknowledgement
+		// is written, so that it can be relayed again once the circuit breaker is reset, or timed out by the sender
+		if k.IsRecvPaused(ctx, msg.Packet.DestinationClient) {
+			ctx.Logger().Info("receive packet rejected", "dest-client", msg.Packet.DestinationClient, "error", types.ErrCircuitBreakerTripped)
+			return nil, errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "receiving packets is paused for client: %s", msg.Packet.DestinationClient)
+		}
 		writeFn()
 	case errors.Is(err, types.ErrNoOpMsg):
 		ctx.Logger().Debug("no-op on redundant relay", "source-client", msg.Packet.SourceClient)
@@ -81,53 +87,84 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 	// build up the recv results for each application callback.
 	ack := types.Acknowledgement{
 		AppAcknowledgements: [][]byte{},
+		NonAtomic:           msg.Packet.NonAtomic,
 	}
 
-	var isAsync bool
+	// anomalies reported by the application callbacks are kept even if their state changes are discarded
+	cacheCtx, writeAnomaliesFn := k.RecordCircuitBreakerAnomalies(cacheCtx)
+	defer writeAnomaliesFn(ctx)
+
+	var pendingPayloadIndices []uint32
 	isSuccess := true
-	for _, pd := range msg.Packet.Payloads {
+	for i, pd := range msg.Packet.Payloads {
 		cb := k.Router.Route(pd.DestinationPort)
-		res := cb.OnRecvPacket(cacheCtx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, pd, signer)
+
+		// each payload of a non-atomic packet is executed in its own cached context,
+		// so that its state changes are committed or discarded independently of the other payloads
+		payloadCtx, writePayloadFn := cacheCtx, func() {}
+		if msg.Packet.NonAtomic {
+			payloadCtx, writePayloadFn = cacheCtx.CacheContext()
+		}
+
+		// the payload is identified in the context so that async applications can acknowledge it by its index
+		payloadCtx = types.WithReceivedPayload(payloadCtx, msg.Packet, uint32(i))
+		res := cb.OnRecvPacket(payloadCtx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, pd, signer)
+
+		if res.Status == types.PacketStatus_Failure && msg.Packet.NonAtomic {
+			// the failure acknowledgement of the application is passed on to the sender,
+			// or the sentinel error acknowledgement if the application did not return one
+			appAck := res.GetAcknowledgement()
+			if len(appAck) == 0 {
+				appAck = types.ErrorAcknowledgement[:]
+			}
+			ack.AppAcknowledgements = append(ack.AppAcknowledgements, appAck)
+			// Modify events in cached payload context to reflect unsuccessful acknowledgement
+			ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(payloadCtx.EventManager().Events()))
+			continue
+		}
 
 		if res.Status == types.PacketStatus_Failure {
 			isSuccess = false
 			// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
-			ack = types.Acknowledgement{
-				AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
-			}
+			ack = types.NewErrorAcknowledgement(msg.Packet)
 			// Modify events in cached context to reflect unsuccessful acknowledgement
 			ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
 			break
 		}
 
+		writePayloadFn()
+
+		if res.Status == types.PacketStatus_Async {
+			// the app acknowledgement of the payload is written by the application once it is available,
+			// until then its entry in the acknowledgement is left empty
+			pendingPayloadIndices = append(pendingPayloadIndices, uint32(i))
+			ack.AppAcknowledgements = append(ack.AppAcknowledgements, nil)
+			continue
+		}
+
 		// successful app acknowledgement cannot equal sentinel error acknowledgement
 		if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
 			return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
 		}
 		// append app acknowledgement to the overall acknowledgement
 		ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
-
-		if res.Status == types.PacketStatus_Async {
-			// Set packet acknowledgement to async if any of the acknowledgements are async.
-			isAsync = true
-			// Return error if there is more than 1 payload
-			// TODO: Handle case where there are multiple payloads
-			if len(msg.Packet.Payloads) > 1 {
-				return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
-			}
-		}
 	}
 
 	// write application state changes for asynchronous and successful acknowledgements
 	// if any application returns a failure, then we discard all state changes
-	// to ensure an atomic execution of all payloads
+	// to ensure an atomic execution of all payloads, unless the packet is non-atomic
+	// in which case only the state changes of the failed payloads have been discarded
 	if isSuccess {
 		writeFn()
 	}
 
+	// Set packet acknowledgement to async if any of the payloads are async, unless another payload failed.
+	isAsync := isSuccess && len(pendingPayloadIndices) > 0
+
 	if !isAsync {
-		// sanity check to ensure returned acknowledgement and calculated isSuccess boolean matches
-		if ack.Success() != isSuccess {
+		// sanity check to ensure returned acknowledgement and calculated isSuccess boolean matches,
+		// the payloads of a non-atomic packet succeed or fail independently
+		if !msg.Packet.NonAtomic && ack.Success() != isSuccess {
 			panic("acknowledgement success does not match isSuccess")
 		}
 
@@ -140,6 +177,15 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 	} else {
 		// store the packet temporarily until the application returns an acknowledgement
 		k.SetAsyncPacket(ctx, msg.Packet.DestinationClient, msg.Packet.Sequence, msg.Packet)
+
+		// store the app acknowledgements of the synchronous payloads of a packet with multiple payloads
+		// until the applications of the async payloads write their app acknowledgements
+		if len(msg.Packet.Payloads) > 1 {
+			k.SetAsyncAcknowledgement(ctx, msg.Packet.DestinationClient, msg.Packet.Sequence, types.AsyncAcknowledgement{
+				AppAcknowledgements:   ack.AppAcknowledgements,
+				PendingPayloadIndices: pendingPayloadIndices,
+			})
+		}
 	}
 
 	// TODO: store the packet for async applications to access if required.
@@ -185,7 +231,8 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *types.MsgAcknowledg
 		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
 		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
 		// for knowing that this is an error acknowledgement and executing the appropriate logic.
-		if recvSuccess {
+		// The acknowledgement of a non-atomic packet always contains the success or failure acknowledgement of each payload.
+		if recvSuccess || msg.Acknowledgement.NonAtomic {
 			ack = msg.Acknowledgement.AppAcknowledgements[i]
 		} else {
 			ack = types.ErrorAcknowledgement[:]
@@ -219,7 +266,7 @@ func (k *Keeper) Timeout(goCtx context.Context, timeout *types.MsgTimeout) (*typ
 	}
 
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight)
+	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight, timeout.NextSequenceRecv)
 
 	switch {
 	case err == nil:
@@ -245,3 +292,72 @@ func (k *Keeper) Timeout(goCtx context.Context, timeout *types.MsgTimeout) (*typ
 
 	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
 }
+
+// UpdateCircuitBreaker defines an rpc handler method for MsgUpdateCircuitBreaker.
+func (k *Keeper) UpdateCircuitBreaker(goCtx context.Context, msg *types.MsgUpdateCircuitBreaker) (*types.MsgUpdateCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isCircuitBreakerAuthorized(ctx, msg.Signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "authority %s or a circuit breaker guardian is authorized to update the circuit breaker for %s, got %s",
+			k.authority, msg.Id, msg.Signer,
+		)
+	}
+
+	k.updateCircuitBreaker(ctx, types.NewCircuitBreaker(msg.Id, msg.SendPaused, msg.RecvPaused))
+
+	return &types.MsgUpdateCircuitBreakerResponse{}, nil
+}
+
+// UpdateCircuitBreakerGuardians defines an rpc handler method for MsgUpdateCircuitBreakerGuardians.
+func (k *Keeper) UpdateCircuitBreakerGuardians(goCtx context.Context, msg *types.MsgUpdateCircuitBreakerGuardians) (*types.MsgUpdateCircuitBreakerGuardiansResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	k.SetCircuitBreakerGuardians(ctx, types.CircuitBreakerGuardians{Guardians: msg.Guardians})
+
+	return &types.MsgUpdateCircuitBreakerGuardiansResponse{}, nil
+}
+
+// PruneAcknowledgements defines an rpc handler method for MsgPruneAcknowledgements.
+// Pruning is permissionless as only the receipts and acknowledgements of packets whose pruning delay has elapsed
+// and whose commitment is proven to have been deleted by the counterparty are pruned.
+func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *types.MsgPruneAcknowledgements) (*types.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		ctx.Logger().Error("prune acknowledgements failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	totalPruned, totalRemaining, err := k.pruneAcknowledgements(ctx, msg.ClientId, msg.Sequences, msg.ProofsAcknowledged, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("prune acknowledgements failed", "client-id", msg.ClientId, "error", errorsmod.Wrap(err, "prune acknowledgements failed"))
+		return nil, errorsmod.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &types.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    totalPruned,
+		TotalRemainingSequences: totalRemaining,
+	}, nil
+}
+
+// VerifyCounterpartyOrdering defines an rpc handler method for MsgVerifyCounterpartyOrdering.
+// Verifying the ordering of the counterparty is permissionless as it is proven by the counterparty state.
+func (k *Keeper) VerifyCounterpartyOrdering(goCtx context.Context, msg *types.MsgVerifyCounterpartyOrdering) (*types.MsgVerifyCounterpartyOrderingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		ctx.Logger().Error("verify counterparty ordering failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	if err := k.verifyCounterpartyOrdering(ctx, msg.ClientId, msg.NextSequenceRecv, msg.ProofNextSequenceRecv, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("verify counterparty ordering failed", "client-id", msg.ClientId, "error", errorsmod.Wrap(err, "counterparty ordering verification failed"))
+		return nil, errorsmod.Wrap(err, "counterparty ordering verification failed")
+	}
+
+	return &types.MsgVerifyCounterpartyOrderingResponse{}, nil
+}
@@ -7,10 +7,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	mockv1 "github.com/cosmos/ibc-go/v10/testing/mock"
@@ -111,6 +113,13 @@ func (s *KeeperTestSuite) TestMsgSendPacket() {
 			},
 			expError: clientv2types.ErrCounterpartyNotFound,
 		},
+		{
+			name: "failure: circuit breaker tripped",
+			malleate: func() {
+				path.EndpointA.Chain.GetSimApp().IBCKeeper.ChannelKeeperV2.SetCircuitBreaker(path.EndpointA.Chain.GetContext(), types.NewCircuitBreaker(path.EndpointA.ClientID, true, false))
+			},
+			expError: types.ErrCircuitBreakerTripped,
+		},
 		{
 			name: "failure: route to non existing app",
 			malleate: func() {
@@ -169,6 +178,7 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 	testCases := []struct {
 		name          string
 		payloads      []types.Payload
+		nonAtomic     bool
 		malleate      func()
 		expError      error
 		expAckWritten bool
@@ -254,6 +264,19 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 			expError:      nil,
 			expAckWritten: true,
 		},
+		{
+			name:     "failure: circuit breaker tripped",
+			payloads: []types.Payload{mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)},
+			malleate: func() {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainB.GetContext(), types.NewCircuitBreaker(path.EndpointB.ClientID, false, true))
+
+				// the application callback must not be executed
+				path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					panic("application callback must not be executed")
+				}
+			},
+			expError: types.ErrCircuitBreakerTripped,
+		},
 		{
 			name:     "failure: relayer not permissioned",
 			payloads: []types.Payload{mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)},
@@ -298,15 +321,130 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 			expError: types.ErrInvalidAcknowledgement,
 		},
 		{
-			name: "failure: async payload with other payloads",
+			name: "success: async payload with other payloads",
+			payloads: []types.Payload{
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			malleate:      func() {},
+			expError:      nil,
+			expAckWritten: false,
+		},
+		{
+			name: "success: error ack after async payload",
+			payloads: []types.Payload{
+				mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			malleate: func() {
+				expAck = types.Acknowledgement{
+					AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
+				}
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: non-atomic multiple payloads with error ack",
+			payloads: []types.Payload{
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			nonAtomic: true,
+			malleate: func() {
+				expAck = types.NewNonAtomicAcknowledgement(
+					mockv2.MockRecvPacketResult.Acknowledgement,
+					types.ErrorAcknowledgement[:],
+					mockv2.MockRecvPacketResult.Acknowledgement,
+				)
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: non-atomic multiple payloads with application error ack",
+			payloads: []types.Payload{
+				mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			nonAtomic: true,
+			malleate: func() {
+				// the failure acknowledgement of the application is passed on to the sender
+				path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					if bytes.Equal(data.Value, mockv1.MockFailPacketData) {
+						return types.RecvPacketResult{
+							Status:          types.PacketStatus_Failure,
+							Acknowledgement: mockv1.MockFailAcknowledgement.Acknowledgement(),
+						}
+					}
+					return mockv2.MockRecvPacketResult
+				}
+
+				expAck = types.NewNonAtomicAcknowledgement(
+					mockv1.MockFailAcknowledgement.Acknowledgement(),
+					mockv2.MockRecvPacketResult.Acknowledgement,
+				)
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: received payload is identified in the context",
+			payloads: []types.Payload{
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			malleate: func() {
+				var payloadIndex uint32
+				path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					receivedPayload, found := types.GetReceivedPayload(ctx)
+					s.Require().True(found)
+					s.Require().Equal(packet, receivedPayload.Packet)
+					s.Require().Equal(payloadIndex, receivedPayload.Index)
+					s.Require().False(receivedPayload.CanFailAsynchronously())
+					payloadIndex++
+
+					return mockv2.MockRecvPacketResult
+				}
+
+				expAck = types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement, mockv2.MockRecvPacketResult.Acknowledgement)
+			},
+			expError:      nil,
+			expAckWritten: true,
+		},
+		{
+			name: "success: non-atomic async payload with other payloads",
 			payloads: []types.Payload{
 				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
 				mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
 			},
+			nonAtomic:     true,
 			malleate:      func() {},
-			expError:      types.ErrInvalidPacket,
+			expError:      nil,
 			expAckWritten: false,
 		},
+		{
+			name: "failure: non-atomic circuit breaker tripped",
+			payloads: []types.Payload{
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			},
+			nonAtomic: true,
+			malleate: func() {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainB.GetContext(), types.NewCircuitBreaker(path.EndpointB.ClientID, false, true))
+			},
+			expError: types.ErrCircuitBreakerTripped,
+		},
+		{
+			name:     "failure: non-atomic flag of packet malleated",
+			payloads: []types.Payload{mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)},
+			malleate: func() {
+				// proof verification fails because the packet commitment of a non-atomic packet is different
+				packet.NonAtomic = true
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -319,7 +457,11 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 			timeoutTimestamp := s.chainA.GetTimeoutTimestampSecs()
 
 			var err error
-			packet, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, tc.payloads...)
+			if tc.nonAtomic {
+				packet, err = path.EndpointA.MsgSendNonAtomicPacket(timeoutTimestamp, tc.payloads...)
+			} else {
+				packet, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, tc.payloads...)
+			}
 			s.Require().NoError(err)
 
 			// default expected acknowledgement is a single successful acknowledgement for moduleB.
@@ -346,7 +488,20 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 				if !tc.expAckWritten {
 					// ack should not be written for async app or if the packet receipt was already present.
 					s.Require().False(ackWritten)
+
+					// the app acknowledgements of a packet with multiple payloads are stored until the async payloads are acknowledged
+					asyncAck, found := ck.GetAsyncAcknowledgement(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+					s.Require().Equal(len(packet.Payloads) > 1, found)
+					if found {
+						s.Require().Equal([]uint32{1}, asyncAck.PendingPayloadIndices)
+						s.Require().Equal(mockv2.MockRecvPacketResult.Acknowledgement, asyncAck.AppAcknowledgements[0])
+						s.Require().Empty(asyncAck.AppAcknowledgements[1])
+					}
 				} else { // successful or failed acknowledgement
+					// no async state is stored for a packet whose acknowledgement is written
+					_, found := ck.GetAsyncPacket(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
+					s.Require().False(found)
+
 					// ack should be written for synchronous app (default mock application behaviour).
 					s.Require().True(ackWritten)
 					expectedBz := types.CommitAcknowledgement(expAck)
@@ -356,8 +511,9 @@ func (s *KeeperTestSuite) TestMsgRecvPacket() {
 				}
 			} else {
 				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
-				_, ok := ck.GetPacketReceipt(path.EndpointB.Chain.GetContext(), packet.SourceClient, packet.Sequence)
+				_, ok := ck.GetPacketReceipt(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence)
 				s.Require().False(ok)
+				s.Require().False(ck.HasPacketAcknowledgement(path.EndpointB.Chain.GetContext(), packet.DestinationClient, packet.Sequence))
 			}
 		})
 	}
@@ -511,6 +667,14 @@ func (s *KeeperTestSuite) TestMsgAcknowledgement() {
 			payloads: []types.Payload{mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)},
 			expError: errors.New("failed packet acknowledgement verification"),
 		},
+		{
+			name: "failure: non-atomic flag of acknowledgement does not match packet",
+			malleate: func() {
+				ack.NonAtomic = true
+			},
+			payloads: []types.Payload{mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)},
+			expError: types.ErrInvalidAcknowledgement,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -707,3 +871,533 @@ func (s *KeeperTestSuite) TestMsgTimeout() {
 		})
 	}
 }
+
+// TestOrderedPacketFlow tests that packets sent over ordered clients are received in order of their sequences,
+// and that sending packets is paused once a packet has timed out.
+func (s *KeeperTestSuite) TestOrderedPacketFlow() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	s.Require().NoError(path.EndpointA.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+	s.Require().NoError(path.EndpointB.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	// packets cannot be sent before the ordering of the counterparty has been verified
+	_, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrCounterpartyOrderingNotVerified)
+
+	s.Require().NoError(path.EndpointA.MsgVerifyCounterpartyOrdering())
+	s.Require().NoError(path.EndpointB.MsgVerifyCounterpartyOrdering())
+
+	packet1, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	s.Require().NoError(err)
+	packet2, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	s.Require().NoError(err)
+
+	// the second packet cannot be received before the first one
+	err = path.EndpointB.MsgRecvPacket(packet2)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrPacketSequenceOutOfOrder)
+
+	s.Require().NoError(path.EndpointA.RelayPacket(packet1))
+	s.Require().NoError(path.EndpointA.RelayPacket(packet2))
+
+	nextSequenceRecv, found := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(s.chainB.GetContext(), path.EndpointB.ClientID)
+	s.Require().True(found)
+	s.Require().Equal(packet2.Sequence+1, nextSequenceRecv)
+
+	// the next packet times out, after which the client is closed for sending packets
+	packet3, err := path.EndpointA.MsgSendPacket(uint64(s.chainB.GetContext().BlockTime().Add(time.Second).Unix()), payload)
+	s.Require().NoError(err)
+	s.Require().NoError(path.EndpointA.UpdateClient())
+	s.Require().NoError(path.EndpointA.MsgTimeoutPacket(packet3))
+
+	ck := s.chainA.App.GetIBCKeeper().ChannelKeeperV2
+	s.Require().True(ck.IsOrderedClientClosed(s.chainA.GetContext(), path.EndpointA.ClientID))
+
+	_, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrOrderedClientClosed)
+
+	// resetting the circuit breaker of the client does not reopen it
+	guardian := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	ck.SetCircuitBreakerGuardians(s.chainA.GetContext(), types.CircuitBreakerGuardians{Guardians: []string{guardian}})
+	_, err = ck.UpdateCircuitBreaker(s.chainA.GetContext(), types.NewMsgUpdateCircuitBreaker(path.EndpointA.ClientID, false, false, guardian))
+	s.Require().NoError(err)
+
+	s.Require().True(ck.IsOrderedClientClosed(s.chainA.GetContext(), path.EndpointA.ClientID))
+	_, err = path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrOrderedClientClosed)
+}
+
+func (s *KeeperTestSuite) TestMsgVerifyCounterpartyOrdering() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgVerifyCounterpartyOrdering
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: counterparty has received packets",
+			func() {
+				msg.NextSequenceRecv = 2
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(s.chainB.GetContext(), path.EndpointB.ClientID, msg.NextSequenceRecv)
+			},
+			nil,
+		},
+		{
+			"failure: counterparty is not ordered",
+			func() {
+				s.Require().NoError(path.EndpointB.UpdateClientConfig(clientv2types.Config{}))
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: next sequence receive does not match the counterparty",
+			func() {
+				msg.NextSequenceRecv = 2
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: client is not ordered",
+			func() {
+				s.Require().NoError(path.EndpointA.UpdateClientConfig(clientv2types.Config{}))
+			},
+			clientv2types.ErrInvalidConfig,
+		},
+		{
+			"failure: invalid signer",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			errors.New("invalid address for msg Signer"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			s.Require().NoError(path.EndpointA.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+			s.Require().NoError(path.EndpointB.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+
+			msg = types.NewMsgVerifyCounterpartyOrdering(path.EndpointA.ClientID, 1, nil, clienttypes.ZeroHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			s.coordinator.CommitBlock(s.chainB)
+			s.Require().NoError(path.EndpointA.UpdateClient())
+			msg.ProofNextSequenceRecv, msg.ProofHeight = path.EndpointB.QueryProof(hostv2.NextSequenceRecvKey(path.EndpointB.ClientID))
+
+			ck := s.chainA.App.GetIBCKeeper().ChannelKeeperV2
+			_, err := ck.VerifyCounterpartyOrdering(s.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().True(ck.IsCounterpartyOrdered(s.chainA.GetContext(), path.EndpointA.ClientID))
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().False(ck.IsCounterpartyOrdered(s.chainA.GetContext(), path.EndpointA.ClientID))
+			}
+		})
+	}
+}
+
+// TestOrderedPacketFlowUnorderedCounterparty tests that packets are neither sent nor received over an ordered client
+// whose counterparty is not ordered, and that the packets sent by the unordered counterparty can be timed out.
+func (s *KeeperTestSuite) TestOrderedPacketFlowUnorderedCounterparty() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	s.Require().NoError(path.EndpointA.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+	// the ordering of the counterparty cannot be verified as it has no next receive sequence to prove
+	_, err := path.EndpointA.MsgSendPacket(uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix()), payload)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrCounterpartyOrderingNotVerified)
+
+	// the packet sent by the unordered counterparty is not received and is timed out with a proof of receipt absence
+	packet, err := path.EndpointB.MsgSendPacket(uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).Unix()), payload)
+	s.Require().NoError(err)
+
+	err = path.EndpointA.MsgRecvPacket(packet)
+	ibctesting.RequireErrorIsOrContains(s.T(), err, types.ErrCounterpartyOrderingNotVerified)
+
+	s.coordinator.IncrementTimeBy(time.Minute)
+	s.Require().NoError(path.EndpointB.UpdateClient())
+	s.Require().NoError(path.EndpointB.MsgTimeoutPacket(packet))
+	s.Require().Nil(s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(s.chainB.GetContext(), packet.SourceClient, packet.Sequence))
+}
+
+func (s *KeeperTestSuite) TestNonAtomicPacketFlow() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	// the receiving application mints a coin for each payload, including the failed one
+	path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+		denom := "success"
+		if bytes.Equal(data.Value, mockv1.MockFailPacketData) {
+			denom = "failure"
+		}
+		s.Require().NoError(s.chainB.GetSimApp().BankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+
+		if denom == "failure" {
+			return types.RecvPacketResult{Status: types.PacketStatus_Failure}
+		}
+		return mockv2.MockRecvPacketResult
+	}
+
+	// each sending application receives the acknowledgement of its own payload
+	var acknowledgements [][]byte
+	path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, acknowledgement []byte, relayer sdk.AccAddress) error {
+		acknowledgements = append(acknowledgements, acknowledgement)
+		return nil
+	}
+
+	packet, err := path.EndpointA.MsgSendNonAtomicPacket(s.chainA.GetTimeoutTimestampSecs(),
+		mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+		mockv2.NewErrorMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+	)
+	s.Require().NoError(err)
+	s.Require().True(packet.NonAtomic)
+
+	s.Require().NoError(path.EndpointA.RelayPacket(packet))
+
+	// only the state changes of the successful payload are committed
+	moduleAddr := s.chainB.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName)
+	balances := s.chainB.GetSimApp().BankKeeper.GetAllBalances(s.chainB.GetContext(), moduleAddr)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("success", 1)), balances)
+
+	s.Require().Equal([][]byte{mockv2.MockRecvPacketResult.Acknowledgement, types.ErrorAcknowledgement[:]}, acknowledgements)
+
+	commitment := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(s.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	s.Require().Empty(commitment)
+}
+
+// TestApplicationStackFlow tests the flow of a packet whose payloads are routed to an application stack
+// with multiple middlewares registered under multiple ports.
+func (s *KeeperTestSuite) TestApplicationStackFlow() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	// the base application of the stack receives the payloads of both ports
+	var recvPorts []string
+	path.EndpointB.Chain.GetSimApp().MockModuleV2Stack.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+		recvPorts = append(recvPorts, data.DestinationPort)
+		return mockv2.MockRecvPacketResult
+	}
+
+	var ackPorts []string
+	path.EndpointA.Chain.GetSimApp().MockModuleV2Stack.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, acknowledgement []byte, relayer sdk.AccAddress) error {
+		s.Require().Equal(mockv2.MockRecvPacketResult.Acknowledgement, acknowledgement)
+		ackPorts = append(ackPorts, data.SourcePort)
+		return nil
+	}
+
+	packet, err := path.EndpointA.MsgSendPacket(s.chainA.GetTimeoutTimestampSecs(),
+		mockv2.NewMockPayload(mockv2.PortIDStackA, mockv2.PortIDStackB),
+		mockv2.NewMockPayload(mockv2.PortIDStackB, mockv2.PortIDStackA),
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(path.EndpointA.RelayPacket(packet))
+
+	s.Require().Equal([]string{mockv2.PortIDStackB, mockv2.PortIDStackA}, recvPorts)
+	s.Require().Equal([]string{mockv2.PortIDStackA, mockv2.PortIDStackB}, ackPorts)
+
+	commitment := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(s.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	s.Require().Empty(commitment)
+}
+
+func (s *KeeperTestSuite) TestMsgPruneAcknowledgements() {
+	var (
+		path         *ibctesting.Path
+		msg          *types.MsgPruneAcknowledgements
+		pruningDelay uint64
+		pruneTime    time.Time
+		relayAcks    bool
+		sequences    []uint64
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPruned    uint64
+		expRemaining uint64
+		expError     error
+	}{
+		{
+			"success: all packets pruned",
+			func() {},
+			3,
+			0,
+			nil,
+		},
+		{
+			"success: subset of packets pruned",
+			func() {
+				sequences = []uint64{1, 2}
+			},
+			2,
+			1,
+			nil,
+		},
+		{
+			"success: packets already pruned are skipped",
+			func() {
+				sequences = []uint64{1, 2, 3, 1}
+			},
+			3,
+			0,
+			nil,
+		},
+		{
+			"success: pruning is disabled",
+			func() {
+				pruningDelay = 0
+			},
+			0,
+			0,
+			nil,
+		},
+		{
+			"failure: pruning delay has not elapsed",
+			func() {
+				pruneTime = pruneTime.Add(-time.Second)
+			},
+			0,
+			0,
+			types.ErrPruningDelayNotElapsed,
+		},
+		{
+			"failure: acknowledgements not relayed",
+			func() {
+				relayAcks = false
+			},
+			0,
+			0,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: counterparty not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			0,
+			0,
+			clientv2types.ErrCounterpartyNotFound,
+		},
+		{
+			"failure: invalid signer",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			0,
+			0,
+			errors.New("invalid address for msg Signer"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			pruningDelay = uint64(time.Hour.Seconds())
+			relayAcks = true
+			sequences = []uint64{1, 2, 3}
+			timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+			msg = types.NewMsgPruneAcknowledgements(path.EndpointB.ClientID, nil, nil, clienttypes.ZeroHeight(), s.chainB.SenderAccount.GetAddress().String())
+
+			// the packets can be pruned once the pruning delay has elapsed after their timeout
+			pruneTime = time.Unix(int64(timeoutTimestamp+pruningDelay), 0)
+
+			tc.malleate()
+
+			s.Require().NoError(path.EndpointB.UpdateClientConfig(clientv2types.Config{PruningDelay: pruningDelay}))
+
+			payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+			var packets []types.Packet
+			for range 3 {
+				packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+				s.Require().NoError(err)
+
+				if relayAcks {
+					s.Require().NoError(path.EndpointA.RelayPacket(packet))
+				} else {
+					s.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+					s.Require().NoError(path.EndpointB.UpdateClient())
+				}
+				packets = append(packets, packet)
+			}
+
+			for _, sequence := range sequences {
+				// the absence of the packet commitment proves the acknowledgement has been relayed
+				proof, proofHeight := path.EndpointA.QueryProof(hostv2.PacketCommitmentKey(path.EndpointA.ClientID, sequence))
+				msg.Sequences = append(msg.Sequences, sequence)
+				msg.ProofsAcknowledged = append(msg.ProofsAcknowledged, proof)
+				msg.ProofHeight = proofHeight
+			}
+
+			ctx := s.chainB.GetContext().WithBlockTime(pruneTime)
+
+			ck := s.chainB.App.GetIBCKeeper().ChannelKeeperV2
+			res, err := ck.PruneAcknowledgements(ctx, msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expPruned, res.TotalPrunedSequences)
+				s.Require().Equal(tc.expRemaining, res.TotalRemainingSequences)
+
+				for i, packet := range packets {
+					pruned := uint64(i) < tc.expPruned
+					s.Require().Equal(!pruned, ck.HasPacketReceipt(ctx, packet.DestinationClient, packet.Sequence))
+					s.Require().Equal(!pruned, ck.HasPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence))
+
+					// pruned packets cannot be received again as they have timed out
+					if pruned {
+						err := ck.RecvPacketTest(ctx, packet, nil, clienttypes.ZeroHeight())
+						s.Require().ErrorIs(err, types.ErrTimeoutElapsed)
+					}
+				}
+
+				expPruningState := types.PruningState{ClientId: path.EndpointB.ClientID, TotalPruned: tc.expPruned, TotalRemaining: tc.expRemaining}
+				s.Require().Equal(expPruningState, ck.GetPruningState(ctx, path.EndpointB.ClientID))
+				s.Require().Len(ck.GetAllPrunablePacketsForClient(ctx, path.EndpointB.ClientID), int(tc.expRemaining))
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateCircuitBreaker() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgUpdateCircuitBreaker
+	)
+
+	guardian := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success: authority pauses sending and receiving",
+			malleate: func() {},
+			expError: nil,
+		},
+		{
+			name: "success: guardian pauses receiving",
+			malleate: func() {
+				msg.SendPaused = false
+				msg.Signer = guardian
+			},
+			expError: nil,
+		},
+		{
+			name: "success: authority resumes all packet flows",
+			malleate: func() {
+				msg.SendPaused = false
+				msg.RecvPaused = false
+			},
+			expError: nil,
+		},
+		{
+			name: "failure: signer is neither the authority nor a guardian",
+			malleate: func() {
+				msg.Signer = s.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			ck := s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2
+			ck.SetCircuitBreakerGuardians(s.chainA.GetContext(), types.CircuitBreakerGuardians{Guardians: []string{guardian}})
+
+			msg = types.NewMsgUpdateCircuitBreaker(path.EndpointA.ClientID, true, true, s.chainA.GetSimApp().IBCKeeper.GetAuthority())
+
+			tc.malleate()
+
+			_, err := ck.UpdateCircuitBreaker(s.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+
+				circuitBreaker := ck.GetCircuitBreaker(s.chainA.GetContext(), path.EndpointA.ClientID)
+				s.Require().Equal(types.NewCircuitBreaker(path.EndpointA.ClientID, msg.SendPaused, msg.RecvPaused), circuitBreaker)
+				s.Require().Equal(msg.SendPaused, ck.IsSendPaused(s.chainA.GetContext(), path.EndpointA.ClientID))
+				s.Require().Equal(msg.RecvPaused, ck.IsRecvPaused(s.chainA.GetContext(), path.EndpointA.ClientID))
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().False(ck.GetCircuitBreaker(s.chainA.GetContext(), path.EndpointA.ClientID).IsTripped())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateCircuitBreakerGuardians() {
+	guardians := []string{s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}
+
+	testCases := []struct {
+		name     string
+		signer   string
+		expError error
+	}{
+		{
+			name:     "success",
+			signer:   s.chainA.GetSimApp().IBCKeeper.GetAuthority(),
+			expError: nil,
+		},
+		{
+			name:     "failure: signer is not the authority",
+			signer:   guardians[0],
+			expError: ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ck := s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2
+			_, err := ck.UpdateCircuitBreakerGuardians(s.chainA.GetContext(), types.NewMsgUpdateCircuitBreakerGuardians(tc.signer, guardians...))
+
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(guardians, ck.GetCircuitBreakerGuardians(s.chainA.GetContext()).Guardians)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().Empty(ck.GetCircuitBreakerGuardians(s.chainA.GetContext()).Guardians)
+			}
+		})
+	}
+}
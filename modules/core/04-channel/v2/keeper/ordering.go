@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// SetCounterpartyOrdered stores that the counterparty of an ordered client has been verified to be ordered.
+func (k *Keeper) SetCounterpartyOrdered(ctx sdk.Context, clientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.CounterpartyOrderedKey(clientID), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
+}
+
+// IsCounterpartyOrdered returns true if the counterparty of an ordered client has been verified to be ordered.
+func (k *Keeper) IsCounterpartyOrdered(ctx sdk.Context, clientID string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.CounterpartyOrderedKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// SetOrderedClientClosed stores that an ordered client is closed for sending packets.
+func (k *Keeper) SetOrderedClientClosed(ctx sdk.Context, clientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.OrderedClientClosedKey(clientID), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
+}
+
+// IsOrderedClientClosed returns true if an ordered client is closed for sending packets. Unlike a paused circuit
+// breaker, a closed ordered client cannot be reopened.
+func (k *Keeper) IsOrderedClientClosed(ctx sdk.Context, clientID string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.OrderedClientClosedKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// verifyCounterpartyOrdering verifies that the counterparty of an ordered client is ordered by proving the next
+// receive sequence of the counterparty client, which is only stored for ordered clients. Packets can only be sent
+// and received over an ordered client once the ordering of its counterparty has been verified, so that packets
+// are not sent in order to a counterparty which cannot prove their timeout with its next receive sequence, nor
+// received in order from a counterparty which does not stop sending packets once a packet has timed out.
+//
+// The ordering of a client cannot be changed once the ordering of its counterparty has been verified.
+func (k *Keeper) verifyCounterpartyOrdering(
+	ctx sdk.Context,
+	clientID string,
+	nextSequenceRecv uint64,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if !k.clientV2Keeper.GetConfig(ctx, clientID).Ordered {
+		return errorsmod.Wrapf(clientv2types.ErrInvalidConfig, "client %s is not ordered", clientID)
+	}
+
+	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, clientID)
+	if !ok {
+		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", clientID)
+	}
+
+	// Before we do client keeper level checks, we first get underlying base clientID
+	baseClientID := clientID
+	if underlyingClientID, isAlias := k.GetClientForAlias(ctx, clientID); isAlias {
+		baseClientID = underlyingClientID
+	}
+
+	path := hostv2.NextSequenceRecvKey(counterparty.ClientId)
+	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
+
+	if err := k.ClientKeeper.VerifyMembership(
+		ctx,
+		baseClientID,
+		proofHeight,
+		0, 0,
+		proof,
+		merklePath,
+		sdk.Uint64ToBigEndian(nextSequenceRecv),
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed next sequence receive verification for client (%s)", baseClientID)
+	}
+
+	k.SetCounterpartyOrdered(ctx, clientID)
+
+	k.Logger(ctx).Info("counterparty ordering verified", "client_id", clientID, "counterparty_client_id", counterparty.ClientId)
+
+	emitVerifyCounterpartyOrderingEvent(ctx, clientID)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"slices"
 	"strconv"
 	"time"
 
@@ -23,7 +24,12 @@ func (k *Keeper) sendPacket(
 	sourceClient string,
 	timeoutTimestamp uint64,
 	payloads []types.Payload,
+	nonAtomic bool,
 ) (uint64, string, error) {
+	if k.IsSendPaused(ctx, sourceClient) {
+		return 0, "", errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "sending packets is paused for client: %s", sourceClient)
+	}
+
 	// lookup counterparty from packet identifiers
 	// note this will be either the client identifier for IBC V2 paths
 	// or an aliased channel identifier for IBC V1 paths
@@ -49,8 +55,19 @@ func (k *Keeper) sendPacket(
 		return 0, "", errorsmod.Wrapf(types.ErrSequenceSendNotFound, "source client: %s", sourceClient)
 	}
 
+	// ORDERING: packets can only be sent over an ordered client once its counterparty has been verified to be ordered
+	if k.clientV2Keeper.GetConfig(ctx, sourceClient).Ordered && !k.IsCounterpartyOrdered(ctx, sourceClient) {
+		return 0, "", errorsmod.Wrapf(types.ErrCounterpartyOrderingNotVerified, "source client: %s", sourceClient)
+	}
+
+	// ORDERING: packets can no longer be sent over an ordered client once one of its packets timed out
+	if k.IsOrderedClientClosed(ctx, sourceClient) {
+		return 0, "", errorsmod.Wrapf(types.ErrOrderedClientClosed, "source client: %s", sourceClient)
+	}
+
 	// construct packet from given fields and channel state
 	packet := types.NewPacket(sequence, sourceClient, counterparty.ClientId, timeoutTimestamp, payloads...)
+	packet.NonAtomic = nonAtomic
 
 	if err := packet.ValidateBasic(); err != nil {
 		return 0, "", errorsmod.Wrapf(types.ErrInvalidPacket, "constructed packet failed basic validation: %v", err)
@@ -131,8 +148,8 @@ func (k *Keeper) recvPacket(
 	}
 
 	// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
-	// Packet receipts must not be pruned, unless it has been marked stale
-	// by the increase of the recvStartSequence.
+	// Packet receipts must not be pruned before the packet has timed out, which is ensured
+	// by the pruning delay of the client.
 	if k.HasPacketReceipt(ctx, packet.DestinationClient, packet.Sequence) {
 		// This error indicates that the packet has already been relayed. Core IBC will
 		// treat this error as a no-op in order to prevent an entire relay transaction
@@ -140,6 +157,30 @@ func (k *Keeper) recvPacket(
 		return types.ErrNoOpMsg
 	}
 
+	// ORDERING: packets received over an ordered client must be received in order of their sequences
+	ordered := k.clientV2Keeper.GetConfig(ctx, packet.DestinationClient).Ordered
+	var nextSequenceRecv uint64
+	if ordered {
+		if !k.IsCounterpartyOrdered(ctx, packet.DestinationClient) {
+			return errorsmod.Wrapf(types.ErrCounterpartyOrderingNotVerified, "destination client: %s", packet.DestinationClient)
+		}
+
+		var found bool
+		nextSequenceRecv, found = k.GetNextSequenceRecv(ctx, packet.DestinationClient)
+		if !found {
+			return errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "client: %s", packet.DestinationClient)
+		}
+
+		if packet.Sequence < nextSequenceRecv {
+			// the packet has already been received, see the receipt check above
+			return types.ErrNoOpMsg
+		}
+
+		if packet.Sequence != nextSequenceRecv {
+			return errorsmod.Wrapf(types.ErrPacketSequenceOutOfOrder, "packet sequence ≠ next receive sequence (%d ≠ %d)", packet.Sequence, nextSequenceRecv)
+		}
+	}
+
 	path := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
 	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
 
@@ -166,6 +207,12 @@ func (k *Keeper) recvPacket(
 	// Set Packet Receipt to prevent timeout from occurring on counterparty
 	k.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
 
+	// the next receive sequence is proven by the counterparty instead of the receipt for the timeout of
+	// packets sent over an ordered client
+	if ordered {
+		k.SetNextSequenceRecv(ctx, packet.DestinationClient, nextSequenceRecv+1)
+	}
+
 	k.Logger(ctx).Info("packet received", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitRecvPacketEvents(ctx, packet)
@@ -186,8 +233,13 @@ func (k *Keeper) writeAcknowledgement(
 		return errorsmod.Wrap(err, "invalid acknowledgement")
 	}
 
-	// Validate the acknowledgement against the payload length
-	if ack.Success() {
+	if ack.NonAtomic != packet.NonAtomic {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "acknowledgement non-atomic (%t) does not match packet non-atomic (%t)", ack.NonAtomic, packet.NonAtomic)
+	}
+
+	// Validate the acknowledgement against the payload length, the acknowledgement
+	// of a non-atomic packet contains an app acknowledgement for each payload
+	if ack.Success() || ack.NonAtomic {
 		if len(ack.AppAcknowledgements) != len(packet.Payloads) {
 			return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(ack.AppAcknowledgements), len(packet.Payloads))
 		}
@@ -222,6 +274,8 @@ func (k *Keeper) writeAcknowledgement(
 		types.CommitAcknowledgement(ack),
 	)
 
+	k.schedulePruning(ctx, packet)
+
 	k.Logger(ctx).Info("acknowledgement written", "sequence", strconv.FormatUint(packet.Sequence, 10), "dst_client_id", packet.DestinationClient)
 
 	emitWriteAcknowledgementEvents(ctx, packet, ack)
@@ -238,6 +292,19 @@ func (k *Keeper) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence
 		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "packet with clientID (%s) and sequence (%d) not found for async acknowledgement", clientID, sequence)
 	}
 
+	// applications are not aware of the execution mode of the packet, which is set by core IBC
+	ack.NonAtomic = packet.NonAtomic
+
+	// the acknowledgements of packets with multiple payloads are written per payload, however an
+	// application may write the app acknowledgement of the only pending payload as the acknowledgement
+	if asyncAck, found := k.GetAsyncAcknowledgement(ctx, clientID, sequence); found {
+		if len(asyncAck.PendingPayloadIndices) != 1 || len(ack.AppAcknowledgements) != 1 {
+			return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "packet with clientID (%s) and sequence (%d) has %d pending payload acknowledgements which must be written with WritePayloadAcknowledgement", clientID, sequence, len(asyncAck.PendingPayloadIndices))
+		}
+
+		return k.WritePayloadAcknowledgement(ctx, clientID, sequence, asyncAck.PendingPayloadIndices[0], ack.AppAcknowledgements[0])
+	}
+
 	// Write the acknowledgement to the store
 	if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
 		ctx.Logger().Error("write acknowledgement failed", "error", errorsmod.Wrap(err, "write acknowledgement failed"))
@@ -250,6 +317,75 @@ func (k *Keeper) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence
 	return nil
 }
 
+// WritePayloadAcknowledgement writes the app acknowledgement of the payload with the given index of an async packet.
+// It is the method to be called by external apps when they want to write the acknowledgement of their payload of a
+// packet with multiple payloads asynchronously. The acknowledgement of the packet is written and its events are
+// emitted once the app acknowledgements of all its payloads have been written.
+//
+// The index of a payload is given by the ReceivedPayload identified by the context passed to OnRecvPacket.
+//
+// A payload which fails asynchronously is acknowledged with the sentinel error acknowledgement. This is only
+// possible for packets with a single payload and for non-atomic packets: the state changes of the other payloads
+// of an atomic packet with multiple payloads have already been committed and cannot be reverted. Applications
+// which may fail asynchronously must therefore fail synchronously in OnRecvPacket when the received payload cannot
+// fail asynchronously, so that the whole packet fails and the state changes of all its payloads are reverted.
+func (k *Keeper) WritePayloadAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, payloadIndex uint32, appAck []byte) error {
+	packet, ok := k.GetAsyncPacket(ctx, clientID, sequence)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "packet with clientID (%s) and sequence (%d) not found for async acknowledgement", clientID, sequence)
+	}
+
+	if int(payloadIndex) >= len(packet.Payloads) {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "payload index %d out of range for packet with %d payloads", payloadIndex, len(packet.Payloads))
+	}
+
+	// the acknowledgement of a packet with a single payload is its app acknowledgement
+	if len(packet.Payloads) == 1 {
+		return k.WriteAcknowledgement(ctx, clientID, sequence, types.NewAcknowledgement(appAck))
+	}
+
+	asyncAck, found := k.GetAsyncAcknowledgement(ctx, clientID, sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "pending acknowledgement of packet with clientID (%s) and sequence (%d) not found", clientID, sequence)
+	}
+
+	pendingIndex := slices.Index(asyncAck.PendingPayloadIndices, payloadIndex)
+	if pendingIndex == -1 {
+		return errorsmod.Wrapf(types.ErrAcknowledgementExists, "acknowledgement for payload %d of packet with clientID (%s) and sequence (%d) is not pending", payloadIndex, clientID, sequence)
+	}
+
+	if len(appAck) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "app acknowledgement cannot be empty")
+	}
+
+	if bytes.Equal(appAck, types.ErrorAcknowledgement[:]) && !packet.NonAtomic {
+		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "app acknowledgement of a packet with multiple payloads cannot be the error acknowledgement")
+	}
+
+	asyncAck.AppAcknowledgements[payloadIndex] = appAck
+	asyncAck.PendingPayloadIndices = slices.Delete(asyncAck.PendingPayloadIndices, pendingIndex, pendingIndex+1)
+
+	k.Logger(ctx).Info("payload acknowledgement written", "sequence", strconv.FormatUint(sequence, 10), "dst_client_id", clientID, "payload_index", payloadIndex)
+
+	if len(asyncAck.PendingPayloadIndices) > 0 {
+		k.SetAsyncAcknowledgement(ctx, clientID, sequence, asyncAck)
+		return nil
+	}
+
+	// Write the acknowledgement to the store once the app acknowledgements of all payloads have been written
+	ack := types.Acknowledgement{AppAcknowledgements: asyncAck.AppAcknowledgements, NonAtomic: packet.NonAtomic}
+	if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
+		ctx.Logger().Error("write acknowledgement failed", "error", errorsmod.Wrap(err, "write acknowledgement failed"))
+		return errorsmod.Wrap(err, "write acknowledgement failed")
+	}
+
+	// Delete the packet and its pending acknowledgement from the async store
+	k.DeleteAsyncPacket(ctx, clientID, sequence)
+	k.DeleteAsyncAcknowledgement(ctx, clientID, sequence)
+
+	return nil
+}
+
 func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement, proof []byte, proofHeight exported.Height) error {
 	// lookup counterparty from packet identifiers
 	// note this will be either the client identifier for IBC V2 paths
@@ -279,6 +415,10 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
+	if acknowledgement.NonAtomic != packet.NonAtomic {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "acknowledgement non-atomic (%t) does not match packet non-atomic (%t)", acknowledgement.NonAtomic, packet.NonAtomic)
+	}
+
 	path := hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
 	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
 
@@ -316,11 +456,19 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 // an absence proof of the packet receipt is performed to ensure that the packet
 // was never delivered to the counterparty. If successful, the packet commitment
 // is deleted and the packet has completed its lifecycle.
+//
+// For packets sent over an ordered client, the next receive sequence of the counterparty
+// may be proven instead of the absence of the packet receipt, and sending packets over the
+// client is closed as the packets sent after the timed out packet can no longer be received.
+// The absence of the packet receipt can always be proven instead, as receipts are written for
+// packets received over ordered clients too, so that packets sent to a counterparty which is
+// no longer ordered can still be timed out.
 func (k *Keeper) timeoutPacket(
 	ctx sdk.Context,
 	packet types.Packet,
 	proof []byte,
 	proofHeight exported.Height,
+	nextSequenceRecv uint64,
 ) error {
 	// lookup counterparty from packet identifiers
 	// note this will be either the client identifier for IBC V2 paths
@@ -370,19 +518,47 @@ func (k *Keeper) timeoutPacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	// verify packet receipt absence
-	path := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
+	config := k.clientV2Keeper.GetConfig(ctx, packet.SourceClient)
+	if !config.Ordered && nextSequenceRecv != 0 {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "next sequence receive must not be set for the timeout of a packet sent over unordered client %s", packet.SourceClient)
+	}
 
-	if err := k.ClientKeeper.VerifyNonMembership(
-		ctx,
-		clientID,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
+	if nextSequenceRecv != 0 {
+		// an ordered packet has been received once the next receive sequence of the counterparty is past its sequence
+		if nextSequenceRecv > packet.Sequence {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.Sequence)
+		}
+
+		// verify next sequence receive of the counterparty
+		path := hostv2.NextSequenceRecvKey(packet.DestinationClient)
+		merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
+
+		if err := k.ClientKeeper.VerifyMembership(
+			ctx,
+			clientID,
+			proofHeight,
+			0, 0,
+			proof,
+			merklePath,
+			sdk.Uint64ToBigEndian(nextSequenceRecv),
+		); err != nil {
+			return errorsmod.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
+		}
+	} else {
+		// verify packet receipt absence
+		path := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
+		merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
+
+		if err := k.ClientKeeper.VerifyNonMembership(
+			ctx,
+			clientID,
+			proofHeight,
+			0, 0,
+			proof,
+			merklePath,
+		); err != nil {
+			return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
+		}
 	}
 
 	// delete packet commitment to prevent replay
@@ -392,5 +568,16 @@ func (k *Keeper) timeoutPacket(
 
 	emitTimeoutPacketEvents(ctx, packet)
 
+	// the packets sent after the timed out packet can no longer be received over an ordered client,
+	// so the client is closed for sending packets as an ordered channel is closed in IBC v1. This is
+	// stored separately from the circuit breaker, so that it cannot be reset by unpausing the client
+	if config.Ordered && !k.IsOrderedClientClosed(ctx, packet.SourceClient) {
+		k.SetOrderedClientClosed(ctx, packet.SourceClient)
+
+		k.Logger(ctx).Info("ordered client closed", "client_id", packet.SourceClient, "sequence", strconv.FormatUint(packet.Sequence, 10))
+
+		emitCloseOrderedClientEvent(ctx, packet.SourceClient)
+	}
+
 	return nil
 }
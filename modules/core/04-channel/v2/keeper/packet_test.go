@@ -112,6 +112,18 @@ func (s *KeeperTestSuite) TestSendPacket() {
 			},
 			types.ErrTimeoutElapsed,
 		},
+		{
+			"success: ordered client", func() {
+				s.setOrdered(path.EndpointA)
+			},
+			nil,
+		},
+		{
+			"counterparty ordering not verified", func() {
+				s.Require().NoError(path.EndpointA.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+			},
+			types.ErrCounterpartyOrderingNotVerified,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -217,6 +229,47 @@ func (s *KeeperTestSuite) TestRecvPacket() {
 			},
 			commitmenttypes.ErrInvalidProof,
 		},
+		{
+			"success: ordered client",
+			func() {
+				s.setOrdered(path.EndpointB)
+			},
+			nil,
+		},
+		{
+			"failure: packet already received on ordered client",
+			func() {
+				s.setOrdered(path.EndpointB)
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence+1)
+			},
+			types.ErrNoOpMsg,
+		},
+		{
+			"failure: packet sequence out of order on ordered client",
+			func() {
+				s.setOrdered(path.EndpointB)
+
+				// send another packet and try to receive it before the first one
+				packet, err = path.EndpointA.MsgSendPacket(packet.TimeoutTimestamp, packet.Payloads...)
+				s.Require().NoError(err)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+		{
+			"failure: next sequence receive not found on ordered client",
+			func() {
+				s.chainB.App.GetIBCKeeper().ClientV2Keeper.SetConfig(s.chainB.GetContext(), path.EndpointB.ClientID, clientv2types.Config{Ordered: true})
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetCounterpartyOrdered(s.chainB.GetContext(), path.EndpointB.ClientID)
+			},
+			types.ErrSequenceReceiveNotFound,
+		},
+		{
+			"failure: counterparty ordering not verified on ordered client",
+			func() {
+				s.Require().NoError(path.EndpointB.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+			},
+			types.ErrCounterpartyOrderingNotVerified,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +301,12 @@ func (s *KeeperTestSuite) TestRecvPacket() {
 
 				_, found := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketReceipt(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
 				s.Require().True(found)
+
+				if s.chainB.App.GetIBCKeeper().ClientV2Keeper.GetConfig(s.chainB.GetContext(), packet.DestinationClient).Ordered {
+					nextSequenceRecv, found := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(s.chainB.GetContext(), packet.DestinationClient)
+					s.Require().True(found)
+					s.Require().Equal(packet.Sequence+1, nextSequenceRecv)
+				}
 			} else {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, tc.expError)
@@ -418,6 +477,216 @@ func (s *KeeperTestSuite) TestWriteAcknowledgement() {
 	}
 }
 
+func (s *KeeperTestSuite) TestWritePayloadAcknowledgement() {
+	var (
+		packet       types.Packet
+		asyncAck     types.AsyncAcknowledgement
+		payloadIndex uint32
+		appAck       []byte
+		expAck       *types.Acknowledgement
+	)
+
+	asyncAppAck := []byte("async app acknowledgement")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: acknowledgement of packet is pending",
+			func() {},
+			nil,
+		},
+		{
+			"success: last pending payload",
+			func() {
+				asyncAck.AppAcknowledgements[2] = asyncAppAck
+				asyncAck.PendingPayloadIndices = []uint32{0}
+				expAck = &types.Acknowledgement{
+					AppAcknowledgements: [][]byte{asyncAppAck, mockv2.MockRecvPacketResult.Acknowledgement, asyncAppAck},
+				}
+			},
+			nil,
+		},
+		{
+			"success: packet with single payload",
+			func() {
+				packet.Payloads = packet.Payloads[:1]
+				expAck = &types.Acknowledgement{
+					AppAcknowledgements: [][]byte{asyncAppAck},
+				}
+			},
+			nil,
+		},
+		{
+			"success: error acknowledgement of last pending payload of non-atomic packet",
+			func() {
+				packet.NonAtomic = true
+				asyncAck.AppAcknowledgements[2] = asyncAppAck
+				asyncAck.PendingPayloadIndices = []uint32{0}
+				appAck = types.ErrorAcknowledgement[:]
+				expAck = &types.Acknowledgement{
+					AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:], mockv2.MockRecvPacketResult.Acknowledgement, asyncAppAck},
+					NonAtomic:           true,
+				}
+			},
+			nil,
+		},
+		{
+			"success: non-atomic packet with single payload",
+			func() {
+				packet.NonAtomic = true
+				packet.Payloads = packet.Payloads[:1]
+				expAck = &types.Acknowledgement{
+					AppAcknowledgements: [][]byte{asyncAppAck},
+					NonAtomic:           true,
+				}
+			},
+			nil,
+		},
+		{
+			"failure: async packet not found",
+			func() {
+				packet.Sequence = 2
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: pending acknowledgement not found",
+			func() {
+				asyncAck.PendingPayloadIndices = nil
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: payload index out of range",
+			func() {
+				payloadIndex = 3
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: payload is not pending",
+			func() {
+				payloadIndex = 1
+			},
+			types.ErrAcknowledgementExists,
+		},
+		{
+			"failure: empty app acknowledgement",
+			func() {
+				appAck = []byte{}
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: error acknowledgement",
+			func() {
+				appAck = types.ErrorAcknowledgement[:]
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path := ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+			// packet with async payloads at index 0 and 2
+			packet = types.NewPacket(1, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp,
+				mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+			)
+			asyncAck = types.AsyncAcknowledgement{
+				AppAcknowledgements:   [][]byte{nil, mockv2.MockRecvPacketResult.Acknowledgement, nil},
+				PendingPayloadIndices: []uint32{0, 2},
+			}
+			payloadIndex = 0
+			appAck = asyncAppAck
+			expAck = nil
+
+			tc.malleate()
+
+			// mock receive with async acknowledgements
+			ctx := s.chainB.GetContext()
+			ck := s.chainB.App.GetIBCKeeper().ChannelKeeperV2
+			ck.SetPacketReceipt(ctx, packet.DestinationClient, 1)
+			ck.SetAsyncPacket(ctx, packet.DestinationClient, 1, packet)
+			if len(asyncAck.PendingPayloadIndices) > 0 && len(packet.Payloads) > 1 {
+				ck.SetAsyncAcknowledgement(ctx, packet.DestinationClient, 1, asyncAck)
+			}
+
+			err := ck.WritePayloadAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, payloadIndex, appAck)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+
+				if expAck == nil {
+					s.Require().False(ck.HasPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence))
+
+					storedAck, found := ck.GetAsyncAcknowledgement(ctx, packet.DestinationClient, packet.Sequence)
+					s.Require().True(found)
+					s.Require().Equal([]uint32{2}, storedAck.PendingPayloadIndices)
+					s.Require().Equal(appAck, storedAck.AppAcknowledgements[payloadIndex])
+				} else {
+					s.Require().Equal(types.CommitAcknowledgement(*expAck), ck.GetPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence))
+
+					_, found := ck.GetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence)
+					s.Require().False(found)
+					_, found = ck.GetAsyncAcknowledgement(ctx, packet.DestinationClient, packet.Sequence)
+					s.Require().False(found)
+				}
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestWriteAcknowledgementMultiplePayloads tests that the acknowledgement of a packet with multiple payloads
+// can only be written with WriteAcknowledgement as the app acknowledgement of its only pending payload.
+func (s *KeeperTestSuite) TestWriteAcknowledgementMultiplePayloads() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	timeoutTimestamp := s.chainA.GetTimeoutTimestampSecs()
+	packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp,
+		mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+		mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+		mockv2.NewAsyncMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(path.EndpointB.MsgRecvPacket(packet))
+
+	ck := s.chainB.App.GetIBCKeeper().ChannelKeeperV2
+	appAck := []byte("async app acknowledgement")
+
+	// the acknowledgement cannot be written as a whole while several payloads are pending
+	err = ck.WriteAcknowledgement(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence, types.NewAcknowledgement(appAck, mockv2.MockRecvPacketResult.Acknowledgement, appAck))
+	s.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+
+	s.Require().NoError(ck.WritePayloadAcknowledgement(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence, 2, appAck))
+	s.Require().False(ck.HasPacketAcknowledgement(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+
+	// the app acknowledgement of the only pending payload is written with WriteAcknowledgement
+	s.Require().NoError(ck.WriteAcknowledgement(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence, types.NewAcknowledgement(appAck)))
+
+	expAck := types.NewAcknowledgement(appAck, mockv2.MockRecvPacketResult.Acknowledgement, appAck)
+	s.Require().Equal(types.CommitAcknowledgement(expAck), ck.GetPacketAcknowledgement(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+
+	// the acknowledgement is relayed back to the sender
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(path.EndpointA.UpdateClient())
+	s.Require().NoError(path.EndpointA.MsgAcknowledgePacket(packet, expAck))
+}
+
 func (s *KeeperTestSuite) TestAcknowledgePacket() {
 	var (
 		packet types.Packet
@@ -534,9 +803,10 @@ func (s *KeeperTestSuite) TestAcknowledgePacket() {
 
 func (s *KeeperTestSuite) TestTimeoutPacket() {
 	var (
-		path         *ibctesting.Path
-		packet       types.Packet
-		freezeClient bool
+		path             *ibctesting.Path
+		packet           types.Packet
+		freezeClient     bool
+		nextSequenceRecv uint64
 	)
 
 	testCases := []struct {
@@ -565,6 +835,20 @@ func (s *KeeperTestSuite) TestTimeoutPacket() {
 			},
 			nil,
 		},
+		{
+			"success: pruning delay elapsed",
+			func() {
+				// receipts are only pruned once the counterparty is proven to have deleted the packet commitment,
+				// so the absence of the receipt proves the packet was not received
+				s.chainA.App.GetIBCKeeper().ClientV2Keeper.SetConfig(s.chainA.GetContext(), packet.SourceClient, clientv2types.Config{PruningDelay: 1})
+
+				// send packet
+				_, _, err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SendPacketTest(s.chainA.GetContext(), packet.SourceClient,
+					packet.TimeoutTimestamp, packet.Payloads)
+				s.Require().NoError(err, "send packet failed")
+			},
+			nil,
+		},
 		{
 			"failure: client not found",
 			func() {
@@ -643,6 +927,18 @@ func (s *KeeperTestSuite) TestTimeoutPacket() {
 			},
 			commitmenttypes.ErrInvalidProof,
 		},
+		{
+			"failure: next sequence receive set for unordered client",
+			func() {
+				// send packet
+				_, _, err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SendPacketTest(s.chainA.GetContext(), packet.SourceClient,
+					packet.TimeoutTimestamp, packet.Payloads)
+				s.Require().NoError(err, "send packet failed")
+
+				nextSequenceRecv = 1
+			},
+			types.ErrInvalidPacket,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -650,6 +946,7 @@ func (s *KeeperTestSuite) TestTimeoutPacket() {
 			s.SetupTest()
 			// initialize freezeClient to false
 			freezeClient = false
+			nextSequenceRecv = 0
 
 			path = ibctesting.NewPath(s.chainA, s.chainB)
 			path.SetupV2()
@@ -681,7 +978,7 @@ func (s *KeeperTestSuite) TestTimeoutPacket() {
 				path.EndpointA.FreezeClient()
 			}
 
-			err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.TimeoutPacketTest(s.chainA.GetContext(), packet, proof, proofHeight)
+			err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.TimeoutPacketTest(s.chainA.GetContext(), packet, proof, proofHeight, nextSequenceRecv)
 
 			expPass := tc.expError == nil
 			if expPass {
@@ -697,6 +994,124 @@ func (s *KeeperTestSuite) TestTimeoutPacket() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTimeoutPacketOrdered() {
+	var (
+		path             *ibctesting.Path
+		packet           types.Packet
+		nextSequenceRecv uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: receiving packets is paused",
+			func() {
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainA.GetContext(), types.NewCircuitBreaker(packet.SourceClient, false, true))
+			},
+			nil,
+		},
+		{
+			"failure: packet already received",
+			func() {
+				nextSequenceRecv = packet.Sequence + 1
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: verify next sequence receive failed",
+			func() {
+				// set next sequence receive to mock a valid past receive
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence+1)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"success: proof of packet receipt absence",
+			func() {
+				nextSequenceRecv = 0
+			},
+			nil,
+		},
+		{
+			"success: proof of packet receipt absence from an unordered counterparty",
+			func() {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.DeleteNextSequenceRecv(s.chainB.GetContext(), packet.DestinationClient)
+				nextSequenceRecv = 0
+			},
+			nil,
+		},
+		{
+			"failure: verify packet receipt absence failed",
+			func() {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				nextSequenceRecv = 0
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+			s.setOrdered(path.EndpointA)
+			s.setOrdered(path.EndpointB)
+
+			payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+
+			// make timeoutTimestamp 1 second more than sending chain time to ensure it passes SendPacket
+			// and times out successfully after update
+			timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Second).Unix())
+
+			_, _, err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SendPacketTest(s.chainA.GetContext(), path.EndpointA.ClientID, timeoutTimestamp, []types.Payload{payload})
+			s.Require().NoError(err)
+			packet = types.NewPacket(1, path.EndpointA.ClientID, path.EndpointB.ClientID, timeoutTimestamp, payload)
+			nextSequenceRecv = 1
+
+			tc.malleate()
+
+			// commit the changes and update the clients
+			s.coordinator.CommitBlock(path.EndpointA.Chain)
+			s.Require().NoError(path.EndpointB.UpdateClient())
+			s.Require().NoError(path.EndpointA.UpdateClient())
+
+			key := hostv2.NextSequenceRecvKey(packet.DestinationClient)
+			if nextSequenceRecv == 0 {
+				key = hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
+			}
+			proof, proofHeight := path.EndpointB.QueryProof(key)
+
+			circuitBreaker := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetCircuitBreaker(s.chainA.GetContext(), packet.SourceClient)
+
+			err = s.chainA.App.GetIBCKeeper().ChannelKeeperV2.TimeoutPacketTest(s.chainA.GetContext(), packet, proof, proofHeight, nextSequenceRecv)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+
+				commitment := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(s.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+				s.Require().Nil(commitment, "packet commitment not deleted")
+
+				// the client is closed for sending packets while its circuit breaker is left as is
+				s.Require().True(s.chainA.App.GetIBCKeeper().ChannelKeeperV2.IsOrderedClientClosed(s.chainA.GetContext(), packet.SourceClient))
+				s.Require().Equal(circuitBreaker, s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetCircuitBreaker(s.chainA.GetContext(), packet.SourceClient))
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().False(s.chainA.App.GetIBCKeeper().ChannelKeeperV2.IsOrderedClientClosed(s.chainA.GetContext(), packet.SourceClient))
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestAliasedChannel() {
 	path := ibctesting.NewPath(s.chainA, s.chainB)
 	path.Setup()
@@ -865,3 +1280,10 @@ func (s *KeeperTestSuite) mockV1Format(endpoint *ibctesting.Endpoint) {
 	clientStore := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(endpoint.Chain.GetContext(), endpoint.ChannelID)
 	clientStore.Delete(clientv2types.CounterpartyKey())
 }
+
+// setOrdered updates the config of the client of the endpoint to deliver packets in order, and stores that its
+// counterparty has been verified to be ordered.
+func (s *KeeperTestSuite) setOrdered(endpoint *ibctesting.Endpoint) {
+	s.Require().NoError(endpoint.UpdateClientConfig(clientv2types.Config{Ordered: true}))
+	endpoint.Chain.App.GetIBCKeeper().ChannelKeeperV2.SetCounterpartyOrdered(endpoint.Chain.GetContext(), endpoint.ClientID)
+}
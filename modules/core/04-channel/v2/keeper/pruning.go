@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// SetPruningState stores the pruning progress of a client.
+func (k *Keeper) SetPruningState(ctx sdk.Context, pruningState types.PruningState) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pruningState)
+	if err := store.Set(types.PruningStateKey(pruningState.ClientId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetPruningState returns the pruning progress of a client.
+// If no packets were ever scheduled for pruning, an empty pruning state is returned.
+func (k *Keeper) GetPruningState(ctx sdk.Context, clientID string) types.PruningState {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PruningStateKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.PruningState{ClientId: clientID}
+	}
+
+	var pruningState types.PruningState
+	k.cdc.MustUnmarshal(bz, &pruningState)
+	return pruningState
+}
+
+// SetPrunablePacket stores an acknowledged packet received over a client whose receipt and acknowledgement
+// can be pruned once the pruning timestamp has passed.
+func (k *Keeper) SetPrunablePacket(ctx sdk.Context, clientID string, sequence, pruningTimestamp uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PruningQueueKey(clientID, sequence), sdk.Uint64ToBigEndian(pruningTimestamp)); err != nil {
+		panic(err)
+	}
+}
+
+// GetPrunablePacket returns the pruning timestamp of an acknowledged packet received over a client whose receipt
+// and acknowledgement are still to be pruned. It returns false if the packet is not scheduled for pruning.
+func (k *Keeper) GetPrunablePacket(ctx sdk.Context, clientID string, sequence uint64) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PruningQueueKey(clientID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// deletePrunablePacket deletes an acknowledged packet from the pruning queue of a client.
+func (k *Keeper) deletePrunablePacket(ctx sdk.Context, clientID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PruningQueueKey(clientID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPrunablePacketsForClient returns all acknowledged packets whose receipts and acknowledgements are still
+// to be pruned for a specified client ID, with their big endian encoded pruning timestamp as data.
+func (k *Keeper) GetAllPrunablePacketsForClient(ctx sdk.Context, clientID string) []types.PacketState {
+	var packets []types.PacketState
+	k.iteratePruningQueue(ctx, clientID, func(sequence, pruningTimestamp uint64) bool {
+		packets = append(packets, types.NewPacketState(clientID, sequence, sdk.Uint64ToBigEndian(pruningTimestamp)))
+		return false
+	})
+	return packets
+}
+
+// iteratePruningQueue iterates over the pruning queue of a client in order of the sequences of the packets.
+// Iteration stops when the callback returns true.
+func (k *Keeper) iteratePruningQueue(ctx sdk.Context, clientID string, cb func(sequence, pruningTimestamp uint64) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storePrefix := types.PruningQueuePrefixKey(clientID)
+	iterator := storetypes.KVStorePrefixIterator(store, storePrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(storePrefix):]
+		if len(key) != 8 {
+			panic("pruning queue key must contain the sequence")
+		}
+
+		if cb(sdk.BigEndianToUint64(key), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// schedulePruning adds an acknowledged packet to the pruning queue of its destination client if the client
+// has a pruning delay. The receipt and acknowledgement of the packet can be pruned once the pruning delay
+// has elapsed after the timeout of the packet, or after the acknowledgement was written if it was written
+// asynchronously after the timeout.
+func (k *Keeper) schedulePruning(ctx sdk.Context, packet types.Packet) {
+	pruningDelay := k.clientV2Keeper.GetConfig(ctx, packet.DestinationClient).PruningDelay
+	if pruningDelay == 0 {
+		return
+	}
+
+	pruningTimestamp := max(packet.TimeoutTimestamp, uint64(ctx.BlockTime().Unix())) + pruningDelay
+	k.SetPrunablePacket(ctx, packet.DestinationClient, packet.Sequence, pruningTimestamp)
+
+	pruningState := k.GetPruningState(ctx, packet.DestinationClient)
+	pruningState.TotalRemaining++
+	k.SetPruningState(ctx, pruningState)
+}
+
+// pruneAcknowledgements deletes the receipts and acknowledgements of the given packets received over the given
+// client once their pruning timestamp has passed and the counterparty is proven to have deleted their packet
+// commitments. As the sender only deletes the commitment of a packet once it has processed its acknowledgement,
+// the acknowledgement is never pruned before it has been relayed, and the packet can no longer be timed out nor
+// received again: its timeout cannot be proven without its commitment, and it cannot be relayed again after its
+// timeout, which is before its pruning timestamp.
+//
+// Packets which are not scheduled for pruning, e.g. as they have already been pruned, are skipped.
+// The number of pruned packets and the number of acknowledged packets still to be pruned are returned.
+func (k *Keeper) pruneAcknowledgements(
+	ctx sdk.Context,
+	clientID string,
+	sequences []uint64,
+	proofs [][]byte,
+	proofHeight exported.Height,
+) (uint64, uint64, error) {
+	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, clientID)
+	if !ok {
+		return 0, 0, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", clientID)
+	}
+
+	// Before we do client keeper level checks, we first get underlying base clientID
+	baseClientID := clientID
+	if underlyingClientID, isAlias := k.GetClientForAlias(ctx, clientID); isAlias {
+		baseClientID = underlyingClientID
+	}
+
+	currentTimestamp := uint64(ctx.BlockTime().Unix())
+	pruningState := k.GetPruningState(ctx, clientID)
+
+	var totalPruned uint64
+	for i, sequence := range sequences {
+		pruningTimestamp, found := k.GetPrunablePacket(ctx, clientID, sequence)
+		if !found {
+			continue
+		}
+
+		if pruningTimestamp > currentTimestamp {
+			return 0, 0, errorsmod.Wrapf(types.ErrPruningDelayNotElapsed, "sequence: %d, pruning timestamp: %d, current timestamp: %d", sequence, pruningTimestamp, currentTimestamp)
+		}
+
+		path := hostv2.PacketCommitmentKey(counterparty.ClientId, sequence)
+		merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
+
+		if err := k.ClientKeeper.VerifyNonMembership(
+			ctx,
+			baseClientID,
+			proofHeight,
+			0, 0,
+			proofs[i],
+			merklePath,
+		); err != nil {
+			return 0, 0, errorsmod.Wrapf(err, "failed packet commitment absence verification for client (%s)", baseClientID)
+		}
+
+		k.deletePacketReceipt(ctx, clientID, sequence)
+		k.deletePacketAcknowledgement(ctx, clientID, sequence)
+		k.deletePrunablePacket(ctx, clientID, sequence)
+
+		totalPruned++
+	}
+
+	if totalPruned == 0 {
+		return 0, pruningState.TotalRemaining, nil
+	}
+
+	pruningState.TotalPruned += totalPruned
+	pruningState.TotalRemaining -= totalPruned
+	k.SetPruningState(ctx, pruningState)
+
+	k.Logger(ctx).Info("packets pruned", "client_id", clientID, "total_pruned", strconv.FormatUint(totalPruned, 10))
+
+	emitPrunePacketsEvent(ctx, pruningState, totalPruned)
+
+	return totalPruned, pruningState.TotalRemaining, nil
+}
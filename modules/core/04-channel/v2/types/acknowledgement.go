@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -21,6 +22,27 @@ func NewAcknowledgement(appAcknowledgements ...[]byte) Acknowledgement {
 	return Acknowledgement{AppAcknowledgements: appAcknowledgements}
 }
 
+// NewNonAtomicAcknowledgement creates a new Acknowledgement of a non-atomic packet containing the provided
+// app acknowledgements, one for each payload of the packet.
+func NewNonAtomicAcknowledgement(appAcknowledgements ...[]byte) Acknowledgement {
+	return Acknowledgement{AppAcknowledgements: appAcknowledgements, NonAtomic: true}
+}
+
+// NewErrorAcknowledgement creates the acknowledgement of an unsuccessful receive of the given packet.
+// It contains the sentinel error acknowledgement as its single app acknowledgement, unless the packet is
+// non-atomic, in which case it contains the sentinel error acknowledgement for each payload of the packet.
+func NewErrorAcknowledgement(packet Packet) Acknowledgement {
+	if !packet.NonAtomic {
+		return NewAcknowledgement(ErrorAcknowledgement[:])
+	}
+
+	appAcknowledgements := make([][]byte, len(packet.Payloads))
+	for i := range appAcknowledgements {
+		appAcknowledgements[i] = ErrorAcknowledgement[:]
+	}
+	return NewNonAtomicAcknowledgement(appAcknowledgements...)
+}
+
 // Validate performs a basic validation of the acknowledgement
 func (ack Acknowledgement) Validate() error {
 	// acknowledgement list should be non-empty
@@ -35,8 +57,9 @@ func (ack Acknowledgement) Validate() error {
 		}
 
 		// Ensure that the app acknowledgement contains ErrorAcknowledgement
-		// **if and only if** the app acknowledgement list has a single element
-		if len(ack.AppAcknowledgements) > 1 {
+		// **if and only if** the app acknowledgement list has a single element,
+		// unless each payload of a non-atomic packet is acknowledged independently
+		if len(ack.AppAcknowledgements) > 1 && !ack.NonAtomic {
 			if bytes.Equal(a, ErrorAcknowledgement[:]) {
 				return errorsmod.Wrap(ErrInvalidAcknowledgement, "cannot have the error acknowledgement in multi acknowledgement list")
 			}
@@ -47,8 +70,19 @@ func (ack Acknowledgement) Validate() error {
 }
 
 // Success returns true if the acknowledgement is successful
-// it implements the exported.Acknowledgement interface
+// it implements the exported.Acknowledgement interface.
+// The acknowledgement of a non-atomic packet is successful if any of its app acknowledgements
+// is not the sentinel error acknowledgement. As the failure acknowledgements of applications are opaque
+// to core IBC, it does not imply that any payload was received successfully: the outcome of each payload
+// is decoded by its application from its own app acknowledgement, and core IBC does not rely on it for
+// the acknowledgements of non-atomic packets.
 func (ack Acknowledgement) Success() bool {
+	if ack.NonAtomic {
+		return slices.ContainsFunc(ack.AppAcknowledgements, func(appAck []byte) bool {
+			return !bytes.Equal(appAck, ErrorAcknowledgement[:])
+		})
+	}
+
 	return !bytes.Equal(ack.AppAcknowledgements[0], ErrorAcknowledgement[:])
 }
 
@@ -26,6 +26,11 @@ func (s *TypesTestSuite) Test_ValidateAcknowledgement() {
 			types.NewAcknowledgement([]byte("appAck1"), []byte("appAck2")),
 			nil,
 		},
+		{
+			"success: error acknowledgement in non-atomic multiple payload list",
+			types.NewNonAtomicAcknowledgement(types.ErrorAcknowledgement[:], []byte("appAck2")),
+			nil,
+		},
 		{
 			"failure: empty acknowledgement",
 			types.NewAcknowledgement(),
@@ -58,3 +63,45 @@ func (s *TypesTestSuite) Test_ValidateAcknowledgement() {
 		})
 	}
 }
+
+// Test_AcknowledgementSuccess tests the acknowledgements Success method
+func (s *TypesTestSuite) Test_AcknowledgementSuccess() {
+	testCases := []struct {
+		name       string
+		ack        types.Acknowledgement
+		expSuccess bool
+	}{
+		{
+			"successful ack",
+			types.NewAcknowledgement([]byte("appAck1"), []byte("appAck2")),
+			true,
+		},
+		{
+			"failed ack",
+			types.NewAcknowledgement(types.ErrorAcknowledgement[:]),
+			false,
+		},
+		{
+			"non-atomic ack with a failed payload",
+			types.NewNonAtomicAcknowledgement(types.ErrorAcknowledgement[:], []byte("appAck2")),
+			true,
+		},
+		{
+			"non-atomic ack with all payloads failed",
+			types.NewErrorAcknowledgement(types.Packet{NonAtomic: true, Payloads: make([]types.Payload, 2)}),
+			false,
+		},
+		{
+			// the failure acknowledgements of applications are opaque to core IBC
+			"non-atomic ack with all payloads failed with application failure acknowledgements",
+			types.NewNonAtomicAcknowledgement([]byte("appErrorAck1"), []byte("appErrorAck2")),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expSuccess, tc.ack.Success())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// Maximum length of the circuit breaker guardians list
+const MaxCircuitBreakerGuardiansLength = 20
+
+// NewCircuitBreaker creates a new CircuitBreaker instance for a v1 channel or v2 client.
+func NewCircuitBreaker(id string, sendPaused, recvPaused bool) CircuitBreaker {
+	return CircuitBreaker{
+		Id:         id,
+		SendPaused: sendPaused,
+		RecvPaused: recvPaused,
+	}
+}
+
+// IsTripped returns true if the sending or receiving of packets is paused.
+func (cb CircuitBreaker) IsTripped() bool {
+	return cb.SendPaused || cb.RecvPaused
+}
+
+// Validate performs basic validation of the circuit breaker.
+func (cb CircuitBreaker) Validate() error {
+	if err := host.ClientIdentifierValidator(cb.Id); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCircuitBreaker, "invalid channel or client ID: %s", err)
+	}
+	return nil
+}
+
+// ValidateCircuitBreakerGuardians ensures all guardians are valid and unique sdk addresses.
+func ValidateCircuitBreakerGuardians(guardians []string) error {
+	if len(guardians) > MaxCircuitBreakerGuardiansLength {
+		return fmt.Errorf("circuit breaker guardians length must not exceed %d items", MaxCircuitBreakerGuardiansLength)
+	}
+
+	seen := make(map[string]bool)
+	for _, guardian := range guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("invalid circuit breaker guardian address: %s", guardian)
+		}
+		if seen[guardian] {
+			return fmt.Errorf("duplicate circuit breaker guardian address: %s", guardian)
+		}
+		seen[guardian] = true
+	}
+	return nil
+}
+
+// IsGuardian returns true if the given address is a circuit breaker guardian.
+func (g CircuitBreakerGuardians) IsGuardian(address string) bool {
+	for _, guardian := range g.Guardians {
+		if guardian == address {
+			return true
+		}
+	}
+	return false
+}
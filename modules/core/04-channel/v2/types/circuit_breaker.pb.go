@@ -0,0 +1,782 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/channel/v2/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreaker defines the packet flows that are paused on a v1 channel or a v2 client.
+// Acknowledgements and timeouts of packets are never paused, so that in-flight funds can be returned.
+type CircuitBreaker struct {
+	// channel or client unique identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// send_paused rejects the sending of packets
+	SendPaused bool `protobuf:"varint,2,opt,name=send_paused,json=sendPaused,proto3" json:"send_paused,omitempty"`
+	// recv_paused rejects the receiving of packets without writing a receipt or acknowledgement, so that they can be
+	// relayed again once receiving is resumed
+	RecvPaused bool `protobuf:"varint,3,opt,name=recv_paused,json=recvPaused,proto3" json:"recv_paused,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778a0f8940a18256, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetSendPaused() bool {
+	if m != nil {
+		return m.SendPaused
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetRecvPaused() bool {
+	if m != nil {
+		return m.RecvPaused
+	}
+	return false
+}
+
+// CircuitBreakerAnomalies defines the number of anomalies reported for a channel or client during an epoch.
+// The epoch is defined by the reporter, e.g. the rate limit window of the rate-limiting middleware.
+type CircuitBreakerAnomalies struct {
+	// epoch in which the anomalies were reported
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// number of anomalies reported during the epoch
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *CircuitBreakerAnomalies) Reset()         { *m = CircuitBreakerAnomalies{} }
+func (m *CircuitBreakerAnomalies) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerAnomalies) ProtoMessage()    {}
+func (*CircuitBreakerAnomalies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778a0f8940a18256, []int{1}
+}
+func (m *CircuitBreakerAnomalies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerAnomalies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerAnomalies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerAnomalies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerAnomalies.Merge(m, src)
+}
+func (m *CircuitBreakerAnomalies) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerAnomalies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerAnomalies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerAnomalies proto.InternalMessageInfo
+
+func (m *CircuitBreakerAnomalies) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CircuitBreakerAnomalies) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// CircuitBreakerGuardians defines the addresses, in addition to the authority, that are allowed to
+// pause and resume the packet flows of a channel or client.
+type CircuitBreakerGuardians struct {
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+}
+
+func (m *CircuitBreakerGuardians) Reset()         { *m = CircuitBreakerGuardians{} }
+func (m *CircuitBreakerGuardians) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerGuardians) ProtoMessage()    {}
+func (*CircuitBreakerGuardians) Descriptor() ([]byte, []int) {
+	return fileDescriptor_778a0f8940a18256, []int{2}
+}
+func (m *CircuitBreakerGuardians) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerGuardians) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerGuardians.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerGuardians) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerGuardians.Merge(m, src)
+}
+func (m *CircuitBreakerGuardians) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerGuardians) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerGuardians.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerGuardians proto.InternalMessageInfo
+
+func (m *CircuitBreakerGuardians) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CircuitBreaker)(nil), "ibc.core.channel.v2.CircuitBreaker")
+	proto.RegisterType((*CircuitBreakerAnomalies)(nil), "ibc.core.channel.v2.CircuitBreakerAnomalies")
+	proto.RegisterType((*CircuitBreakerGuardians)(nil), "ibc.core.channel.v2.CircuitBreakerGuardians")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/channel/v2/circuit_breaker.proto", fileDescriptor_778a0f8940a18256)
+}
+
+var fileDescriptor_778a0f8940a18256 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
+	0x1c, 0x86, 0x9b, 0xb6, 0x8a, 0x8d, 0xd0, 0xe1, 0x14, 0xec, 0x20, 0xb1, 0x74, 0xaa, 0x43, 0x2f,
+	0xb5, 0x0a, 0x4e, 0x0e, 0x56, 0xc4, 0x55, 0x6e, 0x11, 0x5c, 0x4a, 0xf2, 0x4b, 0x68, 0x83, 0x77,
+	0xc9, 0x91, 0x5c, 0x0e, 0xfc, 0x16, 0x7e, 0x2c, 0xc7, 0x8e, 0x8e, 0x72, 0xf7, 0x45, 0xe4, 0x2e,
+	0x16, 0xff, 0x8c, 0xef, 0xc3, 0xc3, 0xfb, 0xc2, 0x8b, 0xcf, 0x15, 0x07, 0x0a, 0xc6, 0x4a, 0x0a,
+	0x1b, 0xa6, 0xb5, 0x4c, 0x69, 0xb9, 0xa0, 0xa0, 0x2c, 0x78, 0x55, 0xac, 0xb8, 0x95, 0xec, 0x45,
+	0xda, 0x38, 0xb7, 0xa6, 0x30, 0xd1, 0x91, 0xe2, 0x10, 0x37, 0x6a, 0xfc, 0xad, 0xc6, 0xe5, 0x62,
+	0xc2, 0xf1, 0xf0, 0x2e, 0xd8, 0xcb, 0x20, 0x47, 0x43, 0xdc, 0x55, 0x62, 0x84, 0xc6, 0x68, 0x3a,
+	0x48, 0xba, 0x4a, 0x44, 0x67, 0xf8, 0xd0, 0x49, 0x2d, 0x56, 0x39, 0xf3, 0x4e, 0x8a, 0x51, 0x77,
+	0x8c, 0xa6, 0x07, 0x09, 0x6e, 0xd0, 0x63, 0x4b, 0x1a, 0xc1, 0x4a, 0x28, 0x77, 0x42, 0x2f, 0x08,
+	0x0d, 0x0a, 0xc2, 0xe4, 0x1e, 0x9f, 0xfc, 0xdd, 0xb8, 0xd5, 0x26, 0x63, 0xa9, 0x92, 0x2e, 0x3a,
+	0xc6, 0x7b, 0x32, 0x37, 0xb0, 0x69, 0xf7, 0xfa, 0x49, 0x08, 0x0d, 0x05, 0xe3, 0x75, 0xd1, 0x8e,
+	0xf5, 0x93, 0x10, 0x26, 0xd7, 0xff, 0x6b, 0x1e, 0x3c, 0xb3, 0x42, 0x31, 0xed, 0xa2, 0x53, 0x3c,
+	0x58, 0xef, 0xc2, 0x08, 0x8d, 0x7b, 0xd3, 0x41, 0xf2, 0x03, 0x96, 0x4f, 0xef, 0x15, 0x41, 0xdb,
+	0x8a, 0xa0, 0xcf, 0x8a, 0xa0, 0xb7, 0x9a, 0x74, 0xb6, 0x35, 0xe9, 0x7c, 0xd4, 0xa4, 0xf3, 0x7c,
+	0xb3, 0x56, 0xc5, 0xc6, 0xf3, 0x18, 0x4c, 0x46, 0xc1, 0xb8, 0xcc, 0x38, 0xaa, 0x38, 0xcc, 0xd6,
+	0x86, 0x96, 0x17, 0x73, 0x9a, 0x19, 0xe1, 0x53, 0xe9, 0xc2, 0xbd, 0xf3, 0xab, 0xd9, 0xaf, 0x87,
+	0x8b, 0xd7, 0x5c, 0x3a, 0xbe, 0xdf, 0x1e, 0x7b, 0xf9, 0x35, 0x00, 0xee, 0xfa, 0x85, 0x25, 0x85,
+	0x01, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecvPaused {
+		i--
+		if m.RecvPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendPaused {
+		i--
+		if m.SendPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerAnomalies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerAnomalies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerAnomalies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerGuardians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerGuardians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerGuardians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.SendPaused {
+		n += 2
+	}
+	if m.RecvPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *CircuitBreakerAnomalies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Epoch))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *CircuitBreakerGuardians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovCircuitBreaker(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecvPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerAnomalies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerAnomalies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerAnomalies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerGuardians) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerGuardians: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerGuardians: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,6 +15,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRecvPacket{},
 		&MsgTimeout{},
 		&MsgAcknowledgement{},
+		&MsgUpdateCircuitBreaker{},
+		&MsgUpdateCircuitBreakerGuardians{},
+		&MsgPruneAcknowledgements{},
+		&MsgVerifyCounterpartyOrdering{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -9,6 +9,7 @@ import (
 // CommitPacket returns the V2 packet commitment bytes. The commitment consists of:
 // ha256_hash(0x02 + sha256_hash(destinationClient) + sha256_hash(timeout) + sha256_hash(payload)) from a given packet.
 // This results in a fixed length preimage of 32 bytes.
+// The commitment of a non-atomic packet is prefixed with 0x03 instead of 0x02.
 // NOTE: A fixed length preimage is ESSENTIAL to prevent relayers from being able
 // to malleate the packet fields and create a commitment hash that matches the original packet.
 func CommitPacket(packet Packet) []byte {
@@ -27,7 +28,7 @@ func CommitPacket(packet Packet) []byte {
 	appHash := sha256.Sum256(appBytes)
 	buf = append(buf, appHash[:]...)
 
-	buf = append([]byte{byte(2)}, buf...)
+	buf = append([]byte{commitmentPrefix(packet.NonAtomic)}, buf...)
 
 	hash := sha256.Sum256(buf)
 	return hash[:]
@@ -52,6 +53,8 @@ func hashPayload(data Payload) []byte {
 
 // CommitAcknowledgement returns the V2 acknowledgement commitment bytes. The commitment consists of:
 // sha256_hash(0x02 + sha256_hash(ack1) + sha256_hash(ack2) + ...) from a given acknowledgement.
+// The commitment of the acknowledgement of a non-atomic packet is prefixed with 0x03 instead of 0x02,
+// so that it can never match the commitment of an atomic acknowledgement with the same app acknowledgements.
 func CommitAcknowledgement(acknowledgement Acknowledgement) []byte {
 	var buf []byte
 	for _, ack := range acknowledgement.GetAppAcknowledgements() {
@@ -59,8 +62,16 @@ func CommitAcknowledgement(acknowledgement Acknowledgement) []byte {
 		buf = append(buf, hash[:]...)
 	}
 
-	buf = append([]byte{byte(2)}, buf...)
+	buf = append([]byte{commitmentPrefix(acknowledgement.NonAtomic)}, buf...)
 
 	hash := sha256.Sum256(buf)
 	return hash[:]
 }
+
+// commitmentPrefix returns the prefix of the packet and acknowledgement commitment preimages.
+func commitmentPrefix(nonAtomic bool) byte {
+	if nonAtomic {
+		return byte(3)
+	}
+	return byte(2)
+}
@@ -42,6 +42,13 @@ func TestCommitPacket(t *testing.T) {
 			},
 			"d408dca5088b9b375edb3c4df6bae0e18084fc0dbd90fcd0d028506553c81b25",
 		},
+		{
+			"non-atomic json packet",
+			func() {
+				packet.NonAtomic = true
+			},
+			"2799d3e812437b498a93f310beedfb32a109012e09bc6136da704e0da82aad7e",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -98,4 +105,16 @@ func TestCommitAcknowledgement(t *testing.T) {
 
 	failedAckCommitment := types.CommitAcknowledgement(failedAck)
 	require.Equal(t, "e2fb30dfbf7abdeaca82d426534d2b3a9d5444dd2a87fa16d38b77ba1a13ced7", hex.EncodeToString(failedAckCommitment))
+
+	// the acknowledgements of non-atomic packets are committed with a distinct prefix
+	nonAtomicAck := types.NewNonAtomicAcknowledgement([]byte("some bytes"))
+
+	nonAtomicAckCommitment := types.CommitAcknowledgement(nonAtomicAck)
+	require.Equal(t, "66d5a0afa1aba102215db8467845777990e9b7e48349925614ec1514f11166d4", hex.EncodeToString(nonAtomicAckCommitment))
+	require.NotEqual(t, commitment, nonAtomicAckCommitment)
+
+	partialAck := types.NewNonAtomicAcknowledgement([]byte("some bytes"), types.ErrorAcknowledgement[:])
+
+	partialAckCommitment := types.CommitAcknowledgement(partialAck)
+	require.Equal(t, "d989c9ef1d15d4ad51645ececdf10724e202c6be151283cef899f87ea272fa33", hex.EncodeToString(partialAckCommitment))
 }
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// receivedPayloadKey is the context key under which the payload passed to OnRecvPacket is identified.
+type receivedPayloadKey struct{}
+
+// ReceivedPayload identifies the payload of a received packet an application is called with in OnRecvPacket.
+// Applications which acknowledge their payload asynchronously use its index to write the app acknowledgement
+// of the payload with WritePayloadAcknowledgement.
+type ReceivedPayload struct {
+	// the received packet
+	Packet Packet
+	// the index of the payload in the packet
+	Index uint32
+}
+
+// CanFailAsynchronously returns true if the payload can be acknowledged asynchronously with the sentinel error
+// acknowledgement. This is not the case for an atomic packet with multiple payloads, as the state changes of its
+// other payloads have already been committed when the payload is acknowledged and cannot be reverted.
+func (p ReceivedPayload) CanFailAsynchronously() bool {
+	return p.Packet.NonAtomic || len(p.Packet.Payloads) == 1
+}
+
+// WithReceivedPayload returns a copy of the context identifying the payload passed to OnRecvPacket.
+func WithReceivedPayload(ctx sdk.Context, packet Packet, index uint32) sdk.Context {
+	return ctx.WithValue(receivedPayloadKey{}, ReceivedPayload{Packet: packet, Index: index})
+}
+
+// GetReceivedPayload returns the payload of a received packet identified by the context passed to OnRecvPacket.
+// It returns false if the context does not identify a received payload.
+func GetReceivedPayload(ctx sdk.Context) (ReceivedPayload, bool) {
+	receivedPayload, ok := ctx.Value(receivedPayloadKey{}).(ReceivedPayload)
+	return receivedPayload, ok
+}
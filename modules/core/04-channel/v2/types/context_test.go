@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+)
+
+// Test_ReceivedPayload tests that the received payload is identified by the context
+func (s *TypesTestSuite) Test_ReceivedPayload() {
+	testCases := []struct {
+		name                  string
+		packet                types.Packet
+		expFailAsynchronously bool
+	}{
+		{
+			"single payload",
+			types.Packet{Payloads: make([]types.Payload, 1)},
+			true,
+		},
+		{
+			"atomic packet with multiple payloads",
+			types.Packet{Payloads: make([]types.Payload, 2)},
+			false,
+		},
+		{
+			"non-atomic packet with multiple payloads",
+			types.Packet{Payloads: make([]types.Payload, 2), NonAtomic: true},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.chainA.GetContext()
+
+			_, found := types.GetReceivedPayload(ctx)
+			s.Require().False(found)
+
+			ctx = types.WithReceivedPayload(ctx, tc.packet, 1)
+
+			receivedPayload, found := types.GetReceivedPayload(ctx)
+			s.Require().True(found)
+			s.Require().Equal(types.ReceivedPayload{Packet: tc.packet, Index: 1}, receivedPayload)
+			s.Require().Equal(tc.expFailAsynchronously, receivedPayload.CanFailAsynchronously())
+		})
+	}
+}
@@ -16,4 +16,22 @@ var (
 	ErrTimeoutNotReached        = errorsmod.Register(SubModuleName, 10, "timeout not reached")
 	ErrAcknowledgementExists    = errorsmod.Register(SubModuleName, 11, "acknowledgement for packet already exists")
 	ErrNoOpMsg                  = errorsmod.Register(SubModuleName, 12, "message is redundant, no-op will be performed")
+	// ErrCircuitBreakerTripped is returned when sending or receiving a packet on a paused channel or client.
+	// A packet received while paused is not received, no receipt or acknowledgement is written, so that it
+	// can be relayed again once the circuit breaker is reset.
+	ErrCircuitBreakerTripped = errorsmod.Register(SubModuleName, 13, "circuit breaker tripped")
+	ErrInvalidCircuitBreaker = errorsmod.Register(SubModuleName, 14, "invalid circuit breaker")
+	// ErrPacketSequenceOutOfOrder is returned when receiving a packet over an ordered client whose sequence
+	// is not the next receive sequence of the client.
+	ErrPacketSequenceOutOfOrder = errorsmod.Register(SubModuleName, 15, "packet sequence is out of order")
+	ErrSequenceReceiveNotFound  = errorsmod.Register(SubModuleName, 16, "sequence receive not found")
+	// ErrPruningDelayNotElapsed is returned when pruning the receipt and acknowledgement of a packet whose
+	// pruning delay has not elapsed yet.
+	ErrPruningDelayNotElapsed = errorsmod.Register(SubModuleName, 17, "pruning delay not elapsed")
+	// ErrCounterpartyOrderingNotVerified is returned when sending or receiving a packet over an ordered client
+	// whose counterparty has not been verified to be ordered with MsgVerifyCounterpartyOrdering.
+	ErrCounterpartyOrderingNotVerified = errorsmod.Register(SubModuleName, 18, "counterparty ordering not verified")
+	// ErrOrderedClientClosed is returned when sending a packet over an ordered client which has been closed
+	// after one of its packets timed out.
+	ErrOrderedClientClosed = errorsmod.Register(SubModuleName, 19, "ordered client closed")
 )
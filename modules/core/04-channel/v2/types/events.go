@@ -8,11 +8,15 @@ import (
 
 // IBC v2 core events
 const (
-	EventTypeSendPacket        = "send_packet"
-	EventTypeRecvPacket        = "recv_packet"
-	EventTypeTimeoutPacket     = "timeout_packet"
-	EventTypeAcknowledgePacket = "acknowledge_packet"
-	EventTypeWriteAck          = "write_acknowledgement"
+	EventTypeSendPacket                 = "send_packet"
+	EventTypeRecvPacket                 = "recv_packet"
+	EventTypeTimeoutPacket              = "timeout_packet"
+	EventTypeAcknowledgePacket          = "acknowledge_packet"
+	EventTypeWriteAck                   = "write_acknowledgement"
+	EventTypeUpdateCircuitBreaker       = "update_circuit_breaker"
+	EventTypePrunePackets               = "prune_packets"
+	EventTypeVerifyCounterpartyOrdering = "verify_counterparty_ordering"
+	EventTypeCloseOrderedClient         = "close_ordered_client"
 
 	AttributeKeySrcClient        = "packet_source_client"
 	AttributeKeyDstClient        = "packet_dest_client"
@@ -20,6 +24,12 @@ const (
 	AttributeKeyTimeoutTimestamp = "packet_timeout_timestamp"
 	AttributeKeyEncodedPacketHex = "encoded_packet_hex"
 	AttributeKeyEncodedAckHex    = "encoded_acknowledgement_hex"
+	AttributeKeyCircuitBreakerID = "circuit_breaker_id"
+	AttributeKeySendPaused       = "send_paused"
+	AttributeKeyRecvPaused       = "recv_paused"
+	AttributeKeyClientID         = "client_id"
+	AttributeKeyTotalPruned      = "total_pruned"
+	AttributeKeyTotalRemaining   = "total_remaining"
 )
 
 // IBC v2 core events vars
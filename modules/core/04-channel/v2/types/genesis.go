@@ -54,11 +54,18 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel v2 submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Acknowledgements: []PacketState{},
-		Receipts:         []PacketState{},
-		Commitments:      []PacketState{},
-		AsyncPackets:     []PacketState{},
-		SendSequences:    []PacketSequence{},
+		Acknowledgements:           []PacketState{},
+		Receipts:                   []PacketState{},
+		Commitments:                []PacketState{},
+		AsyncPackets:               []PacketState{},
+		SendSequences:              []PacketSequence{},
+		CircuitBreakers:            []CircuitBreaker{},
+		AsyncAcknowledgements:      []PacketState{},
+		RecvSequences:              []PacketSequence{},
+		PruningQueue:               []PacketState{},
+		PruningStates:              []PruningState{},
+		CounterpartyOrderedClients: []string{},
+		ClosedOrderedClients:       []string{},
 	}
 }
 
@@ -97,12 +104,64 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, aa := range gs.AsyncAcknowledgements {
+		if err := aa.Validate(); err != nil {
+			return fmt.Errorf("invalid async acknowledgement %v index %d: %w", aa, i, err)
+		}
+		if len(aa.Data) == 0 {
+			return fmt.Errorf("invalid async acknowledgement %v index %d: data bytes cannot be empty", aa, i)
+		}
+	}
+
 	for i, ss := range gs.SendSequences {
 		if err := ss.Validate(); err != nil {
 			return fmt.Errorf("invalid send sequence %v index %d: %w", ss, i, err)
 		}
 	}
 
+	for i, rs := range gs.RecvSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid recv sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
+	for i, pp := range gs.PruningQueue {
+		if err := pp.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning queue packet %v index %d: %w", pp, i, err)
+		}
+		if len(pp.Data) != 8 {
+			return fmt.Errorf("invalid pruning queue packet %v index %d: data bytes must be a big endian encoded pruning timestamp", pp, i)
+		}
+	}
+
+	for i, ps := range gs.PruningStates {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning state %v index %d: %w", ps, i, err)
+		}
+	}
+
+	for i, clientID := range gs.CounterpartyOrderedClients {
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return fmt.Errorf("invalid counterparty ordered client %s index %d: %w", clientID, i, err)
+		}
+	}
+
+	for i, clientID := range gs.ClosedOrderedClients {
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return fmt.Errorf("invalid closed ordered client %s index %d: %w", clientID, i, err)
+		}
+	}
+
+	for i, cb := range gs.CircuitBreakers {
+		if err := cb.Validate(); err != nil {
+			return fmt.Errorf("invalid circuit breaker %v index %d: %w", cb, i, err)
+		}
+	}
+
+	if err := ValidateCircuitBreakerGuardians(gs.CircuitBreakerGuardians); err != nil {
+		return fmt.Errorf("invalid circuit breaker guardians: %w", err)
+	}
+
 	return nil
 }
 
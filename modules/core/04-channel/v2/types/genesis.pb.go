@@ -30,6 +30,23 @@ type GenesisState struct {
 	Receipts         []PacketState    `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts"`
 	AsyncPackets     []PacketState    `protobuf:"bytes,5,rep,name=async_packets,json=asyncPackets,proto3" json:"async_packets"`
 	SendSequences    []PacketSequence `protobuf:"bytes,6,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences"`
+	// circuit breakers of the channels and clients with paused packet flows
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,7,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// addresses allowed to update the circuit breakers in addition to the authority
+	CircuitBreakerGuardians []string `protobuf:"bytes,8,rep,name=circuit_breaker_guardians,json=circuitBreakerGuardians,proto3" json:"circuit_breaker_guardians,omitempty"`
+	// pending acknowledgements of async packets with multiple payloads
+	AsyncAcknowledgements []PacketState `protobuf:"bytes,9,rep,name=async_acknowledgements,json=asyncAcknowledgements,proto3" json:"async_acknowledgements"`
+	// next receive sequences of the ordered clients
+	RecvSequences []PacketSequence `protobuf:"bytes,10,rep,name=recv_sequences,json=recvSequences,proto3" json:"recv_sequences"`
+	// acknowledged packets whose receipts and acknowledgements are still to be pruned, with their pruning timestamp
+	// as data
+	PruningQueue []PacketState `protobuf:"bytes,11,rep,name=pruning_queue,json=pruningQueue,proto3" json:"pruning_queue"`
+	// pruning progress of the clients with pruning delays
+	PruningStates []PruningState `protobuf:"bytes,12,rep,name=pruning_states,json=pruningStates,proto3" json:"pruning_states"`
+	// ordered clients whose counterparty has been verified to be ordered
+	CounterpartyOrderedClients []string `protobuf:"bytes,13,rep,name=counterparty_ordered_clients,json=counterpartyOrderedClients,proto3" json:"counterparty_ordered_clients,omitempty"`
+	// ordered clients which are closed for sending packets after one of their packets timed out
+	ClosedOrderedClients []string `protobuf:"bytes,14,rep,name=closed_ordered_clients,json=closedOrderedClients,proto3" json:"closed_ordered_clients,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +117,62 @@ func (m *GenesisState) GetSendSequences() []PacketSequence {
 	return nil
 }
 
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerGuardians() []string {
+	if m != nil {
+		return m.CircuitBreakerGuardians
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAsyncAcknowledgements() []PacketState {
+	if m != nil {
+		return m.AsyncAcknowledgements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecvSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningQueue() []PacketState {
+	if m != nil {
+		return m.PruningQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningStates() []PruningState {
+	if m != nil {
+		return m.PruningStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCounterpartyOrderedClients() []string {
+	if m != nil {
+		return m.CounterpartyOrderedClients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClosedOrderedClients() []string {
+	if m != nil {
+		return m.ClosedOrderedClients
+	}
+	return nil
+}
+
 // PacketState defines the generic type necessary to retrieve and store
 // packet commitments, acknowledgements, and receipts.
 // Caller is responsible for knowing the context necessary to interpret this
@@ -146,7 +219,7 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
-// PacketSequence defines the genesis type necessary to retrieve and store next send sequences.
+// PacketSequence defines the genesis type necessary to retrieve and store next send and receive sequences.
 type PacketSequence struct {
 	// client unique identifier.
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
@@ -210,32 +283,44 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v2/genesis.proto", fileDescriptor_b5d374f126f051c3) }
 
 var fileDescriptor_b5d374f126f051c3 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x6f, 0xda, 0x40,
-	0x18, 0xc6, 0x6d, 0xec, 0x22, 0x38, 0xfe, 0xa8, 0xba, 0x76, 0xb0, 0xa8, 0x64, 0x5c, 0xba, 0x78,
-	0xc1, 0x47, 0x69, 0xa7, 0x4a, 0x5d, 0x58, 0x5a, 0xd4, 0x05, 0xb9, 0x43, 0xa5, 0x2e, 0xd4, 0x3e,
-	0xbf, 0x32, 0x27, 0xec, 0x3b, 0x97, 0x3b, 0x88, 0xf8, 0x06, 0xd9, 0x92, 0x8f, 0x90, 0x8f, 0xc3,
-	0xc8, 0x98, 0x29, 0x8a, 0xe0, 0x8b, 0x44, 0xd8, 0x80, 0x88, 0x12, 0x45, 0x22, 0xdb, 0xeb, 0xd7,
-	0xcf, 0xef, 0xf7, 0xde, 0xf0, 0xa0, 0x8f, 0x2c, 0xa4, 0x84, 0x8a, 0x19, 0x10, 0x3a, 0x09, 0x38,
-	0x87, 0x84, 0x2c, 0xfa, 0x24, 0x06, 0x0e, 0x92, 0x49, 0x2f, 0x9b, 0x09, 0x25, 0xf0, 0x3b, 0x16,
-	0x52, 0x6f, 0x17, 0xf1, 0xf6, 0x11, 0x6f, 0xd1, 0x6f, 0xbd, 0x8f, 0x45, 0x2c, 0xf2, 0xff, 0x64,
-	0x37, 0x15, 0xd1, 0xce, 0x95, 0x81, 0xea, 0x3f, 0x0a, 0xf8, 0xb7, 0x0a, 0x14, 0x60, 0x1f, 0xbd,
-	0x0d, 0xe8, 0x94, 0x8b, 0x8b, 0x04, 0xa2, 0x18, 0x52, 0xe0, 0x4a, 0x5a, 0x25, 0xc7, 0x70, 0x6b,
-	0x7d, 0xc7, 0x7b, 0x46, 0xeb, 0x8d, 0x02, 0x3a, 0x05, 0x95, 0xb3, 0x03, 0x73, 0x75, 0xd7, 0xd6,
-	0xfc, 0x27, 0x3c, 0xfe, 0x89, 0x6a, 0x54, 0xa4, 0x29, 0x53, 0x85, 0xce, 0x38, 0x4b, 0x77, 0x8a,
-	0xe2, 0x01, 0xaa, 0xcc, 0x80, 0x02, 0xcb, 0x94, 0xb4, 0xcc, 0xb3, 0x34, 0x47, 0x0e, 0xff, 0x42,
-	0x8d, 0x40, 0x2e, 0x39, 0x1d, 0x67, 0x79, 0x48, 0x5a, 0x6f, 0xce, 0x12, 0xd5, 0x73, 0xb8, 0xd8,
-	0x4b, 0x3c, 0x42, 0x4d, 0x09, 0x3c, 0x1a, 0x4b, 0xf8, 0x3f, 0x07, 0x4e, 0x41, 0x5a, 0xe5, 0xdc,
-	0xf6, 0xe9, 0x25, 0xdb, 0x3e, 0xbb, 0x17, 0x36, 0x76, 0x82, 0xc3, 0x4e, 0x76, 0xfe, 0xa1, 0xda,
-	0xc9, 0x51, 0xfc, 0x01, 0x55, 0x69, 0xc2, 0x80, 0xab, 0x31, 0x8b, 0x2c, 0xdd, 0xd1, 0xdd, 0xaa,
-	0x5f, 0x29, 0x16, 0xc3, 0x08, 0xb7, 0x50, 0xe5, 0x70, 0xd8, 0x2a, 0x39, 0xba, 0x6b, 0xfa, 0xc7,
-	0x6f, 0x8c, 0x91, 0x19, 0x05, 0x2a, 0xb0, 0x0c, 0x47, 0x77, 0xeb, 0x7e, 0x3e, 0x7f, 0x33, 0x2f,
-	0x6f, 0xda, 0x5a, 0x67, 0x88, 0x9a, 0x8f, 0x1f, 0xf2, 0xea, 0x23, 0x83, 0x3f, 0xab, 0x8d, 0xad,
-	0xaf, 0x37, 0xb6, 0x7e, 0xbf, 0xb1, 0xf5, 0xeb, 0xad, 0xad, 0xad, 0xb7, 0xb6, 0x76, 0xbb, 0xb5,
-	0xb5, 0xbf, 0xdf, 0x63, 0xa6, 0x26, 0xf3, 0xd0, 0xa3, 0x22, 0x25, 0x54, 0xc8, 0x54, 0x48, 0xc2,
-	0x42, 0xda, 0x8d, 0x05, 0x59, 0x7c, 0xee, 0x91, 0x54, 0x44, 0xf3, 0x04, 0x64, 0xd1, 0xe3, 0xde,
-	0xd7, 0xee, 0x49, 0x95, 0xd5, 0x32, 0x03, 0x19, 0x96, 0xf3, 0x7a, 0x7e, 0x79, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x9c, 0x0e, 0x36, 0xab, 0xee, 0x02, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x9b, 0xb5, 0x6c, 0xad, 0xfb, 0x87, 0xc9, 0x8c, 0x11, 0x0a, 0xea, 0xba, 0x71, 0x29,
+	0x87, 0x25, 0x63, 0xec, 0x34, 0x09, 0x09, 0xba, 0xc3, 0x98, 0x26, 0xc1, 0x28, 0x48, 0x48, 0x48,
+	0x28, 0xa4, 0xce, 0xab, 0xcc, 0x5a, 0x63, 0x67, 0xb6, 0x53, 0xb4, 0x6f, 0xc0, 0x91, 0x8f, 0xc0,
+	0xc7, 0xd9, 0x71, 0x47, 0x4e, 0x08, 0x6d, 0x07, 0xbe, 0x06, 0x8a, 0x9d, 0x8e, 0x74, 0xad, 0x26,
+	0x85, 0x5b, 0xfa, 0xfa, 0xf9, 0x3d, 0x76, 0xdf, 0xd7, 0x32, 0x5a, 0xa7, 0x43, 0xe2, 0x12, 0x2e,
+	0xc0, 0x25, 0xc7, 0x3e, 0x63, 0x30, 0x72, 0xc7, 0xdb, 0x6e, 0x08, 0x0c, 0x24, 0x95, 0x4e, 0x2c,
+	0xb8, 0xe2, 0xf8, 0x1e, 0x1d, 0x12, 0x27, 0x45, 0x9c, 0x0c, 0x71, 0xc6, 0xdb, 0xed, 0x95, 0x90,
+	0x87, 0x5c, 0xaf, 0xbb, 0xe9, 0x97, 0x41, 0xdb, 0x4f, 0xe7, 0xd9, 0x08, 0x15, 0x24, 0xa1, 0xca,
+	0x1b, 0x0a, 0xf0, 0x4f, 0x40, 0x64, 0xe8, 0xdc, 0x8d, 0x63, 0x91, 0x30, 0xca, 0x42, 0x83, 0x6c,
+	0xfc, 0x59, 0x42, 0x8d, 0x7d, 0x73, 0x94, 0xf7, 0xca, 0x57, 0x80, 0x07, 0x68, 0xd9, 0x27, 0x27,
+	0x8c, 0x7f, 0x1d, 0x41, 0x10, 0x42, 0x04, 0x4c, 0x49, 0x7b, 0xa1, 0x5b, 0xee, 0xd5, 0xb7, 0xbb,
+	0xce, 0x9c, 0x43, 0x3a, 0x47, 0x3e, 0x39, 0x01, 0xa5, 0xb3, 0xfd, 0xca, 0xf9, 0xaf, 0xb5, 0xd2,
+	0x60, 0x26, 0x8f, 0x5f, 0xa3, 0x3a, 0xe1, 0x51, 0x44, 0x95, 0xd1, 0x95, 0x0b, 0xe9, 0xf2, 0x51,
+	0xdc, 0x47, 0x55, 0x01, 0x04, 0x68, 0xac, 0xa4, 0x5d, 0x29, 0xa4, 0xb9, 0xce, 0xe1, 0x43, 0xd4,
+	0xf4, 0xe5, 0x19, 0x23, 0x5e, 0xac, 0x21, 0x69, 0xdf, 0x29, 0x24, 0x6a, 0xe8, 0xb0, 0xa9, 0x4b,
+	0x7c, 0x84, 0x5a, 0x12, 0x58, 0xe0, 0x49, 0x38, 0x4d, 0x80, 0x11, 0x90, 0xf6, 0xa2, 0xb6, 0x3d,
+	0xb9, 0xcd, 0x96, 0xb1, 0x99, 0xb0, 0x99, 0x0a, 0x26, 0x35, 0x89, 0x3f, 0xa0, 0xe5, 0x1b, 0xd3,
+	0x94, 0xf6, 0xd2, 0x2d, 0xce, 0x3d, 0x03, 0xf7, 0x0d, 0x9b, 0x39, 0xef, 0x92, 0xa9, 0xaa, 0xc4,
+	0xbb, 0xe8, 0xe1, 0x0d, 0xab, 0x17, 0x26, 0xbe, 0x08, 0xa8, 0xcf, 0xa4, 0x5d, 0xed, 0x96, 0x7b,
+	0xb5, 0xc1, 0x83, 0xe9, 0xcc, 0xfe, 0x64, 0x19, 0x7f, 0x46, 0xab, 0xa6, 0x61, 0x33, 0x17, 0xa3,
+	0x56, 0xa8, 0x73, 0xf7, 0xb5, 0xe5, 0xd5, 0xcd, 0xdb, 0x71, 0x84, 0x5a, 0x02, 0xc8, 0x38, 0xd7,
+	0x42, 0x54, 0xb8, 0x85, 0xa9, 0xe0, 0x5f, 0x0b, 0x0f, 0x51, 0x33, 0xbb, 0xe5, 0xde, 0x69, 0x02,
+	0x09, 0xd8, 0xf5, 0x62, 0x13, 0xce, 0xc2, 0xef, 0xd2, 0x2c, 0x7e, 0x83, 0x5a, 0x13, 0x99, 0x4c,
+	0x21, 0x69, 0x37, 0xb4, 0x6d, 0x7d, 0xbe, 0xcd, 0xa0, 0x79, 0x5d, 0x33, 0xce, 0xd5, 0x24, 0x7e,
+	0x89, 0x1e, 0x13, 0x9e, 0x30, 0x05, 0x22, 0xf6, 0x85, 0x3a, 0xf3, 0xb8, 0x08, 0x40, 0x40, 0xe0,
+	0x91, 0x11, 0xd5, 0x3d, 0x6d, 0xea, 0x61, 0xb4, 0xf3, 0xcc, 0x5b, 0x83, 0xec, 0x19, 0x02, 0xef,
+	0xa0, 0x55, 0x32, 0xe2, 0x12, 0x82, 0x99, 0x6c, 0x4b, 0x67, 0x57, 0xcc, 0xea, 0x74, 0x6a, 0xe3,
+	0x0b, 0xaa, 0xe7, 0xfe, 0x2a, 0x7e, 0x84, 0x6a, 0x26, 0xe5, 0xd1, 0xc0, 0xb6, 0xba, 0x56, 0xaf,
+	0x36, 0xa8, 0x9a, 0xc2, 0x41, 0x80, 0xdb, 0xa8, 0x3a, 0x99, 0x86, 0xbd, 0xd0, 0xb5, 0x7a, 0x95,
+	0xc1, 0xf5, 0x6f, 0x8c, 0x51, 0x25, 0xf0, 0x95, 0x6f, 0x97, 0xbb, 0x56, 0xaf, 0x31, 0xd0, 0xdf,
+	0xbb, 0x95, 0x6f, 0x3f, 0xd6, 0x4a, 0x1b, 0x07, 0xa8, 0x35, 0x3d, 0x9d, 0xff, 0xde, 0xa4, 0xff,
+	0xf1, 0xfc, 0xb2, 0x63, 0x5d, 0x5c, 0x76, 0xac, 0xdf, 0x97, 0x1d, 0xeb, 0xfb, 0x55, 0xa7, 0x74,
+	0x71, 0xd5, 0x29, 0xfd, 0xbc, 0xea, 0x94, 0x3e, 0xbd, 0x08, 0xa9, 0x3a, 0x4e, 0x86, 0x0e, 0xe1,
+	0x91, 0x4b, 0xb8, 0x8c, 0xb8, 0x74, 0xe9, 0x90, 0x6c, 0x86, 0xdc, 0x1d, 0x3f, 0xdb, 0x72, 0x23,
+	0x1e, 0x24, 0x23, 0x90, 0xe6, 0xd1, 0xdb, 0xda, 0xd9, 0xcc, 0xbd, 0x7b, 0xea, 0x2c, 0x06, 0x39,
+	0x5c, 0xd4, 0xcf, 0xde, 0xf3, 0xbf, 0x03, 0x00, 0xb8, 0x5e, 0xa8, 0xab, 0x94, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -258,6 +343,103 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClosedOrderedClients) > 0 {
+		for iNdEx := len(m.ClosedOrderedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClosedOrderedClients[iNdEx])
+			copy(dAtA[i:], m.ClosedOrderedClients[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClosedOrderedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CounterpartyOrderedClients) > 0 {
+		for iNdEx := len(m.CounterpartyOrderedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyOrderedClients[iNdEx])
+			copy(dAtA[i:], m.CounterpartyOrderedClients[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyOrderedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PruningStates) > 0 {
+		for iNdEx := len(m.PruningStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PruningQueue) > 0 {
+		for iNdEx := len(m.PruningQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RecvSequences) > 0 {
+		for iNdEx := len(m.RecvSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AsyncAcknowledgements) > 0 {
+		for iNdEx := len(m.AsyncAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncAcknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CircuitBreakerGuardians) > 0 {
+		for iNdEx := len(m.CircuitBreakerGuardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakerGuardians[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakerGuardians[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CircuitBreakerGuardians[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.SendSequences) > 0 {
 		for iNdEx := len(m.SendSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -455,6 +637,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerGuardians) > 0 {
+		for _, s := range m.CircuitBreakerGuardians {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AsyncAcknowledgements) > 0 {
+		for _, e := range m.AsyncAcknowledgements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvSequences) > 0 {
+		for _, e := range m.RecvSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningQueue) > 0 {
+		for _, e := range m.PruningQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningStates) > 0 {
+		for _, e := range m.PruningStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CounterpartyOrderedClients) > 0 {
+		for _, s := range m.CounterpartyOrderedClients {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClosedOrderedClients) > 0 {
+		for _, s := range m.ClosedOrderedClients {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +929,272 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerGuardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerGuardians = append(m.CircuitBreakerGuardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAcknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncAcknowledgements = append(m.AsyncAcknowledgements, PacketState{})
+			if err := m.AsyncAcknowledgements[len(m.AsyncAcknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvSequences = append(m.RecvSequences, PacketSequence{})
+			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningQueue = append(m.PruningQueue, PacketState{})
+			if err := m.PruningQueue[len(m.PruningQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningStates = append(m.PruningStates, PruningState{})
+			if err := m.PruningStates[len(m.PruningStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyOrderedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyOrderedClients = append(m.CounterpartyOrderedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedOrderedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedOrderedClients = append(m.ClosedOrderedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
@@ -59,6 +61,15 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			errors.New("data bytes cannot be nil"),
 		},
+		{
+			"invalid async acknowledgement",
+			types.GenesisState{
+				AsyncAcknowledgements: []types.PacketState{
+					types.NewPacketState(ibctesting.FirstChannelID, 1, nil),
+				},
+			},
+			errors.New("data bytes cannot be nil"),
+		},
 		{
 			"invalid send seq",
 			types.GenesisState{
@@ -68,6 +79,100 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			errors.New("sequence cannot be 0"),
 		},
+		{
+			"invalid recv seq",
+			types.GenesisState{
+				RecvSequences: []types.PacketSequence{
+					types.NewPacketSequence(ibctesting.FirstChannelID, 0),
+				},
+			},
+			errors.New("sequence cannot be 0"),
+		},
+		{
+			"valid counterparty ordered clients",
+			types.GenesisState{
+				CounterpartyOrderedClients: []string{ibctesting.FirstClientID},
+			},
+			nil,
+		},
+		{
+			"invalid counterparty ordered client",
+			types.GenesisState{
+				CounterpartyOrderedClients: []string{""},
+			},
+			errors.New("invalid counterparty ordered client"),
+		},
+		{
+			"valid closed ordered clients",
+			types.GenesisState{
+				ClosedOrderedClients: []string{ibctesting.FirstClientID},
+			},
+			nil,
+		},
+		{
+			"invalid closed ordered client",
+			types.GenesisState{
+				ClosedOrderedClients: []string{""},
+			},
+			errors.New("invalid closed ordered client"),
+		},
+		{
+			"valid pruning queue and states",
+			types.GenesisState{
+				PruningQueue: []types.PacketState{
+					types.NewPacketState(ibctesting.FirstClientID, 1, sdk.Uint64ToBigEndian(100)),
+				},
+				PruningStates: []types.PruningState{
+					{ClientId: ibctesting.FirstClientID, TotalPruned: 1, TotalRemaining: 1},
+				},
+			},
+			nil,
+		},
+		{
+			"invalid pruning queue packet",
+			types.GenesisState{
+				PruningQueue: []types.PacketState{
+					types.NewPacketState(ibctesting.FirstClientID, 1, []byte("timestamp")),
+				},
+			},
+			errors.New("data bytes must be a big endian encoded pruning timestamp"),
+		},
+		{
+			"invalid pruning state",
+			types.GenesisState{
+				PruningStates: []types.PruningState{
+					{ClientId: ""},
+				},
+			},
+			errors.New("invalid client ID"),
+		},
+		{
+			"valid circuit breakers",
+			types.GenesisState{
+				CircuitBreakers: []types.CircuitBreaker{
+					types.NewCircuitBreaker(ibctesting.FirstChannelID, true, false),
+					types.NewCircuitBreaker(ibctesting.FirstClientID, true, true),
+				},
+				CircuitBreakerGuardians: []string{ibctesting.TestAccAddress},
+			},
+			nil,
+		},
+		{
+			"invalid circuit breaker",
+			types.GenesisState{
+				CircuitBreakers: []types.CircuitBreaker{
+					types.NewCircuitBreaker("", true, false),
+				},
+			},
+			types.ErrInvalidCircuitBreaker,
+		},
+		{
+			"invalid circuit breaker guardians",
+			types.GenesisState{
+				CircuitBreakerGuardians: []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress},
+			},
+			errors.New("duplicate circuit breaker guardian address"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -11,8 +11,32 @@ const (
 	// KeyAsyncPacket defines the key to store the async packet.
 	KeyAsyncPacket = "async_packet"
 
+	// KeyAsyncAcknowledgement defines the key to store the pending acknowledgement of an async packet with multiple payloads.
+	KeyAsyncAcknowledgement = "async_acknowledgement"
+
 	// KeyAlias defines the key to store the alias to base client mapping.
 	KeyAlias = "alias"
+
+	// KeyCircuitBreakerPrefix defines the key prefix to store the circuit breakers of channels and clients.
+	KeyCircuitBreakerPrefix = "circuitBreaker"
+
+	// KeyCircuitBreakerAnomaliesPrefix defines the key prefix to store the anomalies reported for channels and clients.
+	KeyCircuitBreakerAnomaliesPrefix = "circuitBreakerAnomalies"
+
+	// KeyCircuitBreakerGuardians defines the key to store the circuit breaker guardians.
+	KeyCircuitBreakerGuardians = "circuitBreakerGuardians"
+
+	// KeyPruningQueue defines the key to store the acknowledged packets whose receipts and acknowledgements are still to be pruned.
+	KeyPruningQueue = "pruning_queue"
+
+	// KeyPruningState defines the key to store the pruning progress of a client.
+	KeyPruningState = "pruning_state"
+
+	// KeyCounterpartyOrdered defines the key to store that the counterparty of an ordered client has been verified to be ordered.
+	KeyCounterpartyOrdered = "counterparty_ordered"
+
+	// KeyOrderedClientClosed defines the key to store that an ordered client is closed for sending packets.
+	KeyOrderedClientClosed = "ordered_client_closed"
 )
 
 // AsyncPacketKey returns the key under which the packet is stored
@@ -27,8 +51,70 @@ func AsyncPacketPrefixKey(clientID string) []byte {
 	return append([]byte(clientID), []byte(KeyAsyncPacket)...)
 }
 
+// AsyncAcknowledgementKey returns the key under which the pending acknowledgement of a packet is stored
+// if the receiving applications of some of its payloads return async acknowledgements.
+func AsyncAcknowledgementKey(clientID string, sequence uint64) []byte {
+	return append(AsyncAcknowledgementPrefixKey(clientID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// AsyncAcknowledgementPrefixKey returns the prefix key under which all pending acknowledgements of async
+// packets are stored for a given clientID.
+func AsyncAcknowledgementPrefixKey(clientID string) []byte {
+	return append([]byte(clientID), []byte(KeyAsyncAcknowledgement)...)
+}
+
 // AliasKey returns the key under which the base clientID will be stored
 // for an alias (original v1 channelID)
 func AliasKey(alias string) []byte {
 	return append([]byte(alias), []byte(KeyAlias)...)
 }
+
+// CircuitBreakerPrefixKey returns the prefix key under which all circuit breakers are stored.
+func CircuitBreakerPrefixKey() []byte {
+	return []byte(KeyCircuitBreakerPrefix + "/")
+}
+
+// CircuitBreakerKey returns the key under which the circuit breaker of a v1 channel or v2 client is stored.
+func CircuitBreakerKey(id string) []byte {
+	return append(CircuitBreakerPrefixKey(), []byte(id)...)
+}
+
+// CircuitBreakerAnomaliesKey returns the key under which the anomalies reported for a v1 channel
+// or v2 client are stored.
+func CircuitBreakerAnomaliesKey(id string) []byte {
+	return []byte(KeyCircuitBreakerAnomaliesPrefix + "/" + id)
+}
+
+// CircuitBreakerGuardiansKey returns the key under which the circuit breaker guardians are stored.
+func CircuitBreakerGuardiansKey() []byte {
+	return []byte(KeyCircuitBreakerGuardians)
+}
+
+// PruningQueueKey returns the key under which the pruning timestamp of an acknowledged packet whose receipt
+// and acknowledgement are still to be pruned is stored.
+func PruningQueueKey(clientID string, sequence uint64) []byte {
+	return append(PruningQueuePrefixKey(clientID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PruningQueuePrefixKey returns the prefix key under which all acknowledged packets whose receipts and
+// acknowledgements are still to be pruned are stored for a given clientID.
+func PruningQueuePrefixKey(clientID string) []byte {
+	return append([]byte(clientID), []byte(KeyPruningQueue)...)
+}
+
+// PruningStateKey returns the key under which the pruning progress of a client is stored.
+func PruningStateKey(clientID string) []byte {
+	return append([]byte(clientID), []byte(KeyPruningState)...)
+}
+
+// CounterpartyOrderedKey returns the key under which it is stored that the counterparty of an ordered client
+// has been verified to be ordered.
+func CounterpartyOrderedKey(clientID string) []byte {
+	return append([]byte(clientID), []byte(KeyCounterpartyOrdered)...)
+}
+
+// OrderedClientClosedKey returns the key under which it is stored that an ordered client is closed for sending
+// packets.
+func OrderedClientClosedKey(clientID string) []byte {
+	return append([]byte(clientID), []byte(KeyOrderedClientClosed)...)
+}
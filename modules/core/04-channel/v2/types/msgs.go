@@ -27,6 +27,18 @@ var (
 
 	_ sdk.Msg              = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
+
+	_ sdk.Msg              = (*MsgUpdateCircuitBreaker)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCircuitBreaker)(nil)
+
+	_ sdk.Msg              = (*MsgUpdateCircuitBreakerGuardians)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCircuitBreakerGuardians)(nil)
+
+	_ sdk.Msg              = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+
+	_ sdk.Msg              = (*MsgVerifyCounterpartyOrdering)(nil)
+	_ sdk.HasValidateBasic = (*MsgVerifyCounterpartyOrdering)(nil)
 )
 
 // NewMsgSendPacket creates a new MsgSendPacket instance.
@@ -143,3 +155,113 @@ func (msg *MsgTimeout) ValidateBasic() error {
 
 	return msg.Packet.ValidateBasic()
 }
+
+// NewMsgUpdateCircuitBreaker creates a new MsgUpdateCircuitBreaker instance.
+func NewMsgUpdateCircuitBreaker(id string, sendPaused, recvPaused bool, signer string) *MsgUpdateCircuitBreaker {
+	return &MsgUpdateCircuitBreaker{
+		Id:         id,
+		SendPaused: sendPaused,
+		RecvPaused: recvPaused,
+		Signer:     signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateCircuitBreaker.
+func (msg *MsgUpdateCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewCircuitBreaker(msg.Id, msg.SendPaused, msg.RecvPaused).Validate()
+}
+
+// NewMsgUpdateCircuitBreakerGuardians creates a new MsgUpdateCircuitBreakerGuardians instance.
+func NewMsgUpdateCircuitBreakerGuardians(signer string, guardians ...string) *MsgUpdateCircuitBreakerGuardians {
+	return &MsgUpdateCircuitBreakerGuardians{
+		Guardians: guardians,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateCircuitBreakerGuardians.
+func (msg *MsgUpdateCircuitBreakerGuardians) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateCircuitBreakerGuardians(msg.Guardians)
+}
+
+// NewMsgPruneAcknowledgements creates a new MsgPruneAcknowledgements instance.
+func NewMsgPruneAcknowledgements(clientID string, sequences []uint64, proofsAcknowledged [][]byte, proofHeight clienttypes.Height, signer string) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		ClientId:           clientID,
+		Sequences:          sequences,
+		ProofsAcknowledged: proofsAcknowledged,
+		ProofHeight:        proofHeight,
+		Signer:             signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneAcknowledgements.
+func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if len(msg.Sequences) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "sequences of the packets to prune cannot be empty")
+	}
+
+	if len(msg.Sequences) != len(msg.ProofsAcknowledged) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of proofs (%d) must match the number of sequences (%d)", len(msg.ProofsAcknowledged), len(msg.Sequences))
+	}
+
+	for i, sequence := range msg.Sequences {
+		if sequence == 0 {
+			return errorsmod.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+		}
+
+		if len(msg.ProofsAcknowledged[i]) == 0 {
+			return errorsmod.Wrapf(commitmenttypesv1.ErrInvalidProof, "proof of packet sequence %d cannot be empty", sequence)
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgVerifyCounterpartyOrdering creates a new MsgVerifyCounterpartyOrdering instance.
+func NewMsgVerifyCounterpartyOrdering(clientID string, nextSequenceRecv uint64, proofNextSequenceRecv []byte, proofHeight clienttypes.Height, signer string) *MsgVerifyCounterpartyOrdering {
+	return &MsgVerifyCounterpartyOrdering{
+		ClientId:              clientID,
+		NextSequenceRecv:      nextSequenceRecv,
+		ProofNextSequenceRecv: proofNextSequenceRecv,
+		ProofHeight:           proofHeight,
+		Signer:                signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgVerifyCounterpartyOrdering.
+func (msg *MsgVerifyCounterpartyOrdering) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.NextSequenceRecv == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "next sequence receive cannot be 0")
+	}
+
+	if len(msg.ProofNextSequenceRecv) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "proof next sequence receive can not be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
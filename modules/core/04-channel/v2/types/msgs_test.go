@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -380,3 +381,248 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 		})
 	}
 }
+
+func (s *TypesTestSuite) TestMsgUpdateCircuitBreakerValidateBasic() {
+	var msg *types.MsgUpdateCircuitBreaker
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: client ID",
+			malleate: func() {
+				msg.Id = ibctesting.FirstClientID
+			},
+		},
+		{
+			name: "success: reset circuit breaker",
+			malleate: func() {
+				msg.SendPaused = false
+				msg.RecvPaused = false
+			},
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+		{
+			name: "failure: invalid id",
+			malleate: func() {
+				msg.Id = ""
+			},
+			expError: types.ErrInvalidCircuitBreaker,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgUpdateCircuitBreaker(ibctesting.FirstChannelID, true, true, s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgUpdateCircuitBreakerGuardiansValidateBasic() {
+	var msg *types.MsgUpdateCircuitBreakerGuardians
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: no guardians",
+			malleate: func() {
+				msg.Guardians = nil
+			},
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+		{
+			name: "failure: invalid guardian",
+			malleate: func() {
+				msg.Guardians = []string{ibctesting.InvalidID}
+			},
+			expError: errors.New("invalid circuit breaker guardian address"),
+		},
+		{
+			name: "failure: duplicate guardian",
+			malleate: func() {
+				msg.Guardians = append(msg.Guardians, ibctesting.TestAccAddress)
+			},
+			expError: errors.New("duplicate circuit breaker guardian address"),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgUpdateCircuitBreakerGuardians(s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	var msg *types.MsgPruneAcknowledgements
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid client ID",
+			malleate: func() {
+				msg.ClientId = ""
+			},
+			expError: host.ErrInvalidID,
+		},
+		{
+			name: "failure: empty sequences",
+			malleate: func() {
+				msg.Sequences = nil
+				msg.ProofsAcknowledged = nil
+			},
+			expError: ibcerrors.ErrInvalidRequest,
+		},
+		{
+			name: "failure: number of proofs does not match the number of sequences",
+			malleate: func() {
+				msg.ProofsAcknowledged = msg.ProofsAcknowledged[:1]
+			},
+			expError: ibcerrors.ErrInvalidRequest,
+		},
+		{
+			name: "failure: zero sequence",
+			malleate: func() {
+				msg.Sequences[1] = 0
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: empty proof",
+			malleate: func() {
+				msg.ProofsAcknowledged[1] = nil
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgPruneAcknowledgements(ibctesting.FirstClientID, []uint64{1, 2}, [][]byte{testProof, testProof}, clienttypes.ZeroHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgVerifyCounterpartyOrderingValidateBasic() {
+	var msg *types.MsgVerifyCounterpartyOrdering
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid client ID",
+			malleate: func() {
+				msg.ClientId = ""
+			},
+			expError: host.ErrInvalidID,
+		},
+		{
+			name: "failure: zero next sequence receive",
+			malleate: func() {
+				msg.NextSequenceRecv = 0
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: empty proof",
+			malleate: func() {
+				msg.ProofNextSequenceRecv = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgVerifyCounterpartyOrdering(ibctesting.FirstClientID, 1, testProof, clienttypes.ZeroHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
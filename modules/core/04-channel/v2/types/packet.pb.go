@@ -73,6 +73,9 @@ type Packet struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// a list of payloads, each one for a specific application.
 	Payloads []Payload `protobuf:"bytes,5,rep,name=payloads,proto3" json:"payloads"`
+	// if true, the payloads are executed independently of each other on the receiving chain, each payload
+	// committing or reverting its own state changes. Otherwise the payloads are executed atomically.
+	NonAtomic bool `protobuf:"varint,6,opt,name=non_atomic,json=nonAtomic,proto3" json:"non_atomic,omitempty"`
 }
 
 func (m *Packet) Reset()         { *m = Packet{} }
@@ -143,6 +146,13 @@ func (m *Packet) GetPayloads() []Payload {
 	return nil
 }
 
+func (m *Packet) GetNonAtomic() bool {
+	if m != nil {
+		return m.NonAtomic
+	}
+	return false
+}
+
 // Payload contains the source and destination ports and payload for the application (version, encoding, raw bytes)
 type Payload struct {
 	// specifies the source port of the packet.
@@ -231,8 +241,13 @@ func (m *Payload) GetValue() []byte {
 // in the packet.
 // If the receive is not successful, the acknowledgement will contain a single app acknowledgment
 // which will be a constant error acknowledgment as defined by the IBC v2 protocol.
+// The acknowledgement of a non-atomic packet always contains an app acknowledgement for each payload,
+// which is either the success or the failure acknowledgement of the application, or the constant error
+// acknowledgement if the application did not return a failure acknowledgement.
 type Acknowledgement struct {
 	AppAcknowledgements [][]byte `protobuf:"bytes,1,rep,name=app_acknowledgements,json=appAcknowledgements,proto3" json:"app_acknowledgements,omitempty"`
+	// whether the acknowledgement is the acknowledgement of a non-atomic packet.
+	NonAtomic bool `protobuf:"varint,2,opt,name=non_atomic,json=nonAtomic,proto3" json:"non_atomic,omitempty"`
 }
 
 func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
@@ -275,6 +290,71 @@ func (m *Acknowledgement) GetAppAcknowledgements() [][]byte {
 	return nil
 }
 
+func (m *Acknowledgement) GetNonAtomic() bool {
+	if m != nil {
+		return m.NonAtomic
+	}
+	return false
+}
+
+// AsyncAcknowledgement holds the acknowledgement of a received packet with multiple payloads while
+// the acknowledgements of the payloads with an async receive result are pending. The acknowledgement
+// of the packet is written once the acknowledgements of all payloads have been written.
+type AsyncAcknowledgement struct {
+	// app acknowledgements of the payloads in the same order as the payloads of the packet,
+	// the app acknowledgements of pending payloads are empty.
+	AppAcknowledgements [][]byte `protobuf:"bytes,1,rep,name=app_acknowledgements,json=appAcknowledgements,proto3" json:"app_acknowledgements,omitempty"`
+	// indices of the payloads whose app acknowledgement has not been written yet.
+	PendingPayloadIndices []uint32 `protobuf:"varint,2,rep,packed,name=pending_payload_indices,json=pendingPayloadIndices,proto3" json:"pending_payload_indices,omitempty"`
+}
+
+func (m *AsyncAcknowledgement) Reset()         { *m = AsyncAcknowledgement{} }
+func (m *AsyncAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*AsyncAcknowledgement) ProtoMessage()    {}
+func (*AsyncAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f814aba9ca97169, []int{3}
+}
+func (m *AsyncAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AsyncAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AsyncAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AsyncAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AsyncAcknowledgement.Merge(m, src)
+}
+func (m *AsyncAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AsyncAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AsyncAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AsyncAcknowledgement proto.InternalMessageInfo
+
+func (m *AsyncAcknowledgement) GetAppAcknowledgements() [][]byte {
+	if m != nil {
+		return m.AppAcknowledgements
+	}
+	return nil
+}
+
+func (m *AsyncAcknowledgement) GetPendingPayloadIndices() []uint32 {
+	if m != nil {
+		return m.PendingPayloadIndices
+	}
+	return nil
+}
+
 // RecvPacketResult speecifies the status of a packet as well as the acknowledgement bytes.
 type RecvPacketResult struct {
 	// status of the packet
@@ -287,7 +367,7 @@ func (m *RecvPacketResult) Reset()         { *m = RecvPacketResult{} }
 func (m *RecvPacketResult) String() string { return proto.CompactTextString(m) }
 func (*RecvPacketResult) ProtoMessage()    {}
 func (*RecvPacketResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f814aba9ca97169, []int{3}
+	return fileDescriptor_2f814aba9ca97169, []int{4}
 }
 func (m *RecvPacketResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,50 +415,55 @@ func init() {
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v2.Packet")
 	proto.RegisterType((*Payload)(nil), "ibc.core.channel.v2.Payload")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v2.Acknowledgement")
+	proto.RegisterType((*AsyncAcknowledgement)(nil), "ibc.core.channel.v2.AsyncAcknowledgement")
 	proto.RegisterType((*RecvPacketResult)(nil), "ibc.core.channel.v2.RecvPacketResult")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/packet.proto", fileDescriptor_2f814aba9ca97169) }
 
 var fileDescriptor_2f814aba9ca97169 = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0x33, 0x4d, 0xd2, 0x36, 0xd3, 0xfc, 0x7f, 0xdd, 0x69, 0x91, 0x4c, 0x84, 0x52, 0x13,
-	0x24, 0x08, 0xa0, 0xda, 0x6d, 0x60, 0xc3, 0x02, 0xa4, 0xd4, 0x75, 0xa5, 0x0a, 0x14, 0xa2, 0x71,
-	0x22, 0x04, 0x9b, 0x68, 0x32, 0x19, 0xb9, 0x56, 0x6d, 0x8f, 0xf1, 0x8c, 0x5d, 0xf5, 0x15, 0xba,
-	0xe2, 0x05, 0xba, 0x60, 0xcd, 0x8b, 0x74, 0xd9, 0x25, 0x2b, 0x84, 0x5a, 0xf1, 0x1e, 0xc8, 0x63,
-	0xb7, 0x4a, 0x0b, 0xac, 0xec, 0x7b, 0xee, 0x77, 0x7c, 0x75, 0xae, 0x75, 0xa1, 0xe1, 0x4f, 0xa9,
-	0x45, 0x79, 0xc2, 0x2c, 0x7a, 0x48, 0xa2, 0x88, 0x05, 0x56, 0xd6, 0xb3, 0x62, 0x42, 0x8f, 0x98,
-	0x34, 0xe3, 0x84, 0x4b, 0x8e, 0xd6, 0xfd, 0x29, 0x35, 0x73, 0xc2, 0x2c, 0x09, 0x33, 0xeb, 0xb5,
-	0x36, 0x3c, 0xee, 0x71, 0xd5, 0xb7, 0xf2, 0xb7, 0x02, 0xed, 0xfc, 0x02, 0x70, 0x71, 0xa8, 0xbc,
-	0xa8, 0x05, 0x97, 0x05, 0xfb, 0x9c, 0xb2, 0x88, 0x32, 0x1d, 0x18, 0xa0, 0x5b, 0xc3, 0x37, 0x35,
-	0x7a, 0x04, 0xff, 0x13, 0x3c, 0x4d, 0x28, 0x9b, 0xd0, 0xc0, 0x67, 0x91, 0xd4, 0x17, 0x0c, 0xd0,
-	0x6d, 0xe0, 0x66, 0x21, 0xda, 0x4a, 0x43, 0x5b, 0x10, 0xcd, 0x98, 0x90, 0x7e, 0x44, 0xa4, 0xcf,
-	0xa3, 0x6b, 0xb2, 0xaa, 0xc8, 0xb5, 0xb9, 0x4e, 0x89, 0x3f, 0x87, 0x6b, 0xd2, 0x0f, 0x19, 0x4f,
-	0xe5, 0x24, 0x7f, 0x0a, 0x49, 0xc2, 0x58, 0xaf, 0xa9, 0xc1, 0x5a, 0xd9, 0x18, 0x5d, 0xeb, 0xe8,
-	0x0d, 0x5c, 0x8e, 0xc9, 0x49, 0xc0, 0xc9, 0x4c, 0xe8, 0x75, 0xa3, 0xda, 0x5d, 0xe9, 0x3d, 0x30,
-	0xff, 0x92, 0xd2, 0x1c, 0x16, 0xd0, 0x6e, 0xed, 0xfc, 0xc7, 0x66, 0x05, 0xdf, 0x78, 0x3a, 0x5f,
-	0x01, 0x5c, 0x2a, 0x7b, 0x68, 0x13, 0xae, 0x94, 0x61, 0x62, 0x9e, 0x48, 0x95, 0xb5, 0x81, 0x61,
-	0x21, 0x0d, 0x79, 0x22, 0xd1, 0x53, 0xa8, 0xcd, 0x07, 0x51, 0x54, 0x11, 0x78, 0x75, 0x4e, 0x57,
-	0xa8, 0x0e, 0x97, 0x32, 0x96, 0x08, 0x9f, 0x47, 0x65, 0xd0, 0xeb, 0x32, 0x5f, 0x27, 0x8b, 0x28,
-	0x9f, 0xf9, 0x91, 0xa7, 0x52, 0x35, 0xf0, 0x4d, 0x8d, 0x36, 0x60, 0x3d, 0x23, 0x41, 0xca, 0xf4,
-	0xba, 0x01, 0xba, 0x4d, 0x5c, 0x14, 0x9d, 0x3d, 0xb8, 0xda, 0xa7, 0x47, 0x11, 0x3f, 0x0e, 0xd8,
-	0xcc, 0x63, 0x61, 0xbe, 0xa3, 0x1d, 0xb8, 0x41, 0xe2, 0x78, 0x42, 0x6e, 0xcb, 0x42, 0x07, 0x46,
-	0xb5, 0xdb, 0xc4, 0xeb, 0x24, 0x8e, 0xef, 0x38, 0x44, 0xe7, 0x18, 0x6a, 0x98, 0xd1, 0xac, 0xf8,
-	0xa9, 0x98, 0x89, 0x34, 0x90, 0xe8, 0x15, 0x5c, 0x14, 0x92, 0xc8, 0x54, 0xa8, 0xb0, 0xff, 0xf7,
-	0x1e, 0xfe, 0x63, 0x77, 0xb9, 0xc5, 0x55, 0x20, 0x2e, 0x0d, 0xa8, 0x0b, 0x57, 0xef, 0x4c, 0x57,
-	0xab, 0x68, 0xe2, 0xbb, 0xf2, 0xb3, 0x6f, 0x00, 0x36, 0xe7, 0x3f, 0x81, 0x9e, 0xc0, 0xfb, 0xc3,
-	0xbe, 0xfd, 0xd6, 0x19, 0x4d, 0xdc, 0x51, 0x7f, 0x34, 0x76, 0x27, 0xe3, 0x81, 0x3b, 0x74, 0xec,
-	0x83, 0xfd, 0x03, 0x67, 0x4f, 0xab, 0xb4, 0x96, 0x4f, 0xcf, 0x8c, 0xda, 0xe0, 0xfd, 0xc0, 0x41,
-	0x8f, 0xe1, 0xbd, 0xdb, 0xa0, 0x3b, 0xb6, 0x6d, 0xc7, 0x75, 0x35, 0xd0, 0x5a, 0x39, 0x3d, 0x33,
-	0x96, 0xdc, 0x94, 0x52, 0x26, 0xc4, 0x9f, 0xdc, 0x7e, 0xff, 0xe0, 0xdd, 0x18, 0x3b, 0xda, 0x42,
-	0xc1, 0xed, 0x13, 0x3f, 0x48, 0x13, 0x86, 0x3a, 0x70, 0xfd, 0x36, 0xd7, 0x77, 0x3f, 0x0e, 0x6c,
-	0xad, 0xda, 0x6a, 0x9c, 0x9e, 0x19, 0xf5, 0xbe, 0x38, 0x89, 0xe8, 0xee, 0x87, 0xf3, 0xcb, 0x36,
-	0xb8, 0xb8, 0x6c, 0x83, 0x9f, 0x97, 0x6d, 0xf0, 0xe5, 0xaa, 0x5d, 0xb9, 0xb8, 0x6a, 0x57, 0xbe,
-	0x5f, 0xb5, 0x2b, 0x9f, 0x5e, 0x7b, 0xbe, 0x3c, 0x4c, 0xa7, 0x26, 0xe5, 0xa1, 0x45, 0xb9, 0x08,
-	0xb9, 0xb0, 0xfc, 0x29, 0xdd, 0xf2, 0xb8, 0x95, 0xed, 0x6c, 0x5b, 0x21, 0x9f, 0xa5, 0x01, 0x13,
-	0xc5, 0x01, 0x6e, 0xbf, 0xdc, 0x9a, 0xbb, 0x41, 0x79, 0x12, 0x33, 0x31, 0x5d, 0x54, 0x87, 0xf5,
-	0xe2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xab, 0x02, 0xa6, 0xa7, 0x03, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xfc, 0x6b, 0x32, 0x4d, 0x6f, 0xd3, 0x69, 0xaa, 0xeb, 0x1b, 0xdd, 0x9b, 0xfa,
+	0x06, 0x09, 0x0c, 0xa8, 0x71, 0x1b, 0x10, 0x12, 0x0b, 0x90, 0xd2, 0x90, 0x4a, 0x11, 0x28, 0x44,
+	0xe3, 0x44, 0x08, 0x36, 0x96, 0x33, 0x19, 0xa5, 0xa3, 0xda, 0x33, 0xc6, 0x33, 0x4e, 0xd5, 0x25,
+	0x12, 0xab, 0xae, 0x78, 0x81, 0x2e, 0x58, 0xf3, 0x22, 0x5d, 0x76, 0xc9, 0x0a, 0xa1, 0xf6, 0x45,
+	0x90, 0xc7, 0x6e, 0x95, 0x06, 0xd8, 0xb1, 0x4a, 0xce, 0x77, 0x7e, 0xc7, 0xe3, 0xef, 0x3b, 0xd6,
+	0x00, 0x83, 0x4e, 0xb0, 0x85, 0x79, 0x48, 0x2c, 0x7c, 0xe8, 0x32, 0x46, 0x3c, 0x6b, 0xde, 0xb6,
+	0x02, 0x17, 0x1f, 0x11, 0xd9, 0x0a, 0x42, 0x2e, 0x39, 0xdc, 0xa4, 0x13, 0xdc, 0x8a, 0x89, 0x56,
+	0x4a, 0xb4, 0xe6, 0xed, 0x7a, 0x6d, 0xc6, 0x67, 0x5c, 0xf5, 0xad, 0xf8, 0x5f, 0x82, 0x36, 0x3f,
+	0x66, 0x41, 0x71, 0xa8, 0x66, 0x61, 0x1d, 0x94, 0x04, 0x79, 0x1f, 0x11, 0x86, 0x89, 0xae, 0x19,
+	0x9a, 0x99, 0x47, 0x37, 0x35, 0xbc, 0x03, 0xd6, 0x04, 0x8f, 0x42, 0x4c, 0x1c, 0xec, 0x51, 0xc2,
+	0xa4, 0x9e, 0x35, 0x34, 0xb3, 0x8c, 0x2a, 0x89, 0xd8, 0x55, 0x1a, 0xdc, 0x01, 0x70, 0x4a, 0x84,
+	0xa4, 0xcc, 0x95, 0x94, 0xb3, 0x6b, 0x32, 0xa7, 0xc8, 0x8d, 0x85, 0x4e, 0x8a, 0x3f, 0x04, 0x1b,
+	0x92, 0xfa, 0x84, 0x47, 0xd2, 0x89, 0x7f, 0x85, 0x74, 0xfd, 0x40, 0xcf, 0xab, 0x83, 0xab, 0x69,
+	0x63, 0x74, 0xad, 0xc3, 0xe7, 0xa0, 0x14, 0xb8, 0x27, 0x1e, 0x77, 0xa7, 0x42, 0x2f, 0x18, 0x39,
+	0x73, 0xb5, 0xfd, 0x6f, 0xeb, 0x17, 0x2e, 0x5b, 0xc3, 0x04, 0xda, 0xcf, 0x9f, 0x7f, 0xdb, 0xce,
+	0xa0, 0x9b, 0x19, 0xf8, 0x1f, 0x00, 0x8c, 0x33, 0xc7, 0x95, 0xdc, 0xa7, 0x58, 0x2f, 0x1a, 0x9a,
+	0x59, 0x42, 0x65, 0xc6, 0x59, 0x47, 0x09, 0xcd, 0xcf, 0x1a, 0x58, 0x49, 0x47, 0xe1, 0x36, 0x58,
+	0x4d, 0xbd, 0x06, 0x3c, 0x94, 0x2a, 0x8a, 0x32, 0x02, 0x89, 0x34, 0xe4, 0xa1, 0x84, 0xf7, 0x41,
+	0x75, 0xd1, 0xa7, 0xa2, 0x92, 0x3c, 0xd6, 0x17, 0x74, 0x85, 0xea, 0x60, 0x65, 0x4e, 0x42, 0x41,
+	0x39, 0x4b, 0x73, 0xb8, 0x2e, 0xe3, 0xb4, 0x09, 0xc3, 0x7c, 0x4a, 0xd9, 0x4c, 0x99, 0x2e, 0xa3,
+	0x9b, 0x1a, 0xd6, 0x40, 0x61, 0xee, 0x7a, 0x11, 0xd1, 0x0b, 0x86, 0x66, 0x56, 0x50, 0x52, 0x34,
+	0x31, 0x58, 0xef, 0xe0, 0x23, 0xc6, 0x8f, 0x3d, 0x32, 0x9d, 0x11, 0x3f, 0x8e, 0x70, 0x0f, 0xd4,
+	0xdc, 0x20, 0x70, 0xdc, 0xdb, 0xb2, 0xd0, 0x35, 0x23, 0x67, 0x56, 0xd0, 0xa6, 0x1b, 0x04, 0x4b,
+	0x13, 0xcb, 0x41, 0x64, 0x97, 0x83, 0xf8, 0xa0, 0x81, 0x5a, 0x47, 0x9c, 0x30, 0xfc, 0x07, 0x8e,
+	0x7a, 0x02, 0xfe, 0x0e, 0x08, 0x8b, 0x1d, 0x39, 0xe9, 0x1e, 0x1c, 0xca, 0xa6, 0x14, 0x13, 0xa1,
+	0x67, 0x8d, 0x9c, 0xb9, 0x86, 0xb6, 0xd2, 0x76, 0x9a, 0x7c, 0x3f, 0x69, 0x36, 0x8f, 0x41, 0x15,
+	0x11, 0x3c, 0x4f, 0x3e, 0x4b, 0x44, 0x44, 0xe4, 0x49, 0xf8, 0x14, 0x14, 0x85, 0x74, 0x65, 0x24,
+	0xd4, 0x3e, 0xfe, 0x6a, 0xff, 0xff, 0x9b, 0xed, 0xc7, 0x23, 0xb6, 0x02, 0x51, 0x3a, 0x00, 0x4d,
+	0xb0, 0xbe, 0xf4, 0xd6, 0xca, 0x76, 0x05, 0x2d, 0xcb, 0x0f, 0xbe, 0x68, 0xa0, 0xb2, 0xf8, 0x08,
+	0x78, 0x0f, 0xfc, 0x33, 0xec, 0x74, 0x5f, 0xf6, 0x46, 0x8e, 0x3d, 0xea, 0x8c, 0xc6, 0xb6, 0x33,
+	0x1e, 0xd8, 0xc3, 0x5e, 0xb7, 0x7f, 0xd0, 0xef, 0xbd, 0xa8, 0x66, 0xea, 0xa5, 0xd3, 0x33, 0x23,
+	0x3f, 0x78, 0x3d, 0xe8, 0xc1, 0xbb, 0x60, 0xeb, 0x36, 0x68, 0x8f, 0xbb, 0xdd, 0x9e, 0x6d, 0x57,
+	0xb5, 0xfa, 0xea, 0xe9, 0x99, 0xb1, 0x62, 0x47, 0x18, 0x13, 0x21, 0x7e, 0xe6, 0x0e, 0x3a, 0xfd,
+	0x57, 0x63, 0xd4, 0xab, 0x66, 0x13, 0xee, 0xc0, 0xa5, 0x5e, 0x14, 0x12, 0xd8, 0x04, 0x9b, 0xb7,
+	0xb9, 0x8e, 0xfd, 0x76, 0xd0, 0xad, 0xe6, 0xea, 0xe5, 0xd3, 0x33, 0xa3, 0xa0, 0x16, 0xb4, 0xff,
+	0xe6, 0xfc, 0xb2, 0xa1, 0x5d, 0x5c, 0x36, 0xb4, 0xef, 0x97, 0x0d, 0xed, 0xd3, 0x55, 0x23, 0x73,
+	0x71, 0xd5, 0xc8, 0x7c, 0xbd, 0x6a, 0x64, 0xde, 0x3d, 0x9b, 0x51, 0x79, 0x18, 0x4d, 0x5a, 0x98,
+	0xfb, 0x16, 0xe6, 0xc2, 0xe7, 0xc2, 0xa2, 0x13, 0xbc, 0x33, 0xe3, 0xd6, 0x7c, 0x6f, 0xd7, 0xf2,
+	0xf9, 0x34, 0xf2, 0x88, 0x48, 0xae, 0x90, 0xdd, 0xc7, 0x3b, 0x0b, 0xb7, 0x88, 0x3c, 0x09, 0x88,
+	0x98, 0x14, 0xd5, 0xd5, 0xf0, 0xe8, 0xc7, 0x00, 0x55, 0x94, 0x7c, 0x80, 0x69, 0x04, 0x00, 0x00,
 }
 
 func (m *Packet) Marshal() (dAtA []byte, err error) {
@@ -401,6 +486,16 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonAtomic {
+		i--
+		if m.NonAtomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Payloads) > 0 {
 		for iNdEx := len(m.Payloads) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -520,6 +615,66 @@ func (m *Acknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonAtomic {
+		i--
+		if m.NonAtomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AppAcknowledgements) > 0 {
+		for iNdEx := len(m.AppAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AppAcknowledgements[iNdEx])
+			copy(dAtA[i:], m.AppAcknowledgements[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.AppAcknowledgements[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AsyncAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AsyncAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AsyncAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingPayloadIndices) > 0 {
+		dAtA2 := make([]byte, len(m.PendingPayloadIndices)*10)
+		var j1 int
+		for _, num := range m.PendingPayloadIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPacket(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.AppAcknowledgements) > 0 {
 		for iNdEx := len(m.AppAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AppAcknowledgements[iNdEx])
@@ -604,6 +759,9 @@ func (m *Packet) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if m.NonAtomic {
+		n += 2
+	}
 	return n
 }
 
@@ -648,6 +806,31 @@ func (m *Acknowledgement) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if m.NonAtomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *AsyncAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AppAcknowledgements) > 0 {
+		for _, b := range m.AppAcknowledgements {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.PendingPayloadIndices) > 0 {
+		l = 0
+		for _, e := range m.PendingPayloadIndices {
+			l += sovPacket(uint64(e))
+		}
+		n += 1 + sovPacket(uint64(l)) + l
+	}
 	return n
 }
 
@@ -838,6 +1021,26 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonAtomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonAtomic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1132,6 +1335,184 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 			m.AppAcknowledgements = append(m.AppAcknowledgements, make([]byte, postIndex-iNdEx))
 			copy(m.AppAcknowledgements[len(m.AppAcknowledgements)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonAtomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonAtomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AsyncAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AsyncAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AsyncAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgements", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAcknowledgements = append(m.AppAcknowledgements, make([]byte, postIndex-iNdEx))
+			copy(m.AppAcknowledgements[len(m.AppAcknowledgements)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingPayloadIndices = append(m.PendingPayloadIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPacket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPacket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingPayloadIndices) == 0 {
+					m.PendingPayloadIndices = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPacket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingPayloadIndices = append(m.PendingPayloadIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPayloadIndices", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
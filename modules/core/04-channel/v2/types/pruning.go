@@ -0,0 +1,15 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// Validate performs basic validation of the pruning state.
+func (ps PruningState) Validate() error {
+	if err := host.ClientIdentifierValidator(ps.ClientId); err != nil {
+		return fmt.Errorf("invalid client ID: %w", err)
+	}
+	return nil
+}
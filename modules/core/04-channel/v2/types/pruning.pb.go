@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/channel/v2/pruning.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PruningState defines the progress of the pruning of the packet receipts and acknowledgements of the packets
+// received over a client with a pruning delay.
+//
+// Pruning does not track a per-client low-water mark below which all receipts are pruned. Acknowledged packets are
+// scheduled in a pruning queue keyed by their sequence instead, and the progress is only tracked with the counters
+// below. Replay protection of pruned packets relies on the receiver rejecting packets past their timeout, which
+// always precedes their pruning timestamp, rather than on a low-water mark.
+//
+// Only packets whose acknowledgement has been written while the client had a pruning delay are scheduled for
+// pruning. The receipts of packets which are never acknowledged, e.g. packets whose asynchronous acknowledgement
+// is still pending, are never pruned.
+type PruningState struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// number of packets whose receipt and acknowledgement have been pruned
+	TotalPruned uint64 `protobuf:"varint,3,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+	// number of acknowledged packets whose receipt and acknowledgement are still to be pruned
+	TotalRemaining uint64 `protobuf:"varint,4,opt,name=total_remaining,json=totalRemaining,proto3" json:"total_remaining,omitempty"`
+}
+
+func (m *PruningState) Reset()         { *m = PruningState{} }
+func (m *PruningState) String() string { return proto.CompactTextString(m) }
+func (*PruningState) ProtoMessage()    {}
+func (*PruningState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1b7b085525ab5a, []int{0}
+}
+func (m *PruningState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningState.Merge(m, src)
+}
+func (m *PruningState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningState proto.InternalMessageInfo
+
+func (m *PruningState) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *PruningState) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
+func (m *PruningState) GetTotalRemaining() uint64 {
+	if m != nil {
+		return m.TotalRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PruningState)(nil), "ibc.core.channel.v2.PruningState")
+}
+
+func init() { proto.RegisterFile("ibc/core/channel/v2/pruning.proto", fileDescriptor_dc1b7b085525ab5a) }
+
+var fileDescriptor_dc1b7b085525ab5a = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4c, 0x4a, 0xd6,
+	0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd, 0xd1, 0x2f, 0x33, 0xd2,
+	0x2f, 0x28, 0x2a, 0xcd, 0xcb, 0xcc, 0x4b, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xce,
+	0x4c, 0x4a, 0xd6, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0xd1, 0x2b, 0x33, 0x52, 0xaa, 0xe5, 0xe2, 0x09,
+	0x80, 0xa8, 0x0a, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x92, 0xe6, 0xe2, 0x4c, 0xce, 0xc9, 0x4c, 0xcd,
+	0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x80, 0x08, 0x78, 0xa6,
+	0x08, 0x29, 0x72, 0xf1, 0x94, 0xe4, 0x97, 0x24, 0xe6, 0xc4, 0x83, 0x0c, 0x4e, 0x4d, 0x91, 0x60,
+	0x56, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0x06, 0x8b, 0x05, 0x80, 0x85, 0x84, 0xd4, 0xb9, 0xf8, 0x21,
+	0x4a, 0x8a, 0x52, 0x73, 0x13, 0x33, 0x41, 0xe6, 0x4a, 0xb0, 0x80, 0x55, 0xf1, 0x81, 0x85, 0x83,
+	0x60, 0xa2, 0x5e, 0x2c, 0x1c, 0x4c, 0x02, 0xcc, 0x4e, 0xe1, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
+	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
+	0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
+	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
+	0x68, 0xa0, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0xf1, 0xb1, 0x81, 0x89, 0x2e, 0x92,
+	0xa7, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x7e, 0x36, 0x06, 0x0c, 0x00, 0x1b, 0x14,
+	0xb4, 0x6a, 0x18, 0x01, 0x00, 0x00,
+}
+
+func (m *PruningState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalRemaining != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.TotalRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPruned != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintPruning(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPruning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPruning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PruningState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	if m.TotalPruned != 0 {
+		n += 1 + sovPruning(uint64(m.TotalPruned))
+	}
+	if m.TotalRemaining != 0 {
+		n += 1 + sovPruning(uint64(m.TotalRemaining))
+	}
+	return n
+}
+
+func sovPruning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPruning(x uint64) (n int) {
+	return sovPruning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PruningState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruningState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruningState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemaining", wireType)
+			}
+			m.TotalRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPruning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPruning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPruning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPruning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPruning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPruning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPruning = fmt.Errorf("proto: unexpected end of group")
+)
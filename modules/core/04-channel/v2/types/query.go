@@ -22,6 +22,24 @@ func NewQueryNextSequenceSendResponse(
 	}
 }
 
+// NewQueryNextSequenceReceiveRequest creates a new next sequence receive query.
+func NewQueryNextSequenceReceiveRequest(clientID string) *QueryNextSequenceReceiveRequest {
+	return &QueryNextSequenceReceiveRequest{
+		ClientId: clientID,
+	}
+}
+
+// NewQueryNextSequenceReceiveResponse creates a new QueryNextSequenceReceiveResponse instance
+func NewQueryNextSequenceReceiveResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryNextSequenceReceiveResponse {
+	return &QueryNextSequenceReceiveResponse{
+		NextSequenceReceive: sequence,
+		Proof:               proof,
+		ProofHeight:         height,
+	}
+}
+
 // NewQueryPacketCommitmentRequest creates and returns a new packet commitment query request.
 func NewQueryPacketCommitmentRequest(clientID string, sequence uint64) *QueryPacketCommitmentRequest {
 	return &QueryPacketCommitmentRequest{
@@ -80,3 +98,10 @@ func NewQueryUnreceivedPacketsRequest(clientID string, sequences []uint64) *Quer
 		Sequences: sequences,
 	}
 }
+
+// NewQueryPruningStateRequest creates a new pruning state query.
+func NewQueryPruningStateRequest(clientID string) *QueryPruningStateRequest {
+	return &QueryPruningStateRequest{
+		ClientId: clientID,
+	}
+}
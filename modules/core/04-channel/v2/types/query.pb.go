@@ -141,6 +141,116 @@ func (m *QueryNextSequenceSendResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryNextSequenceReceiveRequest is the request type for the Query/QueryNextSequenceReceive RPC method
+type QueryNextSequenceReceiveRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryNextSequenceReceiveRequest) Reset()         { *m = QueryNextSequenceReceiveRequest{} }
+func (m *QueryNextSequenceReceiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceReceiveRequest) ProtoMessage()    {}
+func (*QueryNextSequenceReceiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{2}
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceReceiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceReceiveRequest.Merge(m, src)
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceReceiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceReceiveRequest proto.InternalMessageInfo
+
+func (m *QueryNextSequenceReceiveRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryNextSequenceReceiveResponse is the response type for the Query/QueryNextSequenceReceive RPC method
+type QueryNextSequenceReceiveResponse struct {
+	// next sequence receive number
+	NextSequenceReceive uint64 `protobuf:"varint,1,opt,name=next_sequence_receive,json=nextSequenceReceive,proto3" json:"next_sequence_receive,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryNextSequenceReceiveResponse) Reset()         { *m = QueryNextSequenceReceiveResponse{} }
+func (m *QueryNextSequenceReceiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceReceiveResponse) ProtoMessage()    {}
+func (*QueryNextSequenceReceiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{3}
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceReceiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceReceiveResponse.Merge(m, src)
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceReceiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceReceiveResponse proto.InternalMessageInfo
+
+func (m *QueryNextSequenceReceiveResponse) GetNextSequenceReceive() uint64 {
+	if m != nil {
+		return m.NextSequenceReceive
+	}
+	return 0
+}
+
+func (m *QueryNextSequenceReceiveResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
 // QueryPacketCommitmentRequest is the request type for the Query/PacketCommitment RPC method.
 type QueryPacketCommitmentRequest struct {
 	// client unique identifier
@@ -153,7 +263,7 @@ func (m *QueryPacketCommitmentRequest) Reset()         { *m = QueryPacketCommitm
 func (m *QueryPacketCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentRequest) ProtoMessage()    {}
 func (*QueryPacketCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{2}
+	return fileDescriptor_a328cba4986edcab, []int{4}
 }
 func (m *QueryPacketCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +320,7 @@ func (m *QueryPacketCommitmentResponse) Reset()         { *m = QueryPacketCommit
 func (m *QueryPacketCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentResponse) ProtoMessage()    {}
 func (*QueryPacketCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{3}
+	return fileDescriptor_a328cba4986edcab, []int{5}
 }
 func (m *QueryPacketCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +382,7 @@ func (m *QueryPacketCommitmentsRequest) Reset()         { *m = QueryPacketCommit
 func (m *QueryPacketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentsRequest) ProtoMessage()    {}
 func (*QueryPacketCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{4}
+	return fileDescriptor_a328cba4986edcab, []int{6}
 }
 func (m *QueryPacketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +439,7 @@ func (m *QueryPacketCommitmentsResponse) Reset()         { *m = QueryPacketCommi
 func (m *QueryPacketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentsResponse) ProtoMessage()    {}
 func (*QueryPacketCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{5}
+	return fileDescriptor_a328cba4986edcab, []int{7}
 }
 func (m *QueryPacketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,7 +501,7 @@ func (m *QueryPacketAcknowledgementRequest) Reset()         { *m = QueryPacketAc
 func (m *QueryPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{6}
+	return fileDescriptor_a328cba4986edcab, []int{8}
 }
 func (m *QueryPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +558,7 @@ func (m *QueryPacketAcknowledgementResponse) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{7}
+	return fileDescriptor_a328cba4986edcab, []int{9}
 }
 func (m *QueryPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +623,7 @@ func (m *QueryPacketAcknowledgementsRequest) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{8}
+	return fileDescriptor_a328cba4986edcab, []int{10}
 }
 func (m *QueryPacketAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +687,7 @@ func (m *QueryPacketAcknowledgementsResponse) Reset()         { *m = QueryPacket
 func (m *QueryPacketAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{9}
+	return fileDescriptor_a328cba4986edcab, []int{11}
 }
 func (m *QueryPacketAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +749,7 @@ func (m *QueryPacketReceiptRequest) Reset()         { *m = QueryPacketReceiptReq
 func (m *QueryPacketReceiptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptRequest) ProtoMessage()    {}
 func (*QueryPacketReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{10}
+	return fileDescriptor_a328cba4986edcab, []int{12}
 }
 func (m *QueryPacketReceiptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +806,7 @@ func (m *QueryPacketReceiptResponse) Reset()         { *m = QueryPacketReceiptRe
 func (m *QueryPacketReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptResponse) ProtoMessage()    {}
 func (*QueryPacketReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{11}
+	return fileDescriptor_a328cba4986edcab, []int{13}
 }
 func (m *QueryPacketReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +868,7 @@ func (m *QueryUnreceivedPacketsRequest) Reset()         { *m = QueryUnreceivedPa
 func (m *QueryUnreceivedPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsRequest) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{12}
+	return fileDescriptor_a328cba4986edcab, []int{14}
 }
 func (m *QueryUnreceivedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +923,7 @@ func (m *QueryUnreceivedPacketsResponse) Reset()         { *m = QueryUnreceivedP
 func (m *QueryUnreceivedPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsResponse) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{13}
+	return fileDescriptor_a328cba4986edcab, []int{15}
 }
 func (m *QueryUnreceivedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +979,7 @@ func (m *QueryUnreceivedAcksRequest) Reset()         { *m = QueryUnreceivedAcksR
 func (m *QueryUnreceivedAcksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksRequest) ProtoMessage()    {}
 func (*QueryUnreceivedAcksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{14}
+	return fileDescriptor_a328cba4986edcab, []int{16}
 }
 func (m *QueryUnreceivedAcksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1035,7 @@ func (m *QueryUnreceivedAcksResponse) Reset()         { *m = QueryUnreceivedAcks
 func (m *QueryUnreceivedAcksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksResponse) ProtoMessage()    {}
 func (*QueryUnreceivedAcksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{15}
+	return fileDescriptor_a328cba4986edcab, []int{17}
 }
 func (m *QueryUnreceivedAcksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,9 +1078,278 @@ func (m *QueryUnreceivedAcksResponse) GetHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryCircuitBreakerRequest is the request type for the Query/CircuitBreaker RPC method.
+type QueryCircuitBreakerRequest struct {
+	// channel or client unique identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{18}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryCircuitBreakerResponse is the response type for the Query/CircuitBreaker RPC method.
+type QueryCircuitBreakerResponse struct {
+	// circuit breaker of the channel or client, nothing is paused if it was never tripped
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{19}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+// QueryCircuitBreakerGuardiansRequest is the request type for the Query/CircuitBreakerGuardians RPC method.
+type QueryCircuitBreakerGuardiansRequest struct {
+}
+
+func (m *QueryCircuitBreakerGuardiansRequest) Reset()         { *m = QueryCircuitBreakerGuardiansRequest{} }
+func (m *QueryCircuitBreakerGuardiansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerGuardiansRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerGuardiansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{20}
+}
+func (m *QueryCircuitBreakerGuardiansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerGuardiansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerGuardiansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerGuardiansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerGuardiansRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerGuardiansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerGuardiansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerGuardiansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerGuardiansRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerGuardiansResponse is the response type for the Query/CircuitBreakerGuardians RPC method.
+type QueryCircuitBreakerGuardiansResponse struct {
+	// guardian addresses
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+}
+
+func (m *QueryCircuitBreakerGuardiansResponse) Reset()         { *m = QueryCircuitBreakerGuardiansResponse{} }
+func (m *QueryCircuitBreakerGuardiansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerGuardiansResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerGuardiansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{21}
+}
+func (m *QueryCircuitBreakerGuardiansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerGuardiansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerGuardiansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerGuardiansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerGuardiansResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerGuardiansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerGuardiansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerGuardiansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerGuardiansResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerGuardiansResponse) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+// QueryPruningStateRequest is the request type for the Query/PruningState RPC method.
+type QueryPruningStateRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryPruningStateRequest) Reset()         { *m = QueryPruningStateRequest{} }
+func (m *QueryPruningStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningStateRequest) ProtoMessage()    {}
+func (*QueryPruningStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{22}
+}
+func (m *QueryPruningStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPruningStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningStateRequest.Merge(m, src)
+}
+func (m *QueryPruningStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPruningStateRequest proto.InternalMessageInfo
+
+func (m *QueryPruningStateRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryPruningStateResponse is the response type for the Query/PruningState RPC method.
+type QueryPruningStateResponse struct {
+	// pruning progress of the client, nothing has been pruned if it is empty
+	PruningState PruningState `protobuf:"bytes,1,opt,name=pruning_state,json=pruningState,proto3" json:"pruning_state"`
+}
+
+func (m *QueryPruningStateResponse) Reset()         { *m = QueryPruningStateResponse{} }
+func (m *QueryPruningStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningStateResponse) ProtoMessage()    {}
+func (*QueryPruningStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{23}
+}
+func (m *QueryPruningStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPruningStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningStateResponse.Merge(m, src)
+}
+func (m *QueryPruningStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPruningStateResponse proto.InternalMessageInfo
+
+func (m *QueryPruningStateResponse) GetPruningState() PruningState {
+	if m != nil {
+		return m.PruningState
+	}
+	return PruningState{}
+}
+
 func init() {
 	proto.RegisterType((*QueryNextSequenceSendRequest)(nil), "ibc.core.channel.v2.QueryNextSequenceSendRequest")
 	proto.RegisterType((*QueryNextSequenceSendResponse)(nil), "ibc.core.channel.v2.QueryNextSequenceSendResponse")
+	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v2.QueryNextSequenceReceiveRequest")
+	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v2.QueryNextSequenceReceiveResponse")
 	proto.RegisterType((*QueryPacketCommitmentRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentRequest")
 	proto.RegisterType((*QueryPacketCommitmentResponse)(nil), "ibc.core.channel.v2.QueryPacketCommitmentResponse")
 	proto.RegisterType((*QueryPacketCommitmentsRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsRequest")
@@ -985,77 +1364,101 @@ func init() {
 	proto.RegisterType((*QueryUnreceivedPacketsResponse)(nil), "ibc.core.channel.v2.QueryUnreceivedPacketsResponse")
 	proto.RegisterType((*QueryUnreceivedAcksRequest)(nil), "ibc.core.channel.v2.QueryUnreceivedAcksRequest")
 	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v2.QueryUnreceivedAcksResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "ibc.core.channel.v2.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "ibc.core.channel.v2.QueryCircuitBreakerResponse")
+	proto.RegisterType((*QueryCircuitBreakerGuardiansRequest)(nil), "ibc.core.channel.v2.QueryCircuitBreakerGuardiansRequest")
+	proto.RegisterType((*QueryCircuitBreakerGuardiansResponse)(nil), "ibc.core.channel.v2.QueryCircuitBreakerGuardiansResponse")
+	proto.RegisterType((*QueryPruningStateRequest)(nil), "ibc.core.channel.v2.QueryPruningStateRequest")
+	proto.RegisterType((*QueryPruningStateResponse)(nil), "ibc.core.channel.v2.QueryPruningStateResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/query.proto", fileDescriptor_a328cba4986edcab) }
 
 var fileDescriptor_a328cba4986edcab = []byte{
-	// 1033 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xc4, 0x6e, 0x95, 0xbc, 0x4e, 0x7f, 0xbf, 0x74, 0x08, 0xc8, 0xdd, 0x04, 0xd7, 0x5d,
-	0x24, 0xb0, 0x10, 0xdd, 0x89, 0x5d, 0x04, 0x95, 0xaa, 0x02, 0x49, 0x44, 0x1b, 0x04, 0xaa, 0xca,
-	0x06, 0x54, 0x29, 0xaa, 0x64, 0xad, 0xd7, 0xc3, 0x66, 0xb1, 0xbd, 0xb3, 0xf5, 0xac, 0x4d, 0xaa,
-	0x2a, 0x17, 0xc4, 0x85, 0x1b, 0x52, 0x6f, 0x7c, 0x02, 0xf8, 0x10, 0x20, 0x71, 0x6b, 0x6f, 0x45,
-	0x08, 0x89, 0x13, 0x54, 0x09, 0x12, 0xdf, 0x80, 0x33, 0xf2, 0xcc, 0xd8, 0xde, 0xb5, 0xd7, 0x9b,
-	0xdd, 0xb4, 0x41, 0xdc, 0x66, 0xc7, 0xef, 0x9f, 0xe7, 0x79, 0xe7, 0x79, 0xe7, 0x9d, 0x04, 0x2e,
-	0xba, 0x0d, 0x9b, 0xd8, 0xac, 0x4b, 0x89, 0xbd, 0x67, 0x79, 0x1e, 0x6d, 0x93, 0x7e, 0x8d, 0xdc,
-	0xeb, 0xd1, 0xee, 0x7d, 0xc3, 0xef, 0xb2, 0x80, 0xe1, 0x17, 0xdc, 0x86, 0x6d, 0x0c, 0x0c, 0x0c,
-	0x65, 0x60, 0xf4, 0x6b, 0xda, 0xeb, 0x36, 0xe3, 0x1d, 0xc6, 0x49, 0xc3, 0xe2, 0x54, 0x5a, 0x93,
-	0x7e, 0xb5, 0x41, 0x03, 0xab, 0x4a, 0x7c, 0xcb, 0x71, 0x3d, 0x2b, 0x70, 0x99, 0x27, 0x03, 0x68,
-	0x97, 0xe2, 0x32, 0x38, 0xd4, 0xa3, 0xdc, 0xe5, 0xca, 0x24, 0x04, 0xa2, 0xed, 0x52, 0x2f, 0x20,
-	0xfd, 0xaa, 0x5a, 0x29, 0x83, 0x35, 0x87, 0x31, 0xa7, 0x4d, 0x89, 0xe5, 0xbb, 0xc4, 0xf2, 0x3c,
-	0x16, 0x88, 0x04, 0x43, 0xf7, 0x15, 0x87, 0x39, 0x4c, 0x2c, 0xc9, 0x60, 0x25, 0x77, 0xf5, 0x6b,
-	0xb0, 0xf6, 0xf1, 0x00, 0xd9, 0x2d, 0xba, 0x1f, 0xec, 0xd0, 0x7b, 0x3d, 0xea, 0xd9, 0x74, 0x87,
-	0x7a, 0x4d, 0x73, 0xb0, 0xe6, 0x01, 0x5e, 0x85, 0x45, 0x99, 0xa3, 0xee, 0x36, 0x8b, 0xa8, 0x8c,
-	0x2a, 0x8b, 0xe6, 0x82, 0xdc, 0xf8, 0xa0, 0xa9, 0x7f, 0x87, 0xe0, 0xe5, 0x19, 0xde, 0xdc, 0x67,
-	0x1e, 0xa7, 0xf8, 0x0d, 0xc0, 0x1e, 0xdd, 0x0f, 0xea, 0x5c, 0xfd, 0x58, 0xe7, 0xd4, 0x93, 0x71,
-	0xf2, 0xe6, 0xb2, 0x37, 0xe1, 0x85, 0x57, 0xe0, 0x8c, 0xdf, 0x65, 0xec, 0xb3, 0xe2, 0x7c, 0x19,
-	0x55, 0x96, 0x4c, 0xf9, 0x81, 0xb7, 0x60, 0x49, 0x2c, 0xea, 0x7b, 0xd4, 0x75, 0xf6, 0x82, 0x62,
-	0xae, 0x8c, 0x2a, 0x85, 0x9a, 0x66, 0x8c, 0x4b, 0x2e, 0x8b, 0xd0, 0xaf, 0x1a, 0xdb, 0xc2, 0x62,
-	0x33, 0xff, 0xe8, 0xf7, 0x8b, 0x73, 0x66, 0x41, 0x78, 0xc9, 0x2d, 0xfd, 0x8e, 0xe2, 0x79, 0xdb,
-	0xb2, 0x5b, 0x34, 0xd8, 0x62, 0x9d, 0x8e, 0x1b, 0x74, 0xa8, 0x17, 0xa4, 0xe1, 0x89, 0x35, 0x58,
-	0x18, 0x12, 0x10, 0xd0, 0xf2, 0xe6, 0xe8, 0x5b, 0xff, 0x76, 0x58, 0x83, 0xe9, 0xc8, 0xaa, 0x06,
-	0x25, 0x00, 0x7b, 0xb4, 0x2b, 0x62, 0x2f, 0x99, 0xa1, 0x9d, 0xd3, 0x64, 0xfd, 0xd5, 0x2c, 0x70,
-	0x3c, 0x15, 0xef, 0x1b, 0x00, 0x63, 0xa1, 0x0a, 0x78, 0x85, 0xda, 0xab, 0x86, 0x54, 0xb5, 0x31,
-	0x50, 0xb5, 0x21, 0x7b, 0x40, 0xa9, 0xda, 0xb8, 0x6d, 0x39, 0x54, 0x05, 0x36, 0x43, 0x9e, 0xfa,
-	0x5f, 0x08, 0x4a, 0xb3, 0x60, 0xa8, 0x22, 0x6d, 0x42, 0x61, 0x5c, 0x12, 0x5e, 0x44, 0xe5, 0x5c,
-	0xa5, 0x50, 0x2b, 0x1b, 0x31, 0x6d, 0x65, 0xc8, 0x20, 0x3b, 0x81, 0x15, 0x50, 0x33, 0xec, 0x84,
-	0x6f, 0xc6, 0xc0, 0x7d, 0xed, 0x58, 0xb8, 0x12, 0x40, 0x18, 0x2f, 0xbe, 0x0a, 0x67, 0x33, 0x56,
-	0x5d, 0xd9, 0xeb, 0x77, 0xe1, 0x52, 0x88, 0xe8, 0x86, 0xdd, 0xf2, 0xd8, 0x17, 0x6d, 0xda, 0x74,
-	0xe8, 0x73, 0xd1, 0xda, 0xf7, 0x08, 0xf4, 0xa4, 0xf0, 0xaa, 0x96, 0x15, 0xf8, 0xbf, 0x15, 0xfd,
-	0x49, 0xa9, 0x6e, 0x72, 0xfb, 0x34, 0xa5, 0xf7, 0x38, 0x11, 0xeb, 0xbf, 0xaa, 0x3f, 0xfc, 0x0e,
-	0xac, 0xfa, 0x02, 0x45, 0x7d, 0x2c, 0x97, 0xd1, 0x95, 0xc4, 0x8b, 0xb9, 0x72, 0xae, 0x92, 0x37,
-	0x2f, 0xf8, 0x13, 0xe2, 0x1c, 0x5e, 0x4d, 0x5c, 0xff, 0x1b, 0xc1, 0x2b, 0x89, 0x5c, 0x54, 0xe1,
-	0x3f, 0x82, 0xe5, 0x89, 0x0a, 0xa7, 0x57, 0xf2, 0x94, 0xe7, 0x7f, 0x41, 0xce, 0x9f, 0xc0, 0x85,
-	0x10, 0x6f, 0x93, 0xda, 0xd4, 0xf5, 0x9f, 0x5d, 0xc6, 0x0f, 0x11, 0x68, 0x71, 0x61, 0x55, 0x15,
-	0x35, 0x58, 0xe8, 0x0e, 0xb6, 0xfa, 0xb4, 0x29, 0x5c, 0x17, 0xcc, 0xd1, 0xf7, 0x58, 0xb0, 0xb9,
-	0x24, 0xc1, 0xe6, 0x4f, 0x22, 0xd8, 0x5d, 0x75, 0x55, 0x7e, 0xea, 0x0d, 0xb3, 0x49, 0x78, 0xe9,
-	0xa4, 0xba, 0x06, 0x8b, 0x63, 0x41, 0xcd, 0x0b, 0x41, 0x8d, 0x37, 0xf4, 0x7d, 0x75, 0xff, 0xc5,
-	0xc4, 0x56, 0xa4, 0x23, 0xfe, 0x68, 0xc2, 0x3f, 0x74, 0x82, 0xf3, 0x19, 0x4f, 0xb0, 0xa5, 0x4a,
-	0x3d, 0xce, 0xbc, 0x61, 0xb7, 0xd2, 0x51, 0x5a, 0x87, 0x15, 0xd5, 0x35, 0x96, 0xdd, 0xaa, 0x4f,
-	0xb2, 0xc3, 0xfe, 0xb0, 0x17, 0xc6, 0x7d, 0xd2, 0x83, 0xd5, 0xd8, 0x64, 0xa7, 0xcb, 0xb1, 0xf6,
-	0xf5, 0x39, 0x38, 0x23, 0xf2, 0xe2, 0x1f, 0x11, 0x2c, 0x4f, 0xbe, 0x45, 0x70, 0x35, 0xb6, 0xf7,
-	0x92, 0x5e, 0x3d, 0x5a, 0x2d, 0x8b, 0x8b, 0x64, 0xa7, 0x6f, 0x7d, 0xf9, 0xcb, 0x9f, 0x0f, 0xe7,
-	0xaf, 0xe3, 0x6b, 0x24, 0xee, 0x29, 0x27, 0x29, 0x70, 0xf2, 0x60, 0x54, 0xef, 0x03, 0x32, 0xfd,
-	0x32, 0xc2, 0x8f, 0x11, 0x2c, 0x4f, 0x0e, 0xc9, 0x24, 0x02, 0x33, 0x9e, 0x33, 0x49, 0x04, 0x66,
-	0xbd, 0x53, 0xf4, 0x5b, 0x82, 0xc0, 0x36, 0xbe, 0x91, 0x9a, 0xc0, 0xd4, 0xa5, 0xca, 0xc9, 0x83,
-	0x21, 0x9f, 0x03, 0xfc, 0x13, 0x82, 0xf3, 0x53, 0x03, 0x1f, 0x67, 0x40, 0x36, 0x94, 0xa9, 0x76,
-	0x25, 0x93, 0xcf, 0x89, 0xcf, 0x63, 0x9a, 0x0e, 0xfe, 0x19, 0xc1, 0x8b, 0xb1, 0x97, 0x3e, 0x7e,
-	0xeb, 0x38, 0x4c, 0xf1, 0xc3, 0x5f, 0x7b, 0x3b, 0xb3, 0x9f, 0xe2, 0x73, 0x53, 0xf0, 0xd9, 0xc0,
-	0xef, 0x66, 0xe5, 0x63, 0xd9, 0xad, 0xc8, 0xb9, 0xfc, 0x8a, 0xe0, 0xa5, 0xf8, 0x41, 0x86, 0xb3,
-	0x82, 0x1b, 0x9d, 0xd0, 0xd5, 0xec, 0x8e, 0x8a, 0xd6, 0xb6, 0xa0, 0xb5, 0x89, 0xdf, 0x3b, 0x01,
-	0xad, 0x28, 0xf8, 0x1f, 0x10, 0x9c, 0x8b, 0x4c, 0x14, 0x6c, 0x1c, 0x87, 0x2a, 0x3a, 0xd1, 0x34,
-	0x92, 0xda, 0x5e, 0x81, 0xff, 0x50, 0x80, 0x7f, 0x1f, 0x6f, 0x65, 0x05, 0xdf, 0x95, 0x81, 0x22,
-	0xe7, 0xf2, 0x14, 0xc1, 0xf9, 0xa9, 0x01, 0x91, 0xd4, 0x2f, 0xb3, 0x26, 0x55, 0x52, 0xbf, 0xcc,
-	0x9c, 0x40, 0x7a, 0x43, 0x70, 0xb9, 0x8b, 0x77, 0x9f, 0x4b, 0xfb, 0xf3, 0x03, 0xd2, 0x1b, 0xa5,
-	0xaa, 0xfb, 0x8a, 0xcc, 0x1f, 0x08, 0xfe, 0x17, 0x1d, 0x0e, 0x98, 0xa4, 0xc1, 0x1a, 0x9a, 0x59,
-	0xda, 0x7a, 0x7a, 0x07, 0xc5, 0xec, 0x73, 0xc1, 0xac, 0x89, 0x1b, 0xcf, 0xc4, 0x2c, 0x6e, 0x16,
-	0x46, 0x48, 0x0e, 0xfa, 0x6c, 0xf3, 0xce, 0xa3, 0xc3, 0x12, 0x7a, 0x72, 0x58, 0x42, 0x4f, 0x0f,
-	0x4b, 0xe8, 0x9b, 0xa3, 0xd2, 0xdc, 0x93, 0xa3, 0xd2, 0xdc, 0x6f, 0x47, 0xa5, 0xb9, 0xdd, 0xeb,
-	0x8e, 0x1b, 0xec, 0xf5, 0x1a, 0x86, 0xcd, 0x3a, 0x44, 0xfd, 0x63, 0xc0, 0x6d, 0xd8, 0x97, 0x1d,
-	0x46, 0xfa, 0xd5, 0x75, 0xd2, 0x61, 0xcd, 0x5e, 0x9b, 0x72, 0x89, 0x6e, 0xfd, 0xcd, 0xcb, 0x21,
-	0x80, 0xc1, 0x7d, 0x9f, 0xf2, 0xc6, 0x59, 0xf1, 0xf7, 0xfa, 0x95, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x2d, 0xe8, 0xdc, 0xcf, 0x8b, 0x10, 0x00, 0x00,
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xef, 0x4d, 0xbb, 0xa9, 0x3d, 0xe9, 0xba, 0xee, 0xae, 0x83, 0xd4, 0x2d, 0x69, 0xea, 0xf1,
+	0x27, 0x4c, 0xad, 0x9d, 0xa4, 0xa3, 0x2b, 0x9a, 0x56, 0x68, 0x0b, 0x6b, 0x11, 0x68, 0x1a, 0x2e,
+	0x68, 0x52, 0x35, 0x29, 0x72, 0x9c, 0x8b, 0x6b, 0xd2, 0xd8, 0xae, 0xed, 0x84, 0x4e, 0x55, 0x5f,
+	0x10, 0x1f, 0x00, 0x69, 0x6f, 0x7c, 0x01, 0xe0, 0x81, 0x07, 0x3e, 0x00, 0x48, 0xbc, 0xc0, 0xf6,
+	0x36, 0x84, 0x90, 0x78, 0x82, 0xa9, 0x45, 0xe2, 0x1b, 0xec, 0x19, 0xe5, 0xfa, 0x3a, 0xb1, 0x1d,
+	0x27, 0xb5, 0xbb, 0x16, 0xf1, 0xe6, 0x5c, 0x9f, 0xdf, 0xb9, 0xbf, 0xdf, 0xb9, 0xe7, 0x9e, 0xfe,
+	0x6a, 0x98, 0xd1, 0x2a, 0x8a, 0xa8, 0x18, 0x16, 0x11, 0x95, 0x6d, 0x59, 0xd7, 0xc9, 0x8e, 0xd8,
+	0x2c, 0x89, 0xbb, 0x0d, 0x62, 0x3d, 0x10, 0x4c, 0xcb, 0x70, 0x0c, 0x7c, 0x59, 0xab, 0x28, 0x42,
+	0x2b, 0x40, 0x60, 0x01, 0x42, 0xb3, 0xc4, 0x5d, 0x53, 0x0c, 0xbb, 0x6e, 0xd8, 0x62, 0x45, 0xb6,
+	0x89, 0x1b, 0x2d, 0x36, 0x8b, 0x15, 0xe2, 0xc8, 0x45, 0xd1, 0x94, 0x55, 0x4d, 0x97, 0x1d, 0xcd,
+	0xd0, 0xdd, 0x04, 0xdc, 0xeb, 0x51, 0x3b, 0x28, 0x9a, 0xa5, 0x34, 0x34, 0xa7, 0x5c, 0xb1, 0x88,
+	0x5c, 0x23, 0x16, 0x0b, 0x9d, 0x8d, 0x0a, 0x35, 0xad, 0x86, 0xae, 0xe9, 0x6a, 0xbf, 0x10, 0x95,
+	0xe8, 0xc4, 0xd6, 0x6c, 0x16, 0xe2, 0x93, 0xb4, 0xa3, 0x11, 0xdd, 0x11, 0x9b, 0x45, 0xf6, 0xc4,
+	0x02, 0xa6, 0x55, 0xc3, 0x50, 0x77, 0x88, 0x28, 0x9b, 0x9a, 0x28, 0xeb, 0xba, 0xe1, 0x50, 0xba,
+	0x1e, 0x7c, 0x42, 0x35, 0x54, 0x83, 0x3e, 0x8a, 0xad, 0x27, 0x77, 0x95, 0xbf, 0x09, 0xd3, 0x1f,
+	0xb6, 0x74, 0xde, 0x21, 0x7b, 0xce, 0x26, 0xd9, 0x6d, 0x10, 0x5d, 0x21, 0x9b, 0x44, 0xaf, 0x4a,
+	0xad, 0x67, 0xdb, 0xc1, 0x53, 0x30, 0xe2, 0xee, 0x51, 0xd6, 0xaa, 0x19, 0x94, 0x43, 0xf9, 0x11,
+	0x69, 0xd8, 0x5d, 0x78, 0xaf, 0xca, 0x7f, 0x83, 0xe0, 0xa5, 0x1e, 0x68, 0xdb, 0x34, 0x74, 0x9b,
+	0xe0, 0x39, 0xc0, 0x3a, 0xd9, 0x73, 0xca, 0x36, 0x7b, 0x59, 0xb6, 0x89, 0xee, 0xe6, 0x19, 0x92,
+	0xc6, 0xf5, 0x10, 0x0a, 0x4f, 0xc0, 0x39, 0xd3, 0x32, 0x8c, 0x4f, 0x32, 0xa9, 0x1c, 0xca, 0x8f,
+	0x4a, 0xee, 0x0f, 0xbc, 0x06, 0xa3, 0xf4, 0xa1, 0xbc, 0x4d, 0x34, 0x75, 0xdb, 0xc9, 0x0c, 0xe6,
+	0x50, 0x3e, 0x5d, 0xe2, 0x84, 0xce, 0x01, 0xba, 0x45, 0x68, 0x16, 0x85, 0x0d, 0x1a, 0xb1, 0x3a,
+	0xf4, 0xe8, 0xcf, 0x99, 0x01, 0x29, 0x4d, 0x51, 0xee, 0x12, 0xbf, 0x0c, 0x33, 0x5d, 0x4c, 0x25,
+	0xa2, 0x10, 0xad, 0x49, 0x62, 0x49, 0xfd, 0x1e, 0x41, 0xae, 0x77, 0x02, 0xa6, 0xb6, 0x04, 0x57,
+	0x82, 0x6a, 0x2d, 0x37, 0x80, 0x09, 0xbe, 0xac, 0x77, 0x63, 0xcf, 0x52, 0xf3, 0x3d, 0x76, 0xb6,
+	0x77, 0x65, 0xa5, 0x46, 0x9c, 0x35, 0xa3, 0x5e, 0xd7, 0x9c, 0x3a, 0xd1, 0x9d, 0x38, 0x82, 0x31,
+	0x07, 0xc3, 0x9e, 0x0c, 0x4a, 0x6d, 0x48, 0x6a, 0xff, 0xe6, 0xbf, 0xf2, 0xce, 0xbd, 0x3b, 0x33,
+	0xab, 0x44, 0x16, 0x40, 0x69, 0xaf, 0xd2, 0xdc, 0xa3, 0x92, 0x6f, 0xe5, 0x2c, 0x55, 0x7f, 0xd1,
+	0x8b, 0x9c, 0x1d, 0x4b, 0xf7, 0x6d, 0x80, 0xce, 0x55, 0xa7, 0xf4, 0xd2, 0xa5, 0x57, 0x05, 0x77,
+	0x2e, 0x08, 0xad, 0xb9, 0x20, 0xb8, 0x53, 0x84, 0xcd, 0x05, 0xe1, 0xae, 0xac, 0x7a, 0x1d, 0x24,
+	0xf9, 0x90, 0xfc, 0x3f, 0x08, 0xb2, 0xbd, 0x68, 0xb0, 0x22, 0xad, 0x42, 0xba, 0x53, 0x12, 0x3b,
+	0x83, 0x72, 0x83, 0xf9, 0x74, 0x29, 0x27, 0x44, 0x0c, 0x26, 0xc1, 0x4d, 0xb2, 0xe9, 0xc8, 0x0e,
+	0x91, 0xfc, 0x20, 0xbc, 0x1e, 0x41, 0xf7, 0xb5, 0x63, 0xe9, 0xba, 0x04, 0xfc, 0x7c, 0xf1, 0x12,
+	0x9c, 0x4f, 0x58, 0x75, 0x16, 0xcf, 0xdf, 0x87, 0x59, 0x9f, 0xd0, 0x15, 0xa5, 0xa6, 0x1b, 0x9f,
+	0xed, 0x90, 0xaa, 0x4a, 0x4e, 0xa5, 0xd7, 0xbe, 0x45, 0xc0, 0xf7, 0x4b, 0xcf, 0x6a, 0x99, 0x87,
+	0x8b, 0x72, 0xf0, 0x15, 0xeb, 0xba, 0xf0, 0xf2, 0x59, 0xb6, 0xde, 0xe3, 0xbe, 0x5c, 0xff, 0xd3,
+	0xfe, 0xc3, 0xcb, 0x30, 0x65, 0x52, 0x16, 0xe5, 0x4e, 0xbb, 0xb4, 0x07, 0x93, 0x9d, 0x19, 0xcc,
+	0x0d, 0xe6, 0x87, 0xa4, 0x49, 0x33, 0xd4, 0x9c, 0xde, 0x74, 0xb2, 0xf9, 0x67, 0x08, 0xae, 0xf6,
+	0xd5, 0xc2, 0x0a, 0xff, 0x01, 0x8c, 0x87, 0x2a, 0x1c, 0xbf, 0x93, 0xbb, 0x90, 0xff, 0x87, 0x76,
+	0xfe, 0x08, 0x26, 0x7d, 0xba, 0xe9, 0x98, 0x36, 0x9f, 0xbf, 0x8d, 0x1f, 0x22, 0xe0, 0xa2, 0xd2,
+	0xb2, 0x2a, 0x72, 0x30, 0xcc, 0xfe, 0x56, 0x54, 0x29, 0x74, 0x58, 0x6a, 0xff, 0xee, 0x34, 0xec,
+	0x60, 0xbf, 0x86, 0x1d, 0x3a, 0x49, 0xc3, 0x6e, 0xb1, 0x51, 0xf9, 0xb1, 0xee, 0xed, 0xe6, 0xd2,
+	0x8b, 0xd7, 0xaa, 0xd3, 0x30, 0xd2, 0x69, 0xa8, 0x14, 0x6d, 0xa8, 0xce, 0x02, 0xbf, 0x07, 0xd9,
+	0x5e, 0xb9, 0x99, 0xe8, 0x00, 0x1e, 0x85, 0xf0, 0xbe, 0x13, 0x4c, 0x25, 0x3c, 0xc1, 0x1a, 0x70,
+	0xa1, 0x9d, 0x57, 0x94, 0x5a, 0x3c, 0x49, 0x05, 0x98, 0x60, 0xb7, 0x46, 0x56, 0x6a, 0xe5, 0xb0,
+	0x3a, 0x6c, 0x7a, 0x77, 0xa1, 0x73, 0x4f, 0x1a, 0x30, 0x15, 0xb9, 0xd9, 0x19, 0x6b, 0x9c, 0x63,
+	0x1a, 0xd7, 0x5c, 0xc3, 0xb9, 0xea, 0xfa, 0x4d, 0x4f, 0xe3, 0x18, 0xa4, 0xda, 0xe2, 0x52, 0x5a,
+	0x95, 0xdf, 0x85, 0xa9, 0xc8, 0x68, 0x46, 0x52, 0x82, 0x8b, 0x21, 0xe3, 0x4a, 0xb1, 0xe9, 0xd2,
+	0xd5, 0xc8, 0x2b, 0x1c, 0xcc, 0xc2, 0x88, 0x8d, 0x29, 0x81, 0x55, 0xfe, 0x15, 0x36, 0x3e, 0x82,
+	0xc1, 0xeb, 0x0d, 0xd9, 0xaa, 0x6a, 0xb2, 0xee, 0x9d, 0x06, 0xff, 0x0e, 0xbc, 0xdc, 0x3f, 0xac,
+	0x53, 0x47, 0xd5, 0x5b, 0xa4, 0x75, 0x1c, 0x91, 0x3a, 0x0b, 0xfc, 0x0d, 0xc8, 0xb8, 0x97, 0xcb,
+	0xf5, 0xd4, 0xee, 0x74, 0x89, 0x63, 0xeb, 0x34, 0x98, 0x8c, 0x00, 0xb6, 0x47, 0xdb, 0x05, 0x66,
+	0xd2, 0xcb, 0x76, 0xeb, 0x05, 0x2b, 0xca, 0x6c, 0xf4, 0x5c, 0xf3, 0x65, 0x60, 0x25, 0x19, 0x35,
+	0x7d, 0x6b, 0xa5, 0x67, 0x18, 0xce, 0xd1, 0xbd, 0xf0, 0x8f, 0x08, 0xc6, 0xc3, 0x8e, 0x19, 0x17,
+	0x23, 0xb3, 0xf6, 0xf3, 0xe6, 0x5c, 0x29, 0x09, 0xc4, 0xd5, 0xc4, 0xaf, 0x7d, 0xfe, 0xdb, 0xdf,
+	0x0f, 0x53, 0xb7, 0xf0, 0x4d, 0x31, 0xf2, 0xdf, 0x17, 0x5a, 0x17, 0x5b, 0xdc, 0x6f, 0x57, 0xec,
+	0x40, 0xec, 0xf6, 0xef, 0xf8, 0x17, 0x04, 0x97, 0x23, 0x7c, 0x30, 0xbe, 0x1e, 0x8f, 0x50, 0xd0,
+	0x77, 0x73, 0x6f, 0x24, 0x44, 0x9d, 0x92, 0x12, 0x8b, 0x28, 0x4d, 0xfc, 0x18, 0xc1, 0x78, 0xd8,
+	0xa0, 0xf5, 0x3b, 0x8a, 0x1e, 0x56, 0x9a, 0x2b, 0x25, 0x81, 0x30, 0x01, 0x77, 0xa8, 0x80, 0x0d,
+	0x7c, 0x3b, 0xb6, 0x80, 0xae, 0x3f, 0xe8, 0xb6, 0xb8, 0xef, 0xe9, 0x39, 0xc0, 0x3f, 0x21, 0xb8,
+	0x14, 0xde, 0xcc, 0xc6, 0x09, 0x98, 0x79, 0x97, 0x92, 0x5b, 0x48, 0x84, 0x39, 0xf1, 0x79, 0x74,
+	0xcb, 0xc1, 0xbf, 0x22, 0xb8, 0x12, 0x69, 0x38, 0xf0, 0xe2, 0x71, 0x9c, 0xa2, 0x8d, 0x27, 0x77,
+	0x23, 0x31, 0x8e, 0xe9, 0x59, 0xa7, 0x7a, 0x56, 0xf0, 0x5b, 0x49, 0xf5, 0xc8, 0x4a, 0x2d, 0x70,
+	0x2e, 0xbf, 0x23, 0x78, 0x21, 0x72, 0x2b, 0x1b, 0x27, 0x25, 0xd7, 0x3e, 0xa1, 0xa5, 0xe4, 0x40,
+	0x26, 0x6b, 0x83, 0xca, 0x5a, 0xc5, 0x6f, 0x9f, 0x40, 0x56, 0x90, 0xfc, 0x0f, 0x08, 0x2e, 0x04,
+	0xdc, 0x0c, 0x16, 0x8e, 0x63, 0x15, 0x74, 0x53, 0x9c, 0x18, 0x3b, 0x9e, 0x91, 0x7f, 0x9f, 0x92,
+	0x7f, 0x17, 0xaf, 0x25, 0x25, 0x6f, 0xb9, 0x89, 0x02, 0xe7, 0xf2, 0x14, 0xc1, 0xa5, 0x2e, 0x73,
+	0xd2, 0xef, 0xbe, 0xf4, 0x72, 0x49, 0xdc, 0x42, 0x22, 0x0c, 0xd3, 0x52, 0xa1, 0x5a, 0xee, 0xe3,
+	0xad, 0x53, 0xb9, 0xfe, 0xf6, 0x81, 0xd8, 0x68, 0x6f, 0x55, 0x36, 0x99, 0x98, 0xbf, 0x10, 0x8c,
+	0x05, 0x8d, 0x09, 0x16, 0xe3, 0x70, 0xf5, 0xf9, 0x25, 0xae, 0x10, 0x1f, 0xc0, 0x94, 0x7d, 0x4a,
+	0x95, 0x55, 0x71, 0xe5, 0xb9, 0x94, 0x45, 0xf9, 0xb0, 0x80, 0xc8, 0xd6, 0x3d, 0xc3, 0x5f, 0x23,
+	0x18, 0x0b, 0x7a, 0x87, 0x7e, 0x0a, 0x23, 0xdd, 0x12, 0x57, 0x88, 0x0f, 0x60, 0x0a, 0x4b, 0x54,
+	0xe1, 0x1c, 0xbe, 0x26, 0xc6, 0xf8, 0x08, 0x68, 0x8b, 0xfb, 0x5a, 0xf5, 0x00, 0xff, 0x8c, 0xe0,
+	0xc5, 0x1e, 0x2e, 0x07, 0x2f, 0xc5, 0x65, 0x10, 0xf6, 0x4f, 0xdc, 0x9b, 0x27, 0x40, 0x32, 0x11,
+	0x8b, 0x54, 0x44, 0x01, 0x0b, 0x71, 0x44, 0x94, 0xdb, 0x66, 0x0b, 0x7f, 0x87, 0x60, 0xd4, 0xef,
+	0x76, 0xf0, 0x7c, 0x9f, 0x6b, 0xdc, 0x6d, 0xc8, 0x38, 0x21, 0x6e, 0x38, 0xe3, 0xb9, 0x4c, 0x79,
+	0x2e, 0xe1, 0xc5, 0xf8, 0xed, 0xe4, 0x77, 0x6d, 0xab, 0xf7, 0x1e, 0x1d, 0x66, 0xd1, 0x93, 0xc3,
+	0x2c, 0x7a, 0x7a, 0x98, 0x45, 0x5f, 0x1e, 0x65, 0x07, 0x9e, 0x1c, 0x65, 0x07, 0xfe, 0x38, 0xca,
+	0x0e, 0x6c, 0xdd, 0x52, 0x35, 0x67, 0xbb, 0x51, 0x11, 0x14, 0xa3, 0x2e, 0xb2, 0x2f, 0xbf, 0x5a,
+	0x45, 0x99, 0x57, 0x0d, 0xb1, 0x59, 0x2c, 0x88, 0x75, 0xa3, 0xda, 0xd8, 0x21, 0xb6, 0xbb, 0x63,
+	0xe1, 0xfa, 0xbc, 0x6f, 0x53, 0xe7, 0x81, 0x49, 0xec, 0xca, 0x79, 0xfa, 0x09, 0x75, 0xe1, 0xdf,
+	0x01, 0x00, 0xd7, 0xb6, 0xd0, 0x72, 0x6c, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1072,6 +1475,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// NextSequenceSend returns the next send sequence for a given channel.
 	NextSequenceSend(ctx context.Context, in *QueryNextSequenceSendRequest, opts ...grpc.CallOption) (*QueryNextSequenceSendResponse, error)
+	// NextSequenceReceive returns the next receive sequence for a given ordered client.
+	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
 	// PacketCommitment queries a stored packet commitment hash.
 	PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
@@ -1086,6 +1491,12 @@ type QueryClient interface {
 	UnreceivedPackets(ctx context.Context, in *QueryUnreceivedPacketsRequest, opts ...grpc.CallOption) (*QueryUnreceivedPacketsResponse, error)
 	// UnreceivedAcks returns all the unreceived IBC acknowledgements associated with a channel and sequences.
 	UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAcksRequest, opts ...grpc.CallOption) (*QueryUnreceivedAcksResponse, error)
+	// CircuitBreaker queries the paused packet flows of a channel or client.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
+	// CircuitBreakerGuardians queries the addresses allowed to update circuit breakers in addition to the authority.
+	CircuitBreakerGuardians(ctx context.Context, in *QueryCircuitBreakerGuardiansRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerGuardiansResponse, error)
+	// PruningState queries the progress of the pruning of the packet receipts and acknowledgements of a client.
+	PruningState(ctx context.Context, in *QueryPruningStateRequest, opts ...grpc.CallOption) (*QueryPruningStateResponse, error)
 }
 
 type queryClient struct {
@@ -1105,17 +1516,26 @@ func (c *queryClient) NextSequenceSend(ctx context.Context, in *QueryNextSequenc
 	return out, nil
 }
 
-func (c *queryClient) PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error) {
-	out := new(QueryPacketCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketCommitment", in, out, opts...)
+func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error) {
+	out := new(QueryNextSequenceReceiveResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/NextSequenceReceive", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) PacketCommitments(ctx context.Context, in *QueryPacketCommitmentsRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsResponse, error) {
-	out := new(QueryPacketCommitmentsResponse)
+func (c *queryClient) PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error) {
+	out := new(QueryPacketCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketCommitments(ctx context.Context, in *QueryPacketCommitmentsRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsResponse, error) {
+	out := new(QueryPacketCommitmentsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketCommitments", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1168,10 +1588,39 @@ func (c *queryClient) UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAck
 	return out, nil
 }
 
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakerGuardians(ctx context.Context, in *QueryCircuitBreakerGuardiansRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerGuardiansResponse, error) {
+	out := new(QueryCircuitBreakerGuardiansResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/CircuitBreakerGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PruningState(ctx context.Context, in *QueryPruningStateRequest, opts ...grpc.CallOption) (*QueryPruningStateResponse, error) {
+	out := new(QueryPruningStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PruningState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NextSequenceSend returns the next send sequence for a given channel.
 	NextSequenceSend(context.Context, *QueryNextSequenceSendRequest) (*QueryNextSequenceSendResponse, error)
+	// NextSequenceReceive returns the next receive sequence for a given ordered client.
+	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
 	// PacketCommitment queries a stored packet commitment hash.
 	PacketCommitment(context.Context, *QueryPacketCommitmentRequest) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
@@ -1186,6 +1635,12 @@ type QueryServer interface {
 	UnreceivedPackets(context.Context, *QueryUnreceivedPacketsRequest) (*QueryUnreceivedPacketsResponse, error)
 	// UnreceivedAcks returns all the unreceived IBC acknowledgements associated with a channel and sequences.
 	UnreceivedAcks(context.Context, *QueryUnreceivedAcksRequest) (*QueryUnreceivedAcksResponse, error)
+	// CircuitBreaker queries the paused packet flows of a channel or client.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
+	// CircuitBreakerGuardians queries the addresses allowed to update circuit breakers in addition to the authority.
+	CircuitBreakerGuardians(context.Context, *QueryCircuitBreakerGuardiansRequest) (*QueryCircuitBreakerGuardiansResponse, error)
+	// PruningState queries the progress of the pruning of the packet receipts and acknowledgements of a client.
+	PruningState(context.Context, *QueryPruningStateRequest) (*QueryPruningStateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1195,6 +1650,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) NextSequenceSend(ctx context.Context, req *QueryNextSequenceSendRequest) (*QueryNextSequenceSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceSend not implemented")
 }
+func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
+}
 func (*UnimplementedQueryServer) PacketCommitment(ctx context.Context, req *QueryPacketCommitmentRequest) (*QueryPacketCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketCommitment not implemented")
 }
@@ -1216,6 +1674,15 @@ func (*UnimplementedQueryServer) UnreceivedPackets(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) UnreceivedAcks(ctx context.Context, req *QueryUnreceivedAcksRequest) (*QueryUnreceivedAcksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnreceivedAcks not implemented")
 }
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakerGuardians(ctx context.Context, req *QueryCircuitBreakerGuardiansRequest) (*QueryCircuitBreakerGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakerGuardians not implemented")
+}
+func (*UnimplementedQueryServer) PruningState(ctx context.Context, req *QueryPruningStateRequest) (*QueryPruningStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruningState not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1239,6 +1706,24 @@ func _Query_NextSequenceSend_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSequenceReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextSequenceReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/NextSequenceReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextSequenceReceive(ctx, req.(*QueryNextSequenceReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PacketCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketCommitmentRequest)
 	if err := dec(in); err != nil {
@@ -1365,6 +1850,60 @@ func _Query_UnreceivedAcks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakerGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakerGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/CircuitBreakerGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakerGuardians(ctx, req.(*QueryCircuitBreakerGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PruningState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPruningStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PruningState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/PruningState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PruningState(ctx, req.(*QueryPruningStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v2.Query",
@@ -1374,6 +1913,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceSend",
 			Handler:    _Query_NextSequenceSend_Handler,
 		},
+		{
+			MethodName: "NextSequenceReceive",
+			Handler:    _Query_NextSequenceReceive_Handler,
+		},
 		{
 			MethodName: "PacketCommitment",
 			Handler:    _Query_PacketCommitment_Handler,
@@ -1402,6 +1945,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnreceivedAcks",
 			Handler:    _Query_UnreceivedAcks_Handler,
 		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
+		{
+			MethodName: "CircuitBreakerGuardians",
+			Handler:    _Query_CircuitBreakerGuardians_Handler,
+		},
+		{
+			MethodName: "PruningState",
+			Handler:    _Query_PruningState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v2/query.proto",
@@ -1482,6 +2037,81 @@ func (m *QueryNextSequenceSendResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextSequenceReceiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceReceiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceReceiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NextSequenceReceive != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceReceive))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPacketCommitmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1768,20 +2398,20 @@ func (m *QueryPacketAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA8 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j8 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1972,20 +2602,20 @@ func (m *QueryUnreceivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.Sequences) > 0 {
-		dAtA14 := make([]byte, len(m.Sequences)*10)
-		var j13 int
+		dAtA15 := make([]byte, len(m.Sequences)*10)
+		var j14 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j14++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2030,20 +2660,20 @@ func (m *QueryUnreceivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA17 := make([]byte, len(m.Sequences)*10)
-		var j16 int
+		dAtA18 := make([]byte, len(m.Sequences)*10)
+		var j17 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j17++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA18[j17] = uint8(num)
+			j17++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintQuery(dAtA, i, uint64(j17))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2071,20 +2701,20 @@ func (m *QueryUnreceivedAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.PacketAckSequences) > 0 {
-		dAtA19 := make([]byte, len(m.PacketAckSequences)*10)
-		var j18 int
+		dAtA20 := make([]byte, len(m.PacketAckSequences)*10)
+		var j19 int
 		for _, num := range m.PacketAckSequences {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j19++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintQuery(dAtA, i, uint64(j18))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintQuery(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2129,87 +2759,299 @@ func (m *QueryUnreceivedAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA22 := make([]byte, len(m.Sequences)*10)
-		var j21 int
+		dAtA23 := make([]byte, len(m.Sequences)*10)
+		var j22 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j22++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA23[j22] = uint8(num)
+			j22++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintQuery(dAtA, i, uint64(j21))
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintQuery(dAtA, i, uint64(j22))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryNextSequenceSendRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNextSequenceSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NextSequenceSend != 0 {
-		n += 1 + sovQuery(uint64(m.NextSequenceSend))
-	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakerGuardiansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerGuardiansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerGuardiansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerGuardiansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerGuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerGuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PruningState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryNextSequenceSendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSequenceSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceSend))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNextSequenceReceiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextSequenceReceive != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceReceive))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2454,6 +3296,78 @@ func (m *QueryUnreceivedAcksResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCircuitBreakerGuardiansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerGuardiansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPruningStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPruningStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PruningState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2678,7 +3592,7 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,10 +3615,10 @@ func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketCommitmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2739,25 +3653,6 @@ func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2779,7 +3674,244 @@ func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			}
+			m.NextSequenceReceive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,6 +5804,468 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerGuardiansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerGuardiansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerGuardiansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerGuardiansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerGuardiansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerGuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPruningStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPruningStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPruningStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPruningStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPruningStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPruningStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PruningState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
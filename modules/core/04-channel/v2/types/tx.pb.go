@@ -72,6 +72,7 @@ type MsgSendPacket struct {
 	TimeoutTimestamp uint64    `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	Payloads         []Payload `protobuf:"bytes,3,rep,name=payloads,proto3" json:"payloads"`
 	Signer           string    `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	NonAtomic        bool      `protobuf:"varint,5,opt,name=non_atomic,json=nonAtomic,proto3" json:"non_atomic,omitempty"`
 }
 
 func (m *MsgSendPacket) Reset()         { *m = MsgSendPacket{} }
@@ -230,6 +231,9 @@ type MsgTimeout struct {
 	ProofUnreceived []byte       `protobuf:"bytes,2,opt,name=proof_unreceived,json=proofUnreceived,proto3" json:"proof_unreceived,omitempty"`
 	ProofHeight     types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
 	Signer          string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// next sequence receive of the destination client, only used for the timeout of packets sent over an ordered
+	// client. If it is zero, the absence of the packet receipt is proven instead.
+	NextSequenceRecv uint64 `protobuf:"varint,6,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
 }
 
 func (m *MsgTimeout) Reset()         { *m = MsgTimeout{} }
@@ -383,6 +387,362 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgUpdateCircuitBreaker defines the sdk.Msg type to pause or resume the sending and receiving
+// of packets on a v1 channel or a v2 client.
+type MsgUpdateCircuitBreaker struct {
+	// channel or client unique identifier
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pause the sending of packets
+	SendPaused bool `protobuf:"varint,2,opt,name=send_paused,json=sendPaused,proto3" json:"send_paused,omitempty"`
+	// pause the receiving of packets
+	RecvPaused bool `protobuf:"varint,3,opt,name=recv_paused,json=recvPaused,proto3" json:"recv_paused,omitempty"`
+	// signer address, must be the authority or a circuit breaker guardian
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUpdateCircuitBreaker) Reset()         { *m = MsgUpdateCircuitBreaker{} }
+func (m *MsgUpdateCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreaker) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{8}
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreaker.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreaker proto.InternalMessageInfo
+
+// MsgUpdateCircuitBreakerResponse defines the Msg/UpdateCircuitBreaker response type.
+type MsgUpdateCircuitBreakerResponse struct {
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Reset()         { *m = MsgUpdateCircuitBreakerResponse{} }
+func (m *MsgUpdateCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{9}
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerResponse proto.InternalMessageInfo
+
+// MsgUpdateCircuitBreakerGuardians defines the sdk.Msg type to set the addresses allowed
+// to update circuit breakers in addition to the authority.
+type MsgUpdateCircuitBreakerGuardians struct {
+	// guardian addresses
+	//
+	// NOTE: The full set of guardians must be supplied.
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// signer address, must be the authority
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUpdateCircuitBreakerGuardians) Reset()         { *m = MsgUpdateCircuitBreakerGuardians{} }
+func (m *MsgUpdateCircuitBreakerGuardians) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerGuardians) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerGuardians) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{10}
+}
+func (m *MsgUpdateCircuitBreakerGuardians) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerGuardians) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerGuardians.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerGuardians) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerGuardians.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerGuardians) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerGuardians) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerGuardians.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerGuardians proto.InternalMessageInfo
+
+// MsgUpdateCircuitBreakerGuardiansResponse defines the Msg/UpdateCircuitBreakerGuardians response type.
+type MsgUpdateCircuitBreakerGuardiansResponse struct {
+}
+
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) Reset() {
+	*m = MsgUpdateCircuitBreakerGuardiansResponse{}
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerGuardiansResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerGuardiansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{11}
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerGuardiansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerGuardiansResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerGuardiansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerGuardiansResponse proto.InternalMessageInfo
+
+// MsgPruneAcknowledgements defines the permissionless sdk.Msg type to prune the packet receipts and
+// acknowledgements of packets received over a client. The receipt and acknowledgement of a packet are only
+// pruned once its pruning delay has elapsed and the counterparty is proven to have deleted its packet commitment,
+// that is once its acknowledgement has been relayed to the sender.
+type MsgPruneAcknowledgements struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// sequences of the packets to prune
+	Sequences []uint64 `protobuf:"varint,2,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+	// proofs of the absence of the packet commitments on the counterparty, one for each sequence
+	ProofsAcknowledged [][]byte `protobuf:"bytes,3,rep,name=proofs_acknowledged,json=proofsAcknowledged,proto3" json:"proofs_acknowledged,omitempty"`
+	// height of the counterparty state the proofs are verified against
+	ProofHeight types.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// signer address
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgements) Reset()         { *m = MsgPruneAcknowledgements{} }
+func (m *MsgPruneAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgements) ProtoMessage()    {}
+func (*MsgPruneAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{12}
+}
+func (m *MsgPruneAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgements.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgements proto.InternalMessageInfo
+
+// MsgPruneAcknowledgementsResponse defines the Msg/PruneAcknowledgements response type.
+type MsgPruneAcknowledgementsResponse struct {
+	// number of packets pruned
+	TotalPrunedSequences uint64 `protobuf:"varint,1,opt,name=total_pruned_sequences,json=totalPrunedSequences,proto3" json:"total_pruned_sequences,omitempty"`
+	// number of acknowledged packets of the client still to be pruned
+	TotalRemainingSequences uint64 `protobuf:"varint,2,opt,name=total_remaining_sequences,json=totalRemainingSequences,proto3" json:"total_remaining_sequences,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Reset()         { *m = MsgPruneAcknowledgementsResponse{} }
+func (m *MsgPruneAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgPruneAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{13}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgementsResponse proto.InternalMessageInfo
+
+func (m *MsgPruneAcknowledgementsResponse) GetTotalPrunedSequences() uint64 {
+	if m != nil {
+		return m.TotalPrunedSequences
+	}
+	return 0
+}
+
+func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
+	if m != nil {
+		return m.TotalRemainingSequences
+	}
+	return 0
+}
+
+// MsgVerifyCounterpartyOrdering defines the permissionless sdk.Msg type to verify that the counterparty of an
+// ordered client is ordered, by proving the next receive sequence of the counterparty client. Packets can only
+// be sent and received over an ordered client once the ordering of its counterparty has been verified.
+type MsgVerifyCounterpartyOrdering struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// next sequence receive of the counterparty client
+	NextSequenceRecv uint64 `protobuf:"varint,2,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	// proof of the next sequence receive of the counterparty client
+	ProofNextSequenceRecv []byte       `protobuf:"bytes,3,opt,name=proof_next_sequence_recv,json=proofNextSequenceRecv,proto3" json:"proof_next_sequence_recv,omitempty"`
+	ProofHeight           types.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// signer address
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgVerifyCounterpartyOrdering) Reset()         { *m = MsgVerifyCounterpartyOrdering{} }
+func (m *MsgVerifyCounterpartyOrdering) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyCounterpartyOrdering) ProtoMessage()    {}
+func (*MsgVerifyCounterpartyOrdering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{14}
+}
+func (m *MsgVerifyCounterpartyOrdering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyCounterpartyOrdering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyCounterpartyOrdering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyCounterpartyOrdering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyCounterpartyOrdering.Merge(m, src)
+}
+func (m *MsgVerifyCounterpartyOrdering) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyCounterpartyOrdering) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyCounterpartyOrdering.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyCounterpartyOrdering proto.InternalMessageInfo
+
+// MsgVerifyCounterpartyOrderingResponse defines the Msg/VerifyCounterpartyOrdering response type.
+type MsgVerifyCounterpartyOrderingResponse struct {
+}
+
+func (m *MsgVerifyCounterpartyOrderingResponse) Reset()         { *m = MsgVerifyCounterpartyOrderingResponse{} }
+func (m *MsgVerifyCounterpartyOrderingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyCounterpartyOrderingResponse) ProtoMessage()    {}
+func (*MsgVerifyCounterpartyOrderingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{15}
+}
+func (m *MsgVerifyCounterpartyOrderingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyCounterpartyOrderingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyCounterpartyOrderingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyCounterpartyOrderingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyCounterpartyOrderingResponse.Merge(m, src)
+}
+func (m *MsgVerifyCounterpartyOrderingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyCounterpartyOrderingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyCounterpartyOrderingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyCounterpartyOrderingResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v2.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgSendPacket)(nil), "ibc.core.channel.v2.MsgSendPacket")
@@ -393,63 +753,97 @@ func init() {
 	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v2.MsgTimeoutResponse")
 	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v2.MsgAcknowledgement")
 	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v2.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreaker)(nil), "ibc.core.channel.v2.MsgUpdateCircuitBreaker")
+	proto.RegisterType((*MsgUpdateCircuitBreakerResponse)(nil), "ibc.core.channel.v2.MsgUpdateCircuitBreakerResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreakerGuardians)(nil), "ibc.core.channel.v2.MsgUpdateCircuitBreakerGuardians")
+	proto.RegisterType((*MsgUpdateCircuitBreakerGuardiansResponse)(nil), "ibc.core.channel.v2.MsgUpdateCircuitBreakerGuardiansResponse")
+	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v2.MsgPruneAcknowledgements")
+	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v2.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgVerifyCounterpartyOrdering)(nil), "ibc.core.channel.v2.MsgVerifyCounterpartyOrdering")
+	proto.RegisterType((*MsgVerifyCounterpartyOrderingResponse)(nil), "ibc.core.channel.v2.MsgVerifyCounterpartyOrderingResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/tx.proto", fileDescriptor_d421c7119e969b99) }
 
 var fileDescriptor_d421c7119e969b99 = []byte{
-	// 804 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0xc7, 0x63, 0x62, 0x02, 0x3b, 0x09, 0x9b, 0xac, 0xf7, 0x2d, 0xeb, 0x45, 0x89, 0x95, 0x5d,
-	0x09, 0x36, 0x2b, 0x6c, 0xc8, 0xee, 0x1e, 0x40, 0xda, 0x5d, 0x41, 0xd6, 0x68, 0x91, 0x78, 0x89,
-	0xec, 0x44, 0x55, 0x5b, 0xd4, 0x28, 0x71, 0xa6, 0x8e, 0x45, 0xec, 0x71, 0x33, 0x4e, 0x5a, 0x6e,
-	0x55, 0x4f, 0x28, 0xa7, 0x7e, 0x01, 0xa4, 0x4a, 0xfd, 0x02, 0x1c, 0xfa, 0x21, 0x50, 0x4f, 0x1c,
-	0x39, 0x55, 0x88, 0x1c, 0xf8, 0x1a, 0x95, 0x67, 0x26, 0xaf, 0x38, 0x05, 0xa9, 0xe9, 0x29, 0x9e,
-	0xff, 0xfc, 0x9e, 0xe7, 0x99, 0xe7, 0x3f, 0xce, 0x8c, 0xc1, 0xa2, 0x55, 0x35, 0x14, 0x03, 0x35,
-	0xa1, 0x62, 0xd4, 0x2b, 0x8e, 0x03, 0x1b, 0x4a, 0x3b, 0xa7, 0x78, 0x2f, 0x64, 0xb7, 0x89, 0x3c,
-	0x24, 0x7c, 0x6b, 0x55, 0x0d, 0xd9, 0x9f, 0x95, 0xd9, 0xac, 0xdc, 0xce, 0x89, 0xdf, 0x99, 0xc8,
-	0x44, 0x64, 0x5e, 0xf1, 0x9f, 0x28, 0x2a, 0xfe, 0x68, 0x20, 0x6c, 0x23, 0xac, 0xd8, 0xd8, 0x54,
-	0xda, 0x6b, 0xfe, 0x0f, 0x9b, 0x90, 0x82, 0x2a, 0xb8, 0x15, 0xe3, 0x08, 0x7a, 0x8c, 0x48, 0x0f,
-	0x88, 0x86, 0x05, 0x1d, 0xcf, 0x8f, 0xa7, 0x4f, 0x14, 0xc8, 0xbc, 0xe7, 0xc0, 0xc2, 0x1e, 0x36,
-	0x75, 0xe8, 0xd4, 0x0a, 0x24, 0x50, 0xf8, 0x05, 0x2c, 0x60, 0xd4, 0x6a, 0x1a, 0xb0, 0x4c, 0xc1,
-	0x24, 0x27, 0x71, 0xcb, 0x5f, 0x69, 0x31, 0x2a, 0xe6, 0x89, 0x26, 0xfc, 0x0e, 0xbe, 0xf1, 0x2c,
-	0x1b, 0xa2, 0x96, 0x57, 0xf6, 0x7f, 0xb1, 0x57, 0xb1, 0xdd, 0xe4, 0x8c, 0xc4, 0x2d, 0xf3, 0x5a,
-	0x82, 0x4d, 0x14, 0x7b, 0xba, 0xf0, 0x0f, 0x98, 0x77, 0x2b, 0xc7, 0x0d, 0x54, 0xa9, 0xe1, 0x64,
-	0x58, 0x0a, 0x2f, 0x47, 0x73, 0x8b, 0x72, 0x40, 0xf7, 0x72, 0x81, 0x42, 0x5b, 0xfc, 0xf9, 0x87,
-	0x74, 0x48, 0xeb, 0xc7, 0x08, 0x3f, 0x80, 0x08, 0xb6, 0x4c, 0x07, 0x36, 0x93, 0x3c, 0x59, 0x0a,
-	0x1b, 0x6d, 0xc4, 0x4f, 0xde, 0xa4, 0x43, 0xaf, 0x6e, 0xce, 0xb2, 0x4c, 0xc8, 0xac, 0x83, 0xef,
-	0x47, 0x7a, 0xd1, 0x20, 0x76, 0x91, 0x83, 0xa1, 0x20, 0x82, 0x79, 0x0c, 0x9f, 0xb5, 0xa0, 0x63,
-	0x40, 0xd2, 0x0e, 0xaf, 0xf5, 0xc7, 0x1b, 0xbc, 0x9f, 0x25, 0xd3, 0xa5, 0x3e, 0x68, 0xd0, 0x68,
-	0x33, 0x1f, 0xd6, 0x41, 0x84, 0x5a, 0x49, 0x22, 0xa2, 0xb9, 0x9f, 0x27, 0xac, 0xd9, 0x47, 0xd8,
-	0x92, 0x59, 0x80, 0xf0, 0x1b, 0x48, 0xb8, 0x4d, 0x84, 0x9e, 0x96, 0x0d, 0x64, 0xdb, 0x96, 0x67,
-	0xfb, 0x2e, 0xfa, 0xe6, 0xc4, 0xb4, 0x38, 0xd1, 0xf3, 0x7d, 0x59, 0xc8, 0x83, 0x18, 0x45, 0xeb,
-	0xd0, 0x32, 0xeb, 0x5e, 0x32, 0x4c, 0x6a, 0x89, 0x43, 0xb5, 0xe8, 0x6e, 0xb5, 0xd7, 0xe4, 0xff,
-	0x09, 0xc1, 0x4a, 0x45, 0x49, 0x14, 0x95, 0xee, 0x6f, 0xd0, 0x13, 0x62, 0xd0, 0xa0, 0xc9, 0xbe,
-	0x41, 0xff, 0x82, 0x48, 0x13, 0xe2, 0x56, 0x83, 0x36, 0xfb, 0x75, 0x6e, 0x29, 0xb0, 0xd9, 0x1e,
-	0xae, 0x11, 0xb4, 0x78, 0xec, 0x42, 0x8d, 0x85, 0x31, 0x17, 0xaf, 0x38, 0x00, 0xf6, 0xb0, 0x59,
-	0xa4, 0x6f, 0xc0, 0x54, 0x2c, 0x6c, 0x39, 0x4d, 0x68, 0x40, 0xab, 0x0d, 0x6b, 0x23, 0x16, 0x96,
-	0xfa, 0xf2, 0xb4, 0x2d, 0x9c, 0xfd, 0xb4, 0x85, 0x8f, 0x81, 0x30, 0xe8, 0x70, 0xda, 0xfe, 0xbd,
-	0x9b, 0x21, 0xd9, 0x37, 0x8d, 0x23, 0x07, 0x3d, 0x6f, 0xc0, 0x9a, 0x09, 0xc9, 0x4b, 0xf2, 0x19,
-	0x3e, 0x16, 0x41, 0xbc, 0x32, 0x9a, 0x8d, 0xd8, 0x18, 0xcd, 0xfd, 0x1a, 0x98, 0x63, 0xac, 0x32,
-	0x4b, 0x36, 0x9e, 0x42, 0x48, 0x03, 0x6a, 0x5e, 0xd9, 0x2f, 0x52, 0x23, 0x8e, 0xc7, 0x34, 0x40,
-	0xa4, 0x4d, 0x5f, 0xb9, 0xb5, 0x27, 0xfc, 0x17, 0xdd, 0x13, 0x03, 0x88, 0xb7, 0x5d, 0x9b, 0xf2,
-	0xde, 0x64, 0x2f, 0x39, 0x20, 0xdc, 0x86, 0x84, 0xbf, 0x80, 0xa4, 0xa9, 0x7a, 0xe1, 0x60, 0x5f,
-	0x57, 0xcb, 0x9a, 0xaa, 0x97, 0x76, 0x8b, 0xe5, 0xe2, 0xc3, 0x82, 0x5a, 0x2e, 0xed, 0xeb, 0x05,
-	0x35, 0xbf, 0xb3, 0xbd, 0xa3, 0xfe, 0x97, 0x08, 0x89, 0xf1, 0xce, 0xa9, 0x14, 0x1d, 0x92, 0x84,
-	0x25, 0xf0, 0x53, 0x60, 0xd8, 0xfe, 0xc1, 0x41, 0x21, 0xc1, 0x89, 0xf3, 0x9d, 0x53, 0x89, 0xf7,
-	0x9f, 0x85, 0x15, 0xb0, 0x18, 0x08, 0xea, 0xa5, 0x7c, 0x5e, 0xd5, 0xf5, 0xc4, 0x8c, 0x18, 0xed,
-	0x9c, 0x4a, 0x73, 0x6c, 0x38, 0x11, 0xdf, 0xde, 0xdc, 0xd9, 0x2d, 0x69, 0x6a, 0x22, 0x4c, 0x71,
-	0x36, 0x14, 0xf9, 0x93, 0xb7, 0xa9, 0x50, 0xae, 0x13, 0x06, 0xe1, 0x3d, 0x6c, 0x0a, 0x87, 0x00,
-	0x0c, 0x5d, 0x04, 0x99, 0x40, 0x9f, 0x46, 0x0e, 0x58, 0x31, 0x7b, 0x37, 0xd3, 0xdf, 0x87, 0x43,
-	0x00, 0x86, 0x8e, 0xd7, 0x89, 0xd9, 0x07, 0xcc, 0xe4, 0xec, 0x01, 0x27, 0x98, 0x0e, 0xe6, 0x7a,
-	0xc7, 0x4e, 0x7a, 0x52, 0x18, 0x03, 0xc4, 0xa5, 0x3b, 0x80, 0x7e, 0xd2, 0x23, 0x10, 0x1f, 0xff,
-	0x2f, 0x4e, 0x8c, 0x1d, 0x03, 0x45, 0xe5, 0x9e, 0x60, 0xaf, 0x98, 0x38, 0xfb, 0xf2, 0xe6, 0x2c,
-	0xcb, 0x6d, 0x3d, 0x38, 0xbf, 0x4e, 0x71, 0x17, 0xd7, 0x29, 0xee, 0xea, 0x3a, 0xc5, 0xbd, 0xee,
-	0xa6, 0x42, 0x17, 0xdd, 0x54, 0xe8, 0xb2, 0x9b, 0x0a, 0x3d, 0xfa, 0xdb, 0xb4, 0xbc, 0x7a, 0xab,
-	0x2a, 0x1b, 0xc8, 0x56, 0xd8, 0x27, 0x81, 0x55, 0x35, 0x56, 0x4c, 0xa4, 0xb4, 0xd7, 0x56, 0x15,
-	0x1b, 0xd5, 0x5a, 0x0d, 0x88, 0xe9, 0x6d, 0xbf, 0xfa, 0xe7, 0xca, 0xf0, 0x47, 0xc7, 0xb1, 0x0b,
-	0x71, 0x35, 0x42, 0x6e, 0xfc, 0x3f, 0x3e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x76, 0x66, 0x88, 0x36,
-	0x98, 0x08, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0x8e, 0x93, 0xac, 0x4b, 0x4f, 0xba, 0x35, 0x78, 0xeb, 0x9a, 0x79, 0x6d, 0x62, 0x02, 0xa8,
+	0x21, 0xac, 0xf1, 0x1a, 0x5a, 0xa1, 0x55, 0x1a, 0xa8, 0x0d, 0x19, 0x54, 0x5a, 0xda, 0xc8, 0x49,
+	0x40, 0xc0, 0x84, 0xe5, 0xda, 0x77, 0xae, 0xd5, 0xc4, 0x36, 0xbe, 0x76, 0x58, 0x91, 0x90, 0x10,
+	0x4f, 0x53, 0x85, 0x10, 0x48, 0xe3, 0xb1, 0x12, 0x12, 0xaf, 0x3c, 0xec, 0x81, 0x3f, 0x62, 0x8f,
+	0x7b, 0xdc, 0x13, 0x42, 0xed, 0xc3, 0xc4, 0x3b, 0x7f, 0x00, 0xf2, 0xbd, 0x8e, 0xf3, 0xa3, 0x76,
+	0xbb, 0x42, 0xb5, 0xa7, 0xe4, 0x9e, 0xf3, 0x7d, 0xe7, 0xdc, 0xf3, 0x9d, 0xe3, 0x7b, 0x6d, 0x98,
+	0xd3, 0xb7, 0x15, 0x41, 0x31, 0x6d, 0x24, 0x28, 0x3b, 0xb2, 0x61, 0xa0, 0x8e, 0xd0, 0xab, 0x08,
+	0xce, 0xc3, 0xb2, 0x65, 0x9b, 0x8e, 0xc9, 0x5e, 0xd1, 0xb7, 0x95, 0xb2, 0xe7, 0x2d, 0xfb, 0xde,
+	0x72, 0xaf, 0xc2, 0x5d, 0xd5, 0x4c, 0xcd, 0x24, 0x7e, 0xc1, 0xfb, 0x47, 0xa1, 0xdc, 0xac, 0x62,
+	0xe2, 0xae, 0x89, 0x85, 0x2e, 0xd6, 0x84, 0xde, 0x92, 0xf7, 0xe3, 0x3b, 0xf8, 0xb0, 0x0c, 0x96,
+	0xac, 0xec, 0x22, 0xc7, 0x47, 0xe4, 0x07, 0x88, 0x8e, 0x8e, 0x0c, 0xc7, 0xe3, 0xd3, 0x7f, 0x14,
+	0x50, 0xf8, 0x9b, 0x81, 0x4b, 0x75, 0xac, 0x35, 0x91, 0xa1, 0x36, 0x08, 0x91, 0x7d, 0x03, 0x2e,
+	0x61, 0xd3, 0xb5, 0x15, 0x24, 0x51, 0x60, 0x96, 0xe1, 0x99, 0xe2, 0xa4, 0x38, 0x45, 0x8d, 0x55,
+	0x62, 0x63, 0xdf, 0x81, 0xd7, 0x1c, 0xbd, 0x8b, 0x4c, 0xd7, 0x91, 0xbc, 0x5f, 0xec, 0xc8, 0x5d,
+	0x2b, 0x1b, 0xe7, 0x99, 0x62, 0x52, 0xcc, 0xf8, 0x8e, 0x56, 0xdf, 0xce, 0xbe, 0x0f, 0x29, 0x4b,
+	0xde, 0xeb, 0x98, 0xb2, 0x8a, 0xb3, 0x09, 0x3e, 0x51, 0x4c, 0x57, 0xe6, 0xca, 0x21, 0xd5, 0x97,
+	0x1b, 0x14, 0xb4, 0x9e, 0x7c, 0xfa, 0x67, 0x3e, 0x26, 0x06, 0x1c, 0xf6, 0x1a, 0x4c, 0x60, 0x5d,
+	0x33, 0x90, 0x9d, 0x4d, 0x92, 0xad, 0xf8, 0x2b, 0x76, 0x1e, 0xc0, 0x30, 0x0d, 0x49, 0x76, 0xcc,
+	0xae, 0xae, 0x64, 0x2f, 0xf0, 0x4c, 0x31, 0x25, 0x4e, 0x1a, 0xa6, 0xb1, 0x46, 0x0c, 0xab, 0xd3,
+	0x8f, 0x7e, 0xcd, 0xc7, 0xbe, 0x7f, 0xf1, 0xa4, 0xe4, 0xe3, 0x0b, 0xb7, 0x61, 0x66, 0xa4, 0x54,
+	0x11, 0x61, 0xcb, 0x34, 0x30, 0x62, 0x39, 0x48, 0x61, 0xf4, 0x95, 0x8b, 0x0c, 0x05, 0x91, 0x6a,
+	0x93, 0x62, 0xb0, 0x5e, 0x4d, 0x7a, 0x51, 0x0a, 0x47, 0x54, 0x26, 0x11, 0x29, 0x3d, 0x5f, 0xa6,
+	0xdb, 0x30, 0x41, 0x95, 0x26, 0x8c, 0x74, 0xe5, 0x46, 0x44, 0x49, 0x1e, 0xc4, 0xaf, 0xc8, 0x27,
+	0xb0, 0x6f, 0x43, 0xc6, 0xb2, 0x4d, 0xf3, 0x81, 0xa4, 0x98, 0xdd, 0xae, 0xee, 0x74, 0x3d, 0x91,
+	0x3d, 0xed, 0xa6, 0xc4, 0x69, 0x62, 0xaf, 0x06, 0x66, 0xb6, 0x0a, 0x53, 0x14, 0xba, 0x83, 0x74,
+	0x6d, 0xc7, 0xc9, 0x26, 0x48, 0x2e, 0x6e, 0x28, 0x17, 0x6d, 0x66, 0x6f, 0xa9, 0xfc, 0x31, 0x41,
+	0xf8, 0xa9, 0xd2, 0x84, 0x45, 0x4d, 0x51, 0xfa, 0x1d, 0x17, 0xe8, 0x4b, 0x98, 0x19, 0x29, 0x32,
+	0x10, 0xe8, 0x03, 0x98, 0xb0, 0x11, 0x76, 0x3b, 0xb4, 0xd8, 0xcb, 0x95, 0x85, 0xd0, 0x62, 0xfb,
+	0x70, 0x91, 0x40, 0x5b, 0x7b, 0x16, 0x12, 0x7d, 0x9a, 0xaf, 0xe2, 0x8f, 0x71, 0x80, 0x3a, 0xd6,
+	0x5a, 0x74, 0x40, 0xce, 0x45, 0x42, 0xd7, 0xb0, 0x91, 0x82, 0xf4, 0x1e, 0x52, 0x47, 0x24, 0x6c,
+	0x07, 0xe6, 0xf3, 0x96, 0xf0, 0xc2, 0xc8, 0x08, 0xde, 0x04, 0xd6, 0x40, 0x0f, 0x1d, 0xa9, 0x3f,
+	0x2e, 0x92, 0x8d, 0x94, 0x5e, 0x76, 0x82, 0x3e, 0x08, 0x9e, 0xa7, 0xe9, 0x3b, 0x3c, 0x51, 0x8f,
+	0x0b, 0xfe, 0x05, 0xb0, 0x03, 0x3d, 0xce, 0x5b, 0xed, 0x3f, 0xe2, 0x24, 0xfa, 0x9a, 0xb2, 0x6b,
+	0x98, 0x5f, 0x77, 0x90, 0xaa, 0x21, 0x32, 0x52, 0xff, 0x43, 0xf5, 0x16, 0x4c, 0xcb, 0xa3, 0xd1,
+	0x88, 0xe8, 0xe9, 0xca, 0x9b, 0xa1, 0x31, 0xc6, 0x32, 0xfb, 0xc1, 0xc6, 0x43, 0xb0, 0x79, 0xa0,
+	0x52, 0x4b, 0x5e, 0x12, 0x95, 0xf4, 0x67, 0x4a, 0x04, 0x62, 0x5a, 0x53, 0x76, 0x43, 0x3a, 0x98,
+	0x3c, 0xc7, 0x0e, 0x1e, 0xef, 0x89, 0x02, 0xdc, 0x71, 0xd5, 0xce, 0xbb, 0x37, 0x8f, 0x19, 0x98,
+	0xad, 0x63, 0xad, 0x6d, 0xa9, 0xb2, 0x83, 0xaa, 0xba, 0xad, 0xb8, 0xba, 0xb3, 0x6e, 0x23, 0x79,
+	0x17, 0xd9, 0xec, 0x65, 0x88, 0xeb, 0xaa, 0x7f, 0xea, 0xc6, 0x75, 0xd5, 0xd3, 0x07, 0x23, 0x43,
+	0x95, 0x2c, 0xd9, 0xc5, 0xfe, 0x98, 0xa7, 0x44, 0xc0, 0xe4, 0x18, 0xf3, 0x2c, 0x1e, 0xc0, 0x1b,
+	0xbb, 0x3e, 0x20, 0x41, 0x01, 0x36, 0x79, 0x8c, 0x09, 0xe0, 0xa5, 0x0f, 0x80, 0xd7, 0x21, 0x1f,
+	0xb1, 0xab, 0x7e, 0x45, 0x05, 0x1d, 0xf8, 0x08, 0xc8, 0x47, 0xae, 0x6c, 0xab, 0xba, 0x6c, 0x60,
+	0x76, 0x0e, 0x26, 0xb5, 0xfe, 0x22, 0xcb, 0xf0, 0x89, 0xe2, 0xa4, 0x38, 0x30, 0x0c, 0xed, 0x26,
+	0x7e, 0xf2, 0x6e, 0x4a, 0x50, 0x3c, 0x2d, 0x55, 0xb0, 0xad, 0x7f, 0x18, 0xc8, 0xd6, 0xb1, 0xd6,
+	0xb0, 0x5d, 0x03, 0x8d, 0xf5, 0x0e, 0xb3, 0x37, 0x60, 0x92, 0x8e, 0x88, 0x14, 0x08, 0x9b, 0xa2,
+	0x86, 0x0d, 0xd5, 0xdb, 0x6c, 0xff, 0xe9, 0xc5, 0xd9, 0x38, 0x9f, 0x28, 0x26, 0xc5, 0x81, 0x81,
+	0x15, 0xe0, 0x0a, 0x99, 0x22, 0x2c, 0x0d, 0x8d, 0xad, 0x4a, 0xae, 0xb1, 0x29, 0x91, 0xa5, 0xae,
+	0xa1, 0x7c, 0xaf, 0x7a, 0x58, 0x1f, 0x33, 0xc0, 0x47, 0x95, 0x1d, 0xcc, 0xec, 0x32, 0x5c, 0x73,
+	0x4c, 0x47, 0xee, 0x48, 0x96, 0x07, 0x53, 0xa5, 0x41, 0xb9, 0xf4, 0xb2, 0xbb, 0x4a, 0xbc, 0x24,
+	0x86, 0xda, 0x0c, 0x2a, 0x5f, 0x85, 0xeb, 0x94, 0x65, 0xa3, 0xae, 0xac, 0x1b, 0xba, 0xa1, 0x49,
+	0xc3, 0x3a, 0x79, 0xc4, 0x59, 0x02, 0x10, 0xfb, 0xfe, 0x80, 0x5b, 0xf8, 0x39, 0x0e, 0xf3, 0x75,
+	0xac, 0x7d, 0x82, 0x6c, 0xfd, 0xc1, 0x5e, 0xd5, 0x74, 0x0d, 0x07, 0xd9, 0x96, 0x6c, 0x3b, 0x7b,
+	0x5b, 0xb6, 0x8a, 0x6c, 0xdd, 0xd0, 0x4e, 0x6e, 0x49, 0xf8, 0xa9, 0x1a, 0x0f, 0x3f, 0x55, 0xd9,
+	0xf7, 0x20, 0x4b, 0x15, 0x0f, 0xe1, 0xd0, 0xc3, 0x64, 0x86, 0xf8, 0x37, 0xc7, 0x89, 0xaf, 0xb6,
+	0x55, 0x0b, 0xf0, 0xd6, 0x89, 0x92, 0xf4, 0xdb, 0x55, 0x7a, 0xce, 0x00, 0x7b, 0xfc, 0x00, 0x61,
+	0x57, 0x80, 0x17, 0x6b, 0xcd, 0xc6, 0xd6, 0x66, 0xb3, 0x26, 0x89, 0xb5, 0x66, 0xfb, 0x5e, 0x4b,
+	0x6a, 0x7d, 0xd6, 0xa8, 0x49, 0xed, 0xcd, 0x66, 0xa3, 0x56, 0xdd, 0xb8, 0xbb, 0x51, 0xfb, 0x30,
+	0x13, 0xe3, 0xa6, 0xf7, 0x0f, 0xf8, 0xf4, 0x90, 0x89, 0x5d, 0x80, 0xeb, 0xa1, 0xb4, 0xcd, 0xad,
+	0xad, 0x46, 0x86, 0xe1, 0x52, 0xfb, 0x07, 0x7c, 0xd2, 0xfb, 0xcf, 0x2e, 0xc2, 0x5c, 0x28, 0xb0,
+	0xd9, 0xae, 0x56, 0x6b, 0xcd, 0x66, 0x26, 0xce, 0xa5, 0xf7, 0x0f, 0xf8, 0x8b, 0xfe, 0x32, 0x12,
+	0x7e, 0x77, 0x6d, 0xe3, 0x5e, 0x5b, 0xac, 0x65, 0x12, 0x14, 0xee, 0x2f, 0xb9, 0xe4, 0xa3, 0xdf,
+	0x72, 0xb1, 0xca, 0xef, 0x17, 0x21, 0x51, 0xc7, 0x1a, 0x7b, 0x1f, 0x60, 0xe8, 0x8d, 0xb3, 0x10,
+	0x7a, 0x86, 0x8e, 0xbc, 0xaa, 0x71, 0xa5, 0xd3, 0x31, 0xc1, 0xbc, 0xdf, 0x07, 0x18, 0x7a, 0x51,
+	0x8b, 0x8c, 0x3e, 0xc0, 0x70, 0xa5, 0xd3, 0x31, 0x41, 0xf4, 0x26, 0x5c, 0xec, 0xbf, 0xc0, 0xe4,
+	0xa3, 0x68, 0x3e, 0x80, 0x5b, 0x38, 0x05, 0x10, 0x04, 0xdd, 0x85, 0xe9, 0xf1, 0x7b, 0x3a, 0x92,
+	0x3b, 0x06, 0xe4, 0x84, 0x97, 0x04, 0x06, 0xc9, 0xbe, 0x81, 0xab, 0xa1, 0x17, 0xcf, 0xcd, 0xa8,
+	0x40, 0x61, 0x68, 0x6e, 0xf9, 0x2c, 0xe8, 0x20, 0xf7, 0x2f, 0x0c, 0xcc, 0x9f, 0x7c, 0x79, 0xac,
+	0x9c, 0x25, 0x6e, 0x40, 0xe3, 0xee, 0xfc, 0x27, 0x5a, 0xb0, 0xaf, 0x6f, 0x61, 0x26, 0xfc, 0xee,
+	0x58, 0x8c, 0x8a, 0x1b, 0x0a, 0xe7, 0x56, 0xce, 0x04, 0x0f, 0xd2, 0xff, 0xc0, 0x00, 0x77, 0xc2,
+	0x69, 0x59, 0x89, 0x8a, 0x1a, 0xcd, 0xe1, 0x56, 0xcf, 0xce, 0xe9, 0x6f, 0x87, 0xbb, 0xf0, 0xdd,
+	0x8b, 0x27, 0x25, 0x66, 0xfd, 0xd3, 0xa7, 0x87, 0x39, 0xe6, 0xd9, 0x61, 0x8e, 0xf9, 0xeb, 0x30,
+	0xc7, 0xfc, 0x74, 0x94, 0x8b, 0x3d, 0x3b, 0xca, 0xc5, 0x9e, 0x1f, 0xe5, 0x62, 0x9f, 0xdf, 0xd1,
+	0x74, 0x67, 0xc7, 0xdd, 0x2e, 0x2b, 0x66, 0x57, 0xf0, 0xbf, 0x4e, 0xf5, 0x6d, 0x65, 0x51, 0x33,
+	0x85, 0xde, 0xd2, 0x2d, 0xa1, 0x6b, 0xaa, 0x6e, 0x07, 0x61, 0xfa, 0xe1, 0x79, 0x6b, 0x79, 0x71,
+	0xf8, 0xfb, 0x77, 0xcf, 0x42, 0x78, 0x7b, 0x82, 0x7c, 0x7c, 0xbe, 0xfb, 0xef, 0x00, 0xd5, 0xee,
+	0xd2, 0x34, 0x23, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +866,14 @@ type MsgClient interface {
 	Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// UpdateCircuitBreaker defines a rpc handler method for MsgUpdateCircuitBreaker.
+	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateCircuitBreakerGuardians defines a rpc handler method for MsgUpdateCircuitBreakerGuardians.
+	UpdateCircuitBreakerGuardians(ctx context.Context, in *MsgUpdateCircuitBreakerGuardians, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerGuardiansResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// VerifyCounterpartyOrdering defines a rpc handler method for MsgVerifyCounterpartyOrdering.
+	VerifyCounterpartyOrdering(ctx context.Context, in *MsgVerifyCounterpartyOrdering, opts ...grpc.CallOption) (*MsgVerifyCounterpartyOrderingResponse, error)
 }
 
 type msgClient struct {
@@ -518,6 +920,42 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
+func (c *msgClient) UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error) {
+	out := new(MsgUpdateCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/UpdateCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCircuitBreakerGuardians(ctx context.Context, in *MsgUpdateCircuitBreakerGuardians, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerGuardiansResponse, error) {
+	out := new(MsgUpdateCircuitBreakerGuardiansResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/UpdateCircuitBreakerGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error) {
+	out := new(MsgPruneAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/PruneAcknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyCounterpartyOrdering(ctx context.Context, in *MsgVerifyCounterpartyOrdering, opts ...grpc.CallOption) (*MsgVerifyCounterpartyOrderingResponse, error) {
+	out := new(MsgVerifyCounterpartyOrderingResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/VerifyCounterpartyOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SendPacket defines a rpc handler method for MsgSendPacket.
@@ -528,6 +966,14 @@ type MsgServer interface {
 	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// UpdateCircuitBreaker defines a rpc handler method for MsgUpdateCircuitBreaker.
+	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateCircuitBreakerGuardians defines a rpc handler method for MsgUpdateCircuitBreakerGuardians.
+	UpdateCircuitBreakerGuardians(context.Context, *MsgUpdateCircuitBreakerGuardians) (*MsgUpdateCircuitBreakerGuardiansResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// VerifyCounterpartyOrdering defines a rpc handler method for MsgVerifyCounterpartyOrdering.
+	VerifyCounterpartyOrdering(context.Context, *MsgVerifyCounterpartyOrdering) (*MsgVerifyCounterpartyOrderingResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -546,6 +992,18 @@ func (*UnimplementedMsgServer) Timeout(ctx context.Context, req *MsgTimeout) (*M
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCircuitBreaker(ctx context.Context, req *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCircuitBreakerGuardians(ctx context.Context, req *MsgUpdateCircuitBreakerGuardians) (*MsgUpdateCircuitBreakerGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreakerGuardians not implemented")
+}
+func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
+}
+func (*UnimplementedMsgServer) VerifyCounterpartyOrdering(ctx context.Context, req *MsgVerifyCounterpartyOrdering) (*MsgVerifyCounterpartyOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCounterpartyOrdering not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -623,6 +1081,78 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/UpdateCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, req.(*MsgUpdateCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreakerGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerGuardians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreakerGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/UpdateCircuitBreakerGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreakerGuardians(ctx, req.(*MsgUpdateCircuitBreakerGuardians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneAcknowledgements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/PruneAcknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, req.(*MsgPruneAcknowledgements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyCounterpartyOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyCounterpartyOrdering)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyCounterpartyOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/VerifyCounterpartyOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyCounterpartyOrdering(ctx, req.(*MsgVerifyCounterpartyOrdering))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v2.Msg",
@@ -644,7 +1174,23 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
-	},
+		{
+			MethodName: "UpdateCircuitBreaker",
+			Handler:    _Msg_UpdateCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreakerGuardians",
+			Handler:    _Msg_UpdateCircuitBreakerGuardians_Handler,
+		},
+		{
+			MethodName: "PruneAcknowledgements",
+			Handler:    _Msg_PruneAcknowledgements_Handler,
+		},
+		{
+			MethodName: "VerifyCounterpartyOrdering",
+			Handler:    _Msg_VerifyCounterpartyOrdering_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v2/tx.proto",
 }
@@ -669,6 +1215,16 @@ func (m *MsgSendPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonAtomic {
+		i--
+		if m.NonAtomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -838,6 +1394,11 @@ func (m *MsgTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -998,163 +1559,1703 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourceClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	if m.RecvPaused {
+		i--
+		if m.RecvPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Payloads) > 0 {
-		for _, e := range m.Payloads {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.SendPaused {
+		i--
+		if m.SendPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCircuitBreakerGuardians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerGuardians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerGuardians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofCommitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeout) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgTimeoutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofsAcknowledged) > 0 {
+		for iNdEx := len(m.ProofsAcknowledged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsAcknowledged[iNdEx])
+			copy(dAtA[i:], m.ProofsAcknowledged[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsAcknowledged[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if len(m.Sequences) > 0 {
+		dAtA10 := make([]byte, len(m.Sequences)*10)
+		var j9 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAcknowledgement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Acknowledgement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofAcked)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TotalRemainingSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalRemainingSequences))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TotalPrunedSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPrunedSequences))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAcknowledgementResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVerifyCounterpartyOrdering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyCounterpartyOrdering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyCounterpartyOrdering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofNextSequenceRecv) > 0 {
+		i -= len(m.ProofNextSequenceRecv)
+		copy(dAtA[i:], m.ProofNextSequenceRecv)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofNextSequenceRecv)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyCounterpartyOrderingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyCounterpartyOrderingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyCounterpartyOrderingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NonAtomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSendPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgRecvPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	return n
+}
+
+func (m *MsgTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Acknowledgement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofAcked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
 	if m.Result != 0 {
 		n += 1 + sovTx(uint64(m.Result))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SendPaused {
+		n += 2
+	}
+	if m.RecvPaused {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerGuardians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ProofsAcknowledged) > 0 {
+		for _, b := range m.ProofsAcknowledged {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPrunedSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalPrunedSequences))
+	}
+	if m.TotalRemainingSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalRemainingSequences))
+	}
+	return n
+}
+
+func (m *MsgVerifyCounterpartyOrdering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.ProofNextSequenceRecv)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifyCounterpartyOrderingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payloads = append(m.Payloads, Payload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonAtomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonAtomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,15 +3278,15 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,13 +3314,13 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPaused", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1229,45 +3330,32 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SendPaused = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPaused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payloads = append(m.Payloads, Payload{})
-			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.RecvPaused = bool(v != 0)
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -1321,7 +3409,7 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,31 +3432,12 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1390,7 +3459,7 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerGuardians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,17 +3482,17 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerGuardians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerGuardians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1433,93 +3502,25 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofCommitment == nil {
-				m.ProofCommitment = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1572,7 +3573,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerGuardiansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,31 +3596,12 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerGuardiansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerGuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1641,7 +3623,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,17 +3646,17 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1684,28 +3666,103 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsAcknowledged", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1732,12 +3789,10 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
-			}
+			m.ProofsAcknowledged = append(m.ProofsAcknowledged, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsAcknowledged[len(m.ProofsAcknowledged)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -1823,7 +3878,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,17 +3901,17 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
 			}
-			m.Result = 0
+			m.TotalPrunedSequences = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1866,7 +3921,26 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
+			}
+			m.TotalRemainingSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1892,7 +3966,7 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyCounterpartyOrdering) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,17 +3989,17 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyCounterpartyOrdering: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyCounterpartyOrdering: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1935,30 +4009,29 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
 			}
-			var msglen int
+			m.NextSequenceRecv = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1968,28 +4041,14 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNextSequenceRecv", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2016,9 +4075,9 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAcked == nil {
-				m.ProofAcked = []byte{}
+			m.ProofNextSequenceRecv = append(m.ProofNextSequenceRecv[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNextSequenceRecv == nil {
+				m.ProofNextSequenceRecv = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
@@ -2107,7 +4166,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyCounterpartyOrderingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,31 +4189,12 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifyCounterpartyOrderingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifyCounterpartyOrderingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -32,3 +32,11 @@ func TestPacketAcknowledgementKey(t *testing.T) {
 	actual := hex.EncodeToString(v2.PacketAcknowledgementKey("channel-0", 1))
 	require.Equal(t, "6368616e6e656c2d30030000000000000001", actual)
 }
+
+// TestNextSequenceRecvKey is primarily used to document the expected key output
+// so that other implementations (such as the IBC Solidity) can replicate the
+// same key output. But it is also useful to catch any changes in the keys.
+func TestNextSequenceRecvKey(t *testing.T) {
+	actual := hex.EncodeToString(v2.NextSequenceRecvKey("channel-0"))
+	require.Equal(t, "6368616e6e656c2d3004", actual)
+}
@@ -10,6 +10,7 @@ const (
 	PacketCommitmentBasePrefix      = byte(1)
 	PacketReceiptBasePrefix         = byte(2)
 	PacketAcknowledgementBasePrefix = byte(3)
+	NextSequenceRecvBasePrefix      = byte(4)
 	KeyNextSeqSendPrefix            = "nextSequenceSend/"
 )
 
@@ -53,3 +54,9 @@ func PacketAcknowledgementKey(channelID string, sequence uint64) []byte {
 func NextSequenceSendKey(channelID string) []byte {
 	return fmt.Appendf(nil, "%s/%s", KeyNextSeqSendPrefix, channelID)
 }
+
+// NextSequenceRecvKey returns the store key for the next sequence receive of a given ordered channelID.
+// channelID must be a generated identifier, not provided externally so key collisions are not possible.
+func NextSequenceRecvKey(channelID string) []byte {
+	return append([]byte(channelID), NextSequenceRecvBasePrefix)
+}
@@ -60,6 +60,17 @@ type WriteAcknowledgementWrapper interface {
 		sequence uint64,
 		ack channeltypesv2.Acknowledgement,
 	) error
+
+	// WritePayloadAcknowledgement writes the app acknowledgement of a single payload of a packet
+	// with multiple payloads for an async acknowledgement. The acknowledgement of the packet is
+	// written once the app acknowledgements of all its payloads have been written.
+	WritePayloadAcknowledgement(
+		ctx sdk.Context,
+		srcClientID string,
+		sequence uint64,
+		payloadIndex uint32,
+		appAck []byte,
+	) error
 }
 
 // PacketDataUnmarshaler defines an optional interface which allows a middleware
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Middleware defines the interface that IBC v2 middlewares must implement to be composed into an
+// application stack by the IBCStackBuilder. A middleware wraps the callbacks from core IBC to the
+// underlying application, and the asynchronous acknowledgements written by the underlying application
+// to core IBC.
+type Middleware interface {
+	IBCModule
+	WriteAcknowledgementWrapper
+	WriteAckWrapperSetter
+
+	// SetUnderlyingApplication sets the underlying IBC v2 module. This function is used by the
+	// stack builder after the middleware's initialization to set the module which is below this middleware.
+	SetUnderlyingApplication(IBCModule)
+}
+
+// WriteAckWrapperSetter defines an optional interface for IBC v2 applications which write asynchronous
+// acknowledgements. The stack builder sets the WriteAcknowledgementWrapper of every middleware, and of
+// the base application if it implements this interface, to the middleware above it or to core IBC.
+type WriteAckWrapperSetter interface {
+	// SetWriteAckWrapper sets the WriteAcknowledgementWrapper used to write asynchronous acknowledgements.
+	SetWriteAckWrapper(WriteAcknowledgementWrapper)
+}
+
+// IBCStackBuilder composes a base IBC v2 application and the middlewares wrapping it into an application stack.
+type IBCStackBuilder struct {
+	middlewares     []Middleware
+	baseModule      IBCModule
+	writeAckWrapper WriteAcknowledgementWrapper
+}
+
+// NewIBCStackBuilder creates a new IBCStackBuilder given the WriteAcknowledgementWrapper of core IBC,
+// which is set as the WriteAcknowledgementWrapper of the top level middleware.
+func NewIBCStackBuilder(writeAckWrapper WriteAcknowledgementWrapper) *IBCStackBuilder {
+	return &IBCStackBuilder{
+		writeAckWrapper: writeAckWrapper,
+	}
+}
+
+// Next adds a middleware on top of the stack. Middlewares are added from the bottom to the top of the stack.
+func (b *IBCStackBuilder) Next(middleware Middleware) *IBCStackBuilder {
+	b.middlewares = append(b.middlewares, middleware)
+	return b
+}
+
+// Base sets the base application of the stack.
+func (b *IBCStackBuilder) Base(baseModule IBCModule) *IBCStackBuilder {
+	if baseModule == nil {
+		panic(errors.New("base module cannot be nil"))
+	}
+	if b.baseModule != nil {
+		panic(errors.New("base module already set"))
+	}
+	b.baseModule = baseModule
+	return b
+}
+
+// Build wires the middlewares and the base application together and returns the top level middleware,
+// which can be registered in the Router under one or more ports. If no middlewares were added, the base
+// application is returned, with the WriteAcknowledgementWrapper of core IBC set if it writes asynchronous
+// acknowledgements.
+//
+// Panics:
+//   - if the base module or the WriteAcknowledgementWrapper of core IBC are not set
+//   - if a middleware does not implement PacketDataUnmarshaler while the module below it does, as the
+//     packet data could not be unmarshaled by the middlewares above it
+//   - if a middleware implements PacketDataUnmarshaler while the module below it does not, as the
+//     middleware defers the unmarshaling of packet data to the module below it
+func (b *IBCStackBuilder) Build() IBCModule {
+	if b.baseModule == nil {
+		panic(errors.New("base module cannot be nil"))
+	}
+	if b.writeAckWrapper == nil {
+		panic(errors.New("write acknowledgement wrapper cannot be nil"))
+	}
+
+	if len(b.middlewares) == 0 {
+		if writeAckWrapperSetter, ok := b.baseModule.(WriteAckWrapperSetter); ok {
+			writeAckWrapperSetter.SetWriteAckWrapper(b.writeAckWrapper)
+		}
+
+		return b.baseModule
+	}
+
+	// Build the stack by moving up the middleware list, setting the underlying application
+	// of each middleware and the WriteAcknowledgementWrapper of the underlying module.
+	underlyingModule := b.baseModule
+	for _, middleware := range b.middlewares {
+		if middleware == nil {
+			panic(errors.New("middleware cannot be nil"))
+		}
+
+		_, underlyingUnmarshaler := underlyingModule.(PacketDataUnmarshaler)
+		_, middlewareUnmarshaler := middleware.(PacketDataUnmarshaler)
+		if underlyingUnmarshaler && !middlewareUnmarshaler {
+			panic(fmt.Errorf("middleware %T does not implement %T of the underlying module %T", middleware, (*PacketDataUnmarshaler)(nil), underlyingModule))
+		}
+		if !underlyingUnmarshaler && middlewareUnmarshaler {
+			panic(fmt.Errorf("middleware %T implements %T but the underlying module %T does not", middleware, (*PacketDataUnmarshaler)(nil), underlyingModule))
+		}
+
+		middleware.SetUnderlyingApplication(underlyingModule)
+		if writeAckWrapperSetter, ok := underlyingModule.(WriteAckWrapperSetter); ok {
+			writeAckWrapperSetter.SetWriteAckWrapper(middleware)
+		}
+
+		underlyingModule = middleware
+	}
+
+	// set the WriteAcknowledgementWrapper of core IBC for the top level middleware
+	topLevelMiddleware := b.middlewares[len(b.middlewares)-1]
+	topLevelMiddleware.SetWriteAckWrapper(b.writeAckWrapper)
+
+	return topLevelMiddleware
+}
@@ -0,0 +1,115 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+)
+
+// unmarshalerlessMiddleware is a middleware which does not implement the PacketDataUnmarshaler interface.
+type unmarshalerlessMiddleware struct {
+	api.Middleware
+}
+
+func (s *APITestSuite) TestIBCStackBuilder() {
+	var (
+		builder         *api.IBCStackBuilder
+		baseModule      api.IBCModule
+		writeAckWrapper *channelkeeperv2.Keeper
+	)
+
+	lower, upper := mockv2.NewMiddleware(), mockv2.NewMiddleware()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPanic error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: base module not set",
+			func() {
+				builder = api.NewIBCStackBuilder(writeAckWrapper).Next(lower).Next(upper)
+			},
+			errors.New("base module cannot be nil"),
+		},
+		{
+			"failure: write acknowledgement wrapper is nil",
+			func() {
+				builder = api.NewIBCStackBuilder(nil).Base(baseModule).Next(lower).Next(upper)
+			},
+			errors.New("write acknowledgement wrapper cannot be nil"),
+		},
+		{
+			"failure: middleware does not thread through the packet data unmarshaler",
+			func() {
+				builder.Next(unmarshalerlessMiddleware{mockv2.NewMiddleware()})
+			},
+			fmt.Errorf("middleware %T does not implement %T of the underlying module %T", unmarshalerlessMiddleware{}, (*api.PacketDataUnmarshaler)(nil), upper),
+		},
+		{
+			"failure: underlying module does not implement the packet data unmarshaler",
+			func() {
+				baseModule = struct{ api.IBCModule }{mockv2.NewIBCModule()}
+				builder = api.NewIBCStackBuilder(writeAckWrapper).Base(baseModule).Next(lower)
+			},
+			fmt.Errorf("middleware %T implements %T but the underlying module %T does not", lower, (*api.PacketDataUnmarshaler)(nil), struct{ api.IBCModule }{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			*lower, *upper = mockv2.Middleware{}, mockv2.Middleware{}
+			baseModule = mockv2.NewIBCModule()
+			writeAckWrapper = &channelkeeperv2.Keeper{}
+			builder = api.NewIBCStackBuilder(writeAckWrapper).Base(baseModule).Next(lower).Next(upper)
+
+			tc.malleate()
+
+			if tc.expPanic != nil {
+				s.Require().PanicsWithError(tc.expPanic.Error(), func() { builder.Build() })
+				return
+			}
+
+			stack := builder.Build()
+			s.Require().Same(upper, stack)
+
+			// the callbacks are passed down the stack and the acknowledgements up the stack
+			s.Require().Equal(baseModule, lower.App)
+			s.Require().Same(lower, upper.App)
+			s.Require().Same(upper, lower.WriteAckWrapper)
+			s.Require().Same(writeAckWrapper, upper.WriteAckWrapper)
+
+			// the same stack can be registered under multiple ports
+			router := api.NewRouter().AddRoute("port01", stack).AddRoute("port02", stack)
+			s.Require().Same(stack, router.Route("port01"))
+			s.Require().Same(stack, router.Route("port02"))
+		})
+	}
+
+	s.Run("success: no middlewares", func() {
+		*lower = mockv2.Middleware{}
+
+		// the base module is returned and writes its acknowledgements to core IBC
+		stack := api.NewIBCStackBuilder(writeAckWrapper).Base(lower).Build()
+		s.Require().Same(lower, stack)
+		s.Require().Same(writeAckWrapper, lower.WriteAckWrapper)
+
+		baseModule = mockv2.NewIBCModule()
+		stack = api.NewIBCStackBuilder(writeAckWrapper).Base(baseModule).Build()
+		s.Require().Equal(baseModule, stack)
+	})
+
+	s.Run("failure: base module already set", func() {
+		s.Require().PanicsWithError("base module already set", func() {
+			api.NewIBCStackBuilder(writeAckWrapper).Base(mockv2.NewIBCModule()).Base(mockv2.NewIBCModule())
+		})
+	})
+}
@@ -52,7 +52,7 @@ func NewKeeper(
 	clientV2Keeper := clientv2keeper.NewKeeper(cdc, clientKeeper)
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, storeService, clientKeeper)
 	portKeeper := portkeeper.NewKeeper()
-	channelKeeperV2 := channelkeeperv2.NewKeeper(cdc, storeService, clientKeeper, clientV2Keeper, connectionKeeper)
+	channelKeeperV2 := channelkeeperv2.NewKeeper(cdc, storeService, clientKeeper, clientV2Keeper, connectionKeeper, authority)
 	channelKeeper := channelkeeper.NewKeeper(cdc, storeService, clientKeeper, connectionKeeper, clientV2Keeper, channelKeeperV2)
 
 	return &Keeper{
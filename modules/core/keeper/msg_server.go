@@ -12,6 +12,7 @@ import (
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	internalerrors "github.com/cosmos/ibc-go/v10/modules/core/internal/errors"
@@ -433,6 +434,12 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 
 	switch {
 	case err == nil:
+		// If receiving packets is paused, the packet is not received: neither its receipt nor an acknowledgement
+		// is written, so that it can be relayed again once the circuit breaker is reset, or timed out by the sender
+		if k.ChannelKeeperV2.IsRecvPaused(ctx, msg.Packet.DestinationChannel) {
+			ctx.Logger().Info("receive packet rejected", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", channeltypesv2.ErrCircuitBreakerTripped)
+			return nil, errorsmod.Wrapf(channeltypesv2.ErrCircuitBreakerTripped, "receiving packets is paused for channel: %s", msg.Packet.DestinationChannel)
+		}
 		writeFn()
 	case errors.Is(err, channeltypes.ErrNoOpMsg):
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
@@ -445,8 +452,11 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+	// Anomalies reported by the callback are kept even if its state changes are discarded.
 	cacheCtx, writeFn = ctx.CacheContext()
+	cacheCtx, writeAnomaliesFn := k.ChannelKeeperV2.RecordCircuitBreakerAnomalies(cacheCtx)
 	ack := cbs.OnRecvPacket(cacheCtx, channelVersion, msg.Packet, relayer)
+	writeAnomaliesFn(ctx)
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
@@ -655,6 +665,28 @@ func (k *Keeper) UpdateClientConfig(goCtx context.Context, msg *clientv2types.Ms
 		)
 	}
 
+	// the delivery mode of a client cannot be changed once packets have been sent or received over it
+	config := k.ClientV2Keeper.GetConfig(ctx, msg.ClientId)
+	if config.Ordered != msg.Config.Ordered {
+		if k.ChannelKeeperV2.HasPacketFlow(ctx, msg.ClientId) {
+			return nil, errorsmod.Wrapf(clientv2types.ErrInvalidConfig, "cannot change ordering of client %s after packets have been sent or received", msg.ClientId)
+		}
+	}
+
+	// the ordering of a client cannot be changed once its counterparty has been verified to be ordered, as the
+	// counterparty relies on the client to be ordered
+	if config.Ordered != msg.Config.Ordered && k.ChannelKeeperV2.IsCounterpartyOrdered(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clientv2types.ErrInvalidConfig, "cannot change ordering of client %s after the ordering of its counterparty has been verified", msg.ClientId)
+	}
+
+	if config.Ordered != msg.Config.Ordered {
+		if msg.Config.Ordered {
+			k.ChannelKeeperV2.SetNextSequenceRecv(ctx, msg.ClientId, 1)
+		} else {
+			k.ChannelKeeperV2.DeleteNextSequenceRecv(ctx, msg.ClientId)
+		}
+	}
+
 	k.ClientV2Keeper.SetConfig(ctx, msg.ClientId, msg.Config)
 	return &clientv2types.MsgUpdateClientConfigResponse{}, nil
 }
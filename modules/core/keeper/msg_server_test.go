@@ -12,6 +12,7 @@ import (
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
@@ -272,6 +273,45 @@ func (s *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// TestHandleRecvPacketCircuitBreakerTripped tests that a packet is not received, and neither its receipt nor an
+// acknowledgement is written, if receiving packets is paused on the destination channel, and that the packet is
+// received once receiving packets is resumed.
+func (s *KeeperTestSuite) TestHandleRecvPacketCircuitBreakerTripped() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.Setup()
+
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	s.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainB.GetContext(), channeltypesv2.NewCircuitBreaker(path.EndpointB.ChannelID, false, true))
+
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String())
+
+	ctx := s.chainB.GetContext()
+	_, err = s.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+	s.Require().ErrorIs(err, channeltypesv2.ErrCircuitBreakerTripped)
+	s.Require().NotContains(ctx.EventManager().Events(), ibcmock.NewMockRecvPacketEvent())
+
+	_, found := s.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(s.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().False(found)
+	_, found = s.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(s.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().False(found)
+
+	// the packet is relayed again once receiving packets is resumed
+	s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetCircuitBreaker(s.chainB.GetContext(), channeltypesv2.NewCircuitBreaker(path.EndpointB.ChannelID, false, false))
+
+	res, err := s.chainB.App.GetIBCKeeper().RecvPacket(s.chainB.GetContext(), msg)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	ack, found := s.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(s.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+	s.Require().Equal(channeltypes.CommitAcknowledgement(ibcmock.MockAcknowledgement.Acknowledgement()), ack)
+}
+
 func (s *KeeperTestSuite) TestUpdateClient() {
 	var path *ibctesting.Path
 	testCases := []struct {
@@ -1256,6 +1296,78 @@ func (s *KeeperTestSuite) TestUpdateClientConfig() {
 			},
 			nil,
 		},
+		{
+			"success: valid authority and ordered config",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.Ordered = true
+			},
+			nil,
+		},
+		{
+			"success: valid creator and setting config to unordered before packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(s.chainA.GetContext(), path.EndpointA.ClientID).String()
+				config.Ordered = true
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				config = clientv2types.DefaultConfig()
+			},
+			nil,
+		},
+		{
+			"success: updating allowed relayers of ordered config after packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.Ordered = true
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceSend(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+				config.AllowedRelayers = []string{s.chainA.SenderAccount.GetAddress().String()}
+			},
+			nil,
+		},
+		{
+			"success: valid authority and pruning delay before packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.PruningDelay = 3600
+			},
+			nil,
+		},
+		{
+			"success: setting pruning delay after packets have been received",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(s.chainA.GetContext(), path.EndpointA.ClientID, 1)
+				config.PruningDelay = 3600
+			},
+			nil,
+		},
+		{
+			"success: changing pruning delay after packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.PruningDelay = 3600
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceSend(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+				config.PruningDelay = 60
+			},
+			nil,
+		},
+		{
+			"success: updating allowed relayers of config with pruning delay after packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.PruningDelay = 3600
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceSend(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+				config.AllowedRelayers = []string{s.chainA.SenderAccount.GetAddress().String()}
+			},
+			nil,
+		},
 		{
 			"failure: invalid signer",
 			func() {
@@ -1264,6 +1376,48 @@ func (s *KeeperTestSuite) TestUpdateClientConfig() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"failure: setting config to ordered after packets have been sent",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceSend(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+				config.Ordered = true
+			},
+			clientv2types.ErrInvalidConfig,
+		},
+		{
+			"failure: setting config to ordered after packets have been received",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(s.chainA.GetContext(), path.EndpointA.ClientID, 1)
+				config.Ordered = true
+			},
+			clientv2types.ErrInvalidConfig,
+		},
+		{
+			"failure: setting config to unordered after packets have been received",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.Ordered = true
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+				config = clientv2types.DefaultConfig()
+			},
+			clientv2types.ErrInvalidConfig,
+		},
+		{
+			"failure: setting config to unordered after the ordering of the counterparty has been verified",
+			func() {
+				signer = s.chainA.App.GetIBCKeeper().GetAuthority()
+				config.Ordered = true
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+				s.Require().NoError(err)
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetCounterpartyOrdered(s.chainA.GetContext(), path.EndpointA.ClientID)
+				config = clientv2types.DefaultConfig()
+			},
+			clientv2types.ErrInvalidConfig,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1282,6 +1436,10 @@ func (s *KeeperTestSuite) TestUpdateClientConfig() {
 				s.Require().NoError(err)
 				c := s.chainA.App.GetIBCKeeper().ClientV2Keeper.GetConfig(s.chainA.GetContext(), path.EndpointA.ClientID)
 				s.Require().Equal(config, c)
+
+				// the next receive sequence is only tracked for ordered clients
+				_, found := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(s.chainA.GetContext(), path.EndpointA.ClientID)
+				s.Require().Equal(config.Ordered, found)
 			} else {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.expError.Error())
@@ -99,16 +99,20 @@ import (
 	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icacontrollerv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/v2"
 	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icahostv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/v2"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	packetforward "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware"
 	packetforwardkeeper "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	packetforwardv2 "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/v2"
 	ratelimiting "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting"
 	ratelimitkeeper "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
 	ratelimittypes "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ratelimitingv2 "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/v2"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -380,15 +384,20 @@ func NewSimApp(
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]),
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// Packet Forward Middleware keeper
-	app.PFMKeeper = packetforwardkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]), app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.PFMKeeper = packetforwardkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]), app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// Validate the forward memo key of ICS-20 transfers before they are sent or received
+	app.TransferKeeper.RegisterMemoKeyValidator(packetforwardtypes.ForwardMetadataKey, packetforwardtypes.ValidateForwardMemo)
 
 	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[ratelimittypes.StoreKey]), app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, app.BankKeeper, govAuthority)
+	// Trip the IBC circuit breaker of a channel or client once its rate limits are exceeded too many times
+	app.RateLimitKeeper.SetCircuitBreakerKeeper(app.IBCKeeper.ChannelKeeperV2)
 
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
@@ -409,7 +418,7 @@ func NewSimApp(
 	// create IBC module from bottom to top of stack
 	transferStack := porttypes.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeper)
 	transferStack.Base(transfer.NewIBCModule(app.TransferKeeper)).
-		Next(packetforward.NewIBCMiddleware(app.PFMKeeper, 0, packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp)).
+		Next(packetforward.NewIBCMiddleware(app.PFMKeeper)).
 		Next(ratelimiting.NewIBCMiddleware(app.RateLimitKeeper))
 
 	// Add transfer stack to IBC Router
@@ -432,8 +441,22 @@ func NewSimApp(
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 
-	// register the transfer v2 module.
-	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferv2.NewIBCModule(app.TransferKeeper))
+	// create the transfer v2 stack from bottom to top
+	// - Rate Limit
+	// - Packet Forward Middleware
+	// - Transfer
+	transferStackV2 := ibcapi.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeperV2)
+	transferStackV2.Base(transferv2.NewIBCModule(app.TransferKeeper)).
+		Next(packetforwardv2.NewIBCMiddleware(app.PFMKeeper)).
+		Next(ratelimitingv2.NewIBCMiddleware(app.RateLimitKeeper))
+
+	// register the transfer v2 stack.
+	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferStackV2.Build())
+
+	// register the interchain accounts v2 applications, the controller is registered as a prefix route
+	// as every controller owner is bound to its own controller port.
+	ibcRouterV2.AddPrefixRoute(icatypes.ControllerPortRoutePrefix, icacontrollerv2.NewIBCModule(app.ICAControllerKeeper))
+	ibcRouterV2.AddRoute(icatypes.HostPortID, icahostv2.NewIBCModule(app.ICAHostKeeper))
 
 	// Set the IBC Routers
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -20,6 +20,30 @@ func (ep *Endpoint) RegisterCounterparty() error {
 	return err
 }
 
+// UpdateClientConfig will construct and execute a MsgUpdateClientConfig on the associated ep.
+func (ep *Endpoint) UpdateClientConfig(config clientv2types.Config) error {
+	msg := clientv2types.NewMsgUpdateClientConfig(ep.ClientID, ep.Chain.SenderAccount.GetAddress().String(), config)
+
+	_, err := ep.Chain.SendMsgs(msg)
+
+	return err
+}
+
+// MsgVerifyCounterpartyOrdering updates the client of the associated ep and verifies that its counterparty
+// is ordered with a proof of the next receive sequence of the counterparty.
+func (ep *Endpoint) MsgVerifyCounterpartyOrdering() error {
+	if err := ep.UpdateClient(); err != nil {
+		return err
+	}
+
+	nextSequenceRecv, _ := ep.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(ep.Counterparty.Chain.GetContext(), ep.Counterparty.ClientID)
+	proof, proofHeight := ep.Counterparty.QueryProof(hostv2.NextSequenceRecvKey(ep.Counterparty.ClientID))
+
+	msg := channeltypesv2.NewMsgVerifyCounterpartyOrdering(ep.ClientID, nextSequenceRecv, proof, proofHeight, ep.Chain.SenderAccount.GetAddress().String())
+
+	return ep.Chain.sendMsgs(msg)
+}
+
 // MsgSendPacket sends a packet on the associated endpoint using a predefined sender. The constructed packet is returned.
 func (ep *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
 	senderAccount := SenderAccount{
@@ -30,10 +54,28 @@ func (ep *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payloads ...channelty
 	return ep.MsgSendPacketWithSender(timeoutTimestamp, payloads, senderAccount)
 }
 
+// MsgSendNonAtomicPacket sends a non-atomic packet on the associated endpoint using a predefined sender. The constructed packet is returned.
+func (ep *Endpoint) MsgSendNonAtomicPacket(timeoutTimestamp uint64, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
+	senderAccount := SenderAccount{
+		SenderPrivKey: ep.Chain.SenderPrivKey,
+		SenderAccount: ep.Chain.SenderAccount,
+	}
+
+	msgSendPacket := channeltypesv2.NewMsgSendPacket(ep.ClientID, timeoutTimestamp, senderAccount.SenderAccount.GetAddress().String(), payloads...)
+	msgSendPacket.NonAtomic = true
+
+	return ep.sendPacketWithSender(msgSendPacket, senderAccount)
+}
+
 // MsgSendPacketWithSender sends a packet on the associated endpoint using the provided sender. The constructed packet is returned.
 func (ep *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, payloads []channeltypesv2.Payload, sender SenderAccount) (channeltypesv2.Packet, error) {
 	msgSendPacket := channeltypesv2.NewMsgSendPacket(ep.ClientID, timeoutTimestamp, sender.SenderAccount.GetAddress().String(), payloads...)
 
+	return ep.sendPacketWithSender(msgSendPacket, sender)
+}
+
+// sendPacketWithSender delivers the MsgSendPacket using the provided sender and returns the constructed packet.
+func (ep *Endpoint) sendPacketWithSender(msgSendPacket *channeltypesv2.MsgSendPacket, sender SenderAccount) (channeltypesv2.Packet, error) {
 	res, err := ep.Chain.SendMsgsWithSender(sender, msgSendPacket)
 	if err != nil {
 		return channeltypesv2.Packet{}, err
@@ -56,7 +98,8 @@ func (ep *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, payloads []
 	if err != nil {
 		return channeltypesv2.Packet{}, err
 	}
-	packet := channeltypesv2.NewPacket(sendResponse.Sequence, ep.ClientID, ep.Counterparty.ClientID, timeoutTimestamp, payloads...)
+	packet := channeltypesv2.NewPacket(sendResponse.Sequence, ep.ClientID, ep.Counterparty.ClientID, msgSendPacket.TimeoutTimestamp, msgSendPacket.Payloads...)
+	packet.NonAtomic = msgSendPacket.NonAtomic
 
 	err = ep.Counterparty.UpdateClient()
 	if err != nil {
@@ -127,11 +170,24 @@ func (ep *Endpoint) MsgAcknowledgePacket(packet channeltypesv2.Packet, ack chann
 }
 
 // MsgTimeoutPacket sends a MsgTimeout on the associated endpoint with the provided packet.
+// The next receive sequence of the counterparty is proven instead of the absence of the packet
+// receipt if the client of the endpoint and its counterparty are ordered.
 func (ep *Endpoint) MsgTimeoutPacket(packet channeltypesv2.Packet) error {
 	packetKey := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
+
+	var nextSequenceRecv uint64
+	if ep.Chain.App.GetIBCKeeper().ClientV2Keeper.GetConfig(ep.Chain.GetContext(), ep.ClientID).Ordered {
+		var found bool
+		nextSequenceRecv, found = ep.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(ep.Counterparty.Chain.GetContext(), packet.DestinationClient)
+		if found {
+			packetKey = hostv2.NextSequenceRecvKey(packet.DestinationClient)
+		}
+	}
+
 	proof, proofHeight := ep.Counterparty.QueryProof(packetKey)
 
 	msg := channeltypesv2.NewMsgTimeout(packet, proof, proofHeight, ep.Chain.SenderAccount.GetAddress().String())
+	msg.NextSequenceRecv = nextSequenceRecv
 
 	if err := ep.Chain.sendMsgs(msg); err != nil {
 		return err
@@ -21,6 +21,10 @@ const (
 	PortIDA = ModuleNameA
 	// PortIDB is a port ID that can be used for the second mock application.
 	PortIDB = ModuleNameB
+	// PortIDStackA is the first port ID under which the mock application stack is registered.
+	PortIDStackA = ModuleName + "stackA"
+	// PortIDStackB is the second port ID under which the mock application stack is registered.
+	PortIDStackB = ModuleName + "stackB"
 )
 
 // IBCModule is a mock implementation of the IBCModule interface.
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var (
+	_ api.Middleware            = (*Middleware)(nil)
+	_ api.PacketDataUnmarshaler = (*Middleware)(nil)
+)
+
+// Middleware is a mock implementation of the IBC v2 Middleware interface, which passes the callbacks
+// through to the underlying application and the acknowledgements through to the WriteAcknowledgementWrapper.
+type Middleware struct {
+	App             api.IBCModule
+	WriteAckWrapper api.WriteAcknowledgementWrapper
+}
+
+// NewMiddleware creates a new mock Middleware, whose underlying application and WriteAcknowledgementWrapper
+// are set by the stack builder.
+func NewMiddleware() *Middleware {
+	return &Middleware{}
+}
+
+func (im *Middleware) SetUnderlyingApplication(app api.IBCModule) {
+	im.App = app
+}
+
+func (im *Middleware) SetWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	im.WriteAckWrapper = writeAckWrapper
+}
+
+func (im *Middleware) OnSendPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	return im.App.OnSendPacket(ctx, sourceChannel, destinationChannel, sequence, payload, signer)
+}
+
+func (im *Middleware) OnRecvPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	return im.App.OnRecvPacket(ctx, sourceChannel, destinationChannel, sequence, payload, relayer)
+}
+
+func (im *Middleware) OnAcknowledgementPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	return im.App.OnAcknowledgementPacket(ctx, sourceChannel, destinationChannel, sequence, acknowledgement, payload, relayer)
+}
+
+func (im *Middleware) OnTimeoutPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	return im.App.OnTimeoutPacket(ctx, sourceChannel, destinationChannel, sequence, payload, relayer)
+}
+
+func (im *Middleware) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	return im.WriteAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+func (im *Middleware) WritePayloadAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, payloadIndex uint32, appAck []byte) error {
+	return im.WriteAckWrapper.WritePayloadAcknowledgement(ctx, clientID, sequence, payloadIndex, appAck)
+}
+
+func (im *Middleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.App.(api.PacketDataUnmarshaler).UnmarshalPacketData(payload)
+}
@@ -83,16 +83,20 @@ import (
 	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icacontrollerv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/v2"
 	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icahostv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/v2"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	packetforward "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware"
 	packetforwardkeeper "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/types"
+	packetforwardv2 "github.com/cosmos/ibc-go/v10/modules/apps/packet-forward-middleware/v2"
 	ratelimiting "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting"
 	ratelimitkeeper "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
 	ratelimittypes "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ratelimitingv2 "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/v2"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -168,6 +172,8 @@ type SimApp struct {
 
 	MockModuleV2A mockv2.IBCModule
 	MockModuleV2B mockv2.IBCModule
+	// the base application of the mock v2 application stack
+	MockModuleV2Stack mockv2.IBCModule
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -365,13 +371,18 @@ func NewSimApp(
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]),
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[ratelimittypes.StoreKey]), app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
-	app.PFMKeeper = packetforwardkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]), app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// Trip the IBC circuit breaker of a channel or client once its rate limits are exceeded too many times
+	app.RateLimitKeeper.SetCircuitBreakerKeeper(app.IBCKeeper.ChannelKeeperV2)
+	app.PFMKeeper = packetforwardkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]), app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// Validate the forward memo key of ICS-20 transfers before they are sent or received
+	app.TransferKeeper.RegisterMemoKeyValidator(packetforwardtypes.ForwardMetadataKey, packetforwardtypes.ValidateForwardMemo)
 
 	// Mock Module Stack
 
@@ -406,7 +417,7 @@ func NewSimApp(
 	transferStack := porttypes.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeper)
 	transferApp := transfer.NewIBCModule(app.TransferKeeper)
 	transferStack.Base(transferApp).
-		Next(packetforward.NewIBCMiddleware(app.PFMKeeper, 0, packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp)).
+		Next(packetforward.NewIBCMiddleware(app.PFMKeeper)).
 		Next(ratelimiting.NewIBCMiddleware(app.RateLimitKeeper))
 
 	// Add transfer stack to IBC Router
@@ -446,8 +457,37 @@ func NewSimApp(
 	ibcRouterV2.AddRoute(mockv2.PortIDB, mockV2B)
 	app.MockModuleV2B = mockV2B
 
-	// register the transfer v2 module.
-	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferv2.NewIBCModule(app.TransferKeeper))
+	// create a mock v2 application stack with multiple middlewares from bottom to top
+	// - Mock Middleware
+	// - Mock Middleware
+	// - Mock Application
+	// The stack is registered under multiple ports, which are all routed to its top level middleware.
+	app.MockModuleV2Stack = mockv2.NewIBCModule()
+	mockStackV2 := ibcapi.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeperV2)
+	mockStackV2.Base(app.MockModuleV2Stack).
+		Next(mockv2.NewMiddleware()).
+		Next(mockv2.NewMiddleware())
+
+	mockStackAppV2 := mockStackV2.Build()
+	ibcRouterV2.AddRoute(mockv2.PortIDStackA, mockStackAppV2)
+	ibcRouterV2.AddRoute(mockv2.PortIDStackB, mockStackAppV2)
+
+	// create the transfer v2 stack from bottom to top
+	// - Rate Limit
+	// - Packet Forward Middleware
+	// - Transfer
+	transferStackV2 := ibcapi.NewIBCStackBuilder(app.IBCKeeper.ChannelKeeperV2)
+	transferStackV2.Base(transferv2.NewIBCModule(app.TransferKeeper)).
+		Next(packetforwardv2.NewIBCMiddleware(app.PFMKeeper)).
+		Next(ratelimitingv2.NewIBCMiddleware(app.RateLimitKeeper))
+
+	// register the transfer v2 stack.
+	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferStackV2.Build())
+
+	// register the interchain accounts v2 applications, the controller is registered as a prefix route
+	// as every controller owner is bound to its own controller port.
+	ibcRouterV2.AddPrefixRoute(icatypes.ControllerPortRoutePrefix, icacontrollerv2.NewIBCModule(app.ICAControllerKeeper))
+	ibcRouterV2.AddRoute(icatypes.HostPortID, icahostv2.NewIBCModule(app.ICAHostKeeper))
 
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)